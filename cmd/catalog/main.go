@@ -10,6 +10,7 @@ import (
 	"github.com/hexley21/fixup/internal/catalog/server"
 	"github.com/hexley21/fixup/pkg/config"
 	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/infra/redis"
 	"github.com/hexley21/fixup/pkg/logger/zap_logger"
 	"github.com/hexley21/fixup/pkg/validator/playground_validator"
 )
@@ -40,6 +41,11 @@ func main() {
 		zapLogger.Fatal(err)
 	}
 
+	redisCluster, err := redis.NewClient(&cfg.Redis)
+	if err != nil {
+		zapLogger.Fatal(err)
+	}
+
 	snowflakeNode, err := snowflake.NewNode(cfg.Server.InstanceId)
 	if err != nil {
 		zapLogger.Fatal(err)
@@ -48,6 +54,7 @@ func main() {
 	catalogServer := server.NewServer(
 		cfg,
 		pgPool,
+		redisCluster,
 		zapLogger,
 		snowflakeNode,
 		playgroundValidator,
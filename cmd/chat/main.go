@@ -2,15 +2,32 @@ package main
 
 import (
 	"errors"
-	"fmt"
 	"log"
 	"net/http"
 
-	"github.com/go-chi/chi/v5"
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/cmd/util/shutdown"
+	"github.com/hexley21/fixup/internal/chat/server"
 	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/infra/cassandra"
+	"github.com/hexley21/fixup/pkg/infra/redis"
+	"github.com/hexley21/fixup/pkg/infra/s3"
 	"github.com/hexley21/fixup/pkg/logger/zap_logger"
+	"github.com/hexley21/fixup/pkg/validator/playground_validator"
 )
 
+// @title Chat Microservice
+// @version 1.0.0-alpha0
+// @description Handles real-time chat between users
+// @license.name Apache 2.0
+// @license.url http://www.apache.org/licenses/LICENSE-2.0.html
+// @host localhost:80
+// @BasePath /v1
+// @schemes http
+//
+// @securityDefinitions.apikey access_token
+// @in header
+// @name Authorization
 func main() {
 	cfg, err := config.LoadConfig("./config/config.yml")
 	if err != nil {
@@ -18,32 +35,45 @@ func main() {
 	}
 
 	zapLogger := zap_logger.New(cfg.Logging, cfg.Server.IsProd)
-	
-	mux := &http.Server{
-		Addr:         fmt.Sprintf(":%d", cfg.HTTP.Port),
-		Handler:      chi.NewMux(),
-		IdleTimeout:  cfg.HTTP.IdleTimeout,
-		ReadTimeout:  cfg.HTTP.ReadTimeout,
-		WriteTimeout: cfg.HTTP.WriteTimeout,
+	playgroundValidator := playground_validator.New()
+
+	session, err := cassandra.NewSession(&cfg.Cassandra)
+	if err != nil {
+		zapLogger.Fatal(err)
+	}
+
+	redisCluster, err := redis.NewClient(&cfg.Redis)
+	if err != nil {
+		zapLogger.Fatal(err)
 	}
 
-	zapLogger.Debug("Server Started")
-	if !errors.Is(mux.ListenAndServe(), http.ErrServerClosed) {
+	awsS3Bucket, err := s3.NewClient(cfg.AWS.AWSCfg, cfg.AWS.S3)
+	if err != nil {
 		zapLogger.Fatal(err)
 	}
 
-	zapLogger.Info("Server Stopped")
-	// playgroundValidator := playground_validator.New()
+	snowflakeNode, err := snowflake.NewNode(cfg.Server.InstanceId)
+	if err != nil {
+		zapLogger.Fatal(err)
+	}
 
-	// pgPool, err := postgres.NewPool(&cfg.Postgres)
-	// if err != nil {
-	// 	zapLogger.Fatal(err)
-	// }
+	chatServer := server.NewServer(
+		cfg,
+		session,
+		redisCluster,
+		awsS3Bucket,
+		zapLogger,
+		snowflakeNode,
+		playgroundValidator,
+	)
 
-	// snowflakeNode, err := snowflake.NewNode(cfg.Server.InstanceId)
-	// if err != nil {
-	// 	zapLogger.Fatal(err)
-	// }
+	shutdownChan := make(chan struct{})
+	go shutdown.NotifyShutdown(chatServer, zapLogger, shutdownChan)
+
+	log.Print("Chat service started...")
+	if !errors.Is(chatServer.Run(), http.ErrServerClosed) {
+		zapLogger.Fatal(err)
+	}
 
-	
+	zapLogger.Info("Chat service stopped...")
 }
@@ -2,15 +2,31 @@ package main
 
 import (
 	"errors"
-	"fmt"
 	"log"
 	"net/http"
 
-	"github.com/go-chi/chi/v5"
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/cmd/util/shutdown"
+	"github.com/hexley21/fixup/internal/order/server"
 	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/infra/cassandra"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
 	"github.com/hexley21/fixup/pkg/logger/zap_logger"
+	"github.com/hexley21/fixup/pkg/validator/playground_validator"
 )
 
+// @title Order Microservice
+// @version 1.0.0-alpha0
+// @description Handles order operations
+// @license.name Apache 2.0
+// @license.url http://www.apache.org/licenses/LICENSE-2.0.html
+// @host localhost:80
+// @BasePath /v1
+// @schemes http
+//
+// @securityDefinitions.apikey access_token
+// @in header
+// @name Authorization
 func main() {
 	cfg, err := config.LoadConfig("./config/config.yml")
 	if err != nil {
@@ -18,31 +34,45 @@ func main() {
 	}
 
 	zapLogger := zap_logger.New(cfg.Logging, cfg.Server.IsProd)
+	playgroundValidator := playground_validator.New()
 
-	mux := &http.Server{
-		Addr:         fmt.Sprintf(":%d", cfg.HTTP.Port),
-		Handler:      chi.NewMux(),
-		IdleTimeout:  cfg.HTTP.IdleTimeout,
-		ReadTimeout:  cfg.HTTP.ReadTimeout,
-		WriteTimeout: cfg.HTTP.WriteTimeout,
+	pgPool, err := postgres.NewPool(&cfg.Postgres)
+	if err != nil {
+		zapLogger.Fatal(err)
 	}
 
-	zapLogger.Debug("Server Started")
-	if !errors.Is(mux.ListenAndServe(), http.ErrServerClosed) {
+	catalogPgPool, err := postgres.NewPool(&cfg.CatalogPostgres)
+	if err != nil {
 		zapLogger.Fatal(err)
 	}
 
-	zapLogger.Info("Server Stopped")
-	// playgroundValidator := playground_validator.New()
+	chatSession, err := cassandra.NewSession(&cfg.Cassandra)
+	if err != nil {
+		zapLogger.Fatal(err)
+	}
 
-	// pgPool, err := postgres.NewPool(&cfg.Postgres)
-	// if err != nil {
-	// 	zapLogger.Fatal(err)
-	// }
+	snowflakeNode, err := snowflake.NewNode(cfg.Server.InstanceId)
+	if err != nil {
+		zapLogger.Fatal(err)
+	}
+
+	orderServer := server.NewServer(
+		cfg,
+		pgPool,
+		catalogPgPool,
+		chatSession,
+		zapLogger,
+		snowflakeNode,
+		playgroundValidator,
+	)
 
-	// snowflakeNode, err := snowflake.NewNode(cfg.Server.InstanceId)
-	// if err != nil {
-	// 	zapLogger.Fatal(err)
-	// }
+	shutdownChan := make(chan struct{})
+	go shutdown.NotifyShutdown(orderServer, zapLogger, shutdownChan)
+
+	log.Print("Order service started...")
+	if !errors.Is(orderServer.Run(), http.ErrServerClosed) {
+		zapLogger.Fatal(err)
+	}
 
+	zapLogger.Info("Order service stopped...")
 }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/hexley21/fixup/internal/order/seed"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+// Bulk loads order reference data (cities and currencies) from csv files.
+//
+// Usage:
+//
+//	seed -cities ./worldcities.csv -currencies ./currencies.csv
+func main() {
+	configPath := flag.String("config", "./config/config.yml", "path to the order service config")
+	citiesPath := flag.String("cities", "", "path to the cities csv file")
+	currenciesPath := flag.String("currencies", "", "path to the currencies csv file")
+	flag.Parse()
+
+	if *citiesPath == "" && *currenciesPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		log.Fatalf("could not load config: %v\n", err)
+	}
+
+	pgPool, err := postgres.NewPool(&cfg.Postgres)
+	if err != nil {
+		log.Fatalf("could not connect to database: %v\n", err)
+	}
+	defer pgPool.Close()
+
+	seeder := seed.NewSeeder(pgPool)
+	ctx := context.Background()
+
+	if *currenciesPath != "" {
+		currencies, err := readFile(*currenciesPath, seed.ReadCurrencies)
+		if err != nil {
+			log.Fatalf("could not read currencies: %v\n", err)
+		}
+
+		inserted, err := seeder.SeedCurrencies(ctx, currencies)
+		if err != nil {
+			log.Fatalf("could not seed currencies: %v\n", err)
+		}
+		log.Printf("Seed currencies - read: %d, inserted: %d", len(currencies), inserted)
+	}
+
+	if *citiesPath != "" {
+		cities, err := readFile(*citiesPath, seed.ReadCities)
+		if err != nil {
+			log.Fatalf("could not read cities: %v\n", err)
+		}
+
+		inserted, err := seeder.SeedCities(ctx, cities)
+		if err != nil {
+			log.Fatalf("could not seed cities: %v\n", err)
+		}
+		log.Printf("Seed cities - read: %d, inserted: %d", len(cities), inserted)
+	}
+}
+
+func readFile[T any](path string, read func(r io.Reader) ([]T, error)) ([]T, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return read(f)
+}
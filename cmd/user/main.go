@@ -18,6 +18,9 @@ import (
 	"github.com/hexley21/fixup/pkg/logger/zap_logger"
 	"github.com/hexley21/fixup/pkg/mailer"
 	"github.com/hexley21/fixup/pkg/mailer/gomail"
+	"github.com/hexley21/fixup/pkg/oidc"
+	"github.com/hexley21/fixup/pkg/sms"
+	"github.com/hexley21/fixup/pkg/sms/gateway"
 	"github.com/hexley21/fixup/pkg/validator/playground_validator"
 )
 
@@ -76,6 +79,12 @@ func main() {
 	} else {
 		goMailer = gomail.NewDev(&cfg.Mailer)
 	}
+	var smsSender sms.Sender
+	if cfg.Server.IsProd {
+		smsSender = gateway.New(&cfg.SMS)
+	} else {
+		smsSender = gateway.NewDev(zapLogger)
+	}
 	argon2Hasher := argon2.NewHasher(cfg.Argon2)
 	aesEncryption := aes.NewAesEncryptor(cfg.AesEncryptor.Key)
 
@@ -90,7 +99,10 @@ func main() {
 		awsCloudFrontCdn,
 		argon2Hasher,
 		aesEncryption,
+		aesEncryption,
 		goMailer,
+		smsSender,
+		oidc.New(&cfg.OIDC),
 	)
 
 	shutdownChan := make(chan struct{})
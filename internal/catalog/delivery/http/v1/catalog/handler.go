@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.CatalogTreeService
+	searchService  service.SearchService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.CatalogTreeService,
+	searchService service.SearchService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		searchService:  searchService,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// GetTree
+// @Summary Retrieve the catalog tree
+// @Description Retrieves the whole catalog as category types nesting their categories, subcategories and services.
+// @Description The response carries an ETag, a request with a matching If-None-Match header gets 304 without a body.
+// @Tags Catalog
+// @Param If-None-Match header string false "ETag of a previously received tree"
+// @Success 200 {object} rest.ApiResponse[dto.CatalogTree] "OK - Successfully retrieved the catalog tree"
+// @Success 304 "Not Modified - The tree has not changed since the given ETag"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /catalog/tree [get]
+func (h *Handler) GetTree(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.service.Get(r.Context())
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get catalog tree: %w", err))
+		return
+	}
+
+	etag := `"` + tree.Version + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		h.Writer.WriteNoContent(w, http.StatusNotModified)
+		return
+	}
+
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCatalogTreeToDTO(tree))
+}
+
+// etagMatches reports whether the If-None-Match header lists the etag, weak validators are compared by their opaque tag.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
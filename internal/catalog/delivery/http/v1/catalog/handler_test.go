@@ -0,0 +1,315 @@
+package catalog_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/catalog"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	mock_service "github.com/hexley21/fixup/internal/catalog/service/mock"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const etag = `"version"`
+
+var catalogTree = domain.CatalogTree{
+	Version: "version",
+	Types: []domain.CatalogTreeType{
+		{
+			ID:   1,
+			Name: "Home",
+			Categories: []domain.CatalogTreeCategory{
+				{ID: 2, Name: "Plumbing"},
+			},
+		},
+	},
+}
+
+func setup(t *testing.T) (
+	ctrl *gomock.Controller,
+	mockCatalogTreeService *mock_service.MockCatalogTreeService,
+	mockSearchService *mock_service.MockSearchService,
+	r chi.Router,
+) {
+	ctrl = gomock.NewController(t)
+	mockCatalogTreeService = mock_service.NewMockCatalogTreeService(ctrl)
+	mockSearchService = mock_service.NewMockSearchService(ctrl)
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	h := catalog.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mock_validator.NewMockValidator(ctrl), json_writer.New(logger, jsonManager)),
+		mockCatalogTreeService,
+		mockSearchService,
+		50,
+		100,
+	)
+
+	r = chi.NewRouter()
+	catalog.MapRoutes(h, r)
+
+	return
+}
+
+func TestGetTree(t *testing.T) {
+	ctrl, serviceMock, _, r := setup(t)
+	defer ctrl.Finish()
+
+	serviceMock.EXPECT().Get(gomock.Any()).Return(catalogTree, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/catalog/tree", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+	var response rest.ApiResponse[dto.CatalogTree]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+		assert.Equal(t, dto.CatalogTree{CategoryTypes: []dto.CatalogTreeType{
+			{
+				ID:   "1",
+				Name: "Home",
+				Categories: []dto.CatalogTreeCategory{
+					{ID: "2", Name: "Plumbing", Subcategories: []dto.CatalogTreeSubcategory{}},
+				},
+			},
+		}}, response.Data)
+	}
+}
+
+func TestGetTree_IfNoneMatch(t *testing.T) {
+	ctrl, serviceMock, _, r := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		ifNoneMatch  string
+		expectedCode int
+	}{
+		{
+			name:         "Matching",
+			ifNoneMatch:  etag,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "Weak In List",
+			ifNoneMatch:  `"stale", W/"version"`,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "Stale",
+			ifNoneMatch:  `"stale"`,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock.EXPECT().Get(gomock.Any()).Return(catalogTree, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/catalog/tree", nil)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			assert.Equal(t, etag, rec.Header().Get("ETag"))
+			if tt.expectedCode == http.StatusNotModified {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetTree_ServiceError(t *testing.T) {
+	ctrl, serviceMock, _, r := setup(t)
+	defer ctrl.Finish()
+
+	serviceMock.EXPECT().Get(gomock.Any()).Return(domain.CatalogTree{}, errors.New(""))
+
+	req := httptest.NewRequest(http.MethodGet, "/catalog/tree", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Empty(t, rec.Header().Get("ETag"))
+}
+
+func TestSearch(t *testing.T) {
+	ctrl, _, searchMock, r := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		url           string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			url:  "/catalog/search?q=leaking+tap&page=1&per_page=10",
+			mockSetup: func() {
+				searchMock.EXPECT().Search(gomock.Any(), "leaking tap", int64(10), int64(0)).Return([]domain.SearchResult{
+					{
+						CatalogNode: domain.NewCatalogNode(domain.CatalogKindSubcategory, 2, "Taps"),
+						Path:        []domain.CatalogNode{domain.NewCatalogNode(domain.CatalogKindCategoryType, 1, "Home")},
+					},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Empty Query",
+			url:  "/catalog/search?page=1",
+			mockSetup: func() {
+				searchMock.EXPECT().Search(gomock.Any(), "", int64(50), int64(0)).Return(nil, service.ErrEmptySearchQuery)
+			},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: service.ErrEmptySearchQuery.Error(),
+		},
+		{
+			name:         "Missing Page",
+			url:          "/catalog/search?q=tap",
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSearch_Response(t *testing.T) {
+	ctrl, _, searchMock, r := setup(t)
+	defer ctrl.Finish()
+
+	searchMock.EXPECT().Search(gomock.Any(), "tap", int64(50), int64(0)).Return([]domain.SearchResult{
+		{
+			CatalogNode: domain.NewCatalogNode(domain.CatalogKindSubcategory, 2, "Taps"),
+			Path:        []domain.CatalogNode{domain.NewCatalogNode(domain.CatalogKindCategoryType, 1, "Home")},
+			Rank:        0.5,
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/catalog/search?q=tap&page=1", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	var response rest.ApiResponse[[]dto.SearchResult]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+		assert.Equal(t, []dto.SearchResult{
+			{
+				CatalogNode: dto.CatalogNode{Kind: "subcategory", ID: "2", Name: "Taps"},
+				Path:        []dto.CatalogNode{{Kind: "category_type", ID: "1", Name: "Home"}},
+				Rank:        0.5,
+			},
+		}, response.Data)
+	}
+}
+
+func TestAutocomplete(t *testing.T) {
+	ctrl, _, searchMock, r := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		url           string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			url:  "/catalog/autocomplete?q=ta&limit=5",
+			mockSetup: func() {
+				searchMock.EXPECT().Autocomplete(gomock.Any(), "ta", int64(5)).Return([]domain.CatalogNode{
+					domain.NewCatalogNode(domain.CatalogKindSubcategory, 2, "Taps"),
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Default Limit",
+			url:  "/catalog/autocomplete?q=ta",
+			mockSetup: func() {
+				searchMock.EXPECT().Autocomplete(gomock.Any(), "ta", int64(10)).Return(nil, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:          "Invalid Limit",
+			url:           "/catalog/autocomplete?q=ta&limit=11",
+			mockSetup:     func() {},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: catalog.ErrInvalidLimit.Error(),
+		},
+		{
+			name: "Service Error",
+			url:  "/catalog/autocomplete?q=ta",
+			mockSetup: func() {
+				searchMock.EXPECT().Autocomplete(gomock.Any(), "ta", int64(10)).Return(nil, errors.New(""))
+			},
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: rest.MsgInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, expectedError string) {
+	var errResp rest.ErrorResponse
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp)) {
+		assert.Equal(t, expectedError, errResp.Message)
+	}
+}
@@ -0,0 +1,13 @@
+package catalog
+
+import (
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(h *Handler, router chi.Router) {
+	router.Route("/catalog", func(r chi.Router) {
+		r.Get("/tree", h.GetTree)
+		r.Get("/search", h.Search)
+		r.Get("/autocomplete", h.Autocomplete)
+	})
+}
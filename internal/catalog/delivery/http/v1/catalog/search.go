@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+const maxSuggestions = 10
+
+var (
+	ErrInvalidLimit = errors.New("limit must be a number between 1 and 10")
+)
+
+// Search
+// @Summary Search the catalog
+// @Description Searches the names and descriptions of category types, categories, subcategories and services.
+// @Description Results are ranked by relevance, tolerate typos and carry the path of their ancestors.
+// @Tags Catalog
+// @Param q query string true "Search query"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.SearchResult] "OK - Successfully searched the catalog"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /catalog/search [get]
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	results, err := h.searchService.Search(r.Context(), r.URL.Query().Get("q"), limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEmptySearchQuery):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to search catalog: %w", err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Search catalog - %d", len(results))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapSearchResultsToDTO(results))
+}
+
+// Autocomplete
+// @Summary Autocomplete catalog names
+// @Description Suggests catalog entries with a word of their name starting with the given prefix.
+// @Tags Catalog
+// @Param q query string true "Prefix"
+// @Param limit query int false "Number of suggestions, 10 at most"
+// @Success 200 {object} rest.ApiResponse[[]dto.CatalogNode] "OK - Successfully retrieved the suggestions"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /catalog/autocomplete [get]
+func (h *Handler) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := int64(maxSuggestions)
+	if limitParam := query.Get("limit"); limitParam != "" {
+		parsed, err := strconv.ParseInt(limitParam, 10, 64)
+		if err != nil || parsed < 1 || parsed > maxSuggestions {
+			h.Writer.WriteError(w, rest.NewBadRequestError(ErrInvalidLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.searchService.Autocomplete(r.Context(), query.Get("q"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEmptySearchQuery):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to autocomplete catalog: %w", err))
+		}
+		return
+	}
+
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCatalogNodesToDTO(suggestions))
+}
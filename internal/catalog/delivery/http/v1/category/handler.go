@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/internal/catalog/service"
 	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/pkg/http/handler"
@@ -90,7 +91,8 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Retrieve categories
 // @Description Retrieves a category range
 // @Tags Category
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.Category] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -101,6 +103,11 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 // @Router /categories [get]
 // @Security access_token
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	if request_util.UsesCursor(r) {
+		h.listKeyset(w, r)
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -123,12 +130,29 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	h.Writer.WriteData(w, http.StatusOK, categoryDTOs)
 }
 
+func (h *Handler) listKeyset(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	categoryEntities, err := h.service.ListKeyset(r.Context(), domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch categories: %w", err))
+		return
+	}
+
+	h.writeCategoryPage(w, cursor, limit, categoryEntities)
+}
+
 // ListByTypeId
 // @Summary Retrieve categories
 // @Description Retrieves a category range
 // @Tags Category
 // @Param type_id path int true "Category Type id"
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.Category] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -145,6 +169,11 @@ func (h *Handler) ListByTypeId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request_util.UsesCursor(r) {
+		h.listByTypeIdKeyset(w, r, int32(id))
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -167,6 +196,35 @@ func (h *Handler) ListByTypeId(w http.ResponseWriter, r *http.Request) {
 	h.Writer.WriteData(w, http.StatusOK, categoryDTOs)
 }
 
+func (h *Handler) listByTypeIdKeyset(w http.ResponseWriter, r *http.Request, id int32) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	categoryEntities, err := h.service.ListByTypeIdKeyset(r.Context(), id, domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch categories - type id: %d, error: %w", id, err))
+		return
+	}
+
+	h.writeCategoryPage(w, cursor, limit, categoryEntities)
+}
+
+func (h *Handler) writeCategoryPage(w http.ResponseWriter, cursor request_util.Cursor, limit int64, categoryEntities []domain.Category) {
+	categoriesLen := len(categoryEntities)
+	categoryDTOs := make([]dto.Category, categoriesLen)
+	for i, c := range categoryEntities {
+		categoryDTOs[i] = mapper.MapCategoryToDTO(c)
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, categoryEntities, func(c domain.Category) int64 { return int64(c.ID) })
+
+	h.Logger.Infof("Fetch categories - %d", categoriesLen)
+	h.Writer.WriteCursorData(w, http.StatusOK, categoryDTOs, nextCursor, prevCursor)
+}
+
 // Get
 // @Summary Retrieve a category by ID
 // @Description Retrieves a category specified by the ID.
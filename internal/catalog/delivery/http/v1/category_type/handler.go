@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/internal/catalog/service"
 	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/pkg/http/handler"
@@ -81,7 +82,8 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 // @Summary Retrieve a category types
 // @Description Retrieves a category type range
 // @Tags CategoryType
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.CategoryType] "OK - Successfully retrieved the category types"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -92,6 +94,11 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 // @Router /category-types [get]
 // @Security access_token
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	if request_util.UsesCursor(r) {
+		h.listKeyset(w, r)
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -114,6 +121,31 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 	h.Writer.WriteData(w, http.StatusOK, typeDTOs)
 }
 
+func (h *Handler) listKeyset(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	typeEntities, err := h.service.ListKeyset(r.Context(), domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch list of cateogry types: %w", err))
+		return
+	}
+
+	typesLen := len(typeEntities)
+	typeDTOs := make([]dto.CategoryType, typesLen)
+	for i, ct := range typeEntities {
+		typeDTOs[i] = mapper.MapCategoryTypeToDTO(ct)
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, typeEntities, func(ct domain.CategoryType) int64 { return int64(ct.ID) })
+
+	h.Logger.Infof("Fetch category types - %d", typesLen)
+	h.Writer.WriteCursorData(w, http.StatusOK, typeDTOs, nextCursor, prevCursor)
+}
+
 // Get
 // @Summary Retrieve a category type by ID
 // @Description Retrieves a category type specified by the ID.
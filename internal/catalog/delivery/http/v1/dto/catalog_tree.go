@@ -0,0 +1,27 @@
+package dto
+
+type (
+	CatalogTree struct {
+		CategoryTypes []CatalogTreeType `json:"category_types"`
+	} // @name CatalogTree
+	CatalogTreeType struct {
+		ID         string                `json:"id"`
+		Name       string                `json:"name"`
+		Categories []CatalogTreeCategory `json:"categories"`
+	} // @name CatalogTreeType
+	CatalogTreeCategory struct {
+		ID            string                   `json:"id"`
+		Name          string                   `json:"name"`
+		Subcategories []CatalogTreeSubcategory `json:"subcategories"`
+	} // @name CatalogTreeCategory
+	CatalogTreeSubcategory struct {
+		ID       string               `json:"id"`
+		Name     string               `json:"name"`
+		Services []CatalogTreeService `json:"services"`
+	} // @name CatalogTreeSubcategory
+	CatalogTreeService struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} // @name CatalogTreeService
+)
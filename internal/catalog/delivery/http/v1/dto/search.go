@@ -0,0 +1,15 @@
+package dto
+
+type (
+	CatalogNode struct {
+		Kind string `json:"kind"`
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} // @name CatalogNode
+	SearchResult struct {
+		CatalogNode
+		Description string        `json:"description,omitempty"`
+		Path        []CatalogNode `json:"path"`
+		Rank        float32       `json:"rank"`
+	} // @name SearchResult
+)
@@ -0,0 +1,34 @@
+package dto
+
+type (
+	Service struct {
+		ID string `json:"id"`
+		ServiceInfo
+	} // @name Service
+	ServiceInfo struct {
+		Name          string `json:"name" validate:"min=2,max=100,required"`
+		Description   string `json:"description" validate:"max=1000"`
+		SubcategoryID string `json:"subcategory_id" validate:"number"`
+	} // @name ServiceInfo
+	ProviderServiceInput struct {
+		ServiceID string `json:"service_id" validate:"number,required"`
+	} // @name ProviderServiceInput
+	ServiceProvider struct {
+		ProviderID string `json:"provider_id"`
+	} // @name ServiceProvider
+)
+
+func NewServiceDTO(id string, name string, description string, subcategoryId string) Service {
+	return Service{
+		ID:          id,
+		ServiceInfo: NewServiceInfoDTO(name, description, subcategoryId),
+	}
+}
+
+func NewServiceInfoDTO(name string, description string, subcategoryId string) ServiceInfo {
+	return ServiceInfo{
+		Name:          name,
+		Description:   description,
+		SubcategoryID: subcategoryId,
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+type (
+	Translation struct {
+		Locale string `json:"locale"`
+		TranslationInfo
+	} // @name Translation
+	TranslationInfo struct {
+		Name        string `json:"name" validate:"min=2,max=100,required"`
+		Description string `json:"description,omitempty" validate:"max=1000"`
+	} // @name TranslationInfo
+)
+
+func NewTranslationDTO(locale string, name string, description string) Translation {
+	return Translation{
+		Locale:          locale,
+		TranslationInfo: NewTranslationInfoDTO(name, description),
+	}
+}
+
+func NewTranslationInfoDTO(name string, description string) TranslationInfo {
+	return TranslationInfo{
+		Name:        name,
+		Description: description,
+	}
+}
@@ -0,0 +1,30 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+)
+
+// MapCatalogTreeToDTO maps every level of the tree, empty branches are mapped to empty lists rather than null.
+func MapCatalogTreeToDTO(entity domain.CatalogTree) dto.CatalogTree {
+	types := make([]dto.CatalogTreeType, len(entity.Types))
+	for i, t := range entity.Types {
+		categories := make([]dto.CatalogTreeCategory, len(t.Categories))
+		for j, c := range t.Categories {
+			subcategories := make([]dto.CatalogTreeSubcategory, len(c.Subcategories))
+			for k, sc := range c.Subcategories {
+				services := make([]dto.CatalogTreeService, len(sc.Services))
+				for l, s := range sc.Services {
+					services[l] = dto.CatalogTreeService{ID: strconv.Itoa(int(s.ID)), Name: s.Name, Description: s.Description}
+				}
+				subcategories[k] = dto.CatalogTreeSubcategory{ID: strconv.Itoa(int(sc.ID)), Name: sc.Name, Services: services}
+			}
+			categories[j] = dto.CatalogTreeCategory{ID: strconv.Itoa(int(c.ID)), Name: c.Name, Subcategories: subcategories}
+		}
+		types[i] = dto.CatalogTreeType{ID: strconv.Itoa(int(t.ID)), Name: t.Name, Categories: categories}
+	}
+
+	return dto.CatalogTree{CategoryTypes: types}
+}
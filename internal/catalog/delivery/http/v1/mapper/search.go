@@ -0,0 +1,39 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+)
+
+func MapCatalogNodeToDTO(entity domain.CatalogNode) dto.CatalogNode {
+	return dto.CatalogNode{
+		Kind: string(entity.Kind),
+		ID:   strconv.Itoa(int(entity.ID)),
+		Name: entity.Name,
+	}
+}
+
+func MapCatalogNodesToDTO(entities []domain.CatalogNode) []dto.CatalogNode {
+	dtos := make([]dto.CatalogNode, len(entities))
+	for i, entity := range entities {
+		dtos[i] = MapCatalogNodeToDTO(entity)
+	}
+
+	return dtos
+}
+
+func MapSearchResultsToDTO(entities []domain.SearchResult) []dto.SearchResult {
+	dtos := make([]dto.SearchResult, len(entities))
+	for i, entity := range entities {
+		dtos[i] = dto.SearchResult{
+			CatalogNode: MapCatalogNodeToDTO(entity.CatalogNode),
+			Description: entity.Description,
+			Path:        MapCatalogNodesToDTO(entity.Path),
+			Rank:        entity.Rank,
+		}
+	}
+
+	return dtos
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+)
+
+func MapServiceInfoToVO(dto dto.ServiceInfo) (domain.ServiceInfo, error) {
+	intId, err := strconv.ParseInt(dto.SubcategoryID, 10, 32)
+	if err != nil {
+		return domain.ServiceInfo{}, err
+	}
+
+	return domain.NewServiceInfo(int32(intId), dto.Name, dto.Description), nil
+}
+
+func MapServiceToDTO(entity domain.Service) dto.Service {
+	return dto.NewServiceDTO(
+		strconv.Itoa(int(entity.ID)),
+		entity.Info.Name,
+		entity.Info.Description,
+		strconv.Itoa(int(entity.Info.SubcategoryID)),
+	)
+}
+
+func MapServicesToDTO(entities []domain.Service) []dto.Service {
+	dtos := make([]dto.Service, len(entities))
+	for i, entity := range entities {
+		dtos[i] = MapServiceToDTO(entity)
+	}
+
+	return dtos
+}
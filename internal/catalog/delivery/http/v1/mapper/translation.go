@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+)
+
+func MapTranslationInfoToVO(dto dto.TranslationInfo) domain.TranslationInfo {
+	return domain.NewTranslationInfo(dto.Name, dto.Description)
+}
+
+func MapTranslationToDTO(entity domain.Translation) dto.Translation {
+	return dto.NewTranslationDTO(entity.Locale, entity.Info.Name, entity.Info.Description)
+}
+
+func MapTranslationsToDTO(entities []domain.Translation) []dto.Translation {
+	dtos := make([]dto.Translation, len(entities))
+	for i, entity := range entities {
+		dtos[i] = MapTranslationToDTO(entity)
+	}
+
+	return dtos
+}
@@ -2,9 +2,12 @@ package v1
 
 import (
 	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/catalog"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/category"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/category_type"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/services"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/subcategory"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/translation"
 	"github.com/hexley21/fixup/internal/catalog/service"
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
 	"github.com/hexley21/fixup/internal/common/enum"
@@ -17,16 +20,23 @@ type RouterArgs struct {
 	CategoryTypeService service.CategoryTypeService
 	CategoryService     service.CategoryService
 	SubcategoryService  service.SubcategoryService
+	ServiceService      service.ServiceService
+	CatalogTreeService  service.CatalogTreeService
+	SearchService       service.SearchService
+	TranslationService  service.TranslationService
 	Middleware          *middleware.Middleware
 	HandlerComponents   *handler.Components
 	AccessJWTManager    auth_jwt.Manager
 	PaginationConfig    *config.Pagination
+	LocalizationConfig  *config.Localization
 }
 
 func MapV1Routes(args RouterArgs, router chi.Router) {
 	accessJWTMiddleware := args.Middleware.NewJWT(args.AccessJWTManager)
 	onlyVerifiedMiddleware := args.Middleware.NewAllowVerified(true)
 	onlyAdminMiddleware := args.Middleware.NewAllowRoles(enum.UserRoleADMIN)
+	onlyProviderMiddleware := args.Middleware.NewAllowRoles(enum.UserRolePROVIDER)
+	localeMiddleware := args.Middleware.NewLocale(args.LocalizationConfig.DefaultLocale, args.LocalizationConfig.Locales)
 
 	categoryTypesHandler := category_type.NewHandler(
 		args.HandlerComponents,
@@ -49,9 +59,34 @@ func MapV1Routes(args RouterArgs, router chi.Router) {
 		args.PaginationConfig.XLargePages,
 	)
 
+	servicesHandler := services.NewHandler(
+		args.HandlerComponents,
+		args.ServiceService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	catalogHandler := catalog.NewHandler(
+		args.HandlerComponents,
+		args.CatalogTreeService,
+		args.SearchService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	translationHandler := translation.NewHandler(
+		args.HandlerComponents,
+		args.TranslationService,
+	)
+
 	router.Route("/v1", func(r chi.Router) {
+		r.Use(localeMiddleware)
+
+		catalog.MapRoutes(catalogHandler, r)
 		category_type.MapRoutes(categoryTypesHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, r)
 		category.MapRoutes(categoryHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, r)
 		subcategory.MapRoutes(subcategoryHandler, accessJWTMiddleware, onlyAdminMiddleware, onlyAdminMiddleware, r)
+		services.MapRoutes(servicesHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, onlyProviderMiddleware, r)
+		translation.MapRoutes(translationHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, r)
 	})
 }
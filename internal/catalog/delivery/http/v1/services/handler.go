@@ -0,0 +1,351 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.ServiceService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.ServiceService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Create
+// @Summary Create a new service
+// @Description Creates a new service in the subcategory with the provided data.
+// @Tags Service
+// @Param dto body dto.ServiceInfo true "Service info"
+// @Success 201 {object} rest.ApiResponse[dto.Service] "Created - Successfully created the service"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found - Subcategory not found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services [post]
+// @Security access_token
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var infoDTO dto.ServiceInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapServiceInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidArgumentsError(err))
+		return
+	}
+
+	serviceId, err := h.service.Create(r.Context(), infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNameTaken):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		case errors.Is(err, service.ErrSubcategoryNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create service: %w", err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Create service: %s, Subcategory-ID: %d ID: %d", infoVO.Name, infoVO.SubcategoryID, serviceId)
+	h.Writer.WriteData(w, http.StatusCreated, dto.Service{
+		ID:          strconv.Itoa(int(serviceId)),
+		ServiceInfo: infoDTO,
+	})
+}
+
+// List
+// @Summary Retrieve services
+// @Description Retrieves a service range
+// @Tags Service
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	if request_util.UsesCursor(r) {
+		h.listKeyset(w, r)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	services, err := h.service.List(r.Context(), limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch services: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Fetch services - %d", len(services))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapServicesToDTO(services))
+}
+
+func (h *Handler) listKeyset(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	services, err := h.service.ListKeyset(r.Context(), domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch services: %w", err))
+		return
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, services, serviceCursorID)
+
+	h.Logger.Infof("Fetch services - %d", len(services))
+	h.Writer.WriteCursorData(w, http.StatusOK, mapper.MapServicesToDTO(services), nextCursor, prevCursor)
+}
+
+// Get
+// @Summary Retrieve a service by ID
+// @Description Retrieves a service specified by the ID.
+// @Tags Service
+// @Param service_id path int true "The ID of the service to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services/{service_id} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	serviceEntity, err := h.service.Get(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get service - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch service: %s, ID: %d", serviceEntity.Info.Name, serviceEntity.ID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapServiceToDTO(serviceEntity))
+}
+
+// Update
+// @Summary Update a service by ID
+// @Description Updates a service specified by the ID.
+// @Tags Service
+// @Param service_id path int true "The ID of the service to update"
+// @Param dto body dto.ServiceInfo true "Service info"
+// @Success 200 {object} rest.ApiResponse[dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services/{service_id} [patch]
+// @Security access_token
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	var infoDTO dto.ServiceInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapServiceInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidArgumentsError(err))
+		return
+	}
+
+	serviceEntity, err := h.service.Update(r.Context(), int32(id), infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNameTaken):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		case errors.Is(err, service.ErrServiceNotFound), errors.Is(err, service.ErrSubcategoryNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update service - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Update service: %s, Subcategory-ID: %d ID: %d", serviceEntity.Info.Name, serviceEntity.Info.SubcategoryID, serviceEntity.ID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapServiceToDTO(serviceEntity))
+}
+
+// Delete
+// @Summary Delete a service by ID
+// @Description Deletes a service specified by the ID, the providers stop offering it as well.
+// @Tags Service
+// @Param service_id path int true "The ID of the service to delete"
+// @Success 204 {string} string "No Content - Successfully deleted the service"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services/{service_id} [delete]
+// @Security access_token
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	err = h.service.Delete(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to delete service - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Delete service - ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ListProviders
+// @Summary Retrieve providers of a service
+// @Description Retrieves a range of the providers offering the service
+// @Tags Service
+// @Param service_id path int true "Service id"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.ServiceProvider] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /services/{service_id}/providers [get]
+func (h *Handler) ListProviders(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	if request_util.UsesCursor(r) {
+		h.listProvidersKeyset(w, r, int32(id))
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	providerIds, err := h.service.ListProviderIds(r.Context(), int32(id), limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch service providers - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch service providers - ID: %d, %d", id, len(providerIds))
+	h.Writer.WriteData(w, http.StatusOK, mapServiceProvidersToDTO(providerIds))
+}
+
+func (h *Handler) listProvidersKeyset(w http.ResponseWriter, r *http.Request, id int32) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	providerIds, err := h.service.ListProviderIdsKeyset(r.Context(), id, domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch service providers - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, providerIds, func(providerId int64) int64 { return providerId })
+
+	h.Logger.Infof("Fetch service providers - ID: %d, %d", id, len(providerIds))
+	h.Writer.WriteCursorData(w, http.StatusOK, mapServiceProvidersToDTO(providerIds), nextCursor, prevCursor)
+}
+
+func mapServiceProvidersToDTO(providerIds []int64) []dto.ServiceProvider {
+	providersDTO := make([]dto.ServiceProvider, len(providerIds))
+	for i, providerId := range providerIds {
+		providersDTO[i] = dto.ServiceProvider{ProviderID: strconv.FormatInt(providerId, 10)}
+	}
+
+	return providersDTO
+}
+
+func serviceCursorID(s domain.Service) int64 {
+	return int64(s.ID)
+}
@@ -0,0 +1,395 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/services"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	mock_service "github.com/hexley21/fixup/internal/catalog/service/mock"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	id         int32 = 1
+	providerId int64 = 1234567890
+)
+
+var (
+	serviceEntity = domain.NewService(id, 2, "Tap repair", "Repair of a leaking tap")
+	providerData  = auth_jwt.UserData{ID: "1234567890", Role: enum.UserRolePROVIDER, Verified: true}
+)
+
+func setup(t *testing.T) (
+	ctrl *gomock.Controller,
+	mockServiceService *mock_service.MockServiceService,
+	mockValidator *mock_validator.MockValidator,
+	h *services.Handler,
+) {
+	ctrl = gomock.NewController(t)
+	mockServiceService = mock_service.NewMockServiceService(ctrl)
+	mockValidator = mock_validator.NewMockValidator(ctrl)
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	h = services.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mockValidator, json_writer.New(logger, jsonManager)),
+		mockServiceService,
+		50,
+		100,
+	)
+
+	return
+}
+
+func withProvider(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), auth_jwt.AuthJWTKey, providerData))
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, expectedError string) {
+	var errResp rest.ErrorResponse
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp)) {
+		assert.Equal(t, expectedError, errResp.Message)
+	}
+}
+
+func TestGet(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().Get(gomock.Any(), id).Return(serviceEntity, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func() {
+				serviceMock.EXPECT().Get(gomock.Any(), id).Return(domain.Service{}, service.ErrServiceNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrServiceNotFound.Error(),
+		},
+		{
+			name: "Service Error",
+			mockSetup: func() {
+				serviceMock.EXPECT().Get(gomock.Any(), id).Return(domain.Service{}, errors.New(""))
+			},
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: rest.MsgInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Get("/{service_id}", h.Get)
+
+			req := httptest.NewRequest(http.MethodGet, "/1", nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().Create(gomock.Any(), serviceEntity.Info).Return(id, nil)
+			},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name: "Name Taken",
+			mockSetup: func() {
+				serviceMock.EXPECT().Create(gomock.Any(), serviceEntity.Info).Return(int32(0), service.ErrServiceNameTaken)
+			},
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrServiceNameTaken.Error(),
+		},
+		{
+			name: "Subcategory Not Found",
+			mockSetup: func() {
+				serviceMock.EXPECT().Create(gomock.Any(), serviceEntity.Info).Return(int32(0), service.ErrSubcategoryNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrSubcategoryNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Post("/", h.Create)
+
+			body := `{"name": "Tap repair", "description": "Repair of a leaking tap", "subcategory_id": "2"}`
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestListProviders(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	serviceMock.EXPECT().ListProviderIds(gomock.Any(), id, int64(10), int64(0)).Return([]int64{providerId}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/{service_id}/providers", h.ListProviders)
+
+	req := httptest.NewRequest(http.MethodGet, "/1/providers?page=1&per_page=10", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response rest.ApiResponse[[]dto.ServiceProvider]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+		assert.Equal(t, []dto.ServiceProvider{{ProviderID: "1234567890"}}, response.Data)
+	}
+}
+
+func TestListProviders_Cursor(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func()
+		expectedCode       int
+		expectedError      string
+		expectedNextCursor string
+		expectedPrevCursor string
+	}{
+		{
+			name:  "First Page",
+			query: "per_page=1",
+			mockSetup: func() {
+				serviceMock.EXPECT().ListProviderIdsKeyset(gomock.Any(), id, domain.NewKeyset(0, false), int64(1)).Return([]int64{providerId}, nil)
+			},
+			expectedCode:       http.StatusOK,
+			expectedNextCursor: request_util.Cursor{ID: providerId}.Encode(),
+		},
+		{
+			name:  "Next Page",
+			query: "per_page=2&cursor=" + request_util.Cursor{ID: providerId - 1}.Encode(),
+			mockSetup: func() {
+				serviceMock.EXPECT().ListProviderIdsKeyset(gomock.Any(), id, domain.NewKeyset(providerId-1, false), int64(2)).Return([]int64{providerId}, nil)
+			},
+			expectedCode:       http.StatusOK,
+			expectedPrevCursor: request_util.Cursor{ID: providerId, Backward: true}.Encode(),
+		},
+		{
+			name:          "Invalid Cursor",
+			query:         "cursor=invalid",
+			mockSetup:     func() {},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: request_util.ErrInvalidCursor.Message,
+		},
+		{
+			name:  "Service Not Found",
+			query: "",
+			mockSetup: func() {
+				serviceMock.EXPECT().ListProviderIdsKeyset(gomock.Any(), id, domain.NewKeyset(0, false), int64(50)).Return(nil, service.ErrServiceNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrServiceNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Get("/{service_id}/providers", h.ListProviders)
+
+			req := httptest.NewRequest(http.MethodGet, "/1/providers?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+				return
+			}
+
+			var response rest.ApiResponse[[]dto.ServiceProvider]
+			if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+				assert.Equal(t, []dto.ServiceProvider{{ProviderID: "1234567890"}}, response.Data)
+				assert.Equal(t, tt.expectedNextCursor, response.NextCursor)
+				assert.Equal(t, tt.expectedPrevCursor, response.PrevCursor)
+			}
+		})
+	}
+}
+
+func TestAddMine(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().AddProviderService(gomock.Any(), providerId, id).Return(serviceEntity, nil)
+			},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name: "Already Offered",
+			mockSetup: func() {
+				serviceMock.EXPECT().AddProviderService(gomock.Any(), providerId, id).Return(domain.Service{}, service.ErrProviderServiceExists)
+			},
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrProviderServiceExists.Error(),
+		},
+		{
+			name: "Service Not Found",
+			mockSetup: func() {
+				serviceMock.EXPECT().AddProviderService(gomock.Any(), providerId, id).Return(domain.Service{}, service.ErrServiceNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrServiceNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Post("/", h.AddMine)
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"service_id": "1"}`))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, withProvider(req))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestRemoveProviderService(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().RemoveProviderService(gomock.Any(), providerId, id).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Not Offered",
+			mockSetup: func() {
+				serviceMock.EXPECT().RemoveProviderService(gomock.Any(), providerId, id).Return(service.ErrProviderServiceNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrProviderServiceNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Delete("/{provider_id}/services/{service_id}", h.RemoveProviderService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/1234567890/services/1", nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
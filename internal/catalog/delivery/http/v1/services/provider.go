@@ -0,0 +1,294 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+// ListByProviderId
+// @Summary Retrieve services of a provider
+// @Description Retrieves a range of the services offered by the provider
+// @Tags Provider Service
+// @Param provider_id path int true "Provider id"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /providers/{provider_id}/services [get]
+func (h *Handler) ListByProviderId(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+// ListMine
+// @Summary Retrieve own services
+// @Description Retrieves a range of the services offered by the authenticated provider
+// @Tags Provider Service
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /provider/me/services [get]
+// @Security access_token
+func (h *Handler) ListMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+func (h *Handler) listByProviderId(w http.ResponseWriter, r *http.Request, providerId int64) {
+	if request_util.UsesCursor(r) {
+		h.listByProviderIdKeyset(w, r, providerId)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	services, err := h.service.ListByProviderId(r.Context(), providerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch provider services - provider id: %d, error: %w", providerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch provider services - P-ID: %d, %d", providerId, len(services))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapServicesToDTO(services))
+}
+
+func (h *Handler) listByProviderIdKeyset(w http.ResponseWriter, r *http.Request, providerId int64) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	services, err := h.service.ListByProviderIdKeyset(r.Context(), providerId, domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch provider services - provider id: %d, error: %w", providerId, err))
+		return
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, services, serviceCursorID)
+
+	h.Logger.Infof("Fetch provider services - P-ID: %d, %d", providerId, len(services))
+	h.Writer.WriteCursorData(w, http.StatusOK, mapper.MapServicesToDTO(services), nextCursor, prevCursor)
+}
+
+// GetProviderService
+// @Summary Retrieve a service of a provider
+// @Description Retrieves the service if the provider offers it
+// @Tags Provider Service
+// @Param provider_id path int true "Provider id"
+// @Param service_id path int true "Service id"
+// @Success 200 {object} rest.ApiResponse[dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /providers/{provider_id}/services/{service_id} [get]
+func (h *Handler) GetProviderService(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.getProviderService(w, r, providerId)
+}
+
+// GetMine
+// @Summary Retrieve an own service
+// @Description Retrieves the service if the authenticated provider offers it
+// @Tags Provider Service
+// @Param service_id path int true "Service id"
+// @Success 200 {object} rest.ApiResponse[dto.Service] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /provider/me/services/{service_id} [get]
+// @Security access_token
+func (h *Handler) GetMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.getProviderService(w, r, providerId)
+}
+
+func (h *Handler) getProviderService(w http.ResponseWriter, r *http.Request, providerId int64) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	serviceEntity, err := h.service.GetProviderService(r.Context(), providerId, int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProviderServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get provider service - provider id: %d, id: %d, error: %w", providerId, id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch provider service - P-ID: %d, ID: %d", providerId, id)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapServiceToDTO(serviceEntity))
+}
+
+// AddMine
+// @Summary Offer a service
+// @Description Attaches the service to the services offered by the authenticated provider
+// @Tags Provider Service
+// @Param dto body dto.ProviderServiceInput true "Service to offer"
+// @Success 201 {object} rest.ApiResponse[dto.Service] "Created"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found - Service not found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Service is already offered"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /provider/me/services [post]
+// @Security access_token
+func (h *Handler) AddMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var inputDTO dto.ProviderServiceInput
+	errResp = h.Binder.BindJSON(r, &inputDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(inputDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	id, err := strconv.ParseInt(inputDTO.ServiceID, 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidArgumentsError(err))
+		return
+	}
+
+	serviceEntity, err := h.service.AddProviderService(r.Context(), providerId, int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrProviderServiceExists):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to add provider service - provider id: %d, id: %d, error: %w", providerId, id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Add provider service - P-ID: %d, ID: %d", providerId, id)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapServiceToDTO(serviceEntity))
+}
+
+// RemoveProviderService
+// @Summary Remove a service of a provider
+// @Description Detaches the service from the services offered by the provider
+// @Tags Provider Service
+// @Param provider_id path int true "Provider id"
+// @Param service_id path int true "Service id"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /providers/{provider_id}/services/{service_id} [delete]
+// @Security access_token
+func (h *Handler) RemoveProviderService(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.removeProviderService(w, r, providerId)
+}
+
+// RemoveMine
+// @Summary Stop offering a service
+// @Description Detaches the service from the services offered by the authenticated provider
+// @Tags Provider Service
+// @Param service_id path int true "Service id"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /provider/me/services/{service_id} [delete]
+// @Security access_token
+func (h *Handler) RemoveMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.removeProviderService(w, r, providerId)
+}
+
+func (h *Handler) removeProviderService(w http.ResponseWriter, r *http.Request, providerId int64) {
+	id, err := strconv.Atoi(chi.URLParam(r, "service_id"))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	err = h.service.RemoveProviderService(r.Context(), providerId, int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProviderServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to remove provider service - provider id: %d, id: %d, error: %w", providerId, id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Remove provider service - P-ID: %d, ID: %d", providerId, id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
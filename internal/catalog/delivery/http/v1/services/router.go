@@ -0,0 +1,45 @@
+package services
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyAdminMiddleware func(http.Handler) http.Handler,
+	onlyProviderMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Route("/services", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(jWTAccessMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware)
+
+			r.Post("/", h.Create)
+			r.Patch("/{service_id}", h.Update)
+			r.Delete("/{service_id}", h.Delete)
+		})
+
+		r.Get("/", h.List)
+		r.Get("/{service_id}", h.Get)
+		r.Get("/{service_id}/providers", h.ListProviders)
+	})
+
+	router.Route("/provider/me/services", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware, onlyProviderMiddleware)
+
+		r.Get("/", h.ListMine)
+		r.Post("/", h.AddMine)
+		r.Get("/{service_id}", h.GetMine)
+		r.Delete("/{service_id}", h.RemoveMine)
+	})
+
+	router.Route("/providers/{provider_id}/services", func(r chi.Router) {
+		r.Get("/", h.ListByProviderId)
+		r.Get("/{service_id}", h.GetProviderService)
+		r.With(jWTAccessMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware).Delete("/{service_id}", h.RemoveProviderService)
+	})
+}
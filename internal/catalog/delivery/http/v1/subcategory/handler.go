@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/internal/catalog/service"
 	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/pkg/http/handler"
@@ -74,7 +75,8 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 // @Summary Retrieve subcategory
 // @Description Retrieves a subcategory range
 // @Tags Subcategory
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.Subcategory] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -85,6 +87,11 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 // @Router /subcategories [get]
 // @Security access_token
 func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	if request_util.UsesCursor(r) {
+		h.listKeyset(w, r)
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -118,7 +125,8 @@ func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
 // @Description Retrieves a subcategory range
 // @Tags Subcategory
 // @Param category_id path int true "Category id"
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.Subcategory] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -134,6 +142,11 @@ func (h *Handler) ListByCategoryId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request_util.UsesCursor(r) {
+		h.listByCategoryIdKeyset(w, r, int32(categoryId))
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -167,7 +180,8 @@ func (h *Handler) ListByCategoryId(w http.ResponseWriter, r *http.Request) {
 // @Description Retrieves a subcategory range
 // @Tags Subcategory
 // @Param type_id path int true "Category Type id"
-// @Param page query int true "Page number"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
 // @Param per_page query int false "Number of items per page"
 // @Success 200 {object} rest.ApiResponse[[]dto.Subcategory] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
@@ -183,6 +197,11 @@ func (h *Handler) ListByTypeId(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if request_util.UsesCursor(r) {
+		h.listByTypeIdKeyset(w, r, int32(typeId))
+		return
+	}
+
 	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
 	if errResp != nil {
 		h.Writer.WriteError(w, errResp)
@@ -211,6 +230,67 @@ func (h *Handler) ListByTypeId(w http.ResponseWriter, r *http.Request) {
 	h.Writer.WriteData(w, http.StatusOK, subcategoriesDTO)
 }
 
+func (h *Handler) listKeyset(w http.ResponseWriter, r *http.Request) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	subcategories, err := h.service.ListKeyset(r.Context(), domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerError(err))
+		return
+	}
+
+	h.Logger.Infof("Fetch subcategories - %d", len(subcategories))
+	h.writeSubcategoryPage(w, cursor, limit, subcategories)
+}
+
+func (h *Handler) listByCategoryIdKeyset(w http.ResponseWriter, r *http.Request, categoryId int32) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	subcategories, err := h.service.ListByCategoryIdKeyset(r.Context(), categoryId, domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerError(err))
+		return
+	}
+
+	h.Logger.Infof("Fetch subcategories by category id: %d - %d", categoryId, len(subcategories))
+	h.writeSubcategoryPage(w, cursor, limit, subcategories)
+}
+
+func (h *Handler) listByTypeIdKeyset(w http.ResponseWriter, r *http.Request, typeId int32) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	subcategories, err := h.service.ListByTypeIdKeyset(r.Context(), typeId, domain.NewKeyset(cursor.ID, cursor.Backward), limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerError(err))
+		return
+	}
+
+	h.Logger.Infof("Fetch subcategories by type id: %d - %d", typeId, len(subcategories))
+	h.writeSubcategoryPage(w, cursor, limit, subcategories)
+}
+
+func (h *Handler) writeSubcategoryPage(w http.ResponseWriter, cursor request_util.Cursor, limit int64, subcategories []domain.Subcategory) {
+	subcategoriesDTO := make([]dto.Subcategory, len(subcategories))
+	for i, s := range subcategories {
+		subcategoriesDTO[i] = mapper.MapSubcategoryToDTO(s)
+	}
+
+	nextCursor, prevCursor := request_util.PageCursors(cursor, limit, subcategories, func(s domain.Subcategory) int64 { return int64(s.ID) })
+	h.Writer.WriteCursorData(w, http.StatusOK, subcategoriesDTO, nextCursor, prevCursor)
+}
+
 // Create
 // @Summary Create a new subcategory
 // @Description Creates a new subcategory with the provided data.
@@ -16,6 +16,7 @@ import (
 	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/internal/catalog/service"
 	mock_service "github.com/hexley21/fixup/internal/catalog/service/mock"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
 	"github.com/hexley21/fixup/pkg/http/handler"
 	"github.com/hexley21/fixup/pkg/http/json/std_json"
@@ -204,6 +205,37 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestList_Cursor(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	cursor := request_util.Cursor{ID: 5, Backward: true}
+	serviceMock.EXPECT().ListKeyset(gomock.Any(), domain.NewKeyset(5, true), perPage).Return([]domain.Subcategory{
+		domain.NewSubcategory(3, id, "Leakage"),
+		domain.NewSubcategory(4, id, "Drainage"),
+	}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/", h.List)
+
+	q := make(url.Values)
+	q.Set("cursor", cursor.Encode())
+	q.Set("per_page", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response rest.ApiResponse[[]dto.Subcategory]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+		assert.Len(t, response.Data, 2)
+		assert.Equal(t, request_util.Cursor{ID: 4}.Encode(), response.NextCursor)
+		assert.Empty(t, response.PrevCursor)
+	}
+}
+
 func TestListByCategoryId(t *testing.T) {
 	ctrl, serviceMock, _, h := setup(t)
 	defer ctrl.Finish()
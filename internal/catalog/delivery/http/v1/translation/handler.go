@@ -0,0 +1,276 @@
+package translation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service service.TranslationService
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.TranslationService,
+) *Handler {
+	return &Handler{
+		Components: handlerComponents,
+		service:    service,
+	}
+}
+
+// ListCategoryTranslations
+// @Summary Retrieve translations of a category
+// @Description Retrieves all translations of a category ordered by locale, the names of the default locale are stored on the category itself.
+// @Tags Translation
+// @Param category_id path int true "The ID of the category"
+// @Success 200 {object} rest.ApiResponse[[]dto.Translation] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the translations"
+// @Router /categories/{category_id}/translations [get]
+// @Security access_token
+func (h *Handler) ListCategoryTranslations(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, domain.CatalogKindCategory, "category_id")
+}
+
+// SetCategoryTranslation
+// @Summary Set a translation of a category
+// @Description Creates or replaces the translation of a category to the locale. The description is only translated for services.
+// @Tags Translation
+// @Param category_id path int true "The ID of the category"
+// @Param locale path string true "Locale of the translation, one of the supported non-default locales"
+// @Param dto body dto.TranslationInfo true "Translation data"
+// @Success 200 {object} rest.ApiResponse[dto.Translation] "OK - Successfully set the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while setting the translation"
+// @Router /categories/{category_id}/translations/{locale} [put]
+// @Security access_token
+func (h *Handler) SetCategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	h.set(w, r, domain.CatalogKindCategory, "category_id")
+}
+
+// DeleteCategoryTranslation
+// @Summary Delete a translation of a category
+// @Description Deletes the translation of a category to the locale, the category falls back to the names of the default locale.
+// @Tags Translation
+// @Param category_id path int true "The ID of the category"
+// @Param locale path string true "Locale of the translation"
+// @Success 204 {string} string "No Content - Successfully deleted the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while deleting the translation"
+// @Router /categories/{category_id}/translations/{locale} [delete]
+// @Security access_token
+func (h *Handler) DeleteCategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	h.delete(w, r, domain.CatalogKindCategory, "category_id")
+}
+
+// ListSubcategoryTranslations
+// @Summary Retrieve translations of a subcategory
+// @Description Retrieves all translations of a subcategory ordered by locale, the names of the default locale are stored on the subcategory itself.
+// @Tags Translation
+// @Param subcategory_id path int true "The ID of the subcategory"
+// @Success 200 {object} rest.ApiResponse[[]dto.Translation] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the translations"
+// @Router /subcategories/{subcategory_id}/translations [get]
+// @Security access_token
+func (h *Handler) ListSubcategoryTranslations(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, domain.CatalogKindSubcategory, "subcategory_id")
+}
+
+// SetSubcategoryTranslation
+// @Summary Set a translation of a subcategory
+// @Description Creates or replaces the translation of a subcategory to the locale. The description is only translated for services.
+// @Tags Translation
+// @Param subcategory_id path int true "The ID of the subcategory"
+// @Param locale path string true "Locale of the translation, one of the supported non-default locales"
+// @Param dto body dto.TranslationInfo true "Translation data"
+// @Success 200 {object} rest.ApiResponse[dto.Translation] "OK - Successfully set the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while setting the translation"
+// @Router /subcategories/{subcategory_id}/translations/{locale} [put]
+// @Security access_token
+func (h *Handler) SetSubcategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	h.set(w, r, domain.CatalogKindSubcategory, "subcategory_id")
+}
+
+// DeleteSubcategoryTranslation
+// @Summary Delete a translation of a subcategory
+// @Description Deletes the translation of a subcategory to the locale, the subcategory falls back to the names of the default locale.
+// @Tags Translation
+// @Param subcategory_id path int true "The ID of the subcategory"
+// @Param locale path string true "Locale of the translation"
+// @Success 204 {string} string "No Content - Successfully deleted the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while deleting the translation"
+// @Router /subcategories/{subcategory_id}/translations/{locale} [delete]
+// @Security access_token
+func (h *Handler) DeleteSubcategoryTranslation(w http.ResponseWriter, r *http.Request) {
+	h.delete(w, r, domain.CatalogKindSubcategory, "subcategory_id")
+}
+
+// ListServiceTranslations
+// @Summary Retrieve translations of a service
+// @Description Retrieves all translations of a service ordered by locale, the names of the default locale are stored on the service itself.
+// @Tags Translation
+// @Param service_id path int true "The ID of the service"
+// @Success 200 {object} rest.ApiResponse[[]dto.Translation] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the translations"
+// @Router /services/{service_id}/translations [get]
+// @Security access_token
+func (h *Handler) ListServiceTranslations(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, domain.CatalogKindService, "service_id")
+}
+
+// SetServiceTranslation
+// @Summary Set a translation of a service
+// @Description Creates or replaces the translation of a service to the locale.
+// @Tags Translation
+// @Param service_id path int true "The ID of the service"
+// @Param locale path string true "Locale of the translation, one of the supported non-default locales"
+// @Param dto body dto.TranslationInfo true "Translation data"
+// @Success 200 {object} rest.ApiResponse[dto.Translation] "OK - Successfully set the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while setting the translation"
+// @Router /services/{service_id}/translations/{locale} [put]
+// @Security access_token
+func (h *Handler) SetServiceTranslation(w http.ResponseWriter, r *http.Request) {
+	h.set(w, r, domain.CatalogKindService, "service_id")
+}
+
+// DeleteServiceTranslation
+// @Summary Delete a translation of a service
+// @Description Deletes the translation of a service to the locale, the service falls back to the names of the default locale.
+// @Tags Translation
+// @Param service_id path int true "The ID of the service"
+// @Param locale path string true "Locale of the translation"
+// @Success 204 {string} string "No Content - Successfully deleted the translation"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while deleting the translation"
+// @Router /services/{service_id}/translations/{locale} [delete]
+// @Security access_token
+func (h *Handler) DeleteServiceTranslation(w http.ResponseWriter, r *http.Request) {
+	h.delete(w, r, domain.CatalogKindService, "service_id")
+}
+
+func (h *Handler) list(w http.ResponseWriter, r *http.Request, kind domain.CatalogKind, idParam string) {
+	id, err := strconv.Atoi(chi.URLParam(r, idParam))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	translationEntities, err := h.service.List(r.Context(), kind, int32(id))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch %s translations - id: %d, error: %w", kind, id, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch %s translations - ID: %d, %d", kind, id, len(translationEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapTranslationsToDTO(translationEntities))
+}
+
+func (h *Handler) set(w http.ResponseWriter, r *http.Request, kind domain.CatalogKind, idParam string) {
+	id, err := strconv.Atoi(chi.URLParam(r, idParam))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	var infoDTO dto.TranslationInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	locale := chi.URLParam(r, "locale")
+	translationEntity, err := h.service.Set(r.Context(), kind, int32(id), locale, mapper.MapTranslationInfoToVO(infoDTO))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnsupportedLocale) || errors.Is(err, service.ErrDefaultLocaleTranslation):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrCategoryNotFound) ||
+			errors.Is(err, service.ErrSubcategoryNotFound) ||
+			errors.Is(err, service.ErrServiceNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrCategoryNameTaken) ||
+			errors.Is(err, service.ErrSubcategoryNameTaken) ||
+			errors.Is(err, service.ErrServiceNameTaken):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to set %s translation - id: %d, locale: %s, error: %w", kind, id, locale, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Set %s translation: %s, ID: %d, Locale: %s", kind, translationEntity.Info.Name, id, locale)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapTranslationToDTO(translationEntity))
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, kind domain.CatalogKind, idParam string) {
+	id, err := strconv.Atoi(chi.URLParam(r, idParam))
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	locale := chi.URLParam(r, "locale")
+	err = h.service.Delete(r.Context(), kind, int32(id), locale)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTranslationNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to delete %s translation - id: %d, locale: %s, error: %w", kind, id, locale, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Delete %s translation - ID: %d, Locale: %s", kind, id, locale)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
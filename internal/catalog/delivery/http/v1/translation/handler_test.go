@@ -0,0 +1,201 @@
+package translation_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1/translation"
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	mock_service "github.com/hexley21/fixup/internal/catalog/service/mock"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const id int32 = 1
+
+var translationEntity = domain.NewTranslation("ka", "ონკანის შეკეთება", "")
+
+func setup(t *testing.T) (
+	ctrl *gomock.Controller,
+	mockTranslationService *mock_service.MockTranslationService,
+	mockValidator *mock_validator.MockValidator,
+	h *translation.Handler,
+) {
+	ctrl = gomock.NewController(t)
+	mockTranslationService = mock_service.NewMockTranslationService(ctrl)
+	mockValidator = mock_validator.NewMockValidator(ctrl)
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	h = translation.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mockValidator, json_writer.New(logger, jsonManager)),
+		mockTranslationService,
+	)
+
+	return
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, expectedError string) {
+	var errResp rest.ErrorResponse
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp)) {
+		assert.Equal(t, expectedError, errResp.Message)
+	}
+}
+
+func TestListCategoryTranslations(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	serviceMock.EXPECT().List(gomock.Any(), domain.CatalogKindCategory, id).Return([]domain.Translation{translationEntity}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/categories/{category_id}/translations", h.ListCategoryTranslations)
+
+	req := httptest.NewRequest(http.MethodGet, "/categories/1/translations", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	var resp rest.ApiResponse[[]dto.Translation]
+	if assert.Equal(t, http.StatusOK, rec.Code) && assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp)) {
+		assert.Equal(t, []dto.Translation{dto.NewTranslationDTO("ka", translationEntity.Info.Name, "")}, resp.Data)
+	}
+}
+
+func TestSetServiceTranslation(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	info := domain.NewTranslationInfo("ონკანის შეკეთება", "აღწერა")
+
+	tests := []struct {
+		name          string
+		locale        string
+		mockError     error
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name:         "Success",
+			locale:       "ka",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:          "Unsupported Locale",
+			locale:        "de",
+			mockError:     service.ErrUnsupportedLocale,
+			expectedCode:  http.StatusBadRequest,
+			expectedError: service.ErrUnsupportedLocale.Error(),
+		},
+		{
+			name:          "Default Locale",
+			locale:        "en",
+			mockError:     service.ErrDefaultLocaleTranslation,
+			expectedCode:  http.StatusBadRequest,
+			expectedError: service.ErrDefaultLocaleTranslation.Error(),
+		},
+		{
+			name:          "Service Not Found",
+			locale:        "ka",
+			mockError:     service.ErrServiceNotFound,
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrServiceNotFound.Error(),
+		},
+		{
+			name:          "Name Taken",
+			locale:        "ka",
+			mockError:     service.ErrServiceNameTaken,
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrServiceNameTaken.Error(),
+		},
+		{
+			name:          "Service Error",
+			locale:        "ka",
+			mockError:     errors.New(""),
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: rest.MsgInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+			serviceMock.EXPECT().Set(gomock.Any(), domain.CatalogKindService, id, tt.locale, info).
+				Return(domain.Translation{Locale: tt.locale, Info: info}, tt.mockError)
+
+			r := chi.NewRouter()
+			r.Put("/services/{service_id}/translations/{locale}", h.SetServiceTranslation)
+
+			body := `{"name": "ონკანის შეკეთება", "description": "აღწერა"}`
+			req := httptest.NewRequest(http.MethodPut, "/services/1/translations/"+tt.locale, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestDeleteSubcategoryTranslation(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockError     error
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name:         "Success",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:          "Not Found",
+			mockError:     service.ErrTranslationNotFound,
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrTranslationNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceMock.EXPECT().Delete(gomock.Any(), domain.CatalogKindSubcategory, id, "ka").Return(tt.mockError)
+
+			r := chi.NewRouter()
+			r.Delete("/subcategories/{subcategory_id}/translations/{locale}", h.DeleteSubcategoryTranslation)
+
+			req := httptest.NewRequest(http.MethodDelete, "/subcategories/1/translations/ka", nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package translation
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyAdminMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware)
+
+		r.Get("/categories/{category_id}/translations", h.ListCategoryTranslations)
+		r.Put("/categories/{category_id}/translations/{locale}", h.SetCategoryTranslation)
+		r.Delete("/categories/{category_id}/translations/{locale}", h.DeleteCategoryTranslation)
+
+		r.Get("/subcategories/{subcategory_id}/translations", h.ListSubcategoryTranslations)
+		r.Put("/subcategories/{subcategory_id}/translations/{locale}", h.SetSubcategoryTranslation)
+		r.Delete("/subcategories/{subcategory_id}/translations/{locale}", h.DeleteSubcategoryTranslation)
+
+		r.Get("/services/{service_id}/translations", h.ListServiceTranslations)
+		r.Put("/services/{service_id}/translations/{locale}", h.SetServiceTranslation)
+		r.Delete("/services/{service_id}/translations/{locale}", h.DeleteServiceTranslation)
+	})
+}
@@ -0,0 +1,28 @@
+package domain
+
+type (
+	CatalogTree struct {
+		Version string
+		Types   []CatalogTreeType
+	} // Catalog tree Domain Entity, the version changes whenever the content does
+	CatalogTreeType struct {
+		ID         int32
+		Name       string
+		Categories []CatalogTreeCategory
+	} // Category type node of the catalog tree
+	CatalogTreeCategory struct {
+		ID            int32
+		Name          string
+		Subcategories []CatalogTreeSubcategory
+	} // Category node of the catalog tree
+	CatalogTreeSubcategory struct {
+		ID       int32
+		Name     string
+		Services []CatalogTreeService
+	} // Subcategory node of the catalog tree
+	CatalogTreeService struct {
+		ID          int32
+		Name        string
+		Description string
+	} // Service leaf of the catalog tree
+)
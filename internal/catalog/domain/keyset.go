@@ -0,0 +1,13 @@
+package domain
+
+type Keyset struct {
+	ID       int64
+	Backward bool
+} // Keyset pagination Value Object, selects the items following the ID in the order of the list, or preceding it if backward. The zero value selects the start of the list
+
+func NewKeyset(id int64, backward bool) Keyset {
+	return Keyset{
+		ID:       id,
+		Backward: backward,
+	}
+}
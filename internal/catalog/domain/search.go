@@ -0,0 +1,32 @@
+package domain
+
+type CatalogKind string // Kind of a catalog entry
+
+const (
+	CatalogKindCategoryType CatalogKind = "category_type"
+	CatalogKindCategory     CatalogKind = "category"
+	CatalogKindSubcategory  CatalogKind = "subcategory"
+	CatalogKindService      CatalogKind = "service"
+)
+
+type (
+	CatalogNode struct {
+		Kind CatalogKind
+		ID   int32
+		Name string
+	} // Catalog entry reference Value Object
+	SearchResult struct {
+		CatalogNode
+		Description string
+		Path        []CatalogNode
+		Rank        float32
+	} // Search result Value Object, the path lists the ancestors from the category type down
+)
+
+func NewCatalogNode(kind CatalogKind, id int32, name string) CatalogNode {
+	return CatalogNode{
+		Kind: kind,
+		ID:   id,
+		Name: name,
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+type (
+	Translation struct {
+		Locale string
+		Info   TranslationInfo
+	} // Catalog entry Translation Domain Entity
+	TranslationInfo struct {
+		Name        string
+		Description string
+	} // Translation info Value Object, the description is only translated for services
+)
+
+func NewTranslation(locale string, name string, description string) Translation {
+	info := NewTranslationInfo(name, description)
+	return Translation{
+		Locale: locale,
+		Info:   info,
+	}
+}
+
+func NewTranslationInfo(name string, description string) TranslationInfo {
+	return TranslationInfo{
+		Name:        name,
+		Description: description,
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+type CatalogTree interface {
+	postgres.Repository[CatalogTree]
+	List(ctx context.Context, locale string) ([]CatalogTreeRowModel, error)
+}
+
+type postgresCatalogTreeRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewCatalogTreeRepository(dbtx postgres.PGXQuerier) *postgresCatalogTreeRepository {
+	return &postgresCatalogTreeRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresCatalogTreeRepository) WithTx(tx postgres.PGXQuerier) CatalogTree {
+	return NewCatalogTreeRepository(tx)
+}
+
+const listCatalogTree = `-- name: ListCatalogTree :many
+SELECT ct.id, ct.name,
+    c.id, COALESCE(ctr.name, c.name),
+    sc.id, COALESCE(sctr.name, sc.name),
+    s.id, COALESCE(str.name, s.name), COALESCE(str.description, s.description)
+FROM category_types ct
+LEFT JOIN categories c ON c.type_id = ct.id
+LEFT JOIN category_translations ctr ON ctr.category_id = c.id AND ctr.locale = $1
+LEFT JOIN subcategories sc ON sc.category_id = c.id
+LEFT JOIN subcategory_translations sctr ON sctr.subcategory_id = sc.id AND sctr.locale = $1
+LEFT JOIN services s ON s.subcategory_id = sc.id
+LEFT JOIN service_translations str ON str.service_id = s.id AND str.locale = $1
+ORDER BY ct.id, c.id, sc.id, s.id
+`
+
+// List returns the whole catalog as flat rows, one per leaf, ordered so that the rows of each branch are adjacent.
+// Columns of the levels below an empty branch are NULL.
+// Names are translated to the locale, falling back to the names of the default locale where a translation is missing.
+func (r *postgresCatalogTreeRepository) List(ctx context.Context, locale string) ([]CatalogTreeRowModel, error) {
+	rows, err := r.db.Query(ctx, listCatalogTree, locale)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CatalogTreeRowModel
+	for rows.Next() {
+		var i CatalogTreeRowModel
+		if err := rows.Scan(
+			&i.TypeID,
+			&i.TypeName,
+			&i.CategoryID,
+			&i.CategoryName,
+			&i.SubcategoryID,
+			&i.SubcategoryName,
+			&i.ServiceID,
+			&i.ServiceName,
+			&i.ServiceDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// the keys share a hash tag, so the scripts can reach both of them on a cluster
+const (
+	catalogTreeKey           = "{catalog:tree}"
+	catalogTreeGenerationKey = "{catalog:tree}:generation"
+)
+
+type CatalogTreeCache interface {
+	Get(ctx context.Context, locale string) ([]byte, error)
+	Generation(ctx context.Context) (int64, error)
+	Set(ctx context.Context, locale string, data []byte, generation int64, ttl time.Duration) (bool, error)
+	Delete(ctx context.Context) error
+}
+
+type redisCatalogTreeCache struct {
+	redis redis.UniversalClient
+}
+
+func NewCatalogTreeCache(redis redis.UniversalClient) *redisCatalogTreeCache {
+	return &redisCatalogTreeCache{
+		redis: redis,
+	}
+}
+
+// Get retrieves the cached catalog tree of the locale.
+// If the tree is not cached, it returns redis.Nil.
+func (r *redisCatalogTreeCache) Get(ctx context.Context, locale string) ([]byte, error) {
+	return r.redis.HGet(ctx, catalogTreeKey, locale).Bytes()
+}
+
+// Generation retrieves the generation of the cache, which every Delete advances.
+func (r *redisCatalogTreeCache) Generation(ctx context.Context) (int64, error) {
+	generation, err := r.redis.Get(ctx, catalogTreeGenerationKey).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return generation, err
+}
+
+// KEYS[1] - tree key, KEYS[2] - generation key
+// ARGV[1] - generation, ARGV[2] - locale, ARGV[3] - tree, ARGV[4] - ttl in milliseconds
+var setCatalogTreeScript = redis.NewScript(`
+if (redis.call('GET', KEYS[2]) or '0') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[2], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return 1
+`)
+
+// Set caches the catalog tree of the locale, unless the cache was deleted since the generation the tree was built at.
+// It returns false if the tree was not cached, as it may be older than the last write.
+// The trees of all locales share one hash, which expires when the ttl passes.
+func (r *redisCatalogTreeCache) Set(ctx context.Context, locale string, data []byte, generation int64, ttl time.Duration) (bool, error) {
+	ok, err := setCatalogTreeScript.Run(
+		ctx,
+		r.redis,
+		[]string{catalogTreeKey, catalogTreeGenerationKey},
+		generation,
+		locale,
+		data,
+		ttl.Milliseconds(),
+	).Bool()
+	return ok, err
+}
+
+// Delete drops the cached catalog trees of all locales and advances the generation,
+// so the next read rebuilds them and trees built before cannot be cached anymore.
+func (r *redisCatalogTreeCache) Delete(ctx context.Context) error {
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, catalogTreeKey)
+		pipe.Incr(ctx, catalogTreeGenerationKey)
+		return nil
+	})
+	return err
+}
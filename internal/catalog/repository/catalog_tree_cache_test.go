@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogTreeCache(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	cache := repository.NewCatalogTreeCache(redisClient)
+
+	_, err := cache.Get(ctx, "en")
+	assert.ErrorIs(t, err, redis.Nil)
+
+	generation, err := cache.Generation(ctx)
+	assert.NoError(t, err)
+
+	ok, err := cache.Set(ctx, "en", []byte(`{"Version":"1"}`), generation, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = cache.Set(ctx, "ka", []byte(`{"Version":"2"}`), generation, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	data, err := cache.Get(ctx, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"Version":"1"}`), data)
+
+	data, err = cache.Get(ctx, "ka")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{"Version":"2"}`), data)
+
+	// trees of all locales are dropped together
+	assert.NoError(t, cache.Delete(ctx))
+
+	_, err = cache.Get(ctx, "en")
+	assert.ErrorIs(t, err, redis.Nil)
+	_, err = cache.Get(ctx, "ka")
+	assert.ErrorIs(t, err, redis.Nil)
+
+	// a tree built before the deletion is not cached
+	ok, err = cache.Set(ctx, "en", []byte(`{"Version":"1"}`), generation, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = cache.Get(ctx, "en")
+	assert.ErrorIs(t, err, redis.Nil)
+
+	newGeneration, err := cache.Generation(ctx)
+	assert.NoError(t, err)
+	assert.Greater(t, newGeneration, generation)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCatalogTree(t *testing.T) {
+	ctx := context.Background()
+	pgPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, pgPool)
+
+	repo := repository.NewCatalogTreeRepository(pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+	service, err := insertService(pgPool, ctx, subcategory.ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	emptyType, err := insertCategoryType(pgPool, ctx, "Empty")
+	if err != nil {
+		t.Fatalf("failed to insert category type: %v", err)
+	}
+
+	rows, err := repo.List(ctx, "en")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, subcategory.ID, rows[0].SubcategoryID.Int32)
+		assert.Equal(t, service.ID, rows[0].ServiceID.Int32)
+		assert.Equal(t, serviceName, rows[0].ServiceName.String)
+
+		// a type without categories still has its row
+		assert.Equal(t, emptyType.ID, rows[1].TypeID)
+		assert.False(t, rows[1].CategoryID.Valid)
+		assert.False(t, rows[1].ServiceID.Valid)
+	}
+}
+
+func TestListCatalogTree_Localized(t *testing.T) {
+	ctx := context.Background()
+	pgPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, pgPool)
+
+	repo := repository.NewCatalogTreeRepository(pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+	service, err := insertService(pgPool, ctx, subcategory.ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	if _, err := repository.NewTranslationRepository(pgPool).Upsert(ctx, domain.CatalogKindService, service.ID, "ka", domain.NewTranslationInfo("ონკანის შეკეთება", "")); err != nil {
+		t.Fatalf("failed to insert translation: %v", err)
+	}
+
+	rows, err := repo.List(ctx, "ka")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "ონკანის შეკეთება", rows[0].ServiceName.String)
+		// untranslated names fall back to the default locale
+		assert.Equal(t, subcategory.Name, rows[0].SubcategoryName.String)
+	}
+}
@@ -13,7 +13,9 @@ type CategoryRepository interface {
 	Delete(ctx context.Context, id int32) (bool, error)
 	Get(ctx context.Context, id int32) (CategoryModel, error)
 	List(ctx context.Context, limit int64, offset int64) ([]CategoryModel, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]CategoryModel, error)
 	ListByTypeId(ctx context.Context, id int32, limit int64, offset int64) ([]CategoryModel, error)
+	ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]CategoryModel, error)
 	Update(ctx context.Context, id int32, info domain.CategoryInfo) (CategoryModel, error)
 }
 
@@ -86,8 +88,49 @@ func (r *postgresCategoryRepository) List(ctx context.Context, limit int64, offs
 	return items, nil
 }
 
+const listCategoriesAfter = `-- name: ListCategoriesAfter :many
+SELECT id, type_id, name
+FROM categories
+WHERE ($1 = 0 OR id < $1)
+ORDER BY id DESC LIMIT $2
+`
+
+const listCategoriesBefore = `-- name: ListCategoriesBefore :many
+SELECT * FROM (
+    SELECT id, type_id, name
+    FROM categories
+    WHERE id > $1
+    ORDER BY id LIMIT $2
+) AS page ORDER BY id DESC
+`
+
+func (r *postgresCategoryRepository) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]CategoryModel, error) {
+	query := listCategoriesAfter
+	if keyset.Backward {
+		query = listCategoriesBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CategoryModel
+	for rows.Next() {
+		var i CategoryModel
+		if err := rows.Scan(&i.ID, &i.TypeID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCategoriesByTypeId = `-- name: ListCategoriesByTypeId :many
-SELECT id, type_id, name FROM categories WHERE id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+SELECT id, type_id, name FROM categories WHERE type_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
 `
 
 func (r *postgresCategoryRepository) ListByTypeId(ctx context.Context, id int32, limit int64, offset int64) ([]CategoryModel, error) {
@@ -110,6 +153,47 @@ func (r *postgresCategoryRepository) ListByTypeId(ctx context.Context, id int32,
 	return items, nil
 }
 
+const listCategoriesByTypeIdAfter = `-- name: ListCategoriesByTypeIdAfter :many
+SELECT id, type_id, name
+FROM categories
+WHERE type_id = $1 AND ($2 = 0 OR id < $2)
+ORDER BY id DESC LIMIT $3
+`
+
+const listCategoriesByTypeIdBefore = `-- name: ListCategoriesByTypeIdBefore :many
+SELECT * FROM (
+    SELECT id, type_id, name
+    FROM categories
+    WHERE type_id = $1 AND id > $2
+    ORDER BY id LIMIT $3
+) AS page ORDER BY id DESC
+`
+
+func (r *postgresCategoryRepository) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]CategoryModel, error) {
+	query := listCategoriesByTypeIdAfter
+	if keyset.Backward {
+		query = listCategoriesByTypeIdBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, typeID, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CategoryModel
+	for rows.Next() {
+		var i CategoryModel
+		if err := rows.Scan(&i.ID, &i.TypeID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateCategoryById = `-- name: UpdateCategoryById :one
 UPDATE categories SET name = $2, type_id = $3 WHERE id = $1 Returning id, type_id, name
 `
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/pkg/infra/postgres"
 )
 
@@ -13,6 +14,7 @@ type CategoryTypeRepository interface {
 	Get(ctx context.Context, id int32) (CategoryTypeModel, error)
 	Update(ctx context.Context, id int32, name string) (bool, error)
 	List(ctx context.Context, limit int64, offset int64) ([]CategoryTypeModel, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]CategoryTypeModel, error)
 }
 
 type categoryTypeRepositoryImpl struct {
@@ -92,3 +94,44 @@ func (r *categoryTypeRepositoryImpl) List(ctx context.Context, limit int64, offs
 	}
 	return items, nil
 }
+
+const listCategoryTypesAfter = `-- name: ListCategoryTypesAfter :many
+SELECT id, name
+FROM category_types
+WHERE ($1 = 0 OR id < $1)
+ORDER BY id DESC LIMIT $2
+`
+
+const listCategoryTypesBefore = `-- name: ListCategoryTypesBefore :many
+SELECT * FROM (
+    SELECT id, name
+    FROM category_types
+    WHERE id > $1
+    ORDER BY id LIMIT $2
+) AS page ORDER BY id DESC
+`
+
+func (r *categoryTypeRepositoryImpl) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]CategoryTypeModel, error) {
+	query := listCategoryTypesAfter
+	if keyset.Backward {
+		query = listCategoryTypesBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CategoryTypeModel
+	for rows.Next() {
+		var i CategoryTypeModel
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hexley21/fixup/internal/catalog/domain"
 	"github.com/hexley21/fixup/internal/catalog/repository"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
@@ -149,6 +150,33 @@ func TestListCategoryTypes_NotFound(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestListCategoryTypesKeyset_Success(t *testing.T) {
+	ctx, pgPool, repo := setupCategoryType()
+	defer cleanupPostgres(ctx, pgPool)
+
+	inserts := make([]repository.CategoryTypeModel, 3)
+	for i, name := range []string{"Home", "Garden", "Vehicle"} {
+		insert, err := insertCategoryType(pgPool, ctx, name)
+		if err != nil {
+			t.Fatalf("failed to insert category type: %v", err)
+		}
+		inserts[i] = insert
+	}
+
+	// category types are listed newest first
+	first, err := repo.ListKeyset(ctx, domain.NewKeyset(0, false), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.CategoryTypeModel{inserts[2], inserts[1]}, first)
+
+	next, err := repo.ListKeyset(ctx, domain.NewKeyset(int64(inserts[1].ID), false), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.CategoryTypeModel{inserts[0]}, next)
+
+	prev, err := repo.ListKeyset(ctx, domain.NewKeyset(int64(inserts[0].ID), true), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, first, prev)
+}
+
 func TestUpdateCategoryType_Success(t *testing.T) {
 	ctx, pgPool, repo := setupCategoryType()
 	defer cleanupPostgres(ctx, pgPool)
@@ -10,7 +10,9 @@ import (
 	infra "github.com/hexley21/fixup/pkg/infra/postgres"
 	pgTt "github.com/hexley21/fixup/pkg/infra/postgres/testcontainer"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	redisTt "github.com/testcontainers/testcontainers-go/modules/redis"
 )
 
 var (
@@ -66,3 +68,34 @@ func getPgPool(ctx context.Context) *pgxpool.Pool {
 
 	return pool
 }
+
+func getRedisClient(t *testing.T) (*redisTt.RedisContainer, *redis.Client) {
+	ctx := context.Background()
+
+	redisContainer, err := redisTt.Run(ctx,
+		"docker.io/redis:7",
+		redisTt.WithSnapshotting(10, 1),
+		redisTt.WithLogLevel(redisTt.LogLevelVerbose),
+	)
+
+	if err != nil {
+		t.Fatalf("Failed to start container: %v", err)
+	}
+
+	endpoint, err := redisContainer.Endpoint(ctx, "")
+	if err != nil {
+		t.Fatalf("Failed to get container endpoint: %v", err)
+	}
+
+	return redisContainer, redis.NewClient(&redis.Options{Addr: endpoint})
+}
+
+func setupRedisCleanup(t *testing.T, client *redis.Client, container *redisTt.RedisContainer) {
+	if err := client.Close(); err != nil {
+		t.Fatalf("Failed to close client: %v", err)
+	}
+
+	if err := container.Terminate(context.Background()); err != nil {
+		t.Fatalf("Failed to terminate container: %v", err)
+	}
+}
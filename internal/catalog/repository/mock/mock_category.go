@@ -117,6 +117,36 @@ func (mr *MockCategoryRepositoryMockRecorder) ListByTypeId(ctx, id, limit, offse
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeId", reflect.TypeOf((*MockCategoryRepository)(nil).ListByTypeId), ctx, id, limit, offset)
 }
 
+// ListByTypeIdKeyset mocks base method.
+func (m *MockCategoryRepository) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]repository.CategoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTypeIdKeyset", ctx, typeID, keyset, limit)
+	ret0, _ := ret[0].([]repository.CategoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTypeIdKeyset indicates an expected call of ListByTypeIdKeyset.
+func (mr *MockCategoryRepositoryMockRecorder) ListByTypeIdKeyset(ctx, typeID, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeIdKeyset", reflect.TypeOf((*MockCategoryRepository)(nil).ListByTypeIdKeyset), ctx, typeID, keyset, limit)
+}
+
+// ListKeyset mocks base method.
+func (m *MockCategoryRepository) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]repository.CategoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]repository.CategoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockCategoryRepositoryMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockCategoryRepository)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockCategoryRepository) Update(ctx context.Context, id int32, info domain.CategoryInfo) (repository.CategoryModel, error) {
 	m.ctrl.T.Helper()
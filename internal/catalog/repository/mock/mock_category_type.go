@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	domain "github.com/hexley21/fixup/internal/catalog/domain"
 	repository "github.com/hexley21/fixup/internal/catalog/repository"
 	postgres "github.com/hexley21/fixup/pkg/infra/postgres"
 	gomock "go.uber.org/mock/gomock"
@@ -101,6 +102,21 @@ func (mr *MockCategoryTypeRepositoryMockRecorder) List(ctx, limit, offset any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCategoryTypeRepository)(nil).List), ctx, limit, offset)
 }
 
+// ListKeyset mocks base method.
+func (m *MockCategoryTypeRepository) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]repository.CategoryTypeModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]repository.CategoryTypeModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockCategoryTypeRepositoryMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockCategoryTypeRepository)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockCategoryTypeRepository) Update(ctx context.Context, id int32, name string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +117,21 @@ func (mr *MockSubcategoryMockRecorder) ListByCategoryId(ctx, categoryID, limit,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCategoryId", reflect.TypeOf((*MockSubcategory)(nil).ListByCategoryId), ctx, categoryID, limit, offset)
 }
 
+// ListByCategoryIdKeyset mocks base method.
+func (m *MockSubcategory) ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]repository.SubcategoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCategoryIdKeyset", ctx, categoryID, keyset, limit)
+	ret0, _ := ret[0].([]repository.SubcategoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCategoryIdKeyset indicates an expected call of ListByCategoryIdKeyset.
+func (mr *MockSubcategoryMockRecorder) ListByCategoryIdKeyset(ctx, categoryID, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCategoryIdKeyset", reflect.TypeOf((*MockSubcategory)(nil).ListByCategoryIdKeyset), ctx, categoryID, keyset, limit)
+}
+
 // ListByTypeId mocks base method.
 func (m *MockSubcategory) ListByTypeId(ctx context.Context, typeID int32, limit, offset int64) ([]repository.SubcategoryModel, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,36 @@ func (mr *MockSubcategoryMockRecorder) ListByTypeId(ctx, typeID, limit, offset a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeId", reflect.TypeOf((*MockSubcategory)(nil).ListByTypeId), ctx, typeID, limit, offset)
 }
 
+// ListByTypeIdKeyset mocks base method.
+func (m *MockSubcategory) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]repository.SubcategoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTypeIdKeyset", ctx, typeID, keyset, limit)
+	ret0, _ := ret[0].([]repository.SubcategoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTypeIdKeyset indicates an expected call of ListByTypeIdKeyset.
+func (mr *MockSubcategoryMockRecorder) ListByTypeIdKeyset(ctx, typeID, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeIdKeyset", reflect.TypeOf((*MockSubcategory)(nil).ListByTypeIdKeyset), ctx, typeID, keyset, limit)
+}
+
+// ListKeyset mocks base method.
+func (m *MockSubcategory) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]repository.SubcategoryModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]repository.SubcategoryModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockSubcategoryMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockSubcategory)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockSubcategory) Update(ctx context.Context, id int32, info domain.SubcategoryInfo) (repository.SubcategoryModel, error) {
 	m.ctrl.T.Helper()
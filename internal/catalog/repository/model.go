@@ -2,6 +2,18 @@ package repository
 
 import "github.com/jackc/pgx/v5/pgtype"
 
+type CatalogTreeRowModel struct {
+	TypeID             int32
+	TypeName           string
+	CategoryID         pgtype.Int4
+	CategoryName       pgtype.Text
+	SubcategoryID      pgtype.Int4
+	SubcategoryName    pgtype.Text
+	ServiceID          pgtype.Int4
+	ServiceName        pgtype.Text
+	ServiceDescription pgtype.Text
+}
+
 type CategoryModel struct {
 	ID     int32
 	TypeID int32
@@ -18,6 +30,20 @@ type ProviderServiceModel struct {
 	ServiceID  int32
 }
 
+type SearchResultModel struct {
+	Kind            string
+	ID              int32
+	Name            string
+	Description     pgtype.Text
+	TypeID          pgtype.Int4
+	TypeName        pgtype.Text
+	CategoryID      pgtype.Int4
+	CategoryName    pgtype.Text
+	SubcategoryID   pgtype.Int4
+	SubcategoryName pgtype.Text
+	Rank            float32
+}
+
 type ServiceModel struct {
 	ID            int32
 	SubcategoryID int32
@@ -30,3 +56,16 @@ type SubcategoryModel struct {
 	CategoryID int32
 	Name       string
 }
+
+type SuggestionModel struct {
+	Kind string
+	ID   int32
+	Name string
+}
+
+type TranslationModel struct {
+	EntryID     int32
+	Locale      string
+	Name        string
+	Description pgtype.Text
+}
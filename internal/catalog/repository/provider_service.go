@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+type ProviderService interface {
+	postgres.Repository[ProviderService]
+	Get(ctx context.Context, providerID int64, serviceID int32) (ServiceModel, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]ServiceModel, error)
+	ListByProviderIdKeyset(ctx context.Context, providerID int64, keyset domain.Keyset, limit int64) ([]ServiceModel, error)
+	ListProviderIdsByServiceId(ctx context.Context, serviceID int32, limit int64, offset int64) ([]int64, error)
+	ListProviderIdsByServiceIdKeyset(ctx context.Context, serviceID int32, keyset domain.Keyset, limit int64) ([]int64, error)
+	Create(ctx context.Context, providerID int64, serviceID int32) error
+	Delete(ctx context.Context, providerID int64, serviceID int32) (bool, error)
+}
+
+type postgresProviderServiceRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewProviderServiceRepository(dbtx postgres.PGXQuerier) *postgresProviderServiceRepository {
+	return &postgresProviderServiceRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresProviderServiceRepository) WithTx(tx postgres.PGXQuerier) ProviderService {
+	return NewProviderServiceRepository(tx)
+}
+
+const getProviderService = `-- name: GetProviderService :one
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1 AND ps.service_id = $2
+`
+
+func (r *postgresProviderServiceRepository) Get(ctx context.Context, providerID int64, serviceID int32) (ServiceModel, error) {
+	row := r.db.QueryRow(ctx, getProviderService, providerID, serviceID)
+	var i ServiceModel
+	err := row.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description)
+	return i, err
+}
+
+const listServicesByProviderId = `-- name: ListServicesByProviderId :many
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1
+ORDER BY s.id LIMIT $2 OFFSET $3
+`
+
+func (r *postgresProviderServiceRepository) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]ServiceModel, error) {
+	rows, err := r.db.Query(ctx, listServicesByProviderId, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceModel
+	for rows.Next() {
+		var i ServiceModel
+		if err := rows.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServicesByProviderIdAfter = `-- name: ListServicesByProviderIdAfter :many
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1 AND s.id > $2
+ORDER BY s.id LIMIT $3
+`
+
+const listServicesByProviderIdBefore = `-- name: ListServicesByProviderIdBefore :many
+SELECT * FROM (
+    SELECT s.id, s.subcategory_id, s.name, s.description
+    FROM provider_services ps
+    JOIN services s ON ps.service_id = s.id
+    WHERE ps.provider_id = $1 AND s.id < $2
+    ORDER BY s.id DESC LIMIT $3
+) AS page ORDER BY id
+`
+
+func (r *postgresProviderServiceRepository) ListByProviderIdKeyset(ctx context.Context, providerID int64, keyset domain.Keyset, limit int64) ([]ServiceModel, error) {
+	query := listServicesByProviderIdAfter
+	if keyset.Backward {
+		query = listServicesByProviderIdBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, providerID, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceModel
+	for rows.Next() {
+		var i ServiceModel
+		if err := rows.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProviderIdsByServiceId = `-- name: ListProviderIdsByServiceId :many
+SELECT provider_id FROM provider_services WHERE service_id = $1 ORDER BY provider_id LIMIT $2 OFFSET $3
+`
+
+func (r *postgresProviderServiceRepository) ListProviderIdsByServiceId(ctx context.Context, serviceID int32, limit int64, offset int64) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listProviderIdsByServiceId, serviceID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var providerID int64
+		if err := rows.Scan(&providerID); err != nil {
+			return nil, err
+		}
+		items = append(items, providerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProviderIdsByServiceIdAfter = `-- name: ListProviderIdsByServiceIdAfter :many
+SELECT provider_id
+FROM provider_services
+WHERE service_id = $1 AND provider_id > $2
+ORDER BY provider_id LIMIT $3
+`
+
+const listProviderIdsByServiceIdBefore = `-- name: ListProviderIdsByServiceIdBefore :many
+SELECT * FROM (
+    SELECT provider_id
+    FROM provider_services
+    WHERE service_id = $1 AND provider_id < $2
+    ORDER BY provider_id DESC LIMIT $3
+) AS page ORDER BY provider_id
+`
+
+func (r *postgresProviderServiceRepository) ListProviderIdsByServiceIdKeyset(ctx context.Context, serviceID int32, keyset domain.Keyset, limit int64) ([]int64, error) {
+	query := listProviderIdsByServiceIdAfter
+	if keyset.Backward {
+		query = listProviderIdsByServiceIdBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, serviceID, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var providerID int64
+		if err := rows.Scan(&providerID); err != nil {
+			return nil, err
+		}
+		items = append(items, providerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createProviderService = `-- name: CreateProviderService :exec
+INSERT INTO provider_services (provider_id, service_id) VALUES ($1, $2)
+`
+
+func (r *postgresProviderServiceRepository) Create(ctx context.Context, providerID int64, serviceID int32) error {
+	_, err := r.db.Exec(ctx, createProviderService, providerID, serviceID)
+	return err
+}
+
+const deleteProviderService = `-- name: DeleteProviderService :exec
+DELETE FROM provider_services WHERE provider_id = $1 AND service_id = $2
+`
+
+func (r *postgresProviderServiceRepository) Delete(ctx context.Context, providerID int64, serviceID int32) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteProviderService, providerID, serviceID)
+	return result.RowsAffected() > 0, err
+}
@@ -0,0 +1,142 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+const providerId int64 = 1234567890
+
+func setupProviderService() (
+	ctx context.Context,
+	pgPool *pgxpool.Pool,
+	repo repository.ProviderService,
+) {
+	ctx = context.Background()
+
+	pgPool = getPgPool(ctx)
+	repo = repository.NewProviderServiceRepository(pgPool)
+
+	return
+}
+
+func TestCreateProviderService_Success(t *testing.T) {
+	ctx, pgPool, repo := setupProviderService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	service, err := insertService(pgPool, ctx, insertServiceDependencies(t, pgPool, ctx).ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, providerId, service.ID))
+
+	offered, err := repo.Get(ctx, providerId, service.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, service, offered)
+
+	services, err := repo.ListByProviderId(ctx, providerId, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.ServiceModel{service}, services)
+
+	providerIds, err := repo.ListProviderIdsByServiceId(ctx, service.ID, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{providerId}, providerIds)
+}
+
+func TestListProviderServicesKeyset(t *testing.T) {
+	ctx, pgPool, repo := setupProviderService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	services := make([]repository.ServiceModel, 3)
+	for i, name := range []string{serviceName, "Tap montage", "Pipe repair"} {
+		service, err := insertService(pgPool, ctx, subcategory.ID, name)
+		if err != nil {
+			t.Fatalf("failed to insert service: %v", err)
+		}
+		if err := repo.Create(ctx, providerId, service.ID); err != nil {
+			t.Fatalf("failed to insert provider service: %v", err)
+		}
+		services[i] = service
+	}
+
+	first, err := repo.ListByProviderIdKeyset(ctx, providerId, domain.NewKeyset(0, false), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, services[:2], first)
+
+	next, err := repo.ListByProviderIdKeyset(ctx, providerId, domain.NewKeyset(int64(services[1].ID), false), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, services[2:], next)
+
+	prev, err := repo.ListByProviderIdKeyset(ctx, providerId, domain.NewKeyset(int64(services[2].ID), true), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, first, prev)
+
+	providerIds, err := repo.ListProviderIdsByServiceIdKeyset(ctx, services[0].ID, domain.NewKeyset(providerId, false), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, providerIds)
+}
+
+func TestCreateProviderService_Conflict(t *testing.T) {
+	ctx, pgPool, repo := setupProviderService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	service, err := insertService(pgPool, ctx, insertServiceDependencies(t, pgPool, ctx).ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, providerId, service.ID))
+
+	err = repo.Create(ctx, providerId, service.ID)
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.UniqueViolation, pgErr.Code)
+	}
+}
+
+func TestCreateProviderService_NonexistentService(t *testing.T) {
+	ctx, pgPool, repo := setupProviderService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	err := repo.Create(ctx, providerId, 0)
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.ForeignKeyViolation, pgErr.Code)
+	}
+}
+
+func TestDeleteProviderService(t *testing.T) {
+	ctx, pgPool, repo := setupProviderService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	service, err := insertService(pgPool, ctx, insertServiceDependencies(t, pgPool, ctx).ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	if err := repo.Create(ctx, providerId, service.ID); err != nil {
+		t.Fatalf("failed to insert provider service: %v", err)
+	}
+
+	ok, err := repo.Delete(ctx, providerId, service.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = repo.Get(ctx, providerId, service.ID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+	ok, err = repo.Delete(ctx, providerId, service.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+type Search interface {
+	postgres.Repository[Search]
+	Search(ctx context.Context, query string, locale string, limit int64, offset int64) ([]SearchResultModel, error)
+	Autocomplete(ctx context.Context, prefix string, locale string, limit int64) ([]SuggestionModel, error)
+}
+
+type postgresSearchRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewSearchRepository(dbtx postgres.PGXQuerier) *postgresSearchRepository {
+	return &postgresSearchRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresSearchRepository) WithTx(tx postgres.PGXQuerier) Search {
+	return NewSearchRepository(tx)
+}
+
+const searchCatalog = `-- name: SearchCatalog :many
+WITH q AS (
+    SELECT replace(plainto_tsquery('english', $1::TEXT)::TEXT, '&', '|')::TSQUERY AS tsquery
+)
+SELECT kind, id, name, description, type_id, type_name, category_id, category_name, subcategory_id, subcategory_name,
+    (ts_rank(document, q.tsquery) + word_similarity($1, name))::REAL AS rank
+FROM catalog_search($2), q
+WHERE document @@ q.tsquery OR $1 <% name
+ORDER BY rank DESC, kind, id
+LIMIT $3 OFFSET $4
+`
+
+// Search matches the query against the names and descriptions of the whole catalog, ordered by relevance.
+// Any of the query words is enough for a full-text match, misspelled words are matched by trigram word similarity of the names.
+// Names and descriptions are matched and returned in the locale, falling back to the default locale where a translation is missing.
+// The localized documents aren't indexed, so the whole catalog is scanned.
+func (r *postgresSearchRepository) Search(ctx context.Context, query string, locale string, limit int64, offset int64) ([]SearchResultModel, error) {
+	rows, err := r.db.Query(ctx, searchCatalog, query, locale, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchResultModel
+	for rows.Next() {
+		var i SearchResultModel
+		if err := rows.Scan(
+			&i.Kind,
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.TypeID,
+			&i.TypeName,
+			&i.CategoryID,
+			&i.CategoryName,
+			&i.SubcategoryID,
+			&i.SubcategoryName,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const autocompleteCatalog = `-- name: AutocompleteCatalog :many
+SELECT kind, id, name FROM catalog_search($2)
+WHERE name ILIKE $1::TEXT || '%' OR name ILIKE '% ' || $1::TEXT || '%'
+ORDER BY name ILIKE $1::TEXT || '%' DESC, LENGTH(name), name, kind, id
+LIMIT $3
+`
+
+// Autocomplete returns the catalog entries having a word of their name starting with the prefix.
+// Names starting with the prefix come first, then the shorter names.
+// Names are matched and returned in the locale, falling back to the default locale where a translation is missing.
+// The localized names aren't indexed, so the whole catalog is scanned.
+func (r *postgresSearchRepository) Autocomplete(ctx context.Context, prefix string, locale string, limit int64) ([]SuggestionModel, error) {
+	rows, err := r.db.Query(ctx, autocompleteCatalog, escapeLike(prefix), locale, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SuggestionModel
+	for rows.Next() {
+		var i SuggestionModel
+		if err := rows.Scan(&i.Kind, &i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes the LIKE wildcards of the input match literally.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
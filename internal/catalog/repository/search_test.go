@@ -0,0 +1,123 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupSearch(t *testing.T) (
+	ctx context.Context,
+	pgPool *pgxpool.Pool,
+	repo repository.Search,
+) {
+	ctx = context.Background()
+
+	pgPool = getPgPool(ctx)
+	repo = repository.NewSearchRepository(pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+	if _, err := pgPool.Exec(ctx,
+		"INSERT INTO services (subcategory_id, name, description) VALUES ($1, $2, $3), ($1, 'Pipe_cleaning', NULL)",
+		subcategory.ID, serviceName, serviceDescription,
+	); err != nil {
+		t.Fatalf("failed to insert services: %v", err)
+	}
+
+	return
+}
+
+func TestSearch_FullText(t *testing.T) {
+	ctx, pgPool, repo := setupSearch(t)
+	defer cleanupPostgres(ctx, pgPool)
+
+	results, err := repo.Search(ctx, "fix leaking tap", "en", 10, 0)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, results) {
+		assert.Equal(t, "service", results[0].Kind)
+		assert.Equal(t, serviceName, results[0].Name)
+		assert.Equal(t, categoryTypeName, results[0].TypeName.String)
+		assert.Equal(t, categoryName, results[0].CategoryName.String)
+		assert.Equal(t, subcategoryName1, results[0].SubcategoryName.String)
+	}
+}
+
+func TestSearch_Typo(t *testing.T) {
+	ctx, pgPool, repo := setupSearch(t)
+	defer cleanupPostgres(ctx, pgPool)
+
+	results, err := repo.Search(ctx, "repar", "en", 10, 0)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, results) {
+		assert.Equal(t, serviceName, results[0].Name)
+	}
+}
+
+func TestSearch_NoMatch(t *testing.T) {
+	ctx, pgPool, repo := setupSearch(t)
+	defer cleanupPostgres(ctx, pgPool)
+
+	results, err := repo.Search(ctx, "zzzz", "en", 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestAutocomplete(t *testing.T) {
+	ctx, pgPool, repo := setupSearch(t)
+	defer cleanupPostgres(ctx, pgPool)
+
+	// matches the start of any word of the name
+	suggestions, err := repo.Autocomplete(ctx, "rep", "en", 10)
+	assert.NoError(t, err)
+	if assert.Len(t, suggestions, 1) {
+		assert.Equal(t, repository.SuggestionModel{Kind: "service", ID: suggestions[0].ID, Name: serviceName}, suggestions[0])
+	}
+
+	// wildcards are matched literally
+	suggestions, err = repo.Autocomplete(ctx, "Pipe_", "en", 10)
+	assert.NoError(t, err)
+	if assert.Len(t, suggestions, 1) {
+		assert.Equal(t, "Pipe_cleaning", suggestions[0].Name)
+	}
+
+	suggestions, err = repo.Autocomplete(ctx, "%", "en", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, suggestions)
+}
+
+func TestSearch_Localized(t *testing.T) {
+	ctx, pgPool, repo := setupSearch(t)
+	defer cleanupPostgres(ctx, pgPool)
+
+	var serviceID int32
+	if err := pgPool.QueryRow(ctx, "SELECT id FROM services WHERE name = $1", serviceName).Scan(&serviceID); err != nil {
+		t.Fatalf("failed to get service: %v", err)
+	}
+	if _, err := repository.NewTranslationRepository(pgPool).Upsert(ctx, domain.CatalogKindService, serviceID, "ka", domain.NewTranslationInfo("ონკანის შეკეთება", "გაჟონილი ონკანის შეკეთება")); err != nil {
+		t.Fatalf("failed to insert translation: %v", err)
+	}
+
+	results, err := repo.Search(ctx, "ონკანის", "ka", 10, 0)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, results) {
+		assert.Equal(t, "ონკანის შეკეთება", results[0].Name)
+		assert.Equal(t, "გაჟონილი ონკანის შეკეთება", results[0].Description.String)
+		// untranslated names fall back to the default locale
+		assert.Equal(t, categoryName, results[0].CategoryName.String)
+	}
+
+	suggestions, err := repo.Autocomplete(ctx, "შეკ", "ka", 10)
+	assert.NoError(t, err)
+	if assert.Len(t, suggestions, 1) {
+		assert.Equal(t, "ონკანის შეკეთება", suggestions[0].Name)
+	}
+
+	// the default locale keeps the stored names
+	suggestions, err = repo.Autocomplete(ctx, "შეკ", "en", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, suggestions)
+}
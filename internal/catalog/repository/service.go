@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Service interface {
+	postgres.Repository[Service]
+	Get(ctx context.Context, id int32) (ServiceModel, error)
+	List(ctx context.Context, limit int64, offset int64) ([]ServiceModel, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]ServiceModel, error)
+	Create(ctx context.Context, info domain.ServiceInfo) (int32, error)
+	Update(ctx context.Context, id int32, info domain.ServiceInfo) (ServiceModel, error)
+	Delete(ctx context.Context, id int32) (bool, error)
+}
+
+type postgresServiceRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewServiceRepository(dbtx postgres.PGXQuerier) *postgresServiceRepository {
+	return &postgresServiceRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresServiceRepository) WithTx(tx postgres.PGXQuerier) Service {
+	return NewServiceRepository(tx)
+}
+
+const getServiceById = `-- name: GetServiceById :one
+SELECT id, subcategory_id, name, description FROM services WHERE id = $1
+`
+
+func (r *postgresServiceRepository) Get(ctx context.Context, id int32) (ServiceModel, error) {
+	row := r.db.QueryRow(ctx, getServiceById, id)
+	var i ServiceModel
+	err := row.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description)
+	return i, err
+}
+
+const listServices = `-- name: ListServices :many
+SELECT id, subcategory_id, name, description FROM services ORDER BY id LIMIT $1 OFFSET $2
+`
+
+func (r *postgresServiceRepository) List(ctx context.Context, limit int64, offset int64) ([]ServiceModel, error) {
+	rows, err := r.db.Query(ctx, listServices, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceModel
+	for rows.Next() {
+		var i ServiceModel
+		if err := rows.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServicesAfter = `-- name: ListServicesAfter :many
+SELECT id, subcategory_id, name, description
+FROM services
+WHERE id > $1
+ORDER BY id LIMIT $2
+`
+
+const listServicesBefore = `-- name: ListServicesBefore :many
+SELECT * FROM (
+    SELECT id, subcategory_id, name, description
+    FROM services
+    WHERE id < $1
+    ORDER BY id DESC LIMIT $2
+) AS page ORDER BY id
+`
+
+func (r *postgresServiceRepository) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]ServiceModel, error) {
+	query := listServicesAfter
+	if keyset.Backward {
+		query = listServicesBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ServiceModel
+	for rows.Next() {
+		var i ServiceModel
+		if err := rows.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createService = `-- name: CreateService :one
+INSERT INTO services (subcategory_id, name, description) VALUES ($1, $2, $3) RETURNING id
+`
+
+func (r *postgresServiceRepository) Create(ctx context.Context, info domain.ServiceInfo) (int32, error) {
+	row := r.db.QueryRow(ctx, createService, info.SubcategoryID, info.Name, description(info))
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateService = `-- name: UpdateService :one
+UPDATE services SET subcategory_id = $1, name = $2, description = $3 WHERE id = $4 RETURNING id, subcategory_id, name, description
+`
+
+func (r *postgresServiceRepository) Update(ctx context.Context, id int32, info domain.ServiceInfo) (ServiceModel, error) {
+	row := r.db.QueryRow(ctx, updateService, info.SubcategoryID, info.Name, description(info), id)
+	var i ServiceModel
+	err := row.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description)
+	return i, err
+}
+
+const deleteService = `-- name: DeleteService :exec
+DELETE FROM services WHERE id = $1
+`
+
+func (r *postgresServiceRepository) Delete(ctx context.Context, id int32) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteService, id)
+	return result.RowsAffected() > 0, err
+}
+
+// description stores an empty description as NULL.
+func description(info domain.ServiceInfo) pgtype.Text {
+	return pgtype.Text{String: info.Description, Valid: info.Description != ""}
+}
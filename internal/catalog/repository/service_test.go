@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	serviceName        = "Tap repair"
+	serviceDescription = "Repair of a leaking tap"
+)
+
+func setupService() (
+	ctx context.Context,
+	pgPool *pgxpool.Pool,
+	repo repository.Service,
+) {
+	ctx = context.Background()
+
+	pgPool = getPgPool(ctx)
+	repo = repository.NewServiceRepository(pgPool)
+
+	return
+}
+
+func TestCreateService_Success(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	serviceId, err := repo.Create(ctx, domain.NewServiceInfo(subcategory.ID, serviceName, ""))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, serviceId)
+
+	service, err := repo.Get(ctx, serviceId)
+	assert.NoError(t, err)
+	assert.Equal(t, serviceName, service.Name)
+	assert.False(t, service.Description.Valid)
+}
+
+func TestCreateService_NonexistentSubcategory(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	serviceId, err := repo.Create(ctx, domain.NewServiceInfo(0, serviceName, serviceDescription))
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.ForeignKeyViolation, pgErr.Code)
+	}
+	assert.Empty(t, serviceId)
+}
+
+func TestCreateService_Conflict(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	_, err := repo.Create(ctx, domain.NewServiceInfo(subcategory.ID, serviceName, serviceDescription))
+	assert.NoError(t, err)
+
+	serviceId, err := repo.Create(ctx, domain.NewServiceInfo(subcategory.ID, serviceName, serviceDescription))
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.RaiseException, pgErr.Code)
+	}
+	assert.Empty(t, serviceId)
+}
+
+func TestUpdateService_Success(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	insert, err := insertService(pgPool, ctx, subcategory.ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	// the service keeps its own name without a conflict
+	service, err := repo.Update(ctx, insert.ID, domain.NewServiceInfo(subcategory.ID, serviceName, serviceDescription))
+	assert.NoError(t, err)
+	assert.Equal(t, serviceName, service.Name)
+	assert.Equal(t, serviceDescription, service.Description.String)
+}
+
+func TestUpdateService_NotFound(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	_, err := repo.Update(ctx, 1, domain.NewServiceInfo(1, serviceName, serviceDescription))
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestListServices_Success(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	for _, name := range []string{serviceName, "Tap montage"} {
+		if _, err := insertService(pgPool, ctx, subcategory.ID, name); err != nil {
+			t.Fatalf("failed to insert service: %v", err)
+		}
+	}
+
+	services, err := repo.List(ctx, 1, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, services, 1) {
+		assert.Equal(t, "Tap montage", services[0].Name)
+	}
+}
+
+func TestDeleteService_Success(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	subcategory := insertServiceDependencies(t, pgPool, ctx)
+
+	insert, err := insertService(pgPool, ctx, subcategory.ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	// offerings of the service are removed with it
+	if err := repository.NewProviderServiceRepository(pgPool).Create(ctx, providerId, insert.ID); err != nil {
+		t.Fatalf("failed to insert provider service: %v", err)
+	}
+
+	ok, err := repo.Delete(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = repo.Get(ctx, insert.ID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestDeleteService_NotFound(t *testing.T) {
+	ctx, pgPool, repo := setupService()
+	defer cleanupPostgres(ctx, pgPool)
+
+	ok, err := repo.Delete(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func insertServiceDependencies(t *testing.T, dbPool *pgxpool.Pool, ctx context.Context) repository.SubcategoryModel {
+	_, category := insertSubcategoryDependencies(t, dbPool, ctx)
+
+	subcategory, err := insertSubcategory(dbPool, ctx, category.ID, subcategoryName1)
+	if err != nil {
+		t.Fatalf("failed to insert subcategory: %v", err)
+	}
+
+	return subcategory
+}
+
+func insertService(dbPool *pgxpool.Pool, ctx context.Context, subcategoryID int32, name string) (repository.ServiceModel, error) {
+	row := dbPool.QueryRow(ctx, "INSERT INTO services (subcategory_id, name) VALUES ($1, $2) RETURNING id, subcategory_id, name, description", subcategoryID, name)
+	var i repository.ServiceModel
+	err := row.Scan(&i.ID, &i.SubcategoryID, &i.Name, &i.Description)
+	return i, err
+}
@@ -11,8 +11,11 @@ type Subcategory interface {
 	postgres.Repository[Subcategory]
 	Get(ctx context.Context, id int32) (SubcategoryModel, error)
 	List(ctx context.Context, limit int64, offset int64) ([]SubcategoryModel, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error)
 	ListByCategoryId(ctx context.Context, categoryID int32, limit int64, offset int64) ([]SubcategoryModel, error)
+	ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error)
 	ListByTypeId(ctx context.Context, typeID int32, limit int64, offset int64) ([]SubcategoryModel, error)
+	ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error)
 	Create(ctx context.Context, info domain.SubcategoryInfo) (int32, error)
 	Update(ctx context.Context, id int32, info domain.SubcategoryInfo) (SubcategoryModel, error)
 	Delete(ctx context.Context, id int32) (bool, error)
@@ -67,6 +70,47 @@ func (r *postgresSubcategoryRepository) List(ctx context.Context, limit int64, o
 	return items, nil
 }
 
+const listSubcategoriesAfter = `-- name: ListSubcategoriesAfter :many
+SELECT id, category_id, name
+FROM subcategories
+WHERE id > $1
+ORDER BY id LIMIT $2
+`
+
+const listSubcategoriesBefore = `-- name: ListSubcategoriesBefore :many
+SELECT * FROM (
+    SELECT id, category_id, name
+    FROM subcategories
+    WHERE id < $1
+    ORDER BY id DESC LIMIT $2
+) AS page ORDER BY id
+`
+
+func (r *postgresSubcategoryRepository) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error) {
+	query := listSubcategoriesAfter
+	if keyset.Backward {
+		query = listSubcategoriesBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SubcategoryModel
+	for rows.Next() {
+		var i SubcategoryModel
+		if err := rows.Scan(&i.ID, &i.CategoryID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSubategoriesByCategoryId = `-- name: ListSubategoriesByCategoryId :many
 SELECT id, category_id, name FROM subcategories WHERE category_id = $1 ORDER BY id LIMIT $2 OFFSET $3
 `
@@ -91,6 +135,47 @@ func (r *postgresSubcategoryRepository) ListByCategoryId(ctx context.Context, ca
 	return items, nil
 }
 
+const listSubcategoriesByCategoryIdAfter = `-- name: ListSubcategoriesByCategoryIdAfter :many
+SELECT id, category_id, name
+FROM subcategories
+WHERE category_id = $1 AND id > $2
+ORDER BY id LIMIT $3
+`
+
+const listSubcategoriesByCategoryIdBefore = `-- name: ListSubcategoriesByCategoryIdBefore :many
+SELECT * FROM (
+    SELECT id, category_id, name
+    FROM subcategories
+    WHERE category_id = $1 AND id < $2
+    ORDER BY id DESC LIMIT $3
+) AS page ORDER BY id
+`
+
+func (r *postgresSubcategoryRepository) ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error) {
+	query := listSubcategoriesByCategoryIdAfter
+	if keyset.Backward {
+		query = listSubcategoriesByCategoryIdBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, categoryID, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SubcategoryModel
+	for rows.Next() {
+		var i SubcategoryModel
+		if err := rows.Scan(&i.ID, &i.CategoryID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSubategoriesByTypeId = `-- name: ListSubategoriesByTypeId :many
 SELECT s.id, s.category_id, s.name 
 FROM subcategories s
@@ -119,6 +204,49 @@ func (r *postgresSubcategoryRepository) ListByTypeId(ctx context.Context, typeID
 	return items, nil
 }
 
+const listSubcategoriesByTypeIdAfter = `-- name: ListSubcategoriesByTypeIdAfter :many
+SELECT s.id, s.category_id, s.name
+FROM subcategories s
+JOIN categories c ON s.category_id = c.id
+WHERE c.type_id = $1 AND s.id > $2
+ORDER BY s.id LIMIT $3
+`
+
+const listSubcategoriesByTypeIdBefore = `-- name: ListSubcategoriesByTypeIdBefore :many
+SELECT * FROM (
+    SELECT s.id, s.category_id, s.name
+    FROM subcategories s
+    JOIN categories c ON s.category_id = c.id
+    WHERE c.type_id = $1 AND s.id < $2
+    ORDER BY s.id DESC LIMIT $3
+) AS page ORDER BY id
+`
+
+func (r *postgresSubcategoryRepository) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]SubcategoryModel, error) {
+	query := listSubcategoriesByTypeIdAfter
+	if keyset.Backward {
+		query = listSubcategoriesByTypeIdBefore
+	}
+
+	rows, err := r.db.Query(ctx, query, typeID, keyset.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SubcategoryModel
+	for rows.Next() {
+		var i SubcategoryModel
+		if err := rows.Scan(&i.ID, &i.CategoryID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createSubcategory = `-- name: CreateSubcategory :one
 INSERT INTO subcategories (category_id, name) VALUES ($1, $2) RETURNING id
 `
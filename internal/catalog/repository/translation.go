@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Translation interface {
+	postgres.Repository[Translation]
+	List(ctx context.Context, kind domain.CatalogKind, entryID int32) ([]TranslationModel, error)
+	ListByLocale(ctx context.Context, kind domain.CatalogKind, entryIDs []int32, locale string) ([]TranslationModel, error)
+	Upsert(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string, info domain.TranslationInfo) (TranslationModel, error)
+	Delete(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string) (bool, error)
+}
+
+type postgresTranslationRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewTranslationRepository(dbtx postgres.PGXQuerier) *postgresTranslationRepository {
+	return &postgresTranslationRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresTranslationRepository) WithTx(tx postgres.PGXQuerier) Translation {
+	return NewTranslationRepository(tx)
+}
+
+type translationQueries struct {
+	list         string
+	listByLocale string
+	upsert       string
+	delete       string
+}
+
+const listCategoryTranslations = `-- name: ListCategoryTranslations :many
+SELECT category_id, locale, name, NULL::TEXT AS description FROM category_translations WHERE category_id = $1 ORDER BY locale
+`
+
+const listCategoryTranslationsByLocale = `-- name: ListCategoryTranslationsByLocale :many
+SELECT category_id, locale, name, NULL::TEXT AS description FROM category_translations WHERE category_id = ANY($1::INT[]) AND locale = $2
+`
+
+const upsertCategoryTranslation = `-- name: UpsertCategoryTranslation :one
+INSERT INTO category_translations (category_id, locale, name) VALUES ($1, $2, $3)
+ON CONFLICT (category_id, locale) DO UPDATE SET name = EXCLUDED.name
+RETURNING category_id, locale, name, NULL::TEXT AS description
+`
+
+const deleteCategoryTranslation = `-- name: DeleteCategoryTranslation :exec
+DELETE FROM category_translations WHERE category_id = $1 AND locale = $2
+`
+
+const listSubcategoryTranslations = `-- name: ListSubcategoryTranslations :many
+SELECT subcategory_id, locale, name, NULL::TEXT AS description FROM subcategory_translations WHERE subcategory_id = $1 ORDER BY locale
+`
+
+const listSubcategoryTranslationsByLocale = `-- name: ListSubcategoryTranslationsByLocale :many
+SELECT subcategory_id, locale, name, NULL::TEXT AS description FROM subcategory_translations WHERE subcategory_id = ANY($1::INT[]) AND locale = $2
+`
+
+const upsertSubcategoryTranslation = `-- name: UpsertSubcategoryTranslation :one
+INSERT INTO subcategory_translations (subcategory_id, locale, name) VALUES ($1, $2, $3)
+ON CONFLICT (subcategory_id, locale) DO UPDATE SET name = EXCLUDED.name
+RETURNING subcategory_id, locale, name, NULL::TEXT AS description
+`
+
+const deleteSubcategoryTranslation = `-- name: DeleteSubcategoryTranslation :exec
+DELETE FROM subcategory_translations WHERE subcategory_id = $1 AND locale = $2
+`
+
+const listServiceTranslations = `-- name: ListServiceTranslations :many
+SELECT service_id, locale, name, description FROM service_translations WHERE service_id = $1 ORDER BY locale
+`
+
+const listServiceTranslationsByLocale = `-- name: ListServiceTranslationsByLocale :many
+SELECT service_id, locale, name, description FROM service_translations WHERE service_id = ANY($1::INT[]) AND locale = $2
+`
+
+const upsertServiceTranslation = `-- name: UpsertServiceTranslation :one
+INSERT INTO service_translations (service_id, locale, name, description) VALUES ($1, $2, $3, $4)
+ON CONFLICT (service_id, locale) DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description
+RETURNING service_id, locale, name, description
+`
+
+const deleteServiceTranslation = `-- name: DeleteServiceTranslation :exec
+DELETE FROM service_translations WHERE service_id = $1 AND locale = $2
+`
+
+var translationQueriesByKind = map[domain.CatalogKind]translationQueries{
+	domain.CatalogKindCategory: {
+		list:         listCategoryTranslations,
+		listByLocale: listCategoryTranslationsByLocale,
+		upsert:       upsertCategoryTranslation,
+		delete:       deleteCategoryTranslation,
+	},
+	domain.CatalogKindSubcategory: {
+		list:         listSubcategoryTranslations,
+		listByLocale: listSubcategoryTranslationsByLocale,
+		upsert:       upsertSubcategoryTranslation,
+		delete:       deleteSubcategoryTranslation,
+	},
+	domain.CatalogKindService: {
+		list:         listServiceTranslations,
+		listByLocale: listServiceTranslationsByLocale,
+		upsert:       upsertServiceTranslation,
+		delete:       deleteServiceTranslation,
+	},
+}
+
+func queriesOf(kind domain.CatalogKind) (translationQueries, error) {
+	queries, ok := translationQueriesByKind[kind]
+	if !ok {
+		return translationQueries{}, fmt.Errorf("catalog kind %q has no translations", kind)
+	}
+	return queries, nil
+}
+
+// List returns all translations of the catalog entry ordered by locale.
+func (r *postgresTranslationRepository) List(ctx context.Context, kind domain.CatalogKind, entryID int32) ([]TranslationModel, error) {
+	queries, err := queriesOf(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(ctx, queries.list, entryID)
+}
+
+// ListByLocale returns the translations of the catalog entries to the locale, entries without one are left out.
+func (r *postgresTranslationRepository) ListByLocale(ctx context.Context, kind domain.CatalogKind, entryIDs []int32, locale string) ([]TranslationModel, error) {
+	queries, err := queriesOf(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(ctx, queries.listByLocale, entryIDs, locale)
+}
+
+// Upsert creates the translation of the catalog entry to the locale or replaces the existing one.
+// The description is only stored for services.
+func (r *postgresTranslationRepository) Upsert(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string, info domain.TranslationInfo) (TranslationModel, error) {
+	queries, err := queriesOf(kind)
+	if err != nil {
+		return TranslationModel{}, err
+	}
+
+	args := []any{entryID, locale, info.Name}
+	if kind == domain.CatalogKindService {
+		args = append(args, pgtype.Text{String: info.Description, Valid: info.Description != ""})
+	}
+
+	row := r.db.QueryRow(ctx, queries.upsert, args...)
+	var i TranslationModel
+	err = row.Scan(
+		&i.EntryID,
+		&i.Locale,
+		&i.Name,
+		&i.Description,
+	)
+	return i, err
+}
+
+func (r *postgresTranslationRepository) Delete(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string) (bool, error) {
+	queries, err := queriesOf(kind)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.db.Exec(ctx, queries.delete, entryID, locale)
+	return result.RowsAffected() > 0, err
+}
+
+func (r *postgresTranslationRepository) query(ctx context.Context, query string, args ...any) ([]TranslationModel, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TranslationModel
+	for rows.Next() {
+		var i TranslationModel
+		if err := rows.Scan(
+			&i.EntryID,
+			&i.Locale,
+			&i.Name,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,122 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+const translationName = "ონკანის შეკეთება"
+
+func setupTranslation() (
+	ctx context.Context,
+	pgPool *pgxpool.Pool,
+	repo repository.Translation,
+) {
+	ctx = context.Background()
+
+	pgPool = getPgPool(ctx)
+	repo = repository.NewTranslationRepository(pgPool)
+
+	return
+}
+
+func TestUpsertTranslation_Success(t *testing.T) {
+	ctx, pgPool, repo := setupTranslation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	service, err := insertService(pgPool, ctx, insertServiceDependencies(t, pgPool, ctx).ID, serviceName)
+	if err != nil {
+		t.Fatalf("failed to insert service: %v", err)
+	}
+
+	translation, err := repo.Upsert(ctx, domain.CatalogKindService, service.ID, "ka", domain.NewTranslationInfo("სახელი", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, "სახელი", translation.Name)
+	assert.False(t, translation.Description.Valid)
+
+	translation, err = repo.Upsert(ctx, domain.CatalogKindService, service.ID, "ka", domain.NewTranslationInfo(translationName, "აღწერა"))
+	assert.NoError(t, err)
+	assert.Equal(t, repository.TranslationModel{
+		EntryID:     service.ID,
+		Locale:      "ka",
+		Name:        translationName,
+		Description: pgtype.Text{String: "აღწერა", Valid: true},
+	}, translation)
+
+	translations, err := repo.List(ctx, domain.CatalogKindService, service.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.TranslationModel{translation}, translations)
+
+	translations, err = repo.ListByLocale(ctx, domain.CatalogKindService, []int32{service.ID}, "ru")
+	assert.NoError(t, err)
+	assert.Empty(t, translations)
+}
+
+func TestUpsertTranslation_NonexistentEntry(t *testing.T) {
+	ctx, pgPool, repo := setupTranslation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	_, err := repo.Upsert(ctx, domain.CatalogKindCategory, 0, "ka", domain.NewTranslationInfo(translationName, ""))
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.ForeignKeyViolation, pgErr.Code)
+	}
+}
+
+func TestUpsertTranslation_DuplicateName(t *testing.T) {
+	ctx, pgPool, repo := setupTranslation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	_, category := insertSubcategoryDependencies(t, pgPool, ctx)
+
+	subcategories := make([]repository.SubcategoryModel, 2)
+	for i, name := range []string{subcategoryName1, subcategoryName2} {
+		subcategory, err := insertSubcategory(pgPool, ctx, category.ID, name)
+		if err != nil {
+			t.Fatalf("failed to insert subcategory: %v", err)
+		}
+		subcategories[i] = subcategory
+	}
+
+	_, err := repo.Upsert(ctx, domain.CatalogKindSubcategory, subcategories[0].ID, "ka", domain.NewTranslationInfo(translationName, ""))
+	assert.NoError(t, err)
+
+	// the same name is allowed in another locale
+	_, err = repo.Upsert(ctx, domain.CatalogKindSubcategory, subcategories[1].ID, "ru", domain.NewTranslationInfo(translationName, ""))
+	assert.NoError(t, err)
+
+	_, err = repo.Upsert(ctx, domain.CatalogKindSubcategory, subcategories[1].ID, "ka", domain.NewTranslationInfo(translationName, ""))
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.RaiseException, pgErr.Code)
+	}
+}
+
+func TestDeleteTranslation(t *testing.T) {
+	ctx, pgPool, repo := setupTranslation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	_, category := insertSubcategoryDependencies(t, pgPool, ctx)
+
+	if _, err := repo.Upsert(ctx, domain.CatalogKindCategory, category.ID, "ka", domain.NewTranslationInfo(translationName, "")); err != nil {
+		t.Fatalf("failed to insert translation: %v", err)
+	}
+
+	ok, err := repo.Delete(ctx, domain.CatalogKindCategory, category.ID, "ka")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.Delete(ctx, domain.CatalogKindCategory, category.ID, "ka")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/hexley21/fixup/internal/catalog/delivery/http/v1"
 	"github.com/hexley21/fixup/internal/catalog/repository"
@@ -32,6 +33,10 @@ type services struct {
 	categoryTypes service.CategoryTypeService
 	category      service.CategoryService
 	subcategory   service.SubcategoryService
+	service       service.ServiceService
+	catalogTree   service.CatalogTreeService
+	search        service.SearchService
+	translation   service.TranslationService
 }
 
 type jWTManagers struct {
@@ -45,6 +50,7 @@ type server struct {
 	metricsMux        *http.Server
 	cfg               *config.Config
 	dbPool            *pgxpool.Pool
+	redisCluster      *redis.ClusterClient
 	handlerComponents *handler.Components
 	jWTManagers       *jWTManagers
 	services          *services
@@ -55,6 +61,7 @@ type server struct {
 func NewServer(
 	cfg *config.Config,
 	dbPool *pgxpool.Pool,
+	redisCluster *redis.ClusterClient,
 	logger logger.Logger,
 	_ *snowflake.Node,
 	validator validator.Validator,
@@ -62,11 +69,28 @@ func NewServer(
 	categoryTypeRepository := repository.NewCategoryTypeRepository(dbPool)
 	categoryRepository := repository.NewCategoryRepository(dbPool)
 	subcategoryRepository := repository.NewSubcategoryRepository(dbPool)
+	serviceRepository := repository.NewServiceRepository(dbPool)
+	providerServiceRepository := repository.NewProviderServiceRepository(dbPool)
+	catalogTreeRepository := repository.NewCatalogTreeRepository(dbPool)
+	catalogTreeCache := repository.NewCatalogTreeCache(redisCluster)
+	searchRepository := repository.NewSearchRepository(dbPool)
+	translationRepository := repository.NewTranslationRepository(dbPool)
+
+	localizer := service.NewLocalizer(translationRepository, cfg.Localization.DefaultLocale)
 
 	services := &services{
-		categoryTypes: service.NewCategoryTypeService(categoryTypeRepository),
-		category:      service.NewCategoryService(categoryRepository),
-		subcategory:   service.NewSubcategoryService(subcategoryRepository),
+		categoryTypes: service.NewCategoryTypeService(categoryTypeRepository, catalogTreeCache),
+		category:      service.NewCategoryService(categoryRepository, catalogTreeCache, localizer),
+		subcategory:   service.NewSubcategoryService(subcategoryRepository, catalogTreeCache, localizer),
+		service:       service.NewServiceService(serviceRepository, providerServiceRepository, catalogTreeCache, localizer),
+		catalogTree:   service.NewCatalogTreeService(catalogTreeRepository, catalogTreeCache),
+		search:        service.NewSearchService(searchRepository),
+		translation: service.NewTranslationService(
+			translationRepository,
+			catalogTreeCache,
+			cfg.Localization.DefaultLocale,
+			cfg.Localization.Locales,
+		),
 	}
 
 	jWTManagers := &jWTManagers{
@@ -106,6 +130,7 @@ func NewServer(
 		metricsMux:        metricsMux,
 		cfg:               cfg,
 		dbPool:            dbPool,
+		redisCluster:      redisCluster,
 		handlerComponents: handlerComponents,
 		jWTManagers:       jWTManagers,
 		services:          services,
@@ -137,10 +162,15 @@ func (s *server) Run() error {
 		CategoryTypeService: s.services.categoryTypes,
 		CategoryService:     s.services.category,
 		SubcategoryService:  s.services.subcategory,
+		ServiceService:      s.services.service,
+		CatalogTreeService:  s.services.catalogTree,
+		SearchService:       s.services.search,
+		TranslationService:  s.services.translation,
 		Middleware:          Middleware,
 		HandlerComponents:   s.handlerComponents,
 		AccessJWTManager:    s.jWTManagers.accessJWTManager,
 		PaginationConfig:    &s.cfg.Pagination,
+		LocalizationConfig:  &s.cfg.Localization,
 	}, s.router)
 
 
@@ -167,7 +197,7 @@ func (s *server) Run() error {
 	}
 }
 
-// Close gracefully shuts down the server, including its HTTP mux, metrics mux and database pool.
+// Close gracefully shuts down the server, including its HTTP mux, metrics mux, database pool and redis client.
 // Errors during shutdown are logged, but the function returns nil to ensure all components attempt to close.
 // Complies to io.Closer interface.
 func (s *server) Close() error {
@@ -187,6 +217,12 @@ func (s *server) Close() error {
 	}
 
 	err = postgres.Close(s.dbPool)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	err = s.redisCluster.Close()
 	if err != nil {
 		s.handlerComponents.Logger.Error(err)
 	}
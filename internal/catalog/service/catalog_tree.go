@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/hexley21/fixup/internal/common/locale"
+)
+
+// catalogTreeCacheTTL bounds how long a cached tree may outlive a missed invalidation.
+const catalogTreeCacheTTL = time.Hour
+
+type CatalogTreeService interface {
+	Get(ctx context.Context) (domain.CatalogTree, error)
+}
+
+type catalogTreeImpl struct {
+	catalogTreeRepo  repository.CatalogTree
+	catalogTreeCache repository.CatalogTreeCache
+}
+
+func NewCatalogTreeService(catalogTreeRepo repository.CatalogTree, catalogTreeCache repository.CatalogTreeCache) *catalogTreeImpl {
+	return &catalogTreeImpl{
+		catalogTreeRepo:  catalogTreeRepo,
+		catalogTreeCache: catalogTreeCache,
+	}
+}
+
+// Get returns the whole catalog tree in the locale of the context, served from the cache when it is present.
+// The cache only speeds reads up, so if it can not be read or written the tree is built from the repository.
+// The tree is cached only if no write invalidated the cache while it was built, so a stale tree can not outlive the invalidation.
+// The version of the tree is a hash of its content and is suitable as an ETag.
+func (s *catalogTreeImpl) Get(ctx context.Context) (domain.CatalogTree, error) {
+	loc := locale.FromContext(ctx)
+
+	if data, err := s.catalogTreeCache.Get(ctx, loc); err == nil {
+		var tree domain.CatalogTree
+		if err := json.Unmarshal(data, &tree); err == nil {
+			return tree, nil
+		}
+	}
+
+	// the generation is taken before the tree is built, so invalidations during the build are noticed
+	generation, generationErr := s.catalogTreeCache.Generation(ctx)
+
+	rows, err := s.catalogTreeRepo.List(ctx, loc)
+	if err != nil {
+		return domain.CatalogTree{}, err
+	}
+
+	tree := domain.CatalogTree{Types: buildCatalogTree(rows)}
+
+	content, err := json.Marshal(tree.Types)
+	if err != nil {
+		return domain.CatalogTree{}, err
+	}
+	sum := sha256.Sum256(content)
+	tree.Version = hex.EncodeToString(sum[:])
+
+	if generationErr != nil {
+		return tree, nil
+	}
+	if data, err := json.Marshal(tree); err == nil {
+		_, _ = s.catalogTreeCache.Set(ctx, loc, data, generation, catalogTreeCacheTTL)
+	}
+
+	return tree, nil
+}
+
+// buildCatalogTree nests the flat rows of the repository, it relies on the rows of each branch being adjacent.
+func buildCatalogTree(rows []repository.CatalogTreeRowModel) []domain.CatalogTreeType {
+	types := make([]domain.CatalogTreeType, 0)
+
+	for _, row := range rows {
+		if len(types) == 0 || types[len(types)-1].ID != row.TypeID {
+			types = append(types, domain.CatalogTreeType{ID: row.TypeID, Name: row.TypeName})
+		}
+		if !row.CategoryID.Valid {
+			continue
+		}
+
+		categoryType := &types[len(types)-1]
+		if n := len(categoryType.Categories); n == 0 || categoryType.Categories[n-1].ID != row.CategoryID.Int32 {
+			categoryType.Categories = append(categoryType.Categories, domain.CatalogTreeCategory{ID: row.CategoryID.Int32, Name: row.CategoryName.String})
+		}
+		if !row.SubcategoryID.Valid {
+			continue
+		}
+
+		category := &categoryType.Categories[len(categoryType.Categories)-1]
+		if n := len(category.Subcategories); n == 0 || category.Subcategories[n-1].ID != row.SubcategoryID.Int32 {
+			category.Subcategories = append(category.Subcategories, domain.CatalogTreeSubcategory{ID: row.SubcategoryID.Int32, Name: row.SubcategoryName.String})
+		}
+		if !row.ServiceID.Valid {
+			continue
+		}
+
+		subcategory := &category.Subcategories[len(category.Subcategories)-1]
+		subcategory.Services = append(subcategory.Services, domain.CatalogTreeService{
+			ID:          row.ServiceID.Int32,
+			Name:        row.ServiceName.String,
+			Description: row.ServiceDescription.String,
+		})
+	}
+
+	return types
+}
+
+// invalidateCatalogTree drops the cached catalog tree after a successful write.
+// The write is already done at this point, so a cache failure is not reported to the caller,
+// the stale tree expires after catalogTreeCacheTTL at the latest.
+func invalidateCatalogTree(ctx context.Context, catalogTreeCache repository.CatalogTreeCache) {
+	_ = catalogTreeCache.Delete(ctx)
+}
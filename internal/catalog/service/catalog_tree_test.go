@@ -0,0 +1,275 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	mock_repository "github.com/hexley21/fixup/internal/catalog/repository/mock"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/internal/common/locale"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var catalogTreeRows = []repository.CatalogTreeRowModel{
+	{
+		TypeID:             1,
+		TypeName:           "Home",
+		CategoryID:         pgtype.Int4{Int32: 1, Valid: true},
+		CategoryName:       pgtype.Text{String: "Plumbing", Valid: true},
+		SubcategoryID:      pgtype.Int4{Int32: 1, Valid: true},
+		SubcategoryName:    pgtype.Text{String: "Taps", Valid: true},
+		ServiceID:          pgtype.Int4{Int32: 1, Valid: true},
+		ServiceName:        pgtype.Text{String: "Tap repair", Valid: true},
+		ServiceDescription: pgtype.Text{String: "Repair of a leaking tap", Valid: true},
+	},
+	{
+		TypeID:          1,
+		TypeName:        "Home",
+		CategoryID:      pgtype.Int4{Int32: 1, Valid: true},
+		CategoryName:    pgtype.Text{String: "Plumbing", Valid: true},
+		SubcategoryID:   pgtype.Int4{Int32: 1, Valid: true},
+		SubcategoryName: pgtype.Text{String: "Taps", Valid: true},
+		ServiceID:       pgtype.Int4{Int32: 2, Valid: true},
+		ServiceName:     pgtype.Text{String: "Tap montage", Valid: true},
+	},
+	{
+		TypeID:          1,
+		TypeName:        "Home",
+		CategoryID:      pgtype.Int4{Int32: 1, Valid: true},
+		CategoryName:    pgtype.Text{String: "Plumbing", Valid: true},
+		SubcategoryID:   pgtype.Int4{Int32: 2, Valid: true},
+		SubcategoryName: pgtype.Text{String: "Pipes", Valid: true},
+	},
+	{
+		TypeID:   2,
+		TypeName: "Garden",
+	},
+}
+
+var catalogTreeTypes = []domain.CatalogTreeType{
+	{
+		ID:   1,
+		Name: "Home",
+		Categories: []domain.CatalogTreeCategory{
+			{
+				ID:   1,
+				Name: "Plumbing",
+				Subcategories: []domain.CatalogTreeSubcategory{
+					{
+						ID:   1,
+						Name: "Taps",
+						Services: []domain.CatalogTreeService{
+							{ID: 1, Name: "Tap repair", Description: "Repair of a leaking tap"},
+							{ID: 2, Name: "Tap montage"},
+						},
+					},
+					{ID: 2, Name: "Pipes"},
+				},
+			},
+		},
+	},
+	{ID: 2, Name: "Garden"},
+}
+
+// newCatalogTreeCacheStub returns a cache that accepts any invalidation, for tests that are not about the cache.
+func newCatalogTreeCacheStub(ctrl *gomock.Controller) *mock_repository.MockCatalogTreeCache {
+	cache := mock_repository.NewMockCatalogTreeCache(ctrl)
+	cache.EXPECT().Delete(gomock.Any()).Return(nil).AnyTimes()
+
+	return cache
+}
+
+func setupCatalogTree(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	mockCatalogTreeRepo *mock_repository.MockCatalogTree,
+	mockCatalogTreeCache *mock_repository.MockCatalogTreeCache,
+	svc service.CatalogTreeService,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockCatalogTreeRepo = mock_repository.NewMockCatalogTree(ctrl)
+	mockCatalogTreeCache = mock_repository.NewMockCatalogTreeCache(ctrl)
+	svc = service.NewCatalogTreeService(mockCatalogTreeRepo, mockCatalogTreeCache)
+
+	return
+}
+
+func TestGetCatalogTree_CacheHit(t *testing.T) {
+	ctrl, ctx, _, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	cached := domain.CatalogTree{Version: "version", Types: catalogTreeTypes}
+	data, err := json.Marshal(cached)
+	if err != nil {
+		t.Fatalf("failed to marshal tree: %v", err)
+	}
+
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(data, nil)
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, cached, tree)
+}
+
+func TestGetCatalogTree_CacheMiss(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	var cached []byte
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, redis.Nil)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(4), nil)
+	mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil)
+	mockCatalogTreeCache.EXPECT().Set(ctx, "", gomock.Any(), int64(4), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, data []byte, _ int64, _ any) (bool, error) {
+			cached = data
+			return true, nil
+		},
+	)
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogTreeTypes, tree.Types)
+	assert.NotEmpty(t, tree.Version)
+
+	var cachedTree domain.CatalogTree
+	if assert.NoError(t, json.Unmarshal(cached, &cachedTree)) {
+		assert.Equal(t, tree, cachedTree)
+	}
+}
+
+func TestGetCatalogTree_Localized(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	ctx = locale.WithLocale(ctx, "ka")
+
+	mockCatalogTreeCache.EXPECT().Get(ctx, "ka").Return(nil, redis.Nil)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(0), nil)
+	mockCatalogTreeRepo.EXPECT().List(ctx, "ka").Return(catalogTreeRows, nil)
+	mockCatalogTreeCache.EXPECT().Set(ctx, "ka", gomock.Any(), int64(0), gomock.Any()).Return(true, nil)
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogTreeTypes, tree.Types)
+}
+
+func TestGetCatalogTree_VersionFollowsContent(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, redis.Nil).Times(3)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(0), nil).Times(3)
+	mockCatalogTreeCache.EXPECT().Set(ctx, "", gomock.Any(), int64(0), gomock.Any()).Return(true, nil).Times(3)
+	gomock.InOrder(
+		mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil),
+		mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil),
+		mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows[1:], nil),
+	)
+
+	first, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	same, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	changed, err := svc.Get(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, first.Version, same.Version)
+	assert.NotEqual(t, first.Version, changed.Version)
+}
+
+func TestGetCatalogTree_CacheUnavailable(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, errors.New(""))
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(0), nil)
+	mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil)
+	mockCatalogTreeCache.EXPECT().Set(ctx, "", gomock.Any(), int64(0), gomock.Any()).Return(false, errors.New(""))
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogTreeTypes, tree.Types)
+}
+
+func TestGetCatalogTree_GenerationUnavailable(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	// without the generation a stale tree could not be told apart, so it is not cached
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, redis.Nil)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(0), errors.New(""))
+	mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil)
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogTreeTypes, tree.Types)
+}
+
+func TestGetCatalogTree_InvalidatedWhileBuilt(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	// a write advanced the generation after the rows were read, so the tree is not cached but still served
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, redis.Nil)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(4), nil)
+	mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(catalogTreeRows, nil)
+	mockCatalogTreeCache.EXPECT().Set(ctx, "", gomock.Any(), int64(4), gomock.Any()).Return(false, nil)
+
+	tree, err := svc.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogTreeTypes, tree.Types)
+}
+
+func TestGetCatalogTree_RepositoryError(t *testing.T) {
+	ctrl, ctx, mockCatalogTreeRepo, mockCatalogTreeCache, svc := setupCatalogTree(t)
+	defer ctrl.Finish()
+
+	mockCatalogTreeCache.EXPECT().Get(ctx, "").Return(nil, redis.Nil)
+	mockCatalogTreeCache.EXPECT().Generation(ctx).Return(int64(0), nil)
+	mockCatalogTreeRepo.EXPECT().List(ctx, "").Return(nil, errors.New(""))
+
+	_, err := svc.Get(ctx)
+	assert.Error(t, err)
+}
+
+func TestCatalogWrites_InvalidateTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockCatalogTreeCache := mock_repository.NewMockCatalogTreeCache(ctrl)
+	mockCategoryTypeRepo := mock_repository.NewMockCategoryTypeRepository(ctrl)
+	mockSubcategoryRepo := mock_repository.NewMockSubcategory(ctrl)
+
+	categoryTypeSvc := service.NewCategoryTypeService(mockCategoryTypeRepo, mockCatalogTreeCache)
+	subcategorySvc := service.NewSubcategoryService(mockSubcategoryRepo, mockCatalogTreeCache, newLocalizerStub(ctrl))
+
+	mockCategoryTypeRepo.EXPECT().Create(ctx, categoryTypeName).Return(categoryTypeModel, nil)
+	mockCatalogTreeCache.EXPECT().Delete(ctx).Return(nil)
+
+	_, err := categoryTypeSvc.Create(ctx, categoryTypeName)
+	assert.NoError(t, err)
+
+	// a failed write leaves the cached tree alone
+	mockSubcategoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(int32(0), &pgconn.PgError{Code: pgerrcode.RaiseException})
+
+	_, err = subcategorySvc.Create(ctx, domain.NewSubcategoryInfo(id, "Taps"))
+	assert.ErrorIs(t, err, service.ErrSubcategoryNameTaken)
+
+	// the write is reported as done even if the cache could not be invalidated
+	mockSubcategoryRepo.EXPECT().Delete(ctx, id).Return(true, nil)
+	mockCatalogTreeCache.EXPECT().Delete(ctx).Return(errors.New(""))
+
+	assert.NoError(t, subcategorySvc.Delete(ctx, id))
+}
@@ -17,16 +17,22 @@ type CategoryService interface {
 	Get(ctx context.Context, id int32) (domain.Category, error)
 	List(ctx context.Context, limit int64, offset int64) ([]domain.Category, error)
 	ListByTypeId(ctx context.Context, id int32, limit int64, offset int64) ([]domain.Category, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Category, error)
+	ListByTypeIdKeyset(ctx context.Context, id int32, keyset domain.Keyset, limit int64) ([]domain.Category, error)
 	Update(ctx context.Context, id int32, info domain.CategoryInfo) (domain.Category, error)
 }
 
 type categoryImpl struct {
 	categoryRepository repository.CategoryRepository
+	catalogTreeCache   repository.CatalogTreeCache
+	localizer          Localizer
 }
 
-func NewCategoryService(categoryRepository repository.CategoryRepository) *categoryImpl {
+func NewCategoryService(categoryRepository repository.CategoryRepository, catalogTreeCache repository.CatalogTreeCache, localizer Localizer) *categoryImpl {
 	return &categoryImpl{
 		categoryRepository: categoryRepository,
+		catalogTreeCache:   catalogTreeCache,
+		localizer:          localizer,
 	}
 }
 
@@ -43,6 +49,7 @@ func (s *categoryImpl) Create(ctx context.Context, info domain.CategoryInfo) (in
 		return 0, err
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return categoryId, nil
 }
 
@@ -57,10 +64,11 @@ func (s *categoryImpl) Delete(ctx context.Context, id int32) error {
 		return ErrCategoryNotFound
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return nil
 }
 
-// Get retrieves a category by its ID from the repository.
+// Get retrieves a category by its ID from the repository, named in the locale of the context.
 // If the category is not found, it returns ErrCategoryNotFound.
 func (s *categoryImpl) Get(ctx context.Context, id int32) (domain.Category, error) {
 	model, err := s.categoryRepository.Get(ctx, id)
@@ -71,7 +79,12 @@ func (s *categoryImpl) Get(ctx context.Context, id int32) (domain.Category, erro
 		return domain.Category{}, err
 	}
 
-	return domain.NewCategory(model.ID, model.TypeID, model.Name), nil
+	categories := []domain.Category{domain.NewCategory(model.ID, model.TypeID, model.Name)}
+	if err := localizeCategories(ctx, s.localizer, categories); err != nil {
+		return domain.Category{}, err
+	}
+
+	return categories[0], nil
 }
 
 // List retrieves a list of categories from the repository with the specified limit and offset.
@@ -86,6 +99,10 @@ func (s *categoryImpl) List(ctx context.Context, limit int64, offset int64) ([]d
 		categories[i] = domain.NewCategory(c.ID, c.TypeID, c.Name)
 	}
 
+	if err := localizeCategories(ctx, s.localizer, categories); err != nil {
+		return nil, err
+	}
+
 	return categories, nil
 }
 
@@ -101,6 +118,48 @@ func (s *categoryImpl) ListByTypeId(ctx context.Context, id int32, limit int64,
 		categories[i] = domain.NewCategory(c.ID, c.TypeID, c.Name)
 	}
 
+	if err := localizeCategories(ctx, s.localizer, categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// ListKeyset retrieves a page of categories from the repository, starting after or before the keyset.
+func (s *categoryImpl) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Category, error) {
+	list, err := s.categoryRepository.ListKeyset(ctx, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]domain.Category, len(list))
+	for i, c := range list {
+		categories[i] = domain.NewCategory(c.ID, c.TypeID, c.Name)
+	}
+
+	if err := localizeCategories(ctx, s.localizer, categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// ListByTypeIdKeyset retrieves a page of categories by their type ID from the repository, starting after or before the keyset.
+func (s *categoryImpl) ListByTypeIdKeyset(ctx context.Context, id int32, keyset domain.Keyset, limit int64) ([]domain.Category, error) {
+	list, err := s.categoryRepository.ListByTypeIdKeyset(ctx, id, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]domain.Category, len(list))
+	for i, c := range list {
+		categories[i] = domain.NewCategory(c.ID, c.TypeID, c.Name)
+	}
+
+	if err := localizeCategories(ctx, s.localizer, categories); err != nil {
+		return nil, err
+	}
+
 	return categories, nil
 }
 
@@ -127,5 +186,6 @@ func (s *categoryImpl) Update(ctx context.Context, id int32, info domain.Categor
 		return domain.Category{}, err
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return domain.NewCategory(model.ID, model.TypeID, model.Name), nil
 }
@@ -33,7 +33,7 @@ func setupCategory(t *testing.T) (
 	ctx = context.Background()
 
 	mockCategoryRepository = mock_repository.NewMockCategoryRepository(ctrl)
-	svc = service.NewCategoryService(mockCategoryRepository)
+	svc = service.NewCategoryService(mockCategoryRepository, newCatalogTreeCacheStub(ctrl), newLocalizerStub(ctrl))
 
 	return
 }
@@ -170,6 +170,30 @@ func TestListCategoriesByTypeId_RepositoryError(t *testing.T) {
 	assert.Empty(t, categoriesDTO)
 }
 
+func TestListCategoriesKeyset_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCategoryRepository := setupCategory(t)
+	defer ctrl.Finish()
+
+	keyset := domain.NewKeyset(int64(id), true)
+	mockCategoryRepository.EXPECT().ListKeyset(ctx, keyset, limit).Return([]repository.CategoryModel{categoryModel}, nil)
+
+	categories, err := svc.ListKeyset(ctx, keyset, limit)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Category{domain.NewCategory(id, id, categoryName)}, categories)
+}
+
+func TestListCategoriesByTypeIdKeyset_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockCategoryRepository := setupCategory(t)
+	defer ctrl.Finish()
+
+	keyset := domain.NewKeyset(0, false)
+	mockCategoryRepository.EXPECT().ListByTypeIdKeyset(ctx, id, keyset, limit).Return(nil, errors.New(""))
+
+	categories, err := svc.ListByTypeIdKeyset(ctx, id, keyset, limit)
+	assert.Error(t, err)
+	assert.Empty(t, categories)
+}
+
 func TestUpdateCategoryById_Success(t *testing.T) {
 	ctrl, ctx, svc, mockCategoryRepository := setupCategory(t)
 	defer ctrl.Finish()
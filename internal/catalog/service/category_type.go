@@ -16,16 +16,19 @@ type CategoryTypeService interface {
 	Delete(ctx context.Context, id int32) error
 	Get(ctx context.Context, id int32) (domain.CategoryType, error)
 	List(ctx context.Context, limit int64, offset int64) ([]domain.CategoryType, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.CategoryType, error)
 	Update(ctx context.Context, id int32, name string) error
 }
 
 type categoryTypeImpl struct {
 	categoryTypeRepository repository.CategoryTypeRepository
+	catalogTreeCache       repository.CatalogTreeCache
 }
 
-func NewCategoryTypeService(categoryTypeRepository repository.CategoryTypeRepository) *categoryTypeImpl {
+func NewCategoryTypeService(categoryTypeRepository repository.CategoryTypeRepository, catalogTreeCache repository.CatalogTreeCache) *categoryTypeImpl {
 	return &categoryTypeImpl{
 		categoryTypeRepository: categoryTypeRepository,
+		catalogTreeCache:       catalogTreeCache,
 	}
 }
 
@@ -41,6 +44,7 @@ func (s *categoryTypeImpl) Create(ctx context.Context, name string) (domain.Cate
 		return domain.CategoryType{}, err
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return domain.NewCategoryType(model.ID, model.Name), nil
 }
 
@@ -55,6 +59,7 @@ func (s *categoryTypeImpl) Delete(ctx context.Context, id int32) error {
 		return ErrCategoryTypeNotFound
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return nil
 }
 
@@ -87,6 +92,21 @@ func (s *categoryTypeImpl) List(ctx context.Context, limit int64, offset int64)
 	return categoryTypes, nil
 }
 
+// ListKeyset retrieves a page of category types from the repository, starting after or before the keyset.
+func (s *categoryTypeImpl) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.CategoryType, error) {
+	list, err := s.categoryTypeRepository.ListKeyset(ctx, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryTypes := make([]domain.CategoryType, len(list))
+	for i, ct := range list {
+		categoryTypes[i] = domain.NewCategoryType(ct.ID, ct.Name)
+	}
+
+	return categoryTypes, nil
+}
+
 // Update modifies an existing category type in the repository using the provided ID and name.
 // It returns ErrCategoryTypeNameTaken if name is taken
 // If category type not found, returns ErrCategoryNotFound.
@@ -103,5 +123,6 @@ func (s *categoryTypeImpl) Update(ctx context.Context, id int32, name string) er
 		return ErrCategoryTypeNotFound
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return nil
 }
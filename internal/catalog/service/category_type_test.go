@@ -43,7 +43,7 @@ func setupCategoryType(t *testing.T) (
 	ctx = context.Background()
 
 	mockCategoryTypeRepo = mock_repository.NewMockCategoryTypeRepository(ctrl)
-	svc = service.NewCategoryTypeService(mockCategoryTypeRepo)
+	svc = service.NewCategoryTypeService(mockCategoryTypeRepo, newCatalogTreeCacheStub(ctrl))
 
 	return
 }
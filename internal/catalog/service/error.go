@@ -11,4 +11,16 @@ var (
 
 	ErrSubcategoryNotFound = errors.New("subcategory not found")
 	ErrSubcategoryNameTaken = errors.New("subcategory name is taken")
+
+	ErrServiceNotFound = errors.New("service not found")
+	ErrServiceNameTaken = errors.New("service name is taken")
+
+	ErrProviderServiceNotFound = errors.New("provider does not offer the service")
+	ErrProviderServiceExists = errors.New("provider already offers the service")
+
+	ErrEmptySearchQuery = errors.New("search query is empty")
+
+	ErrTranslationNotFound = errors.New("translation not found")
+	ErrUnsupportedLocale = errors.New("locale is not supported")
+	ErrDefaultLocaleTranslation = errors.New("names of the default locale are not translated")
 )
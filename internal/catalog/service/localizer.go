@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/hexley21/fixup/internal/common/locale"
+)
+
+type Localizer interface {
+	Translations(ctx context.Context, kind domain.CatalogKind, entryIDs []int32) (map[int32]domain.TranslationInfo, error)
+}
+
+type localizerImpl struct {
+	translationRepo repository.Translation
+	defaultLocale   string
+}
+
+func NewLocalizer(translationRepo repository.Translation, defaultLocale string) *localizerImpl {
+	return &localizerImpl{
+		translationRepo: translationRepo,
+		defaultLocale:   defaultLocale,
+	}
+}
+
+// Translations returns the translations of the catalog entries to the locale of the context, keyed by the entry ID.
+// The names of the default locale are stored on the entries, so in the default locale, or without a locale, it returns no translations.
+func (l *localizerImpl) Translations(ctx context.Context, kind domain.CatalogKind, entryIDs []int32) (map[int32]domain.TranslationInfo, error) {
+	loc := locale.FromContext(ctx)
+	if loc == "" || loc == l.defaultLocale || len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	list, err := l.translationRepo.ListByLocale(ctx, kind, entryIDs, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make(map[int32]domain.TranslationInfo, len(list))
+	for _, t := range list {
+		translations[t.EntryID] = domain.NewTranslationInfo(t.Name, t.Description.String)
+	}
+
+	return translations, nil
+}
+
+// localize applies the translations to the locale of the context to the entries in place, untranslated entries keep their names.
+func localize[T any](
+	ctx context.Context,
+	localizer Localizer,
+	kind domain.CatalogKind,
+	entries []T,
+	id func(T) int32,
+	translate func(*T, domain.TranslationInfo),
+) error {
+	ids := make([]int32, len(entries))
+	for i, e := range entries {
+		ids[i] = id(e)
+	}
+
+	translations, err := localizer.Translations(ctx, kind, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range entries {
+		if info, ok := translations[ids[i]]; ok {
+			translate(&entries[i], info)
+		}
+	}
+
+	return nil
+}
+
+func localizeCategories(ctx context.Context, localizer Localizer, categories []domain.Category) error {
+	return localize(ctx, localizer, domain.CatalogKindCategory, categories,
+		func(c domain.Category) int32 { return c.ID },
+		func(c *domain.Category, info domain.TranslationInfo) { c.Info.Name = info.Name },
+	)
+}
+
+func localizeSubcategories(ctx context.Context, localizer Localizer, subcategories []domain.Subcategory) error {
+	return localize(ctx, localizer, domain.CatalogKindSubcategory, subcategories,
+		func(sc domain.Subcategory) int32 { return sc.ID },
+		func(sc *domain.Subcategory, info domain.TranslationInfo) { sc.Info.Name = info.Name },
+	)
+}
+
+// localizeServices keeps the description of the default locale when the translation has none.
+func localizeServices(ctx context.Context, localizer Localizer, services []domain.Service) error {
+	return localize(ctx, localizer, domain.CatalogKindService, services,
+		func(s domain.Service) int32 { return s.ID },
+		func(s *domain.Service, info domain.TranslationInfo) {
+			s.Info.Name = info.Name
+			if info.Description != "" {
+				s.Info.Description = info.Description
+			}
+		},
+	)
+}
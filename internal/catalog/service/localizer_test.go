@@ -0,0 +1,98 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	mock_repository "github.com/hexley21/fixup/internal/catalog/repository/mock"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	mock_service "github.com/hexley21/fixup/internal/catalog/service/mock"
+	"github.com/hexley21/fixup/internal/common/locale"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const defaultLocale = "en"
+
+// newLocalizerStub returns a localizer that leaves everything in the default locale.
+func newLocalizerStub(ctrl *gomock.Controller) *mock_service.MockLocalizer {
+	localizer := mock_service.NewMockLocalizer(ctrl)
+	localizer.EXPECT().Translations(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	return localizer
+}
+
+func TestLocalizerTranslations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTranslationRepo := mock_repository.NewMockTranslation(ctrl)
+	localizer := service.NewLocalizer(mockTranslationRepo, defaultLocale)
+
+	ctx := locale.WithLocale(context.Background(), "ka")
+	mockTranslationRepo.EXPECT().ListByLocale(ctx, domain.CatalogKindService, []int32{1, 2}, "ka").Return([]repository.TranslationModel{
+		{EntryID: 2, Locale: "ka", Name: "ონკანის შეკეთება", Description: pgtype.Text{String: "აღწერა", Valid: true}},
+	}, nil)
+
+	translations, err := localizer.Translations(ctx, domain.CatalogKindService, []int32{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int32]domain.TranslationInfo{2: domain.NewTranslationInfo("ონკანის შეკეთება", "აღწერა")}, translations)
+}
+
+func TestLocalizerTranslations_DefaultLocale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	localizer := service.NewLocalizer(mock_repository.NewMockTranslation(ctrl), defaultLocale)
+
+	for _, ctx := range []context.Context{context.Background(), locale.WithLocale(context.Background(), defaultLocale)} {
+		translations, err := localizer.Translations(ctx, domain.CatalogKindCategory, []int32{1})
+		assert.NoError(t, err)
+		assert.Empty(t, translations)
+	}
+}
+
+func TestListServices_Localized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockServiceRepo := mock_repository.NewMockService(ctrl)
+	mockLocalizer := mock_service.NewMockLocalizer(ctrl)
+	svc := service.NewServiceService(mockServiceRepo, mock_repository.NewMockProviderService(ctrl), newCatalogTreeCacheStub(ctrl), mockLocalizer)
+
+	translated := repository.ServiceModel{ID: 3, SubcategoryID: 1, Name: "Pipe repair", Description: pgtype.Text{String: "Repair of a pipe", Valid: true}}
+
+	mockServiceRepo.EXPECT().List(ctx, int64(10), int64(0)).Return([]repository.ServiceModel{serviceModel, translated}, nil)
+	mockLocalizer.EXPECT().Translations(ctx, domain.CatalogKindService, []int32{serviceModel.ID, translated.ID}).Return(map[int32]domain.TranslationInfo{
+		translated.ID: domain.NewTranslationInfo("მილის შეკეთება", ""),
+	}, nil)
+
+	services, err := svc.List(ctx, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Service{
+		domain.NewService(serviceModel.ID, serviceModel.SubcategoryID, serviceModel.Name, serviceModel.Description.String),
+		// a translation without a description keeps the default one
+		domain.NewService(translated.ID, translated.SubcategoryID, "მილის შეკეთება", "Repair of a pipe"),
+	}, services)
+}
+
+func TestGetCategory_LocalizationError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockCategoryRepository := mock_repository.NewMockCategoryRepository(ctrl)
+	mockLocalizer := mock_service.NewMockLocalizer(ctrl)
+	svc := service.NewCategoryService(mockCategoryRepository, newCatalogTreeCacheStub(ctrl), mockLocalizer)
+
+	mockCategoryRepository.EXPECT().Get(ctx, id).Return(categoryModel, nil)
+	mockLocalizer.EXPECT().Translations(ctx, domain.CatalogKindCategory, []int32{id}).Return(nil, errors.New(""))
+
+	_, err := svc.Get(ctx, id)
+	assert.Error(t, err)
+}
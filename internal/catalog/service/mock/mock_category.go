@@ -114,6 +114,36 @@ func (mr *MockCategoryServiceMockRecorder) ListByTypeId(ctx, id, limit, offset a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeId", reflect.TypeOf((*MockCategoryService)(nil).ListByTypeId), ctx, id, limit, offset)
 }
 
+// ListByTypeIdKeyset mocks base method.
+func (m *MockCategoryService) ListByTypeIdKeyset(ctx context.Context, id int32, keyset domain.Keyset, limit int64) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTypeIdKeyset", ctx, id, keyset, limit)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTypeIdKeyset indicates an expected call of ListByTypeIdKeyset.
+func (mr *MockCategoryServiceMockRecorder) ListByTypeIdKeyset(ctx, id, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeIdKeyset", reflect.TypeOf((*MockCategoryService)(nil).ListByTypeIdKeyset), ctx, id, keyset, limit)
+}
+
+// ListKeyset mocks base method.
+func (m *MockCategoryService) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockCategoryServiceMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockCategoryService)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockCategoryService) Update(ctx context.Context, id int32, info domain.CategoryInfo) (domain.Category, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +99,21 @@ func (mr *MockCategoryTypeServiceMockRecorder) List(ctx, limit, offset any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCategoryTypeService)(nil).List), ctx, limit, offset)
 }
 
+// ListKeyset mocks base method.
+func (m *MockCategoryTypeService) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.CategoryType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]domain.CategoryType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockCategoryTypeServiceMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockCategoryTypeService)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockCategoryTypeService) Update(ctx context.Context, id int32, name string) error {
 	m.ctrl.T.Helper()
@@ -114,6 +114,21 @@ func (mr *MockSubcategoryServiceMockRecorder) ListByCategoryId(ctx, categoryID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCategoryId", reflect.TypeOf((*MockSubcategoryService)(nil).ListByCategoryId), ctx, categoryID, limit, offset)
 }
 
+// ListByCategoryIdKeyset mocks base method.
+func (m *MockSubcategoryService) ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCategoryIdKeyset", ctx, categoryID, keyset, limit)
+	ret0, _ := ret[0].([]domain.Subcategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByCategoryIdKeyset indicates an expected call of ListByCategoryIdKeyset.
+func (mr *MockSubcategoryServiceMockRecorder) ListByCategoryIdKeyset(ctx, categoryID, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCategoryIdKeyset", reflect.TypeOf((*MockSubcategoryService)(nil).ListByCategoryIdKeyset), ctx, categoryID, keyset, limit)
+}
+
 // ListByTypeId mocks base method.
 func (m *MockSubcategoryService) ListByTypeId(ctx context.Context, typeID int32, limit, offset int64) ([]domain.Subcategory, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +144,36 @@ func (mr *MockSubcategoryServiceMockRecorder) ListByTypeId(ctx, typeID, limit, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeId", reflect.TypeOf((*MockSubcategoryService)(nil).ListByTypeId), ctx, typeID, limit, offset)
 }
 
+// ListByTypeIdKeyset mocks base method.
+func (m *MockSubcategoryService) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTypeIdKeyset", ctx, typeID, keyset, limit)
+	ret0, _ := ret[0].([]domain.Subcategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTypeIdKeyset indicates an expected call of ListByTypeIdKeyset.
+func (mr *MockSubcategoryServiceMockRecorder) ListByTypeIdKeyset(ctx, typeID, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTypeIdKeyset", reflect.TypeOf((*MockSubcategoryService)(nil).ListByTypeIdKeyset), ctx, typeID, keyset, limit)
+}
+
+// ListKeyset mocks base method.
+func (m *MockSubcategoryService) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyset", ctx, keyset, limit)
+	ret0, _ := ret[0].([]domain.Subcategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyset indicates an expected call of ListKeyset.
+func (mr *MockSubcategoryServiceMockRecorder) ListKeyset(ctx, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyset", reflect.TypeOf((*MockSubcategoryService)(nil).ListKeyset), ctx, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockSubcategoryService) Update(ctx context.Context, id int32, info domain.SubcategoryInfo) (domain.Subcategory, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/hexley21/fixup/internal/common/locale"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, query string, limit int64, offset int64) ([]domain.SearchResult, error)
+	Autocomplete(ctx context.Context, prefix string, limit int64) ([]domain.CatalogNode, error)
+}
+
+type searchImpl struct {
+	searchRepo repository.Search
+}
+
+func NewSearchService(searchRepo repository.Search) *searchImpl {
+	return &searchImpl{searchRepo: searchRepo}
+}
+
+// Search looks the query up in the names and descriptions of the whole catalog and returns the matches ranked by relevance.
+// The catalog is searched in the locale of the context. If the query is blank, it returns ErrEmptySearchQuery.
+func (s *searchImpl) Search(ctx context.Context, query string, limit int64, offset int64) ([]domain.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	list, err := s.searchRepo.Search(ctx, query, locale.FromContext(ctx), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.SearchResult, len(list))
+	for i, model := range list {
+		results[i] = domain.SearchResult{
+			CatalogNode: domain.NewCatalogNode(domain.CatalogKind(model.Kind), model.ID, model.Name),
+			Description: model.Description.String,
+			Path:        searchResultPath(model),
+			Rank:        model.Rank,
+		}
+	}
+
+	return results, nil
+}
+
+// Autocomplete returns the catalog entries with a word of their name starting with the prefix.
+// The names are those of the locale of the context. If the prefix is blank, it returns ErrEmptySearchQuery.
+func (s *searchImpl) Autocomplete(ctx context.Context, prefix string, limit int64) ([]domain.CatalogNode, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	list, err := s.searchRepo.Autocomplete(ctx, prefix, locale.FromContext(ctx), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]domain.CatalogNode, len(list))
+	for i, model := range list {
+		suggestions[i] = domain.NewCatalogNode(domain.CatalogKind(model.Kind), model.ID, model.Name)
+	}
+
+	return suggestions, nil
+}
+
+// searchResultPath lists the ancestors of the result, the levels above a category type are NULL in the model.
+func searchResultPath(model repository.SearchResultModel) []domain.CatalogNode {
+	path := make([]domain.CatalogNode, 0, 3)
+
+	if model.TypeID.Valid {
+		path = append(path, domain.NewCatalogNode(domain.CatalogKindCategoryType, model.TypeID.Int32, model.TypeName.String))
+	}
+	if model.CategoryID.Valid {
+		path = append(path, domain.NewCatalogNode(domain.CatalogKindCategory, model.CategoryID.Int32, model.CategoryName.String))
+	}
+	if model.SubcategoryID.Valid {
+		path = append(path, domain.NewCatalogNode(domain.CatalogKindSubcategory, model.SubcategoryID.Int32, model.SubcategoryName.String))
+	}
+
+	return path
+}
@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	mock_repository "github.com/hexley21/fixup/internal/catalog/repository/mock"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/hexley21/fixup/internal/common/locale"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSearch(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	mockSearchRepo *mock_repository.MockSearch,
+	svc service.SearchService,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockSearchRepo = mock_repository.NewMockSearch(ctrl)
+	svc = service.NewSearchService(mockSearchRepo)
+
+	return
+}
+
+func TestSearch(t *testing.T) {
+	ctrl, ctx, mockSearchRepo, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	mockSearchRepo.EXPECT().Search(ctx, "leaking tap", "", limit, offset).Return([]repository.SearchResultModel{
+		{
+			Kind:            "service",
+			ID:              3,
+			Name:            "Tap repair",
+			Description:     pgtype.Text{String: "Repair of a leaking tap", Valid: true},
+			TypeID:          pgtype.Int4{Int32: 1, Valid: true},
+			TypeName:        pgtype.Text{String: "Home", Valid: true},
+			CategoryID:      pgtype.Int4{Int32: 1, Valid: true},
+			CategoryName:    pgtype.Text{String: "Plumbing", Valid: true},
+			SubcategoryID:   pgtype.Int4{Int32: 2, Valid: true},
+			SubcategoryName: pgtype.Text{String: "Taps", Valid: true},
+			Rank:            0.5,
+		},
+		{
+			Kind: "category_type",
+			ID:   1,
+			Name: "Home",
+			Rank: 0.1,
+		},
+	}, nil)
+
+	results, err := svc.Search(ctx, "  leaking tap ", limit, offset)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SearchResult{
+		{
+			CatalogNode: domain.NewCatalogNode(domain.CatalogKindService, 3, "Tap repair"),
+			Description: "Repair of a leaking tap",
+			Path: []domain.CatalogNode{
+				domain.NewCatalogNode(domain.CatalogKindCategoryType, 1, "Home"),
+				domain.NewCatalogNode(domain.CatalogKindCategory, 1, "Plumbing"),
+				domain.NewCatalogNode(domain.CatalogKindSubcategory, 2, "Taps"),
+			},
+			Rank: 0.5,
+		},
+		{
+			CatalogNode: domain.NewCatalogNode(domain.CatalogKindCategoryType, 1, "Home"),
+			Path:        []domain.CatalogNode{},
+			Rank:        0.1,
+		},
+	}, results)
+}
+
+func TestSearch_EmptyQuery(t *testing.T) {
+	ctrl, ctx, _, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	_, err := svc.Search(ctx, " ", limit, offset)
+	assert.ErrorIs(t, err, service.ErrEmptySearchQuery)
+}
+
+func TestSearch_Localized(t *testing.T) {
+	ctrl, ctx, mockSearchRepo, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	ctx = locale.WithLocale(ctx, "ka")
+
+	mockSearchRepo.EXPECT().Search(ctx, "ონკანი", "ka", limit, offset).Return([]repository.SearchResultModel{
+		{Kind: "service", ID: 3, Name: "ონკანის შეკეთება", Rank: 0.5},
+	}, nil)
+
+	results, err := svc.Search(ctx, "ონკანი", limit, offset)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, domain.NewCatalogNode(domain.CatalogKindService, 3, "ონკანის შეკეთება"), results[0].CatalogNode)
+	}
+}
+
+func TestSearch_RepositoryError(t *testing.T) {
+	ctrl, ctx, mockSearchRepo, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	mockSearchRepo.EXPECT().Search(ctx, "tap", "", limit, offset).Return(nil, errors.New(""))
+
+	_, err := svc.Search(ctx, "tap", limit, offset)
+	assert.Error(t, err)
+}
+
+func TestAutocomplete(t *testing.T) {
+	ctrl, ctx, mockSearchRepo, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	mockSearchRepo.EXPECT().Autocomplete(ctx, "ta", "", limit).Return([]repository.SuggestionModel{
+		{Kind: "subcategory", ID: 2, Name: "Taps"},
+	}, nil)
+
+	suggestions, err := svc.Autocomplete(ctx, "ta", limit)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CatalogNode{domain.NewCatalogNode(domain.CatalogKindSubcategory, 2, "Taps")}, suggestions)
+}
+
+func TestAutocomplete_Localized(t *testing.T) {
+	ctrl, ctx, mockSearchRepo, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	ctx = locale.WithLocale(ctx, "ka")
+
+	mockSearchRepo.EXPECT().Autocomplete(ctx, "ონ", "ka", limit).Return([]repository.SuggestionModel{
+		{Kind: "service", ID: 3, Name: "ონკანის შეკეთება"},
+	}, nil)
+
+	suggestions, err := svc.Autocomplete(ctx, "ონ", limit)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CatalogNode{domain.NewCatalogNode(domain.CatalogKindService, 3, "ონკანის შეკეთება")}, suggestions)
+}
+
+func TestAutocomplete_EmptyPrefix(t *testing.T) {
+	ctrl, ctx, _, svc := setupSearch(t)
+	defer ctrl.Finish()
+
+	_, err := svc.Autocomplete(ctx, "", limit)
+	assert.ErrorIs(t, err, service.ErrEmptySearchQuery)
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type ServiceService interface {
+	Get(ctx context.Context, id int32) (domain.Service, error)
+	List(ctx context.Context, limit int64, offset int64) ([]domain.Service, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Service, error)
+	Create(ctx context.Context, info domain.ServiceInfo) (int32, error)
+	Update(ctx context.Context, id int32, info domain.ServiceInfo) (domain.Service, error)
+	Delete(ctx context.Context, id int32) error
+	ListProviderIds(ctx context.Context, id int32, limit int64, offset int64) ([]int64, error)
+	ListProviderIdsKeyset(ctx context.Context, id int32, keyset domain.Keyset, limit int64) ([]int64, error)
+	GetProviderService(ctx context.Context, providerID int64, id int32) (domain.Service, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Service, error)
+	ListByProviderIdKeyset(ctx context.Context, providerID int64, keyset domain.Keyset, limit int64) ([]domain.Service, error)
+	AddProviderService(ctx context.Context, providerID int64, id int32) (domain.Service, error)
+	RemoveProviderService(ctx context.Context, providerID int64, id int32) error
+}
+
+type serviceImpl struct {
+	serviceRepo         repository.Service
+	providerServiceRepo repository.ProviderService
+	catalogTreeCache    repository.CatalogTreeCache
+	localizer           Localizer
+}
+
+func NewServiceService(
+	serviceRepo repository.Service,
+	providerServiceRepo repository.ProviderService,
+	catalogTreeCache repository.CatalogTreeCache,
+	localizer Localizer,
+) *serviceImpl {
+	return &serviceImpl{
+		serviceRepo:         serviceRepo,
+		providerServiceRepo: providerServiceRepo,
+		catalogTreeCache:    catalogTreeCache,
+		localizer:           localizer,
+	}
+}
+
+// Get retrieves a service by its ID from the repository, named in the locale of the context.
+// If the service is not found, it returns ErrServiceNotFound.
+func (s *serviceImpl) Get(ctx context.Context, id int32) (domain.Service, error) {
+	service, err := s.serviceRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Service{}, ErrServiceNotFound
+		}
+		return domain.Service{}, err
+	}
+
+	services := []domain.Service{mapServiceModelToEntity(service)}
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return domain.Service{}, err
+	}
+
+	return services[0], nil
+}
+
+// List retrieves a list of services from the repository with the specified limit and offset.
+func (s *serviceImpl) List(ctx context.Context, limit int64, offset int64) ([]domain.Service, error) {
+	list, err := s.serviceRepo.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	services := mapServiceModelsToEntities(list)
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// ListKeyset retrieves a page of services from the repository, starting after or before the keyset.
+func (s *serviceImpl) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Service, error) {
+	list, err := s.serviceRepo.ListKeyset(ctx, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	services := mapServiceModelsToEntities(list)
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// Create adds a new service to the repository using the provided ServiceInfo.
+// If the service name is already taken in the subcategory, it returns ErrServiceNameTaken.
+// If the subcategory is not found, it returns ErrSubcategoryNotFound.
+func (s *serviceImpl) Create(ctx context.Context, info domain.ServiceInfo) (int32, error) {
+	serviceId, err := s.serviceRepo.Create(ctx, info)
+	if err != nil {
+		return 0, mapServiceWriteError(err)
+	}
+
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
+	return serviceId, nil
+}
+
+// Update modifies an existing service in the repository using the provided ServiceInfo and ID.
+// It returns the updated service or an error if the update fails.
+// If the service is not found, it returns ErrServiceNotFound.
+// If the service name is already taken in the subcategory, it returns ErrServiceNameTaken.
+// If the subcategory is not found, it returns ErrSubcategoryNotFound.
+func (s *serviceImpl) Update(ctx context.Context, id int32, info domain.ServiceInfo) (domain.Service, error) {
+	service, err := s.serviceRepo.Update(ctx, id, info)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Service{}, ErrServiceNotFound
+		}
+		return domain.Service{}, mapServiceWriteError(err)
+	}
+
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
+	return mapServiceModelToEntity(service), nil
+}
+
+// Delete removes a service from the repository by its ID, the providers stop offering it as well.
+// If the service is not found, it returns ErrServiceNotFound.
+func (s *serviceImpl) Delete(ctx context.Context, id int32) error {
+	ok, err := s.serviceRepo.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrServiceNotFound
+	}
+
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
+	return nil
+}
+
+// ListProviderIds retrieves the IDs of the providers offering the service with the specified limit and offset.
+// If the service is not found, it returns ErrServiceNotFound.
+func (s *serviceImpl) ListProviderIds(ctx context.Context, id int32, limit int64, offset int64) ([]int64, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.providerServiceRepo.ListProviderIdsByServiceId(ctx, id, limit, offset)
+}
+
+// ListProviderIdsKeyset retrieves a page of IDs of the providers offering the service, starting after or before the keyset.
+// If the service is not found, it returns ErrServiceNotFound.
+func (s *serviceImpl) ListProviderIdsKeyset(ctx context.Context, id int32, keyset domain.Keyset, limit int64) ([]int64, error) {
+	if _, err := s.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.providerServiceRepo.ListProviderIdsByServiceIdKeyset(ctx, id, keyset, limit)
+}
+
+// GetProviderService retrieves the service offered by the provider.
+// If the provider does not offer the service, it returns ErrProviderServiceNotFound.
+func (s *serviceImpl) GetProviderService(ctx context.Context, providerID int64, id int32) (domain.Service, error) {
+	service, err := s.providerServiceRepo.Get(ctx, providerID, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Service{}, ErrProviderServiceNotFound
+		}
+		return domain.Service{}, err
+	}
+
+	services := []domain.Service{mapServiceModelToEntity(service)}
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return domain.Service{}, err
+	}
+
+	return services[0], nil
+}
+
+// ListByProviderId retrieves a list of services offered by the provider with the specified limit and offset.
+func (s *serviceImpl) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Service, error) {
+	list, err := s.providerServiceRepo.ListByProviderId(ctx, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	services := mapServiceModelsToEntities(list)
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// ListByProviderIdKeyset retrieves a page of services offered by the provider, starting after or before the keyset.
+func (s *serviceImpl) ListByProviderIdKeyset(ctx context.Context, providerID int64, keyset domain.Keyset, limit int64) ([]domain.Service, error) {
+	list, err := s.providerServiceRepo.ListByProviderIdKeyset(ctx, providerID, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	services := mapServiceModelsToEntities(list)
+	if err := localizeServices(ctx, s.localizer, services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// AddProviderService attaches the service to the services offered by the provider and returns the service.
+// If the service is not found, it returns ErrServiceNotFound.
+// If the provider already offers the service, it returns ErrProviderServiceExists.
+func (s *serviceImpl) AddProviderService(ctx context.Context, providerID int64, id int32) (domain.Service, error) {
+	service, err := s.Get(ctx, id)
+	if err != nil {
+		return domain.Service{}, err
+	}
+
+	if err := s.providerServiceRepo.Create(ctx, providerID, id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return domain.Service{}, ErrProviderServiceExists
+			case pgerrcode.ForeignKeyViolation:
+				return domain.Service{}, ErrServiceNotFound
+			}
+		}
+		return domain.Service{}, err
+	}
+
+	return service, nil
+}
+
+// RemoveProviderService detaches the service from the services offered by the provider.
+// If the provider does not offer the service, it returns ErrProviderServiceNotFound.
+func (s *serviceImpl) RemoveProviderService(ctx context.Context, providerID int64, id int32) error {
+	ok, err := s.providerServiceRepo.Delete(ctx, providerID, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrProviderServiceNotFound
+	}
+
+	return nil
+}
+
+func mapServiceWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.RaiseException:
+			return ErrServiceNameTaken
+		case pgerrcode.ForeignKeyViolation:
+			return ErrSubcategoryNotFound
+		}
+	}
+
+	return err
+}
+
+func mapServiceModelToEntity(model repository.ServiceModel) domain.Service {
+	return domain.NewService(model.ID, model.SubcategoryID, model.Name, model.Description.String)
+}
+
+func mapServiceModelsToEntities(models []repository.ServiceModel) []domain.Service {
+	entities := make([]domain.Service, len(models))
+	for i, model := range models {
+		entities[i] = mapServiceModelToEntity(model)
+	}
+
+	return entities
+}
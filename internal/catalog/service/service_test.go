@@ -0,0 +1,258 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	mock_repository "github.com/hexley21/fixup/internal/catalog/repository/mock"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const serviceProviderId int64 = 1234567890
+
+var (
+	serviceInfo  = domain.NewServiceInfo(1, "Tap repair", "Repair of a leaking tap")
+	serviceModel = repository.ServiceModel{
+		ID:            2,
+		SubcategoryID: 1,
+		Name:          "Tap repair",
+		Description:   pgtype.Text{String: "Repair of a leaking tap", Valid: true},
+	}
+)
+
+func setupService(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	mockServiceRepo *mock_repository.MockService,
+	mockProviderServiceRepo *mock_repository.MockProviderService,
+	svc service.ServiceService,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockServiceRepo = mock_repository.NewMockService(ctrl)
+	mockProviderServiceRepo = mock_repository.NewMockProviderService(ctrl)
+	svc = service.NewServiceService(mockServiceRepo, mockProviderServiceRepo, newCatalogTreeCacheStub(ctrl), newLocalizerStub(ctrl))
+
+	return
+}
+
+func TestGetService(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockError     error
+		expectedError error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:          "NotFound",
+			mockError:     pgx.ErrNoRows,
+			expectedError: service.ErrServiceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(serviceModel, tt.mockError)
+
+			result, err := svc.Get(ctx, serviceModel.ID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.NewService(serviceModel.ID, serviceInfo.SubcategoryID, serviceInfo.Name, serviceInfo.Description), result)
+			}
+		})
+	}
+}
+
+func TestCreateService(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockError     error
+		expectedError error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:          "NameTaken",
+			mockError:     &pgconn.PgError{Code: pgerrcode.RaiseException},
+			expectedError: service.ErrServiceNameTaken,
+		},
+		{
+			name:          "SubcategoryNotFound",
+			mockError:     &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation},
+			expectedError: service.ErrSubcategoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceRepo.EXPECT().Create(ctx, serviceInfo).Return(serviceModel.ID, tt.mockError)
+
+			id, err := svc.Create(ctx, serviceInfo)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, serviceModel.ID, id)
+			}
+		})
+	}
+}
+
+func TestUpdateService_NotFound(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockServiceRepo.EXPECT().Update(ctx, serviceModel.ID, serviceInfo).Return(repository.ServiceModel{}, pgx.ErrNoRows)
+
+	_, err := svc.Update(ctx, serviceModel.ID, serviceInfo)
+	assert.ErrorIs(t, err, service.ErrServiceNotFound)
+}
+
+func TestDeleteService_NotFound(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockServiceRepo.EXPECT().Delete(ctx, serviceModel.ID).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Delete(ctx, serviceModel.ID), service.ErrServiceNotFound)
+}
+
+func TestListProviderIds(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, mockProviderServiceRepo, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(serviceModel, nil)
+	mockProviderServiceRepo.EXPECT().ListProviderIdsByServiceId(ctx, serviceModel.ID, int64(10), int64(0)).Return([]int64{serviceProviderId}, nil)
+
+	providerIds, err := svc.ListProviderIds(ctx, serviceModel.ID, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{serviceProviderId}, providerIds)
+}
+
+func TestListProviderIds_ServiceNotFound(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(repository.ServiceModel{}, pgx.ErrNoRows)
+
+	_, err := svc.ListProviderIds(ctx, serviceModel.ID, 10, 0)
+	assert.ErrorIs(t, err, service.ErrServiceNotFound)
+}
+
+func TestListProviderIdsKeyset(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, mockProviderServiceRepo, svc := setupService(t)
+	defer ctrl.Finish()
+
+	keyset := domain.NewKeyset(serviceProviderId, false)
+	mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(serviceModel, nil)
+	mockProviderServiceRepo.EXPECT().ListProviderIdsByServiceIdKeyset(ctx, serviceModel.ID, keyset, int64(10)).Return([]int64{serviceProviderId + 1}, nil)
+
+	providerIds, err := svc.ListProviderIdsKeyset(ctx, serviceModel.ID, keyset, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{serviceProviderId + 1}, providerIds)
+}
+
+func TestListProviderIdsKeyset_ServiceNotFound(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, _, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(repository.ServiceModel{}, pgx.ErrNoRows)
+
+	_, err := svc.ListProviderIdsKeyset(ctx, serviceModel.ID, domain.NewKeyset(0, false), 10)
+	assert.ErrorIs(t, err, service.ErrServiceNotFound)
+}
+
+func TestListByProviderIdKeyset(t *testing.T) {
+	ctrl, ctx, _, mockProviderServiceRepo, svc := setupService(t)
+	defer ctrl.Finish()
+
+	keyset := domain.NewKeyset(0, false)
+	mockProviderServiceRepo.EXPECT().ListByProviderIdKeyset(ctx, serviceProviderId, keyset, int64(10)).Return([]repository.ServiceModel{serviceModel}, nil)
+
+	services, err := svc.ListByProviderIdKeyset(ctx, serviceProviderId, keyset, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, services, 1) {
+		assert.Equal(t, serviceModel.ID, services[0].ID)
+	}
+}
+
+func TestAddProviderService(t *testing.T) {
+	ctrl, ctx, mockServiceRepo, mockProviderServiceRepo, svc := setupService(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		getError      error
+		createError   error
+		expectedError error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:          "ServiceNotFound",
+			getError:      pgx.ErrNoRows,
+			expectedError: service.ErrServiceNotFound,
+		},
+		{
+			name:          "AlreadyOffered",
+			createError:   &pgconn.PgError{Code: pgerrcode.UniqueViolation},
+			expectedError: service.ErrProviderServiceExists,
+		},
+		{
+			name:          "RepositoryError",
+			createError:   errors.New(""),
+			expectedError: errors.New(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServiceRepo.EXPECT().Get(ctx, serviceModel.ID).Return(serviceModel, tt.getError)
+			if tt.getError == nil {
+				mockProviderServiceRepo.EXPECT().Create(ctx, serviceProviderId, serviceModel.ID).Return(tt.createError)
+			}
+
+			result, err := svc.AddProviderService(ctx, serviceProviderId, serviceModel.ID)
+
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, serviceModel.ID, result.ID)
+			}
+		})
+	}
+}
+
+func TestRemoveProviderService_NotFound(t *testing.T) {
+	ctrl, ctx, _, mockProviderServiceRepo, svc := setupService(t)
+	defer ctrl.Finish()
+
+	mockProviderServiceRepo.EXPECT().Delete(ctx, serviceProviderId, serviceModel.ID).Return(false, nil)
+
+	assert.ErrorIs(t, svc.RemoveProviderService(ctx, serviceProviderId, serviceModel.ID), service.ErrProviderServiceNotFound)
+}
@@ -16,20 +16,25 @@ type SubcategoryService interface {
 	List(ctx context.Context, limit int64, offset int64) ([]domain.Subcategory, error)
 	ListByCategoryId(ctx context.Context, categoryID int32, limit int64, offset int64) ([]domain.Subcategory, error)
 	ListByTypeId(ctx context.Context, typeID int32, limit int64, offset int64) ([]domain.Subcategory, error)
+	ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error)
+	ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error)
+	ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error)
 	Create(ctx context.Context, info domain.SubcategoryInfo) (int32, error)
 	Update(ctx context.Context, id int32, info domain.SubcategoryInfo) (domain.Subcategory, error)
 	Delete(ctx context.Context, id int32) error
 }
 
 type subcategoryImpl struct {
-	subcategoryRepo repository.Subcategory
+	subcategoryRepo  repository.Subcategory
+	catalogTreeCache repository.CatalogTreeCache
+	localizer        Localizer
 }
 
-func NewSubcategoryService(subcategoryRepo repository.Subcategory) *subcategoryImpl {
-	return &subcategoryImpl{subcategoryRepo: subcategoryRepo}
+func NewSubcategoryService(subcategoryRepo repository.Subcategory, catalogTreeCache repository.CatalogTreeCache, localizer Localizer) *subcategoryImpl {
+	return &subcategoryImpl{subcategoryRepo: subcategoryRepo, catalogTreeCache: catalogTreeCache, localizer: localizer}
 }
 
-// Get retrieves a subcategory by its ID from the repository.
+// Get retrieves a subcategory by its ID from the repository, named in the locale of the context.
 // If the subcategory is not found, it returns ErrSubcategoryNotFound.
 func (s *subcategoryImpl) Get(ctx context.Context, id int32) (domain.Subcategory, error) {
 	subcategory, err := s.subcategoryRepo.Get(ctx, id)
@@ -40,7 +45,12 @@ func (s *subcategoryImpl) Get(ctx context.Context, id int32) (domain.Subcategory
 		return domain.Subcategory{}, err
 	}
 
-	return domain.NewSubcategory(subcategory.ID, subcategory.CategoryID, subcategory.Name), nil
+	entities := []domain.Subcategory{domain.NewSubcategory(subcategory.ID, subcategory.CategoryID, subcategory.Name)}
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return domain.Subcategory{}, err
+	}
+
+	return entities[0], nil
 }
 
 // List retrieves a list of subcategories from the repository with the specified limit and offset.
@@ -55,6 +65,10 @@ func (s *subcategoryImpl) List(ctx context.Context, limit int64, offset int64) (
 		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
 	}
 
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -69,6 +83,10 @@ func (s *subcategoryImpl) ListByCategoryId(ctx context.Context, categoryID int32
 		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
 	}
 
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -83,6 +101,67 @@ func (s *subcategoryImpl) ListByTypeId(ctx context.Context, typeID int32, limit
 		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
 	}
 
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// ListKeyset retrieves a page of subcategories from the repository, starting after or before the keyset.
+func (s *subcategoryImpl) ListKeyset(ctx context.Context, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	list, err := s.subcategoryRepo.ListKeyset(ctx, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]domain.Subcategory, len(list))
+	for i, sc := range list {
+		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
+	}
+
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// ListByCategoryIdKeyset retrieves a page of subcategories by their category ID from the repository, starting after or before the keyset.
+func (s *subcategoryImpl) ListByCategoryIdKeyset(ctx context.Context, categoryID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	list, err := s.subcategoryRepo.ListByCategoryIdKeyset(ctx, categoryID, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]domain.Subcategory, len(list))
+	for i, sc := range list {
+		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
+	}
+
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
+// ListByTypeIdKeyset retrieves a page of subcategories by their type ID from the repository, starting after or before the keyset.
+func (s *subcategoryImpl) ListByTypeIdKeyset(ctx context.Context, typeID int32, keyset domain.Keyset, limit int64) ([]domain.Subcategory, error) {
+	list, err := s.subcategoryRepo.ListByTypeIdKeyset(ctx, typeID, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]domain.Subcategory, len(list))
+	for i, sc := range list {
+		entities[i] = domain.NewSubcategory(sc.ID, sc.CategoryID, sc.Name)
+	}
+
+	if err := localizeSubcategories(ctx, s.localizer, entities); err != nil {
+		return nil, err
+	}
+
 	return entities, nil
 }
 
@@ -99,6 +178,7 @@ func (s *subcategoryImpl) Create(ctx context.Context, info domain.SubcategoryInf
 		return 0, err
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return subcategoryId, nil
 }
 
@@ -127,6 +207,7 @@ func (s *subcategoryImpl) Update(ctx context.Context, id int32, info domain.Subc
 		return domain.Subcategory{}, err
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return domain.NewSubcategory(subcategory.ID, subcategory.CategoryID, subcategory.Name), nil
 }
 
@@ -141,5 +222,6 @@ func (s *subcategoryImpl) Delete(ctx context.Context, id int32) error {
 		return ErrSubcategoryNotFound
 	}
 
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
 	return nil
 }
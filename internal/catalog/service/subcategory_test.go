@@ -27,7 +27,7 @@ func setupSubcategory(t *testing.T) (
 	ctx = context.Background()
 
 	mockSubcategoryRepo = mock_repository.NewMockSubcategory(ctrl)
-	svc = service.NewSubcategoryService(mockSubcategoryRepo)
+	svc = service.NewSubcategoryService(mockSubcategoryRepo, newCatalogTreeCacheStub(ctrl), newLocalizerStub(ctrl))
 
 	return
 }
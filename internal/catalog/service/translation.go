@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type TranslationService interface {
+	List(ctx context.Context, kind domain.CatalogKind, entryID int32) ([]domain.Translation, error)
+	Set(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string, info domain.TranslationInfo) (domain.Translation, error)
+	Delete(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string) error
+}
+
+type translationImpl struct {
+	translationRepo  repository.Translation
+	catalogTreeCache repository.CatalogTreeCache
+	defaultLocale    string
+	locales          []string
+}
+
+func NewTranslationService(
+	translationRepo repository.Translation,
+	catalogTreeCache repository.CatalogTreeCache,
+	defaultLocale string,
+	locales []string,
+) *translationImpl {
+	return &translationImpl{
+		translationRepo:  translationRepo,
+		catalogTreeCache: catalogTreeCache,
+		defaultLocale:    defaultLocale,
+		locales:          locales,
+	}
+}
+
+// List retrieves all translations of the catalog entry ordered by locale.
+func (s *translationImpl) List(ctx context.Context, kind domain.CatalogKind, entryID int32) ([]domain.Translation, error) {
+	list, err := s.translationRepo.List(ctx, kind, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make([]domain.Translation, len(list))
+	for i, t := range list {
+		translations[i] = domain.NewTranslation(t.Locale, t.Name, t.Description.String)
+	}
+
+	return translations, nil
+}
+
+// Set creates or replaces the translation of the catalog entry to the locale, the description is only kept for services.
+// If the locale is not supported, it returns ErrUnsupportedLocale.
+// If the locale is the default one, it returns ErrDefaultLocaleTranslation, as its names are stored on the entry.
+// If the entry is not found, it returns the not found error of its kind, e.g. ErrCategoryNotFound.
+// If the name is already taken in the locale, it returns the name taken error of its kind, e.g. ErrCategoryNameTaken.
+func (s *translationImpl) Set(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string, info domain.TranslationInfo) (domain.Translation, error) {
+	if err := s.checkLocale(locale); err != nil {
+		return domain.Translation{}, err
+	}
+
+	if kind != domain.CatalogKindService {
+		info.Description = ""
+	}
+
+	model, err := s.translationRepo.Upsert(ctx, kind, entryID, locale, info)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgerrcode.ForeignKeyViolation:
+				return domain.Translation{}, entryNotFoundError(kind)
+			case pgerrcode.RaiseException:
+				return domain.Translation{}, entryNameTakenError(kind)
+			}
+		}
+		return domain.Translation{}, err
+	}
+
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
+	return domain.NewTranslation(model.Locale, model.Name, model.Description.String), nil
+}
+
+// Delete removes the translation of the catalog entry to the locale, the entry falls back to the names of the default locale.
+// If the translation is not found, it returns ErrTranslationNotFound.
+func (s *translationImpl) Delete(ctx context.Context, kind domain.CatalogKind, entryID int32, locale string) error {
+	ok, err := s.translationRepo.Delete(ctx, kind, entryID, locale)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrTranslationNotFound
+	}
+
+	invalidateCatalogTree(ctx, s.catalogTreeCache)
+	return nil
+}
+
+func (s *translationImpl) checkLocale(locale string) error {
+	if locale == s.defaultLocale {
+		return ErrDefaultLocaleTranslation
+	}
+	if !slices.Contains(s.locales, locale) {
+		return ErrUnsupportedLocale
+	}
+	return nil
+}
+
+func entryNotFoundError(kind domain.CatalogKind) error {
+	switch kind {
+	case domain.CatalogKindCategory:
+		return ErrCategoryNotFound
+	case domain.CatalogKindSubcategory:
+		return ErrSubcategoryNotFound
+	default:
+		return ErrServiceNotFound
+	}
+}
+
+func entryNameTakenError(kind domain.CatalogKind) error {
+	switch kind {
+	case domain.CatalogKindCategory:
+		return ErrCategoryNameTaken
+	case domain.CatalogKindSubcategory:
+		return ErrSubcategoryNameTaken
+	default:
+		return ErrServiceNameTaken
+	}
+}
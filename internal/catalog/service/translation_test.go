@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/catalog/domain"
+	"github.com/hexley21/fixup/internal/catalog/repository"
+	mock_repository "github.com/hexley21/fixup/internal/catalog/repository/mock"
+	"github.com/hexley21/fixup/internal/catalog/service"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var locales = []string{"en", "ka", "ru"}
+
+func setupTranslation(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	mockTranslationRepo *mock_repository.MockTranslation,
+	svc service.TranslationService,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockTranslationRepo = mock_repository.NewMockTranslation(ctrl)
+	svc = service.NewTranslationService(mockTranslationRepo, newCatalogTreeCacheStub(ctrl), defaultLocale, locales)
+
+	return
+}
+
+func TestListTranslations(t *testing.T) {
+	ctrl, ctx, mockTranslationRepo, svc := setupTranslation(t)
+	defer ctrl.Finish()
+
+	mockTranslationRepo.EXPECT().List(ctx, domain.CatalogKindService, id).Return([]repository.TranslationModel{
+		{EntryID: id, Locale: "ka", Name: "ონკანის შეკეთება", Description: pgtype.Text{String: "აღწერა", Valid: true}},
+		{EntryID: id, Locale: "ru", Name: "Ремонт крана"},
+	}, nil)
+
+	translations, err := svc.List(ctx, domain.CatalogKindService, id)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Translation{
+		domain.NewTranslation("ka", "ონკანის შეკეთება", "აღწერა"),
+		domain.NewTranslation("ru", "Ремонт крана", ""),
+	}, translations)
+}
+
+func TestSetTranslation(t *testing.T) {
+	ctrl, ctx, mockTranslationRepo, svc := setupTranslation(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		kind          domain.CatalogKind
+		locale        string
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			kind:   domain.CatalogKindCategory,
+			locale: "ka",
+		},
+		{
+			name:          "UnsupportedLocale",
+			kind:          domain.CatalogKindCategory,
+			locale:        "de",
+			expectedError: service.ErrUnsupportedLocale,
+		},
+		{
+			name:          "DefaultLocale",
+			kind:          domain.CatalogKindCategory,
+			locale:        defaultLocale,
+			expectedError: service.ErrDefaultLocaleTranslation,
+		},
+		{
+			name:          "SubcategoryNotFound",
+			kind:          domain.CatalogKindSubcategory,
+			locale:        "ka",
+			mockError:     &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation},
+			expectedError: service.ErrSubcategoryNotFound,
+		},
+		{
+			name:          "ServiceNameTaken",
+			kind:          domain.CatalogKindService,
+			locale:        "ka",
+			mockError:     &pgconn.PgError{Code: pgerrcode.RaiseException},
+			expectedError: service.ErrServiceNameTaken,
+		},
+		{
+			name:          "RepositoryError",
+			kind:          domain.CatalogKindService,
+			locale:        "ka",
+			mockError:     errors.New(""),
+			expectedError: errors.New(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := domain.NewTranslationInfo("სახლი", "აღწერა")
+
+			if tt.mockError != nil || tt.expectedError == nil {
+				expectedInfo := info
+				if tt.kind != domain.CatalogKindService {
+					// only services have their description translated
+					expectedInfo.Description = ""
+				}
+				mockTranslationRepo.EXPECT().Upsert(ctx, tt.kind, id, tt.locale, expectedInfo).Return(
+					repository.TranslationModel{EntryID: id, Locale: tt.locale, Name: info.Name},
+					tt.mockError,
+				)
+			}
+
+			translation, err := svc.Set(ctx, tt.kind, id, tt.locale, info)
+
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, domain.NewTranslation(tt.locale, info.Name, ""), translation)
+			}
+		})
+	}
+}
+
+func TestDeleteTranslation_NotFound(t *testing.T) {
+	ctrl, ctx, mockTranslationRepo, svc := setupTranslation(t)
+	defer ctrl.Finish()
+
+	mockTranslationRepo.EXPECT().Delete(ctx, domain.CatalogKindCategory, id, "ka").Return(false, nil)
+
+	assert.ErrorIs(t, svc.Delete(ctx, domain.CatalogKindCategory, id, "ka"), service.ErrTranslationNotFound)
+}
@@ -0,0 +1,271 @@
+package channel
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service         service.ChannelService
+	presenceService service.PresenceService
+	defaultPerPage  int64
+	maxPerPage      int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.ChannelService,
+	presenceService service.PresenceService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:      handlerComponents,
+		service:         service,
+		presenceService: presenceService,
+		defaultPerPage:  defaultPerPage,
+		maxPerPage:      maxPerPage,
+	}
+}
+
+// List
+// @Summary Retrieve own channels
+// @Description Retrieves a range of the authenticated user's channels created before the "before" channel id, newest first, with their last message and unread count
+// @Tags Channel
+// @Param before query int false "Channel id to retrieve channels before, latest channels if omitted"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Channel] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving channels"
+// @Router /chat/channels [get]
+// @Security access_token
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	before, limit, errResp := request_util.ParseBeforeAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	channels, err := h.service.List(r.Context(), userId, before, limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch channels - user id: %d, error: %w", userId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch channels - U-ID: %d, %d", userId, len(channels))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapChannelsToDTO(channels))
+}
+
+// Get
+// @Summary Retrieve a channel by ID
+// @Description Retrieves a channel with its last message and the unread count. Available to the channel members, moderators and admins.
+// @Tags Channel
+// @Param channel_id path int true "Channel id"
+// @Success 200 {object} rest.ApiResponse[dto.Channel] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the channel"
+// @Router /chat/channels/{channel_id} [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	channel, err := h.service.Get(r.Context(), channelId, userId, userData.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch channel - id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch channel - ID: %d, U-ID: %d", channelId, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapChannelToDTO(channel))
+}
+
+// MarkRead
+// @Summary Mark a channel as read
+// @Description Moves the authenticated member's read receipt up to the message and notifies the connected participants. Every message is marked as read if the body or the message id is omitted.
+// @Tags Channel
+// @Param channel_id path int true "Channel id"
+// @Param dto body dto.ReadInfo false "Last read message"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while marking the channel as read"
+// @Router /chat/channels/{channel_id}/read [post]
+// @Security access_token
+func (h *Handler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var messageId int64
+	if r.ContentLength != 0 {
+		var infoDTO dto.ReadInfo
+		errResp = h.Binder.BindJSON(r, &infoDTO)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		errResp = h.Validator.Validate(infoDTO)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		if infoDTO.MessageID != "" {
+			messageId, err = strconv.ParseInt(infoDTO.MessageID, 10, 64)
+			if err != nil {
+				h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+				return
+			}
+		}
+	}
+
+	err = h.service.MarkRead(r.Context(), channelId, userId, messageId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to mark channel as read - id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Mark channel as read - ID: %d, U-ID: %d", channelId, userId)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ListReceipts
+// @Summary Retrieve channel read receipts
+// @Description Retrieves the last read message id of every channel member, "0" if the member has read nothing
+// @Tags Channel
+// @Param channel_id path int true "Channel id"
+// @Success 200 {object} rest.ApiResponse[[]dto.ReadReceipt] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving read receipts"
+// @Router /chat/channels/{channel_id}/receipts [get]
+// @Security access_token
+func (h *Handler) ListReceipts(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	receipts, err := h.service.ListReceipts(r.Context(), channelId, userId, userData.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch read receipts - channel id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch read receipts - Channel-ID: %d, U-ID: %d", channelId, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapReadReceiptsToDTO(receipts))
+}
+
+// GetPresence
+// @Summary Retrieve channel members presence
+// @Description Retrieves whether every channel member is online
+// @Tags Channel
+// @Param channel_id path int true "Channel id"
+// @Success 200 {object} rest.ApiResponse[[]dto.Presence] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving presence"
+// @Router /chat/channels/{channel_id}/presence [get]
+// @Security access_token
+func (h *Handler) GetPresence(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	presences, err := h.presenceService.Get(r.Context(), channelId, userId, userData.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch presence - channel id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch presence - Channel-ID: %d, U-ID: %d", channelId, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapPresencesToDTO(presences))
+}
@@ -0,0 +1,23 @@
+package channel
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Get("/channels", h.List)
+		r.Get("/channels/{channel_id}", h.Get)
+		r.Post("/channels/{channel_id}/read", h.MarkRead)
+		r.Get("/channels/{channel_id}/receipts", h.ListReceipts)
+		r.Get("/channels/{channel_id}/presence", h.GetPresence)
+	})
+}
@@ -0,0 +1,23 @@
+package dto
+
+type (
+	Channel struct {
+		ID          string   `json:"id"`
+		OrderID     string   `json:"order_id"`
+		CustomerID  string   `json:"customer_id"`
+		ProviderID  string   `json:"provider_id"`
+		LastMessage *Message `json:"last_message,omitempty"`
+		UnreadCount int64    `json:"unread_count"`
+	} // @name Channel
+	ReadInfo struct {
+		MessageID string `json:"message_id" validate:"omitempty,number"`
+	} // @name ReadInfo
+	ReadReceipt struct {
+		UserID    string `json:"user_id"`
+		MessageID string `json:"message_id"`
+	} // @name ReadReceipt
+	Presence struct {
+		UserID string `json:"user_id"`
+		Online bool   `json:"online"`
+	} // @name Presence
+)
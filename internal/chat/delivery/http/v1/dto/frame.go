@@ -0,0 +1,29 @@
+package dto
+
+const (
+	FrameSubscribe    = "subscribe"
+	FrameUnsubscribe  = "unsubscribe"
+	FrameMessage      = "message"
+	FrameTyping       = "typing"
+	FrameRead         = "read"
+	FrameSubscribed   = "subscribed"
+	FrameUnsubscribed = "unsubscribed"
+	FrameError        = "error"
+)
+
+type (
+	InboundFrame struct {
+		Type      string `json:"type"`
+		ChannelID string `json:"channel_id"`
+		Content   string `json:"content,omitempty"`
+		MessageID string `json:"message_id,omitempty"`
+	} // @name InboundFrame
+	OutboundFrame struct {
+		Type      string   `json:"type"`
+		ChannelID string   `json:"channel_id,omitempty"`
+		UserID    string   `json:"user_id,omitempty"`
+		MessageID string   `json:"message_id,omitempty"`
+		Message   *Message `json:"message,omitempty"`
+		Error     string   `json:"error,omitempty"`
+	} // @name OutboundFrame
+)
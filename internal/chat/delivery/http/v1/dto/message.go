@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type (
+	Message struct {
+		ID          string       `json:"id"`
+		ChannelID   string       `json:"channel_id"`
+		AuthorID    string       `json:"author_id"`
+		Content     string       `json:"content"`
+		Attachments []Attachment `json:"attachments,omitempty"`
+		CreatedAt   time.Time    `json:"created_at"`
+	} // @name Message
+	Attachment struct {
+		Name        string `json:"name"`
+		ContentType string `json:"content_type"`
+		Size        int64  `json:"size"`
+		Url         string `json:"url"`
+	} // @name Attachment
+	MessageInfo struct {
+		Content string `json:"content" validate:"required,max=2000"`
+	} // @name MessageInfo
+)
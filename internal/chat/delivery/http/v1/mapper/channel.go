@@ -0,0 +1,58 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/domain"
+)
+
+func MapChannelToDTO(entity domain.Channel) dto.Channel {
+	channelDTO := dto.Channel{
+		ID:          strconv.FormatInt(entity.ID, 10),
+		OrderID:     strconv.FormatInt(entity.OrderID, 10),
+		CustomerID:  strconv.FormatInt(entity.CustomerID, 10),
+		ProviderID:  strconv.FormatInt(entity.ProviderID, 10),
+		UnreadCount: entity.UnreadCount,
+	}
+
+	if entity.LastMessage != nil {
+		lastMessage := mapMessagePreviewToDTO(*entity.LastMessage)
+		channelDTO.LastMessage = &lastMessage
+	}
+
+	return channelDTO
+}
+
+func MapChannelsToDTO(entities []domain.Channel) []dto.Channel {
+	channelsDTO := make([]dto.Channel, len(entities))
+	for i, c := range entities {
+		channelsDTO[i] = MapChannelToDTO(c)
+	}
+
+	return channelsDTO
+}
+
+func MapReadReceiptsToDTO(receipts []domain.ReadReceipt) []dto.ReadReceipt {
+	receiptsDTO := make([]dto.ReadReceipt, len(receipts))
+	for i, r := range receipts {
+		receiptsDTO[i] = dto.ReadReceipt{
+			UserID:    strconv.FormatInt(r.UserID, 10),
+			MessageID: strconv.FormatInt(r.MessageID, 10),
+		}
+	}
+
+	return receiptsDTO
+}
+
+func MapPresencesToDTO(presences []domain.Presence) []dto.Presence {
+	presencesDTO := make([]dto.Presence, len(presences))
+	for i, p := range presences {
+		presencesDTO[i] = dto.Presence{
+			UserID: strconv.FormatInt(p.UserID, 10),
+			Online: p.Online,
+		}
+	}
+
+	return presencesDTO
+}
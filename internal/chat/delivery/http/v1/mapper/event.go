@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+func MapEventToFrame(event domain.Event, urlSigner cdn.URLSigner) (dto.OutboundFrame, error) {
+	frame := dto.OutboundFrame{
+		ChannelID: strconv.FormatInt(event.ChannelID, 10),
+		UserID:    strconv.FormatInt(event.UserID, 10),
+	}
+
+	switch event.Type {
+	case domain.EventTypeMESSAGE:
+		frame.Type = dto.FrameMessage
+		if event.Message != nil {
+			messageDTO, err := MapMessageToDTO(*event.Message, urlSigner)
+			if err != nil {
+				return dto.OutboundFrame{}, err
+			}
+			frame.Message = &messageDTO
+		}
+	case domain.EventTypeREAD:
+		frame.Type = dto.FrameRead
+		frame.MessageID = strconv.FormatInt(event.MessageID, 10)
+	case domain.EventTypeTYPING:
+		frame.Type = dto.FrameTyping
+	}
+
+	return frame, nil
+}
@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+// MapMessageToDTO maps the message and signs the urls of its attachments.
+func MapMessageToDTO(entity domain.Message, urlSigner cdn.URLSigner) (dto.Message, error) {
+	messageDTO := mapMessagePreviewToDTO(entity)
+
+	if len(entity.Attachments) > 0 {
+		messageDTO.Attachments = make([]dto.Attachment, len(entity.Attachments))
+		for i, a := range entity.Attachments {
+			signedUrl, err := urlSigner.SignURL(a.Key)
+			if err != nil {
+				return dto.Message{}, err
+			}
+
+			messageDTO.Attachments[i] = dto.Attachment{
+				Name:        a.Name,
+				ContentType: a.ContentType,
+				Size:        a.Size,
+				Url:         signedUrl,
+			}
+		}
+	}
+
+	return messageDTO, nil
+}
+
+func MapMessagesToDTO(entities []domain.Message, urlSigner cdn.URLSigner) ([]dto.Message, error) {
+	messagesDTO := make([]dto.Message, len(entities))
+	for i, m := range entities {
+		messageDTO, err := MapMessageToDTO(m, urlSigner)
+		if err != nil {
+			return nil, err
+		}
+		messagesDTO[i] = messageDTO
+	}
+
+	return messagesDTO, nil
+}
+
+// mapMessagePreviewToDTO maps the message without its attachments.
+func mapMessagePreviewToDTO(entity domain.Message) dto.Message {
+	return dto.Message{
+		ID:        strconv.FormatInt(entity.ID, 10),
+		ChannelID: strconv.FormatInt(entity.ChannelID, 10),
+		AuthorID:  strconv.FormatInt(entity.AuthorID, 10),
+		Content:   entity.Content,
+		CreatedAt: entity.CreatedAt,
+	}
+}
@@ -0,0 +1,253 @@
+package message
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.MessageService
+	urlSigner      cdn.URLSigner
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.MessageService,
+	urlSigner cdn.URLSigner,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		urlSigner:      urlSigner,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Send
+// @Summary Send a message
+// @Description Stores a message in the channel and delivers it to the connected participants.
+// @Tags Message
+// @Param channel_id path int true "Channel id"
+// @Param dto body dto.MessageInfo true "Message data"
+// @Success 201 {object} rest.ApiResponse[dto.Message] "Created - Successfully sent the message"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while sending the message"
+// @Router /chat/channels/{channel_id}/messages [post]
+// @Security access_token
+func (h *Handler) Send(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var infoDTO dto.MessageInfo
+	errResp = h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	messageEntity, err := h.service.Send(r.Context(), channelId, userId, userData.Role, infoDTO.Content)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEmptyMessage), errors.Is(err, service.ErrMessageTooLong):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send message - channel id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	messageDTO, err := mapper.MapMessageToDTO(messageEntity, h.urlSigner)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send message due to mapping error - channel id: %d, error: %w", channelId, err))
+		return
+	}
+
+	h.Logger.Infof("Send message - Channel-ID: %d, U-ID: %d, ID: %d", channelId, userId, messageEntity.ID)
+	h.Writer.WriteData(w, http.StatusCreated, messageDTO)
+}
+
+// SendAttachment
+// @Summary Send an attachment
+// @Description Uploads an image or a PDF file and sends it to the channel as a message with an optional content.
+// @Tags Message
+// @Accept multipart/form-data
+// @Param channel_id path int true "Channel id"
+// @Param file formData file true "Image or PDF file"
+// @Param content formData string false "Message content"
+// @Success 201 {object} rest.ApiResponse[dto.Message] "Created - Successfully sent the attachment"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while sending the attachment"
+// @Router /chat/channels/{channel_id}/messages/attachments [post]
+// @Security access_token
+func (h *Handler) SendAttachment(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	form, errResp := h.Binder.BindMultipartForm(r, maxAttachmentSize)
+	if errResp != nil {
+		h.Writer.WriteError(w, rest.NewReadFileError(errResp))
+		return
+	}
+
+	formFile := form.File["file"]
+	if len(formFile) < 1 {
+		h.Writer.WriteError(w, rest.NewBadRequestError(rest.ErrNoFile))
+		return
+	}
+
+	attachmentFile := formFile[0]
+
+	file, err := attachmentFile.Open()
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewReadFileError(err))
+		return
+	}
+	defer func(file multipart.File) {
+		err := file.Close()
+		if err != nil {
+			h.Logger.Errorf("failed to close file: %v", err)
+		}
+	}(file)
+
+	messageEntity, err := h.service.SendAttachment(
+		r.Context(),
+		channelId,
+		userId,
+		userData.Role,
+		r.FormValue("content"),
+		file,
+		attachmentFile.Filename,
+		attachmentFile.Size,
+		attachmentFile.Header.Get("Content-Type"),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrMessageTooLong):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send attachment - channel id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	messageDTO, err := mapper.MapMessageToDTO(messageEntity, h.urlSigner)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send attachment due to mapping error - channel id: %d, error: %w", channelId, err))
+		return
+	}
+
+	h.Logger.Infof("Send attachment - Channel-ID: %d, U-ID: %d, ID: %d", channelId, userId, messageEntity.ID)
+	h.Writer.WriteData(w, http.StatusCreated, messageDTO)
+}
+
+// History
+// @Summary Retrieve channel messages
+// @Description Retrieves a range of channel messages older than the "before" message id, newest first
+// @Tags Message
+// @Param channel_id path int true "Channel id"
+// @Param before query int false "Message id to retrieve messages before, latest messages if omitted"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Message] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving messages"
+// @Router /chat/channels/{channel_id}/messages [get]
+// @Security access_token
+func (h *Handler) History(w http.ResponseWriter, r *http.Request) {
+	channelId, err := strconv.ParseInt(chi.URLParam(r, "channel_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, userData, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	before, limit, errResp := request_util.ParseBeforeAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	messages, err := h.service.History(r.Context(), channelId, userId, userData.Role, before, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrChannelNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrChannelAccessDenied):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch messages - channel id: %d, error: %w", channelId, err))
+		}
+		return
+	}
+
+	messagesDTO, err := mapper.MapMessagesToDTO(messages, h.urlSigner)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch messages due to mapping error - channel id: %d, error: %w", channelId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch messages - Channel-ID: %d, %d", channelId, len(messages))
+	h.Writer.WriteData(w, http.StatusOK, messagesDTO)
+}
@@ -0,0 +1,32 @@
+package message
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/middleware"
+)
+
+const maxAttachmentSize int64 = 10 << 20
+
+func MapRoutes(
+	mw *middleware.Middleware,
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Route("/channels/{channel_id}/messages", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Get("/", h.History)
+		r.Post("/", h.Send)
+
+		r.Group(func(r chi.Router) {
+			r.Use(
+				mw.NewAllowFilesAmount(maxAttachmentSize, "file", 1),
+				mw.NewAllowContentType(maxAttachmentSize, "file", "image/jpeg", "image/png", "application/pdf"),
+			)
+			r.Post("/attachments", h.SendAttachment)
+		})
+	})
+}
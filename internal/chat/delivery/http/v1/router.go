@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/channel"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/message"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/ws"
+	"github.com/hexley21/fixup/internal/chat/hub"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+type RouterArgs struct {
+	MessageService    service.MessageService
+	ChannelService    service.ChannelService
+	PresenceService   service.PresenceService
+	Hub               *hub.Hub
+	Middleware        *middleware.Middleware
+	HandlerComponents *handler.Components
+	AccessJWTManager  auth_jwt.Manager
+	CdnUrlSigner      cdn.URLSigner
+	PaginationConfig  *config.Pagination
+	AllowedOrigins    []string
+}
+
+func MapV1Routes(args RouterArgs, router chi.Router) {
+	accessJWTMiddleware := args.Middleware.NewJWT(args.AccessJWTManager)
+
+	messageHandler := message.NewHandler(
+		args.HandlerComponents,
+		args.MessageService,
+		args.CdnUrlSigner,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	channelHandler := channel.NewHandler(
+		args.HandlerComponents,
+		args.ChannelService,
+		args.PresenceService,
+		args.PaginationConfig.MediumPages,
+		args.PaginationConfig.LargePages,
+	)
+
+	wsHandler := ws.NewHandler(
+		args.HandlerComponents,
+		args.MessageService,
+		args.ChannelService,
+		args.PresenceService,
+		args.Hub,
+		args.AccessJWTManager,
+		args.CdnUrlSigner,
+		args.AllowedOrigins,
+	)
+
+	router.Route("/v1/chat", func(r chi.Router) {
+		channel.MapRoutes(channelHandler, accessJWTMiddleware, r)
+		message.MapRoutes(args.Middleware, messageHandler, accessJWTMiddleware, r)
+		ws.MapRoutes(wsHandler, r)
+	})
+}
@@ -0,0 +1,227 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/chat/hub"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/enum"
+)
+
+const (
+	// Time allowed to write a frame to the peer
+	writeWait = 10 * time.Second
+	// Time allowed to read the next pong from the peer
+	pongWait = 60 * time.Second
+	// Pings are sent with this period, must be less than pongWait
+	pingPeriod = pongWait * 9 / 10
+	// Maximum size of an inbound frame, fits a message of domain.MaxMessageLength multibyte characters
+	maxFrameSize = 16 << 10
+	// Amount of messages buffered for a client before it is dropped as too slow
+	listenerBuffer = 256
+	// Amount of replies buffered for a client
+	replyBuffer = 16
+)
+
+var (
+	errUnknownFrame     = errors.New("unknown frame type")
+	errInvalidChannelId = errors.New("invalid channel id")
+	errInvalidMessageId = errors.New("invalid message id")
+)
+
+// client pumps frames between a WebSocket connection and the hub.
+// readPump handles inbound frames on the request goroutine, writePump is the only writer of the connection.
+type client struct {
+	h      *Handler
+	conn   *websocket.Conn
+	userID int64
+	role   enum.UserRole
+	// presence connection id, empty if the connection could not be registered
+	connID   string
+	listener *hub.Listener
+	replies  chan dto.OutboundFrame
+	// closed by readPump when the peer is gone
+	done chan struct{}
+	// closed by writePump when the connection can no longer be written
+	writerDone chan struct{}
+}
+
+func newClient(h *Handler, conn *websocket.Conn, userID int64, role enum.UserRole, connID string) *client {
+	return &client{
+		h:          h,
+		conn:       conn,
+		userID:     userID,
+		role:       role,
+		connID:     connID,
+		listener:   hub.NewListener(listenerBuffer),
+		replies:    make(chan dto.OutboundFrame, replyBuffer),
+		done:       make(chan struct{}),
+		writerDone: make(chan struct{}),
+	}
+}
+
+func (c *client) readPump(ctx context.Context) {
+	defer func() {
+		c.h.hub.UnsubscribeAll(c.listener)
+		close(c.done)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxFrameSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		var frame dto.InboundFrame
+		if err := c.conn.ReadJSON(&frame); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				c.h.Logger.Errorf("chat connection closed unexpectedly - U-ID: %d, error: %v", c.userID, err)
+			}
+			return
+		}
+
+		if !c.handleFrame(ctx, frame) {
+			return
+		}
+	}
+}
+
+// handleFrame processes a single inbound frame, it returns false if the connection should be closed.
+func (c *client) handleFrame(ctx context.Context, frame dto.InboundFrame) bool {
+	channelId, err := strconv.ParseInt(frame.ChannelID, 10, 64)
+	if err != nil {
+		return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: errInvalidChannelId.Error()})
+	}
+
+	switch frame.Type {
+	case dto.FrameSubscribe:
+		err := c.h.channelService.CheckAccess(ctx, channelId, c.userID, c.role)
+		switch {
+		case err == nil:
+		case errors.Is(err, service.ErrChannelNotFound), errors.Is(err, service.ErrChannelAccessDenied):
+			return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: err.Error()})
+		default:
+			c.h.Logger.Errorf("failed to subscribe - channel id: %d, U-ID: %d, error: %v", channelId, c.userID, err)
+			return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: "failed to subscribe"})
+		}
+
+		c.h.hub.Subscribe(channelId, c.listener)
+		return c.reply(dto.OutboundFrame{Type: dto.FrameSubscribed, ChannelID: frame.ChannelID})
+	case dto.FrameUnsubscribe:
+		c.h.hub.Unsubscribe(channelId, c.listener)
+		return c.reply(dto.OutboundFrame{Type: dto.FrameUnsubscribed, ChannelID: frame.ChannelID})
+	case dto.FrameMessage:
+		// the stored message is delivered back through the hub, like to any other subscriber
+		_, err := c.h.service.Send(ctx, channelId, c.userID, c.role, frame.Content)
+		return c.replyResult(frame, err, "failed to send message")
+	case dto.FrameTyping:
+		err := c.h.channelService.Typing(ctx, channelId, c.userID, c.role)
+		return c.replyResult(frame, err, "failed to send typing")
+	case dto.FrameRead:
+		var messageId int64
+		if frame.MessageID != "" {
+			messageId, err = strconv.ParseInt(frame.MessageID, 10, 64)
+			if err != nil {
+				return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: errInvalidMessageId.Error()})
+			}
+		}
+
+		err = c.h.channelService.MarkRead(ctx, channelId, c.userID, messageId)
+		return c.replyResult(frame, err, "failed to mark channel as read")
+	default:
+		return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: errUnknownFrame.Error()})
+	}
+}
+
+// replyResult replies with an error frame if the frame failed, results are delivered through the hub otherwise.
+// Unexpected errors are logged and replaced with the failure message.
+func (c *client) replyResult(frame dto.InboundFrame, err error, failure string) bool {
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, service.ErrEmptyMessage),
+		errors.Is(err, service.ErrMessageTooLong),
+		errors.Is(err, service.ErrChannelNotFound),
+		errors.Is(err, service.ErrChannelAccessDenied):
+		return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: err.Error()})
+	default:
+		c.h.Logger.Errorf("%s - channel id: %s, U-ID: %d, error: %v", failure, frame.ChannelID, c.userID, err)
+		return c.reply(dto.OutboundFrame{Type: dto.FrameError, ChannelID: frame.ChannelID, Error: failure})
+	}
+}
+
+// reply queues a frame for writePump, it returns false if the connection can no longer be written.
+func (c *client) reply(frame dto.OutboundFrame) bool {
+	select {
+	case c.replies <- frame:
+		return true
+	case <-c.writerDone:
+		return false
+	}
+}
+
+func (c *client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		close(c.writerDone)
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case frame := <-c.replies:
+			if err := c.write(frame); err != nil {
+				return
+			}
+		case event := <-c.listener.C():
+			frame, err := mapper.MapEventToFrame(event, c.h.urlSigner)
+			if err != nil {
+				c.h.Logger.Errorf("failed to map chat event - channel id: %d, U-ID: %d, error: %v", event.ChannelID, c.userID, err)
+				continue
+			}
+			if err := c.write(frame); err != nil {
+				return
+			}
+		case <-c.listener.Done():
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "client is too slow"))
+			return
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+			c.heartbeat()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *client) write(frame dto.OutboundFrame) error {
+	_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.conn.WriteJSON(frame)
+}
+
+// heartbeat keeps the user online while the connection is alive.
+func (c *client) heartbeat() {
+	if c.connID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeWait)
+	defer cancel()
+
+	if err := c.h.presenceService.Heartbeat(ctx, c.userID, c.connID); err != nil {
+		c.h.Logger.Errorf("failed to refresh presence - U-ID: %d, error: %v", c.userID, err)
+	}
+}
@@ -0,0 +1,132 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/hexley21/fixup/internal/chat/hub"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+type Handler struct {
+	*handler.Components
+	service         service.MessageService
+	channelService  service.ChannelService
+	presenceService service.PresenceService
+	hub             *hub.Hub
+	verifier        auth_jwt.Verifier
+	urlSigner       cdn.URLSigner
+	upgrader        websocket.Upgrader
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.MessageService,
+	channelService service.ChannelService,
+	presenceService service.PresenceService,
+	hub *hub.Hub,
+	verifier auth_jwt.Verifier,
+	urlSigner cdn.URLSigner,
+	allowedOrigins []string,
+) *Handler {
+	return &Handler{
+		Components:      handlerComponents,
+		service:         service,
+		channelService:  channelService,
+		presenceService: presenceService,
+		hub:             hub,
+		verifier:        verifier,
+		urlSigner:       urlSigner,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || slices.Contains(allowedOrigins, origin)
+			},
+		},
+	}
+}
+
+// Connect
+// @Summary Open a chat connection
+// @Description Upgrades the request to a WebSocket connection. Browsers can't set headers on WebSocket requests, so the access token may be passed in the "access_token" query parameter instead.
+// @Description Clients send InboundFrame objects to subscribe to channels, unsubscribe from them and send messages, and receive OutboundFrame objects.
+// @Description Besides messages, clients can send "typing" frames and "read" frames with an optional message_id, which are delivered to the channel subscribers.
+// @Description Only channel members, moderators and admins can subscribe or send messages to a channel.
+// @Description The user is online while any connection is open and for a short grace period after it closes.
+// @Tags Chat
+// @Param access_token query string false "Access token, if the Authorization header is not set"
+// @Success 101 {string} string "Switching Protocols"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Router /chat/ws [get]
+// @Security access_token
+func (h *Handler) Connect(w http.ResponseWriter, r *http.Request) {
+	tokenString := r.URL.Query().Get("access_token")
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		tokenString = strings.TrimPrefix(authHeader, "Bearer ")
+		if tokenString == authHeader {
+			h.Writer.WriteError(w, middleware.ErrMissingBearerToken)
+			return
+		}
+	}
+	if tokenString == "" {
+		h.Writer.WriteError(w, middleware.ErrMissingAuthorizationHeader)
+		return
+	}
+
+	claims, errResp := h.verifier.Verify(tokenString)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if !claims.Data.Role.Valid() {
+		h.Writer.WriteError(w, rest.NewUnauthorizedError(enum.ErrInvalidRole))
+		return
+	}
+
+	userId, err := strconv.ParseInt(claims.Data.ID, 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to parse claims id: %w", err))
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client
+		h.Logger.Errorf("failed to upgrade chat connection - U-ID: %d, error: %v", userId, err)
+		return
+	}
+
+	h.Logger.Infof("Open chat connection - U-ID: %d", userId)
+
+	// presence is best effort, a connection that could not be registered still works
+	connId, err := h.presenceService.Connect(r.Context(), userId)
+	if err != nil {
+		h.Logger.Errorf("failed to register presence - U-ID: %d, error: %v", userId, err)
+	}
+
+	c := newClient(h, conn, userId, claims.Data.Role, connId)
+	go c.writePump()
+	c.readPump(r.Context())
+
+	if connId != "" {
+		if err := h.presenceService.Disconnect(context.Background(), userId, connId); err != nil {
+			h.Logger.Errorf("failed to unregister presence - U-ID: %d, error: %v", userId, err)
+		}
+	}
+
+	h.Logger.Infof("Close chat connection - U-ID: %d", userId)
+}
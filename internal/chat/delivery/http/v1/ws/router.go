@@ -0,0 +1,9 @@
+package ws
+
+import (
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(h *Handler, router chi.Router) {
+	router.Get("/ws", h.Connect)
+}
@@ -0,0 +1,15 @@
+package domain
+
+type Channel struct {
+	ID          int64
+	OrderID     int64
+	CustomerID  int64
+	ProviderID  int64
+	LastMessage *Message
+	UnreadCount int64
+} // Channel Domain Entity
+
+// IsMember reports whether the user is one of the channel participants.
+func (c Channel) IsMember(userID int64) bool {
+	return c.CustomerID == userID || c.ProviderID == userID
+}
@@ -0,0 +1,38 @@
+package domain
+
+// Event is delivered to the connected participants of a channel.
+// Message is set for MESSAGE events, MessageID is the read message for READ events.
+type Event struct {
+	Type      EventType
+	ChannelID int64
+	UserID    int64
+	MessageID int64
+	Message   *Message
+} // Event Domain Entity
+
+func NewMessageEvent(message Message) Event {
+	return Event{
+		Type:      EventTypeMESSAGE,
+		ChannelID: message.ChannelID,
+		UserID:    message.AuthorID,
+		MessageID: message.ID,
+		Message:   &message,
+	}
+}
+
+func NewTypingEvent(channelID int64, userID int64) Event {
+	return Event{
+		Type:      EventTypeTYPING,
+		ChannelID: channelID,
+		UserID:    userID,
+	}
+}
+
+func NewReadEvent(channelID int64, userID int64, messageID int64) Event {
+	return Event{
+		Type:      EventTypeREAD,
+		ChannelID: channelID,
+		UserID:    userID,
+		MessageID: messageID,
+	}
+}
@@ -0,0 +1,19 @@
+package domain
+
+type EventType string
+
+const (
+	EventTypeMESSAGE EventType = "MESSAGE"
+	EventTypeTYPING  EventType = "TYPING"
+	EventTypeREAD    EventType = "READ"
+)
+
+func (e EventType) Valid() bool {
+	switch e {
+	case EventTypeMESSAGE,
+		EventTypeTYPING,
+		EventTypeREAD:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// BucketSize is the time span of a single messages partition.
+// Messages of a channel are partitioned by (channel_id, bucket) so that a partition never grows unbounded.
+const BucketSize = 10 * 24 * time.Hour
+
+// MaxMessageLength is the maximum amount of characters in a message.
+const MaxMessageLength = 2000
+
+type Message struct {
+	ID          int64
+	ChannelID   int64
+	AuthorID    int64
+	Content     string
+	Attachments []Attachment
+	CreatedAt   time.Time
+} // Message Domain Entity
+
+type Attachment struct {
+	Key         string
+	Name        string
+	ContentType string
+	Size        int64
+} // Attachment Value Object
+
+func NewAttachment(key string, name string, contentType string, size int64) Attachment {
+	return Attachment{
+		Key:         key,
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+	}
+}
+
+// NewMessage creates a message, its creation time is derived from the snowflake id.
+func NewMessage(id int64, channelID int64, authorID int64, content string) Message {
+	return Message{
+		ID:        id,
+		ChannelID: channelID,
+		AuthorID:  authorID,
+		Content:   content,
+		CreatedAt: time.UnixMilli(snowflake.ID(id).Time()),
+	}
+}
+
+// Bucket returns the bucket of the snowflake id.
+func Bucket(id int64) int32 {
+	return int32(snowflake.ID(id).Time() / BucketSize.Milliseconds())
+}
+
+// BucketAt returns the bucket of the time.
+func BucketAt(t time.Time) int32 {
+	return int32(t.UnixMilli() / BucketSize.Milliseconds())
+}
@@ -0,0 +1,26 @@
+package domain
+
+type (
+	Presence struct {
+		UserID int64
+		Online bool
+	} // Presence Value Object
+	ReadReceipt struct {
+		UserID    int64
+		MessageID int64
+	} // Read receipt Value Object
+)
+
+func NewPresence(userID int64, online bool) Presence {
+	return Presence{
+		UserID: userID,
+		Online: online,
+	}
+}
+
+func NewReadReceipt(userID int64, messageID int64) ReadReceipt {
+	return ReadReceipt{
+		UserID:    userID,
+		MessageID: messageID,
+	}
+}
@@ -0,0 +1,124 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/hexley21/fixup/internal/chat/domain"
+)
+
+// Listener receives the events of the channels it is subscribed to.
+// If the listener falls behind and its buffer fills up, it is dropped:
+// Done is closed and the listener is removed from every channel.
+type Listener struct {
+	c        chan domain.Event
+	done     chan struct{}
+	dropOnce sync.Once
+}
+
+func NewListener(buffer int) *Listener {
+	return &Listener{
+		c:    make(chan domain.Event, buffer),
+		done: make(chan struct{}),
+	}
+}
+
+// C returns the channel of the delivered events.
+func (l *Listener) C() <-chan domain.Event {
+	return l.c
+}
+
+// Done is closed when the listener was dropped for being too slow.
+func (l *Listener) Done() <-chan struct{} {
+	return l.done
+}
+
+func (l *Listener) drop() {
+	l.dropOnce.Do(func() {
+		close(l.done)
+	})
+}
+
+// Hub keeps the listeners of every channel connected to this instance and fans out the published events.
+type Hub struct {
+	mu        sync.RWMutex
+	channels  map[int64]map[*Listener]struct{}
+	listeners map[*Listener]map[int64]struct{}
+}
+
+func New() *Hub {
+	return &Hub{
+		channels:  make(map[int64]map[*Listener]struct{}),
+		listeners: make(map[*Listener]map[int64]struct{}),
+	}
+}
+
+// Subscribe adds the listener to the channel.
+func (h *Hub) Subscribe(channelID int64, l *Listener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.channels[channelID]; !ok {
+		h.channels[channelID] = make(map[*Listener]struct{})
+	}
+	h.channels[channelID][l] = struct{}{}
+
+	if _, ok := h.listeners[l]; !ok {
+		h.listeners[l] = make(map[int64]struct{})
+	}
+	h.listeners[l][channelID] = struct{}{}
+}
+
+// Unsubscribe removes the listener from the channel.
+func (h *Hub) Unsubscribe(channelID int64, l *Listener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.unsubscribe(channelID, l)
+}
+
+// UnsubscribeAll removes the listener from every channel it is subscribed to.
+func (h *Hub) UnsubscribeAll(l *Listener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for channelID := range h.listeners[l] {
+		h.unsubscribe(channelID, l)
+	}
+}
+
+func (h *Hub) unsubscribe(channelID int64, l *Listener) {
+	if listeners, ok := h.channels[channelID]; ok {
+		delete(listeners, l)
+		if len(listeners) == 0 {
+			delete(h.channels, channelID)
+		}
+	}
+
+	if channels, ok := h.listeners[l]; ok {
+		delete(channels, channelID)
+		if len(channels) == 0 {
+			delete(h.listeners, l)
+		}
+	}
+}
+
+// Publish delivers the event to every listener of its channel without blocking.
+// Listeners with a full buffer are dropped.
+func (h *Hub) Publish(event domain.Event) {
+	var slow []*Listener
+
+	h.mu.RLock()
+	for l := range h.channels[event.ChannelID] {
+		select {
+		case l.c <- event:
+		default:
+			slow = append(slow, l)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, l := range slow {
+		l.drop()
+		h.UnsubscribeAll(l)
+	}
+}
@@ -0,0 +1,76 @@
+package hub_test
+
+import (
+	"testing"
+
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/hub"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	channelId      int64 = 1
+	otherChannelId int64 = 2
+)
+
+var event = domain.NewMessageEvent(domain.NewMessage(3, channelId, 4, "hello"))
+
+func TestPublish_DeliversToChannelListeners(t *testing.T) {
+	h := hub.New()
+	listener := hub.NewListener(1)
+	otherListener := hub.NewListener(1)
+
+	h.Subscribe(channelId, listener)
+	h.Subscribe(otherChannelId, otherListener)
+
+	h.Publish(event)
+
+	assert.Equal(t, event, <-listener.C())
+	assert.Len(t, otherListener.C(), 0)
+}
+
+func TestPublish_AfterUnsubscribe(t *testing.T) {
+	h := hub.New()
+	listener := hub.NewListener(1)
+
+	h.Subscribe(channelId, listener)
+	h.Unsubscribe(channelId, listener)
+
+	h.Publish(event)
+
+	assert.Len(t, listener.C(), 0)
+}
+
+func TestPublish_AfterUnsubscribeAll(t *testing.T) {
+	h := hub.New()
+	listener := hub.NewListener(2)
+
+	h.Subscribe(channelId, listener)
+	h.Subscribe(otherChannelId, listener)
+	h.UnsubscribeAll(listener)
+
+	h.Publish(event)
+	h.Publish(domain.NewMessageEvent(domain.NewMessage(5, otherChannelId, 4, "hello")))
+
+	assert.Len(t, listener.C(), 0)
+}
+
+func TestPublish_DropsSlowListener(t *testing.T) {
+	h := hub.New()
+	listener := hub.NewListener(1)
+
+	h.Subscribe(channelId, listener)
+
+	h.Publish(event)
+	h.Publish(event)
+
+	select {
+	case <-listener.Done():
+	default:
+		t.Fatal("slow listener was not dropped")
+	}
+
+	<-listener.C()
+	h.Publish(event)
+	assert.Len(t, listener.C(), 0)
+}
@@ -0,0 +1,150 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// Time allowed to publish an event to redis
+const publishTimeout = 2 * time.Second
+
+var errInvalidEventType = errors.New("invalid chat event type")
+
+// relayedEvent is the wire format of domain.Event on the redis topic.
+type relayedEvent struct {
+	Type        domain.EventType    `json:"type"`
+	ChannelID   int64               `json:"channel_id"`
+	UserID      int64               `json:"user_id"`
+	MessageID   int64               `json:"message_id,omitempty"`
+	Content     string              `json:"content,omitempty"`
+	Attachments []relayedAttachment `json:"attachments,omitempty"`
+}
+
+type relayedAttachment struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// Relay shares events between the chat instances through redis pub/sub,
+// every instance, including the publishing one, delivers the relayed events to its own hub.
+type Relay struct {
+	client redis.UniversalClient
+	hub    *Hub
+	topic  string
+	logger logger.Logger
+}
+
+func NewRelay(client redis.UniversalClient, hub *Hub, topic string, logger logger.Logger) *Relay {
+	return &Relay{
+		client: client,
+		hub:    hub,
+		topic:  topic,
+		logger: logger,
+	}
+}
+
+// Publish sends the event to every chat instance.
+// If redis is unavailable, the event is delivered to the listeners of this instance only.
+func (r *Relay) Publish(event domain.Event) {
+	payload, err := encodeEvent(event)
+	if err != nil {
+		r.logger.Errorf("failed to encode chat event - channel id: %d, error: %v", event.ChannelID, err)
+		r.hub.Publish(event)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	if err := r.client.Publish(ctx, r.topic, payload).Err(); err != nil {
+		r.logger.Errorf("failed to relay chat event - channel id: %d, error: %v", event.ChannelID, err)
+		r.hub.Publish(event)
+	}
+}
+
+// Run delivers the relayed events to the hub until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) error {
+	sub := r.client.Subscribe(ctx, r.topic)
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+
+			event, err := decodeEvent([]byte(msg.Payload))
+			if err != nil {
+				r.logger.Errorf("failed to decode relayed chat event: %s", msg.Payload)
+				continue
+			}
+
+			r.hub.Publish(event)
+		}
+	}
+}
+
+func encodeEvent(event domain.Event) ([]byte, error) {
+	relayed := relayedEvent{
+		Type:      event.Type,
+		ChannelID: event.ChannelID,
+		UserID:    event.UserID,
+		MessageID: event.MessageID,
+	}
+	if event.Message != nil {
+		relayed.Content = event.Message.Content
+		for _, a := range event.Message.Attachments {
+			relayed.Attachments = append(relayed.Attachments, relayedAttachment{
+				Key:         a.Key,
+				Name:        a.Name,
+				ContentType: a.ContentType,
+				Size:        a.Size,
+			})
+		}
+	}
+
+	return json.Marshal(relayed)
+}
+
+func decodeEvent(payload []byte) (domain.Event, error) {
+	var relayed relayedEvent
+	if err := json.Unmarshal(payload, &relayed); err != nil {
+		return domain.Event{}, err
+	}
+	if !relayed.Type.Valid() {
+		return domain.Event{}, errInvalidEventType
+	}
+
+	return mapRelayedEvent(relayed), nil
+}
+
+func mapRelayedEvent(relayed relayedEvent) domain.Event {
+	switch relayed.Type {
+	case domain.EventTypeMESSAGE:
+		message := domain.NewMessage(relayed.MessageID, relayed.ChannelID, relayed.UserID, relayed.Content)
+		for _, a := range relayed.Attachments {
+			message.Attachments = append(message.Attachments, domain.NewAttachment(a.Key, a.Name, a.ContentType, a.Size))
+		}
+		return domain.NewMessageEvent(message)
+	case domain.EventTypeREAD:
+		return domain.NewReadEvent(relayed.ChannelID, relayed.UserID, relayed.MessageID)
+	default:
+		return domain.NewTypingEvent(relayed.ChannelID, relayed.UserID)
+	}
+}
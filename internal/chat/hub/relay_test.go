@@ -0,0 +1,39 @@
+package hub
+
+import (
+	"testing"
+
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelayedEvent_RoundTrip(t *testing.T) {
+	message := domain.NewMessage(3, 1, 4, "")
+	message.Attachments = []domain.Attachment{
+		domain.NewAttachment("chat/1/invoice.pdf", "invoice.pdf", "application/pdf", 2048),
+		domain.NewAttachment("chat/1/photo.png", "photo.png", "image/png", 512),
+	}
+
+	events := []domain.Event{
+		domain.NewMessageEvent(domain.NewMessage(3, 1, 4, "hello")),
+		domain.NewMessageEvent(message),
+		domain.NewReadEvent(1, 4, 3),
+		domain.NewTypingEvent(1, 4),
+	}
+
+	for _, event := range events {
+		payload, err := encodeEvent(event)
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		relayed, err := decodeEvent(payload)
+		assert.NoError(t, err)
+		assert.Equal(t, event, relayed)
+	}
+}
+
+func TestDecodeEvent_InvalidType(t *testing.T) {
+	_, err := decodeEvent([]byte(`{"type":"unknown","channel_id":1}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+)
+
+// ChannelRepository reads the channels created by the order service and maintains their chat state.
+type ChannelRepository interface {
+	Get(ctx context.Context, channelID int64) (ChannelModel, error)
+	GetMember(ctx context.Context, userID int64, channelID int64) (MemberModel, error)
+	ListByUser(ctx context.Context, userID int64, beforeID int64, limit int64) ([]MemberModel, error)
+	UpdateLastMessage(ctx context.Context, message MessageModel) error
+	UpdateLastRead(ctx context.Context, userID int64, channelID int64, messageID int64) error
+}
+
+type cassandraChannelRepository struct {
+	session *gocql.Session
+}
+
+func NewChannelRepository(session *gocql.Session) *cassandraChannelRepository {
+	return &cassandraChannelRepository{
+		session,
+	}
+}
+
+const getChannel = `
+SELECT channel_id, order_id, customer_id, provider_id, last_message_id, last_author_id, last_content FROM channels
+WHERE channel_id = ?
+`
+
+// Get retrieves a channel by its id, it returns gocql.ErrNotFound if the channel does not exist.
+func (r *cassandraChannelRepository) Get(ctx context.Context, channelID int64) (ChannelModel, error) {
+	var i ChannelModel
+	err := r.session.Query(getChannel, channelID).WithContext(ctx).Scan(
+		&i.ChannelID,
+		&i.OrderID,
+		&i.CustomerID,
+		&i.ProviderID,
+		&i.LastMessageID,
+		&i.LastAuthorID,
+		&i.LastContent,
+	)
+	return i, err
+}
+
+const getChannelMember = `
+SELECT user_id, channel_id, last_read_id FROM user_channels
+WHERE user_id = ? AND channel_id = ?
+`
+
+// GetMember retrieves the channel state of the user, it returns gocql.ErrNotFound if the user is not a member.
+func (r *cassandraChannelRepository) GetMember(ctx context.Context, userID int64, channelID int64) (MemberModel, error) {
+	var i MemberModel
+	err := r.session.Query(getChannelMember, userID, channelID).WithContext(ctx).Scan(
+		&i.UserID,
+		&i.ChannelID,
+		&i.LastReadID,
+	)
+	return i, err
+}
+
+const listChannelMembersByUser = `
+SELECT user_id, channel_id, last_read_id FROM user_channels
+WHERE user_id = ? AND channel_id < ?
+LIMIT ?
+`
+
+// ListByUser retrieves the channel states of the user older than beforeID, newest channels first.
+func (r *cassandraChannelRepository) ListByUser(ctx context.Context, userID int64, beforeID int64, limit int64) ([]MemberModel, error) {
+	iter := r.session.Query(listChannelMembersByUser, userID, beforeID, limit).WithContext(ctx).Iter()
+
+	var items []MemberModel
+	var i MemberModel
+	for iter.Scan(&i.UserID, &i.ChannelID, &i.LastReadID) {
+		items = append(items, i)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChannelLastMessage = `
+UPDATE channels SET last_message_id = ?, last_author_id = ?, last_content = ?
+WHERE channel_id = ?
+`
+
+func (r *cassandraChannelRepository) UpdateLastMessage(ctx context.Context, message MessageModel) error {
+	return r.session.Query(updateChannelLastMessage,
+		message.MessageID,
+		message.AuthorID,
+		message.Content,
+		message.ChannelID,
+	).WithContext(ctx).Exec()
+}
+
+const updateChannelLastRead = `
+UPDATE user_channels SET last_read_id = ?
+WHERE user_id = ? AND channel_id = ?
+`
+
+func (r *cassandraChannelRepository) UpdateLastRead(ctx context.Context, userID int64, channelID int64, messageID int64) error {
+	return r.session.Query(updateChannelLastRead, messageID, userID, channelID).WithContext(ctx).Exec()
+}
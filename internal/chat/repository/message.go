@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/internal/chat/domain"
+)
+
+type MessageRepository interface {
+	Create(ctx context.Context, channelID int64, authorID int64, content string, attachments []AttachmentModel) (MessageModel, error)
+	ListByBucket(ctx context.Context, channelID int64, bucket int32, beforeID int64, limit int64) ([]MessageModel, error)
+	CountByBucket(ctx context.Context, channelID int64, bucket int32, afterID int64) (int64, error)
+}
+
+type cassandraMessageRepository struct {
+	session   *gocql.Session
+	snowflake *snowflake.Node
+}
+
+func NewMessageRepository(session *gocql.Session, snowflake *snowflake.Node) *cassandraMessageRepository {
+	return &cassandraMessageRepository{
+		session,
+		snowflake,
+	}
+}
+
+const createMessage = `
+INSERT INTO messages (channel_id, bucket, message_id, author_id, content, attachments) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+// Create generates a snowflake id for the message and stores it in the bucket of the id.
+func (r *cassandraMessageRepository) Create(ctx context.Context, channelID int64, authorID int64, content string, attachments []AttachmentModel) (MessageModel, error) {
+	id := r.snowflake.Generate().Int64()
+	model := MessageModel{
+		ChannelID:   channelID,
+		Bucket:      domain.Bucket(id),
+		MessageID:   id,
+		AuthorID:    authorID,
+		Content:     content,
+		Attachments: attachments,
+	}
+
+	err := r.session.Query(createMessage,
+		model.ChannelID,
+		model.Bucket,
+		model.MessageID,
+		model.AuthorID,
+		model.Content,
+		model.Attachments,
+	).WithContext(ctx).Exec()
+
+	return model, err
+}
+
+const listMessagesByBucket = `
+SELECT channel_id, bucket, message_id, author_id, content, attachments FROM messages
+WHERE channel_id = ? AND bucket = ? AND message_id < ?
+LIMIT ?
+`
+
+// ListByBucket retrieves messages of a single bucket older than beforeID, newest first.
+func (r *cassandraMessageRepository) ListByBucket(ctx context.Context, channelID int64, bucket int32, beforeID int64, limit int64) ([]MessageModel, error) {
+	iter := r.session.Query(listMessagesByBucket, channelID, bucket, beforeID, limit).WithContext(ctx).Iter()
+
+	var items []MessageModel
+	var i MessageModel
+	for iter.Scan(&i.ChannelID, &i.Bucket, &i.MessageID, &i.AuthorID, &i.Content, &i.Attachments) {
+		items = append(items, i)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countMessagesByBucket = `
+SELECT COUNT(*) FROM messages
+WHERE channel_id = ? AND bucket = ? AND message_id > ?
+`
+
+// CountByBucket counts messages of a single bucket newer than afterID.
+func (r *cassandraMessageRepository) CountByBucket(ctx context.Context, channelID int64, bucket int32, afterID int64) (int64, error) {
+	var count int64
+	err := r.session.Query(countMessagesByBucket, channelID, bucket, afterID).WithContext(ctx).Scan(&count)
+	return count, err
+}
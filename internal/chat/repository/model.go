@@ -0,0 +1,34 @@
+package repository
+
+type MessageModel struct {
+	ChannelID   int64
+	Bucket      int32
+	MessageID   int64
+	AuthorID    int64
+	Content     string
+	Attachments []AttachmentModel
+}
+
+// AttachmentModel is stored as the attachment user defined type.
+type AttachmentModel struct {
+	Key         string `cql:"key"`
+	Name        string `cql:"name"`
+	ContentType string `cql:"content_type"`
+	Size        int64  `cql:"size"`
+}
+
+type ChannelModel struct {
+	ChannelID     int64
+	OrderID       int64
+	CustomerID    int64
+	ProviderID    int64
+	LastMessageID int64
+	LastAuthorID  int64
+	LastContent   string
+}
+
+type MemberModel struct {
+	UserID     int64
+	ChannelID  int64
+	LastReadID int64
+}
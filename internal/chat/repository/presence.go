@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// PresenceRepository keeps the live connections of every user in a redis sorted set,
+// scored by the time the connection expires unless it is touched again.
+type PresenceRepository interface {
+	Touch(ctx context.Context, userID int64, connID string, ttl time.Duration) error
+	Leave(ctx context.Context, userID int64, connID string, grace time.Duration) error
+	Online(ctx context.Context, userIDs ...int64) ([]bool, error)
+}
+
+type redisPresenceRepository struct {
+	redis redis.UniversalClient
+}
+
+func NewPresenceRepository(redis redis.UniversalClient) *redisPresenceRepository {
+	return &redisPresenceRepository{
+		redis: redis,
+	}
+}
+
+func presenceKey(userID int64) string {
+	return "chat:presence:" + strconv.FormatInt(userID, 10)
+}
+
+// Touch extends the connection for ttl and evicts the expired connections of the user,
+// the whole set expires along with the latest connection, so the connections of a dead node don't outlive it.
+func (r *redisPresenceRepository) Touch(ctx context.Context, userID int64, connID string, ttl time.Duration) error {
+	key := presenceKey(userID)
+	now := time.Now()
+
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.Add(ttl).UnixMilli()), Member: connID})
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// Leave shortens the connection to the grace period instead of removing it, so a reconnecting user stays online.
+func (r *redisPresenceRepository) Leave(ctx context.Context, userID int64, connID string, grace time.Duration) error {
+	return r.redis.ZAddXX(ctx, presenceKey(userID), redis.Z{
+		Score:  float64(time.Now().Add(grace).UnixMilli()),
+		Member: connID,
+	}).Err()
+}
+
+// Online reports, for every user, whether any of their connections has not expired yet.
+func (r *redisPresenceRepository) Online(ctx context.Context, userIDs ...int64) ([]bool, error) {
+	now := "(" + strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	cmds := make([]*redis.IntCmd, len(userIDs))
+	_, err := r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, userID := range userIDs {
+			cmds[i] = pipe.ZCount(ctx, presenceKey(userID), now, "+inf")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	online := make([]bool, len(userIDs))
+	for i, cmd := range cmds {
+		online[i] = cmd.Val() > 0
+	}
+	return online, nil
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-chi/chi/v5"
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/gocql/gocql"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/hexley21/fixup/internal/chat/delivery/http/v1"
+	"github.com/hexley21/fixup/internal/chat/hub"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/infra/cassandra"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+	"github.com/hexley21/fixup/pkg/infra/s3"
+	"github.com/hexley21/fixup/pkg/logger"
+	"github.com/hexley21/fixup/pkg/validator"
+)
+
+const eventsTopic = "chat:events"
+
+type services struct {
+	message  service.MessageService
+	channel  service.ChannelService
+	presence service.PresenceService
+}
+
+type jWTManagers struct {
+	accessJWTManager auth_jwt.Manager
+}
+
+type server struct {
+	router            chi.Router
+	metricsRouter     chi.Router
+	mux               *http.Server
+	metricsMux        *http.Server
+	cfg               *config.Config
+	session           *gocql.Session
+	redisCluster      *redis.ClusterClient
+	hub               *hub.Hub
+	relay             *hub.Relay
+	relayCtx          context.Context
+	stopRelay         context.CancelFunc
+	handlerComponents *handler.Components
+	cdnUrlSigner      cdn.URLSigner
+	jWTManagers       *jWTManagers
+	services          *services
+}
+
+// NewServer initializes and returns a new server instance with the provided configuration and dependencies.
+// It sets up repositories, the event hub and its redis relay, services, JWT managers, handler components, and HTTP servers for both main and metrics endpoints.
+func NewServer(
+	cfg *config.Config,
+	session *gocql.Session,
+	redisCluster *redis.ClusterClient,
+	s3Bucket s3.Bucket,
+	logger logger.Logger,
+	snowflakeNode *snowflake.Node,
+	validator validator.Validator,
+) *server {
+	eventHub := hub.New()
+	eventRelay := hub.NewRelay(redisCluster, eventHub, eventsTopic, logger)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+
+	messageRepository := repository.NewMessageRepository(session, snowflakeNode)
+	channelRepository := repository.NewChannelRepository(session)
+	presenceRepository := repository.NewPresenceRepository(redisCluster)
+
+	services := &services{
+		message:  service.NewMessageService(messageRepository, channelRepository, eventRelay, s3Bucket),
+		channel:  service.NewChannelService(channelRepository, messageRepository, eventRelay),
+		presence: service.NewPresenceService(presenceRepository, channelRepository, snowflakeNode),
+	}
+
+	jWTManagers := &jWTManagers{
+		accessJWTManager: auth_jwt.NewManager(cfg.JWT.AccessSecret, cfg.JWT.AccessTTL),
+	}
+
+	jsonManager := std_json.New()
+	handlerComponents := &handler.Components{
+		Logger:    logger,
+		Binder:    std_binder.New(jsonManager),
+		Validator: validator,
+		Writer:    json_writer.New(logger, jsonManager),
+	}
+
+	router := chi.NewMux()
+	// WebSocket connections are long-lived, so read and write deadlines are managed per connection
+	mux := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.HTTP.Port),
+		Handler:           router,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+		ReadHeaderTimeout: cfg.HTTP.ReadTimeout,
+	}
+
+	metricsRouter := chi.NewMux()
+	metricsMux := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.Metrics.Port),
+		Handler:      metricsRouter,
+		IdleTimeout:  cfg.HTTP.IdleTimeout,
+		ReadTimeout:  cfg.HTTP.ReadTimeout,
+		WriteTimeout: cfg.HTTP.WriteTimeout,
+	}
+
+	return &server{
+		router:            router,
+		metricsRouter:     metricsRouter,
+		mux:               mux,
+		metricsMux:        metricsMux,
+		cfg:               cfg,
+		session:           session,
+		redisCluster:      redisCluster,
+		hub:               eventHub,
+		relay:             eventRelay,
+		relayCtx:          relayCtx,
+		stopRelay:         stopRelay,
+		handlerComponents: handlerComponents,
+		cdnUrlSigner:      cdn.NewCloudFrontURLSigner(cfg.AWS.CDN),
+		jWTManagers:       jWTManagers,
+		services:          services,
+	}
+}
+
+func (s *server) Run() error {
+	// Initialize middleware with binder and writer components
+	Middleware := middleware.NewMiddleware(s.handlerComponents.Binder, s.handlerComponents.Writer)
+
+	// Set up logging middleware for chi router
+	chiLogger := &chi_middleware.DefaultLogFormatter{
+		Logger:  s.handlerComponents.Logger,
+		NoColor: false,
+	}
+
+	allowedOrigins := strings.Split(s.cfg.HTTP.CorsOrigins, ",")
+
+	s.router.Use(cors.Handler(cors.Options{
+		AllowedOrigins:   allowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowCredentials: true,
+		MaxAge:           300,
+	}))
+	s.router.Use(chi_middleware.Recoverer)
+	s.router.Use(chi_middleware.RequestLogger(chiLogger))
+
+	v1.MapV1Routes(v1.RouterArgs{
+		MessageService:    s.services.message,
+		ChannelService:    s.services.channel,
+		PresenceService:   s.services.presence,
+		Hub:               s.hub,
+		Middleware:        Middleware,
+		HandlerComponents: s.handlerComponents,
+		AccessJWTManager:  s.jWTManagers.accessJWTManager,
+		CdnUrlSigner:      s.cdnUrlSigner,
+		PaginationConfig:  &s.cfg.Pagination,
+		AllowedOrigins:    allowedOrigins,
+	}, s.router)
+
+	// Setup metrics endpoint
+	s.metricsRouter.Use(chi_middleware.Recoverer)
+	s.metricsRouter.Handle("/metrics", promhttp.Handler())
+
+	mainErrChan := make(chan error, 1)
+	metricsErrChan := make(chan error, 1)
+	relayErrChan := make(chan error, 1)
+
+	go func() {
+		mainErrChan <- s.mux.ListenAndServe()
+	}()
+
+	go func() {
+		metricsErrChan <- s.metricsMux.ListenAndServe()
+	}()
+
+	// events published on other instances reach the local listeners through the relay only
+	go func() {
+		if err := s.relay.Run(s.relayCtx); !errors.Is(err, context.Canceled) {
+			relayErrChan <- fmt.Errorf("chat event relay stopped: %w", err)
+		}
+	}()
+
+	select {
+	case mainErr := <-mainErrChan:
+		return mainErr
+	case metricsErr := <-metricsErrChan:
+		return metricsErr
+	case relayErr := <-relayErrChan:
+		return relayErr
+	}
+}
+
+// Close gracefully shuts down the server, including its HTTP mux, metrics mux, event relay, cassandra session and redis client.
+// Errors during shutdown are logged, but the function returns nil to ensure all components attempt to close.
+// Complies to io.Closer interface.
+func (s *server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	err := s.mux.Shutdown(ctx)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	err = s.metricsMux.Shutdown(ctx)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	s.stopRelay()
+
+	err = cassandra.Close(s.session)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	err = s.redisCluster.Close()
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+	}
+
+	return nil
+}
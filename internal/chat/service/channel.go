@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	"github.com/hexley21/fixup/internal/common/enum"
+)
+
+type ChannelService interface {
+	Get(ctx context.Context, channelID int64, userID int64, role enum.UserRole) (domain.Channel, error)
+	List(ctx context.Context, userID int64, beforeID int64, limit int64) ([]domain.Channel, error)
+	MarkRead(ctx context.Context, channelID int64, userID int64, messageID int64) error
+	ListReceipts(ctx context.Context, channelID int64, userID int64, role enum.UserRole) ([]domain.ReadReceipt, error)
+	Typing(ctx context.Context, channelID int64, userID int64, role enum.UserRole) error
+	CheckAccess(ctx context.Context, channelID int64, userID int64, role enum.UserRole) error
+}
+
+type channelServiceImpl struct {
+	channelRepository repository.ChannelRepository
+	messageRepository repository.MessageRepository
+	publisher         EventPublisher
+}
+
+func NewChannelService(
+	channelRepository repository.ChannelRepository,
+	messageRepository repository.MessageRepository,
+	publisher EventPublisher,
+) *channelServiceImpl {
+	return &channelServiceImpl{
+		channelRepository: channelRepository,
+		messageRepository: messageRepository,
+		publisher:         publisher,
+	}
+}
+
+// Get retrieves a channel with its last message and the unread count of the user.
+// Moderators and admins, who are not members, always get an unread count of 0.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *channelServiceImpl) Get(ctx context.Context, channelID int64, userID int64, role enum.UserRole) (domain.Channel, error) {
+	model, err := authorize(ctx, s.channelRepository, channelID, userID, role)
+	if err != nil {
+		return domain.Channel{}, err
+	}
+
+	channel := MapChannelModelToEntity(model)
+	if !channel.IsMember(userID) {
+		return channel, nil
+	}
+
+	member, err := s.channelRepository.GetMember(ctx, userID, channelID)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return domain.Channel{}, err
+	}
+
+	channel.UnreadCount, err = s.countUnread(ctx, model, member.LastReadID)
+	if err != nil {
+		return domain.Channel{}, err
+	}
+
+	return channel, nil
+}
+
+// List retrieves up to limit channels of the user created before beforeID, newest first,
+// each with its last message and the unread count of the user.
+// If beforeID is 0, the latest channels are retrieved.
+func (s *channelServiceImpl) List(ctx context.Context, userID int64, beforeID int64, limit int64) ([]domain.Channel, error) {
+	if beforeID == 0 {
+		beforeID = math.MaxInt64
+	}
+
+	members, err := s.channelRepository.ListByUser(ctx, userID, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]domain.Channel, 0, len(members))
+	for _, member := range members {
+		model, err := s.channelRepository.Get(ctx, member.ChannelID)
+		if err != nil {
+			// the membership is written along with the channel, a missing channel is completed by the order service on retry
+			if errors.Is(err, gocql.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		channel := MapChannelModelToEntity(model)
+		channel.UnreadCount, err = s.countUnread(ctx, model, member.LastReadID)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+// MarkRead moves the read receipt of the user up to the message and notifies the channel participants.
+// If messageID is 0 or newer than the last message, every message of the channel is marked as read.
+// The receipt never moves backwards, marking an already read message is a no-op.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is not a member of the channel, it returns ErrChannelAccessDenied.
+func (s *channelServiceImpl) MarkRead(ctx context.Context, channelID int64, userID int64, messageID int64) error {
+	model, err := s.channelRepository.Get(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return ErrChannelNotFound
+		}
+		return err
+	}
+
+	if !MapChannelModelToEntity(model).IsMember(userID) {
+		return ErrChannelAccessDenied
+	}
+
+	if messageID == 0 || messageID > model.LastMessageID {
+		messageID = model.LastMessageID
+	}
+
+	member, err := s.channelRepository.GetMember(ctx, userID, channelID)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return err
+	}
+
+	if messageID <= member.LastReadID {
+		return nil
+	}
+
+	if err := s.channelRepository.UpdateLastRead(ctx, userID, channelID, messageID); err != nil {
+		return err
+	}
+
+	s.publisher.Publish(domain.NewReadEvent(channelID, userID, messageID))
+	return nil
+}
+
+// ListReceipts retrieves the read receipts of the channel members, a member who has read nothing has a receipt of 0.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *channelServiceImpl) ListReceipts(ctx context.Context, channelID int64, userID int64, role enum.UserRole) ([]domain.ReadReceipt, error) {
+	model, err := authorize(ctx, s.channelRepository, channelID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := []int64{model.CustomerID, model.ProviderID}
+	receipts := make([]domain.ReadReceipt, len(memberIDs))
+	for i, memberID := range memberIDs {
+		member, err := s.channelRepository.GetMember(ctx, memberID, channelID)
+		if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+			return nil, err
+		}
+
+		receipts[i] = domain.NewReadReceipt(memberID, member.LastReadID)
+	}
+
+	return receipts, nil
+}
+
+// Typing notifies the channel participants that the user is typing, the event is not stored.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *channelServiceImpl) Typing(ctx context.Context, channelID int64, userID int64, role enum.UserRole) error {
+	if _, err := authorize(ctx, s.channelRepository, channelID, userID, role); err != nil {
+		return err
+	}
+
+	s.publisher.Publish(domain.NewTypingEvent(channelID, userID))
+	return nil
+}
+
+// CheckAccess checks that the user may read and post to the channel.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *channelServiceImpl) CheckAccess(ctx context.Context, channelID int64, userID int64, role enum.UserRole) error {
+	_, err := authorize(ctx, s.channelRepository, channelID, userID, role)
+	return err
+}
+
+// countUnread counts the messages of the channel newer than lastReadID,
+// walking the buckets backwards from the last message to the last read one.
+func (s *channelServiceImpl) countUnread(ctx context.Context, model repository.ChannelModel, lastReadID int64) (int64, error) {
+	if model.LastMessageID <= lastReadID {
+		return 0, nil
+	}
+
+	firstBucket := domain.Bucket(max(lastReadID, model.ChannelID))
+
+	var count int64
+	for bucket := domain.Bucket(model.LastMessageID); bucket >= firstBucket; bucket-- {
+		n, err := s.messageRepository.CountByBucket(ctx, model.ChannelID, bucket, lastReadID)
+		if err != nil {
+			return 0, err
+		}
+		count += n
+	}
+
+	return count, nil
+}
+
+// authorize fetches the channel and checks that the user is one of its members, or a moderator or admin.
+func authorize(ctx context.Context, channelRepository repository.ChannelRepository, channelID int64, userID int64, role enum.UserRole) (repository.ChannelModel, error) {
+	model, err := channelRepository.Get(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return repository.ChannelModel{}, ErrChannelNotFound
+		}
+		return repository.ChannelModel{}, err
+	}
+
+	if role == enum.UserRoleMODERATOR || role == enum.UserRoleADMIN {
+		return model, nil
+	}
+
+	if !MapChannelModelToEntity(model).IsMember(userID) {
+		return repository.ChannelModel{}, ErrChannelAccessDenied
+	}
+
+	return model, nil
+}
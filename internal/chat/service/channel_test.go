@@ -0,0 +1,273 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	mock_repository "github.com/hexley21/fixup/internal/chat/repository/mock"
+	"github.com/hexley21/fixup/internal/chat/service"
+	mock_service "github.com/hexley21/fixup/internal/chat/service/mock"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	activeChannelModel = repository.ChannelModel{
+		ChannelID:     channelId,
+		OrderID:       1,
+		CustomerID:    authorId,
+		ProviderID:    providerId,
+		LastMessageID: messageId,
+		LastAuthorID:  providerId,
+		LastContent:   content,
+	}
+)
+
+func setupChannel(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.ChannelService,
+	mockChannelRepository *mock_repository.MockChannelRepository,
+	mockMessageRepository *mock_repository.MockMessageRepository,
+	mockPublisher *mock_service.MockEventPublisher,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockChannelRepository = mock_repository.NewMockChannelRepository(ctrl)
+	mockMessageRepository = mock_repository.NewMockMessageRepository(ctrl)
+	mockPublisher = mock_service.NewMockEventPublisher(ctrl)
+	svc = service.NewChannelService(mockChannelRepository, mockMessageRepository, mockPublisher)
+
+	return
+}
+
+func TestGetChannel_Member(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, mockMessageRepository, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId}, nil)
+	gomock.InOrder(
+		mockMessageRepository.EXPECT().CountByBucket(ctx, channelId, firstBucket+1, int64(0)).Return(int64(2), nil),
+		mockMessageRepository.EXPECT().CountByBucket(ctx, channelId, firstBucket, int64(0)).Return(int64(3), nil),
+	)
+
+	channel, err := svc.Get(ctx, channelId, authorId, enum.UserRoleCUSTOMER)
+	assert.NoError(t, err)
+	assert.Equal(t, channelId, channel.ID)
+	assert.Equal(t, int64(5), channel.UnreadCount)
+	if assert.NotNil(t, channel.LastMessage) {
+		assert.Equal(t, messageId, channel.LastMessage.ID)
+		assert.Equal(t, providerId, channel.LastMessage.AuthorID)
+	}
+}
+
+func TestGetChannel_AllRead(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, providerId, channelId).Return(repository.MemberModel{UserID: providerId, ChannelID: channelId, LastReadID: messageId}, nil)
+
+	channel, err := svc.Get(ctx, channelId, providerId, enum.UserRolePROVIDER)
+	assert.NoError(t, err)
+	assert.Zero(t, channel.UnreadCount)
+}
+
+func TestGetChannel_Moderator(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+
+	channel, err := svc.Get(ctx, channelId, outsiderId, enum.UserRoleMODERATOR)
+	assert.NoError(t, err)
+	assert.Equal(t, channelId, channel.ID)
+	assert.Zero(t, channel.UnreadCount)
+}
+
+func TestGetChannel_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+
+	channel, err := svc.Get(ctx, channelId, outsiderId, enum.UserRoleCUSTOMER)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Empty(t, channel)
+}
+
+func TestGetChannel_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(repository.ChannelModel{}, gocql.ErrNotFound)
+
+	channel, err := svc.Get(ctx, channelId, authorId, enum.UserRoleADMIN)
+	assert.ErrorIs(t, err, service.ErrChannelNotFound)
+	assert.Empty(t, channel)
+}
+
+func TestListChannels_Success(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, mockMessageRepository, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	members := []repository.MemberModel{
+		{UserID: authorId, ChannelID: channelId, LastReadID: olderMessageModel.MessageID},
+		{UserID: authorId, ChannelID: channelId - 1},
+	}
+
+	mockChannelRepository.EXPECT().ListByUser(ctx, authorId, int64(math.MaxInt64), limit).Return(members, nil)
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockMessageRepository.EXPECT().CountByBucket(ctx, channelId, firstBucket+1, olderMessageModel.MessageID).Return(int64(1), nil)
+	mockMessageRepository.EXPECT().CountByBucket(ctx, channelId, firstBucket, olderMessageModel.MessageID).Return(int64(0), nil)
+	mockChannelRepository.EXPECT().Get(ctx, channelId-1).Return(repository.ChannelModel{}, gocql.ErrNotFound)
+
+	channels, err := svc.List(ctx, authorId, 0, limit)
+	assert.NoError(t, err)
+	if assert.Len(t, channels, 1) {
+		assert.Equal(t, channelId, channels[0].ID)
+		assert.Equal(t, int64(1), channels[0].UnreadCount)
+	}
+}
+
+func TestListChannels_Error(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("cassandra error")
+	mockChannelRepository.EXPECT().ListByUser(ctx, authorId, channelId, limit).Return(nil, expectedErr)
+
+	channels, err := svc.List(ctx, authorId, channelId, limit)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, channels)
+}
+
+func TestMarkChannelRead_Success(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, mockPublisher := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId}, nil)
+	mockChannelRepository.EXPECT().UpdateLastRead(ctx, authorId, channelId, messageId).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewReadEvent(channelId, authorId, messageId))
+
+	assert.NoError(t, svc.MarkRead(ctx, channelId, authorId, 0))
+}
+
+func TestMarkChannelRead_UpToMessage(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, mockPublisher := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{}, gocql.ErrNotFound)
+	mockChannelRepository.EXPECT().UpdateLastRead(ctx, authorId, channelId, olderMessageModel.MessageID).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewReadEvent(channelId, authorId, olderMessageModel.MessageID))
+
+	assert.NoError(t, svc.MarkRead(ctx, channelId, authorId, olderMessageModel.MessageID))
+}
+
+func TestMarkChannelRead_CapsToLastMessage(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, mockPublisher := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId}, nil)
+	mockChannelRepository.EXPECT().UpdateLastRead(ctx, authorId, channelId, messageId).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewReadEvent(channelId, authorId, messageId))
+
+	assert.NoError(t, svc.MarkRead(ctx, channelId, authorId, messageId+1))
+}
+
+func TestMarkChannelRead_AlreadyRead(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId, LastReadID: messageId}, nil)
+
+	assert.NoError(t, svc.MarkRead(ctx, channelId, authorId, olderMessageModel.MessageID))
+}
+
+func TestMarkChannelRead_NoMessages(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId}, nil)
+
+	assert.NoError(t, svc.MarkRead(ctx, channelId, authorId, 0))
+}
+
+func TestMarkChannelRead_NotMember(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+
+	assert.ErrorIs(t, svc.MarkRead(ctx, channelId, outsiderId, 0), service.ErrChannelAccessDenied)
+}
+
+func TestListReceipts_Success(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, authorId, channelId).Return(repository.MemberModel{UserID: authorId, ChannelID: channelId, LastReadID: olderMessageModel.MessageID}, nil)
+	mockChannelRepository.EXPECT().GetMember(ctx, providerId, channelId).Return(repository.MemberModel{}, gocql.ErrNotFound)
+
+	receipts, err := svc.ListReceipts(ctx, channelId, providerId, enum.UserRolePROVIDER)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ReadReceipt{
+		domain.NewReadReceipt(authorId, olderMessageModel.MessageID),
+		domain.NewReadReceipt(providerId, 0),
+	}, receipts)
+}
+
+func TestListReceipts_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+
+	receipts, err := svc.ListReceipts(ctx, channelId, outsiderId, enum.UserRoleCUSTOMER)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Nil(t, receipts)
+}
+
+func TestTyping_Success(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, mockPublisher := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockPublisher.EXPECT().Publish(domain.NewTypingEvent(channelId, providerId))
+
+	assert.NoError(t, svc.Typing(ctx, channelId, providerId, enum.UserRolePROVIDER))
+}
+
+func TestTyping_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(repository.ChannelModel{}, gocql.ErrNotFound)
+
+	assert.ErrorIs(t, svc.Typing(ctx, channelId, providerId, enum.UserRolePROVIDER), service.ErrChannelNotFound)
+}
+
+func TestCheckChannelAccess(t *testing.T) {
+	ctrl, ctx, svc, mockChannelRepository, _, _ := setupChannel(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil).Times(3)
+
+	assert.NoError(t, svc.CheckAccess(ctx, channelId, providerId, enum.UserRolePROVIDER))
+	assert.NoError(t, svc.CheckAccess(ctx, channelId, outsiderId, enum.UserRoleADMIN))
+	assert.ErrorIs(t, svc.CheckAccess(ctx, channelId, outsiderId, enum.UserRolePROVIDER), service.ErrChannelAccessDenied)
+}
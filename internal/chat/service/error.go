@@ -0,0 +1,10 @@
+package service
+
+import "errors"
+
+var (
+	ErrEmptyMessage        = errors.New("message is empty")
+	ErrMessageTooLong      = errors.New("message is too long")
+	ErrChannelNotFound     = errors.New("channel not found")
+	ErrChannelAccessDenied = errors.New("channel access denied")
+)
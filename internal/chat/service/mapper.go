@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+)
+
+func MapMessageModelToEntity(model repository.MessageModel) domain.Message {
+	message := domain.NewMessage(model.MessageID, model.ChannelID, model.AuthorID, model.Content)
+
+	if len(model.Attachments) > 0 {
+		message.Attachments = make([]domain.Attachment, len(model.Attachments))
+		for i, a := range model.Attachments {
+			message.Attachments[i] = domain.NewAttachment(a.Key, a.Name, a.ContentType, a.Size)
+		}
+	}
+
+	return message
+}
+
+// MapChannelModelToEntity maps the channel and its last message, the unread count is left to the caller.
+func MapChannelModelToEntity(model repository.ChannelModel) domain.Channel {
+	channel := domain.Channel{
+		ID:         model.ChannelID,
+		OrderID:    model.OrderID,
+		CustomerID: model.CustomerID,
+		ProviderID: model.ProviderID,
+	}
+
+	if model.LastMessageID != 0 {
+		lastMessage := domain.NewMessage(model.LastMessageID, model.ChannelID, model.LastAuthorID, model.LastContent)
+		channel.LastMessage = &lastMessage
+	}
+
+	return channel
+}
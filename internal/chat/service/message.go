@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/pkg/infra/s3"
+)
+
+var attachmentDirectory = "chat/"
+
+type MessageService interface {
+	Send(ctx context.Context, channelID int64, authorID int64, role enum.UserRole, content string) (domain.Message, error)
+	SendAttachment(ctx context.Context, channelID int64, authorID int64, role enum.UserRole, content string, file io.Reader, fileName string, fileSize int64, fileType string) (domain.Message, error)
+	History(ctx context.Context, channelID int64, userID int64, role enum.UserRole, beforeID int64, limit int64) ([]domain.Message, error)
+}
+
+type messageServiceImpl struct {
+	messageRepository repository.MessageRepository
+	channelRepository repository.ChannelRepository
+	publisher         EventPublisher
+	s3Bucket          s3.Bucket
+}
+
+func NewMessageService(
+	messageRepository repository.MessageRepository,
+	channelRepository repository.ChannelRepository,
+	publisher EventPublisher,
+	s3Bucket s3.Bucket,
+) *messageServiceImpl {
+	return &messageServiceImpl{
+		messageRepository: messageRepository,
+		channelRepository: channelRepository,
+		publisher:         publisher,
+		s3Bucket:          s3Bucket,
+	}
+}
+
+// Send stores the message in the channel, makes it the last message of the channel and publishes it to the channel participants.
+// The message is marked as read by its author.
+// If the content is blank, it returns ErrEmptyMessage.
+// If the content is longer than domain.MaxMessageLength characters, it returns ErrMessageTooLong.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the author is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *messageServiceImpl) Send(ctx context.Context, channelID int64, authorID int64, role enum.UserRole, content string) (domain.Message, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return domain.Message{}, ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(content) > domain.MaxMessageLength {
+		return domain.Message{}, ErrMessageTooLong
+	}
+
+	channelModel, err := authorize(ctx, s.channelRepository, channelID, authorID, role)
+	if err != nil {
+		return domain.Message{}, err
+	}
+
+	model, err := s.messageRepository.Create(ctx, channelID, authorID, content, nil)
+	if err != nil {
+		return domain.Message{}, err
+	}
+
+	return s.deliver(ctx, channelModel, model)
+}
+
+// SendAttachment uploads the file to S3 and sends it as a message with an optional content.
+// If the upload succeeds, but the message can not be stored, the uploaded file is deleted.
+// If the content is longer than domain.MaxMessageLength characters, it returns ErrMessageTooLong.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the author is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *messageServiceImpl) SendAttachment(
+	ctx context.Context,
+	channelID int64,
+	authorID int64,
+	role enum.UserRole,
+	content string,
+	file io.Reader,
+	fileName string,
+	fileSize int64,
+	fileType string,
+) (domain.Message, error) {
+	content = strings.TrimSpace(content)
+	if utf8.RuneCountInString(content) > domain.MaxMessageLength {
+		return domain.Message{}, ErrMessageTooLong
+	}
+
+	channelModel, err := authorize(ctx, s.channelRepository, channelID, authorID, role)
+	if err != nil {
+		return domain.Message{}, err
+	}
+
+	var directoryBuilder strings.Builder
+	directoryBuilder.WriteString(attachmentDirectory)
+	directoryBuilder.WriteString(strconv.FormatInt(channelID, 10))
+	directoryBuilder.WriteString("/")
+	directory := directoryBuilder.String()
+
+	key, err := s.s3Bucket.PutObject(ctx, file, directory, "", fileSize, fileType)
+	if err != nil {
+		return domain.Message{}, err
+	}
+	key = directory + key
+
+	attachments := []repository.AttachmentModel{{
+		Key:         key,
+		Name:        fileName,
+		ContentType: fileType,
+		Size:        fileSize,
+	}}
+
+	model, err := s.messageRepository.Create(ctx, channelID, authorID, content, attachments)
+	if err != nil {
+		return domain.Message{}, errors.Join(err, s.s3Bucket.DeleteObject(ctx, key))
+	}
+
+	return s.deliver(ctx, channelModel, model)
+}
+
+// deliver makes the stored message the last message of the channel, marks it as read by its author and publishes it.
+func (s *messageServiceImpl) deliver(ctx context.Context, channelModel repository.ChannelModel, model repository.MessageModel) (domain.Message, error) {
+	if err := s.channelRepository.UpdateLastMessage(ctx, model); err != nil {
+		return domain.Message{}, err
+	}
+
+	if MapChannelModelToEntity(channelModel).IsMember(model.AuthorID) {
+		if err := s.channelRepository.UpdateLastRead(ctx, model.AuthorID, model.ChannelID, model.MessageID); err != nil {
+			return domain.Message{}, err
+		}
+	}
+
+	message := MapMessageModelToEntity(model)
+	s.publisher.Publish(domain.NewMessageEvent(message))
+
+	return message, nil
+}
+
+// History retrieves up to limit messages of the channel older than beforeID, newest first.
+// If beforeID is 0, the latest messages are retrieved.
+// Buckets are walked backwards until the limit is reached or the bucket of the channel creation is passed,
+// channel ids are snowflakes, so the channel can not have messages before its own bucket.
+// The walk starts no later than the current bucket, as beforeID comes from the client and may lie in the future.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *messageServiceImpl) History(ctx context.Context, channelID int64, userID int64, role enum.UserRole, beforeID int64, limit int64) ([]domain.Message, error) {
+	if _, err := authorize(ctx, s.channelRepository, channelID, userID, role); err != nil {
+		return nil, err
+	}
+
+	bucket := domain.BucketAt(time.Now())
+	if beforeID > 0 {
+		bucket = min(domain.Bucket(beforeID), bucket)
+	} else {
+		beforeID = math.MaxInt64
+	}
+
+	firstBucket := domain.Bucket(channelID)
+	messages := make([]domain.Message, 0, limit)
+
+	for ; bucket >= firstBucket && int64(len(messages)) < limit; bucket-- {
+		models, err := s.messageRepository.ListByBucket(ctx, channelID, bucket, beforeID, limit-int64(len(messages)))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range models {
+			messages = append(messages, MapMessageModelToEntity(m))
+		}
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,360 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	mock_repository "github.com/hexley21/fixup/internal/chat/repository/mock"
+	"github.com/hexley21/fixup/internal/chat/service"
+	mock_service "github.com/hexley21/fixup/internal/chat/service/mock"
+	"github.com/hexley21/fixup/internal/common/enum"
+	mock_s3 "github.com/hexley21/fixup/pkg/infra/s3/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	authorId   int64 = 2
+	providerId int64 = 5
+	outsiderId int64 = 11
+	content          = "Hello there"
+	limit      int64 = 3
+
+	firstBucket int32 = 2000
+
+	fileKey        = "b1946ac92492d2347c6235b4d2611184"
+	fileName       = "boiler.jpg"
+	fileType       = "image/jpeg"
+	fileSize int64 = 1024
+)
+
+var (
+	channelId = snowflakeAt(firstBucket, 5*24*time.Hour)
+	messageId = snowflakeAt(firstBucket+1, 5*24*time.Hour)
+
+	messageModel = repository.MessageModel{
+		ChannelID: channelId,
+		Bucket:    firstBucket + 1,
+		MessageID: messageId,
+		AuthorID:  authorId,
+		Content:   content,
+	}
+
+	channelModel = repository.ChannelModel{
+		ChannelID:  channelId,
+		OrderID:    1,
+		CustomerID: authorId,
+		ProviderID: providerId,
+	}
+
+	olderMessageModel = repository.MessageModel{
+		ChannelID: channelId,
+		Bucket:    firstBucket,
+		MessageID: channelId + 1,
+		AuthorID:  authorId,
+		Content:   content,
+	}
+)
+
+// snowflakeAt returns a snowflake id generated at the offset from the start of the bucket.
+func snowflakeAt(bucket int32, offset time.Duration) int64 {
+	ms := int64(bucket)*domain.BucketSize.Milliseconds() + offset.Milliseconds()
+	return (ms - snowflake.Epoch) << (snowflake.NodeBits + snowflake.StepBits)
+}
+
+func setupMessage(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.MessageService,
+	mockMessageRepository *mock_repository.MockMessageRepository,
+	mockChannelRepository *mock_repository.MockChannelRepository,
+	mockPublisher *mock_service.MockEventPublisher,
+	mockS3Bucket *mock_s3.MockBucket,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockMessageRepository = mock_repository.NewMockMessageRepository(ctrl)
+	mockChannelRepository = mock_repository.NewMockChannelRepository(ctrl)
+	mockPublisher = mock_service.NewMockEventPublisher(ctrl)
+	mockS3Bucket = mock_s3.NewMockBucket(ctrl)
+	svc = service.NewMessageService(mockMessageRepository, mockChannelRepository, mockPublisher, mockS3Bucket)
+
+	return
+}
+
+func TestSendMessage_Success(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, mockPublisher, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().Create(ctx, channelId, authorId, content, nil).Return(messageModel, nil)
+	mockChannelRepository.EXPECT().UpdateLastMessage(ctx, messageModel).Return(nil)
+	mockChannelRepository.EXPECT().UpdateLastRead(ctx, authorId, channelId, messageId).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewMessageEvent(service.MapMessageModelToEntity(messageModel)))
+
+	message, err := svc.Send(ctx, channelId, authorId, enum.UserRoleCUSTOMER, "  "+content+"\n")
+	assert.NoError(t, err)
+	assert.Equal(t, messageId, message.ID)
+	assert.Equal(t, content, message.Content)
+	assert.Equal(t, time.UnixMilli(snowflake.ID(messageId).Time()), message.CreatedAt)
+}
+
+func TestSendMessage_Moderator(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, mockPublisher, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	moderatorMessageModel := messageModel
+	moderatorMessageModel.AuthorID = outsiderId
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().Create(ctx, channelId, outsiderId, content, nil).Return(moderatorMessageModel, nil)
+	mockChannelRepository.EXPECT().UpdateLastMessage(ctx, moderatorMessageModel).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewMessageEvent(service.MapMessageModelToEntity(moderatorMessageModel)))
+
+	message, err := svc.Send(ctx, channelId, outsiderId, enum.UserRoleMODERATOR, content)
+	assert.NoError(t, err)
+	assert.Equal(t, outsiderId, message.AuthorID)
+}
+
+func TestSendMessage_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+
+	message, err := svc.Send(ctx, channelId, outsiderId, enum.UserRolePROVIDER, content)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Empty(t, message)
+}
+
+func TestSendMessage_ChannelNotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(repository.ChannelModel{}, gocql.ErrNotFound)
+
+	message, err := svc.Send(ctx, channelId, authorId, enum.UserRoleCUSTOMER, content)
+	assert.ErrorIs(t, err, service.ErrChannelNotFound)
+	assert.Empty(t, message)
+}
+
+func TestSendMessage_Empty(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	message, err := svc.Send(ctx, channelId, authorId, enum.UserRoleCUSTOMER, " \t\n")
+	assert.ErrorIs(t, err, service.ErrEmptyMessage)
+	assert.Empty(t, message)
+}
+
+func TestSendMessage_TooLong(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	message, err := svc.Send(ctx, channelId, authorId, enum.UserRoleCUSTOMER, strings.Repeat("ა", domain.MaxMessageLength+1))
+	assert.ErrorIs(t, err, service.ErrMessageTooLong)
+	assert.Empty(t, message)
+}
+
+func TestSendMessage_Error(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("cassandra error")
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().Create(ctx, channelId, authorId, content, nil).Return(repository.MessageModel{}, expectedErr)
+
+	message, err := svc.Send(ctx, channelId, authorId, enum.UserRoleCUSTOMER, content)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, message)
+}
+
+func TestSendAttachment_Success(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, mockPublisher, mockS3Bucket := setupMessage(t)
+	defer ctrl.Finish()
+
+	file := bytes.NewReader([]byte(content))
+	directory := "chat/" + strconv.FormatInt(channelId, 10) + "/"
+	attachments := []repository.AttachmentModel{{Key: directory + fileKey, Name: fileName, ContentType: fileType, Size: fileSize}}
+
+	attachmentMessageModel := messageModel
+	attachmentMessageModel.Content = ""
+	attachmentMessageModel.Attachments = attachments
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockS3Bucket.EXPECT().PutObject(ctx, file, directory, "", fileSize, fileType).Return(fileKey, nil)
+	mockMessageRepository.EXPECT().Create(ctx, channelId, authorId, "", attachments).Return(attachmentMessageModel, nil)
+	mockChannelRepository.EXPECT().UpdateLastMessage(ctx, attachmentMessageModel).Return(nil)
+	mockChannelRepository.EXPECT().UpdateLastRead(ctx, authorId, channelId, messageId).Return(nil)
+	mockPublisher.EXPECT().Publish(domain.NewMessageEvent(service.MapMessageModelToEntity(attachmentMessageModel)))
+
+	message, err := svc.SendAttachment(ctx, channelId, authorId, enum.UserRoleCUSTOMER, " ", file, fileName, fileSize, fileType)
+	assert.NoError(t, err)
+	assert.Equal(t, messageId, message.ID)
+	assert.Equal(t, []domain.Attachment{domain.NewAttachment(directory+fileKey, fileName, fileType, fileSize)}, message.Attachments)
+}
+
+func TestSendAttachment_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+
+	message, err := svc.SendAttachment(ctx, channelId, outsiderId, enum.UserRolePROVIDER, content, bytes.NewReader(nil), fileName, fileSize, fileType)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Empty(t, message)
+}
+
+func TestSendAttachment_TooLong(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	message, err := svc.SendAttachment(ctx, channelId, authorId, enum.UserRoleCUSTOMER, strings.Repeat("ა", domain.MaxMessageLength+1), bytes.NewReader(nil), fileName, fileSize, fileType)
+	assert.ErrorIs(t, err, service.ErrMessageTooLong)
+	assert.Empty(t, message)
+}
+
+func TestSendAttachment_UploadError(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository, _, mockS3Bucket := setupMessage(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("s3 error")
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockS3Bucket.EXPECT().PutObject(ctx, gomock.Any(), gomock.Any(), "", fileSize, fileType).Return("", expectedErr)
+
+	message, err := svc.SendAttachment(ctx, channelId, authorId, enum.UserRoleCUSTOMER, content, bytes.NewReader(nil), fileName, fileSize, fileType)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, message)
+}
+
+func TestSendAttachment_CreateError(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, mockS3Bucket := setupMessage(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("cassandra error")
+	directory := "chat/" + strconv.FormatInt(channelId, 10) + "/"
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockS3Bucket.EXPECT().PutObject(ctx, gomock.Any(), directory, "", fileSize, fileType).Return(fileKey, nil)
+	mockMessageRepository.EXPECT().Create(ctx, channelId, authorId, content, gomock.Any()).Return(repository.MessageModel{}, expectedErr)
+	mockS3Bucket.EXPECT().DeleteObject(ctx, directory+fileKey).Return(nil)
+
+	message, err := svc.SendAttachment(ctx, channelId, authorId, enum.UserRoleCUSTOMER, content, bytes.NewReader(nil), fileName, fileSize, fileType)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, message)
+}
+
+func TestMessageHistory_WalksBuckets(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	beforeId := messageId + 1
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	gomock.InOrder(
+		mockMessageRepository.EXPECT().ListByBucket(ctx, channelId, firstBucket+1, beforeId, limit).Return([]repository.MessageModel{messageModel}, nil),
+		mockMessageRepository.EXPECT().ListByBucket(ctx, channelId, firstBucket, beforeId, limit-1).Return([]repository.MessageModel{olderMessageModel}, nil),
+	)
+
+	messages, err := svc.History(ctx, channelId, authorId, enum.UserRoleCUSTOMER, beforeId, limit)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Message{
+		service.MapMessageModelToEntity(messageModel),
+		service.MapMessageModelToEntity(olderMessageModel),
+	}, messages)
+}
+
+func TestMessageHistory_StopsAtLimit(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	beforeId := messageId + 1
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().ListByBucket(ctx, channelId, firstBucket+1, beforeId, int64(1)).Return([]repository.MessageModel{messageModel}, nil)
+
+	messages, err := svc.History(ctx, channelId, authorId, enum.UserRoleCUSTOMER, beforeId, 1)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+}
+
+func TestMessageHistory_Latest(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	recentChannelModel := channelModel
+	recentChannelModel.ChannelID = (time.Now().UnixMilli() - snowflake.Epoch) << (snowflake.NodeBits + snowflake.StepBits)
+
+	mockChannelRepository.EXPECT().Get(ctx, recentChannelModel.ChannelID).Return(recentChannelModel, nil)
+	mockMessageRepository.EXPECT().ListByBucket(ctx, recentChannelModel.ChannelID, gomock.Any(), gomock.Any(), limit).Return([]repository.MessageModel{}, nil)
+
+	messages, err := svc.History(ctx, recentChannelModel.ChannelID, providerId, enum.UserRolePROVIDER, 0, limit)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestMessageHistory_FutureBeforeId(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	recentChannelModel := channelModel
+	recentChannelModel.ChannelID = (time.Now().UnixMilli() - snowflake.Epoch) << (snowflake.NodeBits + snowflake.StepBits)
+	futureId := (time.Now().AddDate(10, 0, 0).UnixMilli() - snowflake.Epoch) << (snowflake.NodeBits + snowflake.StepBits)
+
+	// the walk starts at the current bucket instead of years of empty buckets ahead
+	mockChannelRepository.EXPECT().Get(ctx, recentChannelModel.ChannelID).Return(recentChannelModel, nil)
+	mockMessageRepository.EXPECT().ListByBucket(ctx, recentChannelModel.ChannelID, gomock.Any(), futureId, limit).
+		DoAndReturn(func(_ context.Context, _ int64, bucket int32, _ int64, _ int64) ([]repository.MessageModel, error) {
+			assert.LessOrEqual(t, bucket, domain.BucketAt(time.Now()))
+			return []repository.MessageModel{}, nil
+		}).
+		MaxTimes(2)
+
+	messages, err := svc.History(ctx, recentChannelModel.ChannelID, providerId, enum.UserRolePROVIDER, futureId, limit)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestMessageHistory_Admin(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().ListByBucket(ctx, channelId, firstBucket+1, messageId, int64(1)).Return([]repository.MessageModel{olderMessageModel}, nil)
+
+	messages, err := svc.History(ctx, channelId, outsiderId, enum.UserRoleADMIN, messageId, 1)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+}
+
+func TestMessageHistory_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+
+	messages, err := svc.History(ctx, channelId, outsiderId, enum.UserRoleCUSTOMER, messageId, limit)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Nil(t, messages)
+}
+
+func TestMessageHistory_Error(t *testing.T) {
+	ctrl, ctx, svc, mockMessageRepository, mockChannelRepository, _, _ := setupMessage(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("cassandra error")
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(channelModel, nil)
+	mockMessageRepository.EXPECT().ListByBucket(ctx, channelId, firstBucket+1, messageId, limit).Return(nil, expectedErr)
+
+	messages, err := svc.History(ctx, channelId, authorId, enum.UserRoleCUSTOMER, messageId, limit)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, messages)
+}
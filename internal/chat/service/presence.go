@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	"github.com/hexley21/fixup/internal/common/enum"
+)
+
+const (
+	// PresenceTTL is how long a connection stays online without a heartbeat,
+	// connections of a node that died without closing them go offline after it.
+	PresenceTTL = 2 * time.Minute
+	// ReconnectGrace is how long a closed connection stays online, so that reconnecting users don't flap offline.
+	ReconnectGrace = 15 * time.Second
+)
+
+type PresenceService interface {
+	Connect(ctx context.Context, userID int64) (string, error)
+	Heartbeat(ctx context.Context, userID int64, connID string) error
+	Disconnect(ctx context.Context, userID int64, connID string) error
+	Get(ctx context.Context, channelID int64, userID int64, role enum.UserRole) ([]domain.Presence, error)
+}
+
+type presenceServiceImpl struct {
+	presenceRepository repository.PresenceRepository
+	channelRepository  repository.ChannelRepository
+	snowflake          *snowflake.Node
+}
+
+func NewPresenceService(
+	presenceRepository repository.PresenceRepository,
+	channelRepository repository.ChannelRepository,
+	snowflake *snowflake.Node,
+) *presenceServiceImpl {
+	return &presenceServiceImpl{
+		presenceRepository: presenceRepository,
+		channelRepository:  channelRepository,
+		snowflake:          snowflake,
+	}
+}
+
+// Connect registers a new connection of the user and returns its id.
+// The connection has to be kept alive with Heartbeat within PresenceTTL.
+func (s *presenceServiceImpl) Connect(ctx context.Context, userID int64) (string, error) {
+	connID := strconv.FormatInt(s.snowflake.Generate().Int64(), 10)
+
+	if err := s.presenceRepository.Touch(ctx, userID, connID, PresenceTTL); err != nil {
+		return "", err
+	}
+
+	return connID, nil
+}
+
+// Heartbeat keeps the connection of the user online for another PresenceTTL.
+func (s *presenceServiceImpl) Heartbeat(ctx context.Context, userID int64, connID string) error {
+	return s.presenceRepository.Touch(ctx, userID, connID, PresenceTTL)
+}
+
+// Disconnect keeps the closed connection online for ReconnectGrace only.
+func (s *presenceServiceImpl) Disconnect(ctx context.Context, userID int64, connID string) error {
+	return s.presenceRepository.Leave(ctx, userID, connID, ReconnectGrace)
+}
+
+// Get retrieves the presence of the channel members.
+// If the channel is not found, it returns ErrChannelNotFound.
+// If the user is neither a member nor a moderator or admin, it returns ErrChannelAccessDenied.
+func (s *presenceServiceImpl) Get(ctx context.Context, channelID int64, userID int64, role enum.UserRole) ([]domain.Presence, error) {
+	model, err := authorize(ctx, s.channelRepository, channelID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := []int64{model.CustomerID, model.ProviderID}
+	online, err := s.presenceRepository.Online(ctx, memberIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	presences := make([]domain.Presence, len(memberIDs))
+	for i, memberID := range memberIDs {
+		presences[i] = domain.NewPresence(memberID, online[i])
+	}
+
+	return presences, nil
+}
@@ -0,0 +1,139 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/internal/chat/domain"
+	"github.com/hexley21/fixup/internal/chat/repository"
+	mock_repository "github.com/hexley21/fixup/internal/chat/repository/mock"
+	"github.com/hexley21/fixup/internal/chat/service"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const connId = "1"
+
+func setupPresence(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.PresenceService,
+	mockPresenceRepository *mock_repository.MockPresenceRepository,
+	mockChannelRepository *mock_repository.MockChannelRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	node, err := snowflake.NewNode(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockPresenceRepository = mock_repository.NewMockPresenceRepository(ctrl)
+	mockChannelRepository = mock_repository.NewMockChannelRepository(ctrl)
+	svc = service.NewPresenceService(mockPresenceRepository, mockChannelRepository, node)
+
+	return
+}
+
+func TestConnect_Success(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, _ := setupPresence(t)
+	defer ctrl.Finish()
+
+	var touched string
+	mockPresenceRepository.EXPECT().Touch(ctx, authorId, gomock.Any(), service.PresenceTTL).DoAndReturn(
+		func(_ context.Context, _ int64, connID string, _ any) error {
+			touched = connID
+			return nil
+		},
+	)
+
+	connID, err := svc.Connect(ctx, authorId)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, connID)
+	assert.Equal(t, touched, connID)
+}
+
+func TestConnect_Error(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, _ := setupPresence(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("redis error")
+	mockPresenceRepository.EXPECT().Touch(ctx, authorId, gomock.Any(), service.PresenceTTL).Return(expectedErr)
+
+	connID, err := svc.Connect(ctx, authorId)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, connID)
+}
+
+func TestHeartbeat(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, _ := setupPresence(t)
+	defer ctrl.Finish()
+
+	mockPresenceRepository.EXPECT().Touch(ctx, authorId, connId, service.PresenceTTL).Return(nil)
+
+	assert.NoError(t, svc.Heartbeat(ctx, authorId, connId))
+}
+
+func TestDisconnect(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, _ := setupPresence(t)
+	defer ctrl.Finish()
+
+	mockPresenceRepository.EXPECT().Leave(ctx, authorId, connId, service.ReconnectGrace).Return(nil)
+
+	assert.NoError(t, svc.Disconnect(ctx, authorId, connId))
+}
+
+func TestGetPresence_Success(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, mockChannelRepository := setupPresence(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockPresenceRepository.EXPECT().Online(ctx, authorId, providerId).Return([]bool{true, false}, nil)
+
+	presences, err := svc.Get(ctx, channelId, authorId, enum.UserRoleCUSTOMER)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Presence{
+		domain.NewPresence(authorId, true),
+		domain.NewPresence(providerId, false),
+	}, presences)
+}
+
+func TestGetPresence_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository := setupPresence(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(repository.ChannelModel{}, gocql.ErrNotFound)
+
+	presences, err := svc.Get(ctx, channelId, authorId, enum.UserRoleCUSTOMER)
+	assert.ErrorIs(t, err, service.ErrChannelNotFound)
+	assert.Nil(t, presences)
+}
+
+func TestGetPresence_AccessDenied(t *testing.T) {
+	ctrl, ctx, svc, _, mockChannelRepository := setupPresence(t)
+	defer ctrl.Finish()
+
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+
+	presences, err := svc.Get(ctx, channelId, outsiderId, enum.UserRolePROVIDER)
+	assert.ErrorIs(t, err, service.ErrChannelAccessDenied)
+	assert.Nil(t, presences)
+}
+
+func TestGetPresence_Error(t *testing.T) {
+	ctrl, ctx, svc, mockPresenceRepository, mockChannelRepository := setupPresence(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("redis error")
+	mockChannelRepository.EXPECT().Get(ctx, channelId).Return(activeChannelModel, nil)
+	mockPresenceRepository.EXPECT().Online(ctx, authorId, providerId).Return(nil, expectedErr)
+
+	presences, err := svc.Get(ctx, channelId, outsiderId, enum.UserRoleADMIN)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, presences)
+}
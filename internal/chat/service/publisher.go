@@ -0,0 +1,8 @@
+package service
+
+import "github.com/hexley21/fixup/internal/chat/domain"
+
+// EventPublisher fans out events to the connected participants of the channel.
+type EventPublisher interface {
+	Publish(event domain.Event)
+}
@@ -26,17 +26,19 @@ type UserClaims struct {
 }
 
 type UserData struct {
-	ID       string        `json:"id"`
-	Role     enum.UserRole `json:"role"`
-	Verified bool          `json:"verified"`
+	ID            string        `json:"id"`
+	Role          enum.UserRole `json:"role"`
+	Verified      bool          `json:"verified"`
+	PhoneVerified bool          `json:"phone_verified"`
 }
 
-func NewClaims(id string, role enum.UserRole, Verified bool, expiry time.Duration) UserClaims {
+func NewClaims(id string, role enum.UserRole, Verified bool, phoneVerified bool, expiry time.Duration) UserClaims {
 	return UserClaims{
 		Data: UserData{
-			ID:       id,
-			Role:     role,
-			Verified: Verified,
+			ID:            id,
+			Role:          role,
+			Verified:      Verified,
+			PhoneVerified: phoneVerified,
 		},
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
@@ -50,11 +52,15 @@ func mapToClaim(mapClaims any) UserClaims {
 		return UserClaims{}
 	}
 
+	// tokens issued before phone verification lack the claim, their phone is treated as not verified
+	phoneVerified, _ := claims["phone_verified"].(bool)
+
 	return UserClaims{
 		Data: UserData{
-			ID:       claims["id"].(string),
-			Role:     enum.UserRole(claims["role"].(string)),
-			Verified: claims["verified"].(bool),
+			ID:            claims["id"].(string),
+			Role:          enum.UserRole(claims["role"].(string)),
+			Verified:      claims["verified"].(bool),
+			PhoneVerified: phoneVerified,
 		},
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Unix(int64(claims["exp"].(float64)), 0)),
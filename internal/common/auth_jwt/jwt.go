@@ -15,7 +15,7 @@ type Manager interface {
 }
 
 type Generator interface {
-	Generate(id int64, role enum.UserRole, verified bool) (string, *rest.ErrorResponse)
+	Generate(id int64, role enum.UserRole, verified bool, phoneVerified bool) (string, *rest.ErrorResponse)
 }
 
 type Verifier interface {
@@ -31,8 +31,8 @@ func NewManager(secretKey string, ttl time.Duration) *managerImpl {
 	return &managerImpl{secretKey: secretKey, ttl: ttl}
 }
 
-func (j *managerImpl) Generate(id int64, role enum.UserRole, verified bool) (string, *rest.ErrorResponse) {
-	token, err := jwt.Generate(NewClaims(strconv.FormatInt(id, 10), role, verified, j.ttl), j.secretKey)
+func (j *managerImpl) Generate(id int64, role enum.UserRole, verified bool, phoneVerified bool) (string, *rest.ErrorResponse) {
+	token, err := jwt.Generate(NewClaims(strconv.FormatInt(id, 10), role, verified, phoneVerified, j.ttl), j.secretKey)
 	if err != nil {
 		return "", rest.NewInternalServerError(err)
 	}
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	auth_jwt "github.com/hexley21/fixup/internal/common/auth_jwt"
+	enum "github.com/hexley21/fixup/internal/common/enum"
 	rest "github.com/hexley21/fixup/pkg/http/rest"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,18 +42,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockManager) Generate(id, role string, verified bool) (string, *rest.ErrorResponse) {
+func (m *MockManager) Generate(id int64, role enum.UserRole, verified, phoneVerified bool) (string, *rest.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generate", id, role, verified)
+	ret := m.ctrl.Call(m, "Generate", id, role, verified, phoneVerified)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(*rest.ErrorResponse)
 	return ret0, ret1
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockManagerMockRecorder) Generate(id, role, verified any) *gomock.Call {
+func (mr *MockManagerMockRecorder) Generate(id, role, verified, phoneVerified any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockManager)(nil).Generate), id, role, verified)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockManager)(nil).Generate), id, role, verified, phoneVerified)
 }
 
 // Verify mocks base method.
@@ -94,18 +95,18 @@ func (m *MockGenerator) EXPECT() *MockGeneratorMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockGenerator) Generate(id, role string, verified bool) (string, *rest.ErrorResponse) {
+func (m *MockGenerator) Generate(id int64, role enum.UserRole, verified, phoneVerified bool) (string, *rest.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generate", id, role, verified)
+	ret := m.ctrl.Call(m, "Generate", id, role, verified, phoneVerified)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(*rest.ErrorResponse)
 	return ret0, ret1
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockGeneratorMockRecorder) Generate(id, role, verified any) *gomock.Call {
+func (mr *MockGeneratorMockRecorder) Generate(id, role, verified, phoneVerified any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), id, role, verified)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), id, role, verified, phoneVerified)
 }
 
 // MockVerifier is a mock of Verifier interface.
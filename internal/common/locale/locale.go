@@ -0,0 +1,105 @@
+package locale
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ctxKey string
+
+const (
+	LocaleKey ctxKey = "locale"
+)
+
+// WithLocale returns a copy of the context carrying the locale.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, LocaleKey, locale)
+}
+
+// FromContext returns the locale carried by the context, or an empty string if there is none.
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(LocaleKey).(string)
+	return locale
+}
+
+// Negotiate picks the locale of the request out of the supported locales.
+// The "lang" query parameter takes precedence over the Accept-Language header, whose ranges are tried in the order of their quality values.
+// A range matches a locale either exactly or by its primary language subtag, e.g. "ka-GE" matches "ka".
+// If nothing matches, it returns the default locale.
+func Negotiate(r *http.Request, defaultLocale string, locales []string) string {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		if locale, ok := match(lang, locales); ok {
+			return locale
+		}
+	}
+
+	for _, lang := range parseAcceptLanguage(r.Header.Get("Accept-Language")) {
+		if lang == "*" {
+			break
+		}
+		if locale, ok := match(lang, locales); ok {
+			return locale
+		}
+	}
+
+	return defaultLocale
+}
+
+func match(lang string, locales []string) (string, bool) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if slices.Contains(locales, lang) {
+		return lang, true
+	}
+
+	if primary, _, found := strings.Cut(lang, "-"); found && slices.Contains(locales, primary) {
+		return primary, true
+	}
+
+	return "", false
+}
+
+// parseAcceptLanguage returns the language ranges of the header sorted by their quality values, the ranges with zero quality are left out.
+func parseAcceptLanguage(header string) []string {
+	type languageRange struct {
+		lang    string
+		quality float64
+	}
+
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(part, ";")
+		lang = strings.TrimSpace(lang)
+		if lang == "" {
+			continue
+		}
+
+		quality := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, languageRange{lang: lang, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	langs := make([]string, len(ranges))
+	for i, r := range ranges {
+		langs[i] = r.lang
+	}
+
+	return langs
+}
@@ -0,0 +1,80 @@
+package locale_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/common/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+var locales = []string{"en", "ka", "ru"}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		acceptLanguage string
+		expected       string
+	}{
+		{
+			name:     "No Preference",
+			target:   "/",
+			expected: "en",
+		},
+		{
+			name:           "Lang Param Precedence",
+			target:         "/?lang=ru",
+			acceptLanguage: "ka",
+			expected:       "ru",
+		},
+		{
+			name:           "Unsupported Lang Param",
+			target:         "/?lang=de",
+			acceptLanguage: "ka",
+			expected:       "ka",
+		},
+		{
+			name:           "Quality Order",
+			target:         "/",
+			acceptLanguage: "de;q=0.9, ru;q=0.5, ka-GE;q=0.8",
+			expected:       "ka",
+		},
+		{
+			name:           "Zero Quality",
+			target:         "/",
+			acceptLanguage: "ka;q=0, ru;q=0.1",
+			expected:       "ru",
+		},
+		{
+			name:           "Wildcard",
+			target:         "/",
+			acceptLanguage: "de, *;q=0.5, ka;q=0.1",
+			expected:       "en",
+		},
+		{
+			name:           "Unsupported",
+			target:         "/",
+			acceptLanguage: "de-DE, fr",
+			expected:       "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+
+			assert.Equal(t, tt.expected, locale.Negotiate(req, "en", locales))
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Empty(t, locale.FromContext(context.Background()))
+	assert.Equal(t, "ka", locale.FromContext(locale.WithLocale(context.Background(), "ka")))
+}
@@ -18,7 +18,7 @@ import (
 )
 
 var (
-	userClaims = auth_jwt.NewClaims("1", enum.UserRoleCUSTOMER, true, time.Hour)
+	userClaims = auth_jwt.NewClaims("1", enum.UserRoleCUSTOMER, true, true, time.Hour)
 )
 
 func setupJWT(t *testing.T) (*gomock.Controller, func(http.Handler) http.Handler, *mockJwt.MockVerifier) {
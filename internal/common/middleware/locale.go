@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/hexley21/fixup/internal/common/locale"
+)
+
+// NewLocale creates a middleware that negotiates the locale of the request and stores it in the request context.
+// The locale is picked from the "lang" query parameter or the Accept-Language header, falling back to the default locale.
+// It announces the picked locale with the Content-Language header.
+func (f *Middleware) NewLocale(defaultLocale string, locales []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			negotiated := locale.Negotiate(r, defaultLocale, locales)
+
+			w.Header().Set("Content-Language", negotiated)
+			w.Header().Add("Vary", "Accept-Language")
+
+			next.ServeHTTP(w, r.WithContext(locale.WithLocale(r.Context(), negotiated)))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/common/locale"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocale(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Language", "ka-GE, en;q=0.8")
+	rec := httptest.NewRecorder()
+
+	var negotiated string
+	mw.NewLocale("en", []string{"en", "ka"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		negotiated = locale.FromContext(r.Context())
+		BasicHandlerFunc(w, r)
+	})).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "ka", negotiated)
+	assert.Equal(t, "ka", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", rec.Header().Get("Vary"))
+}
@@ -13,6 +13,7 @@ import (
 var (
 	ErrUserVerified       = rest.NewForbiddenError(errors.New("user has to be not-verified"))
 	ErrUserNotVerified    = rest.NewForbiddenError(errors.New("user is not verified"))
+	ErrPhoneNotVerified   = rest.NewForbiddenError(errors.New("user's phone number is not verified"))
 )
 
 // NewAllowRoles creates a middleware that restricts access to users with specific roles.
@@ -63,3 +64,25 @@ func (f *Middleware) NewAllowVerified(verified bool) func(http.Handler) http.Han
 		})
 	}
 }
+
+// NewAllowPhoneVerified creates a middleware that restricts access to users with a verified phone number.
+// It retrieves the JWT claims from the request context and checks the user's phone verification status.
+// If the JWT is not set or the phone number is not verified, it writes an error response.
+func (f *Middleware) NewAllowPhoneVerified() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := r.Context().Value(auth_jwt.AuthJWTKey).(auth_jwt.UserData)
+			if !ok {
+				f.writer.WriteError(w, auth_jwt.ErrJWTNotSet)
+				return
+			}
+
+			if !claims.PhoneVerified {
+				f.writer.WriteError(w, ErrPhoneNotVerified)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
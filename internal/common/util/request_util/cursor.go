@@ -0,0 +1,117 @@
+package request_util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+var ErrInvalidCursor = rest.NewBadRequestError(errors.New("invalid cursor parameter"))
+
+// Cursor points into a list by the id of one of its items, and its creation time for lists ordered by it.
+// A forward cursor selects the items following the item in the order of the list, a backward one the items preceding it.
+// The zero value points to the start of the list.
+type Cursor struct {
+	ID        int64      `json:"id"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Backward  bool       `json:"backward,omitempty"`
+}
+
+// Encode returns the opaque form of the cursor, which is handed to clients.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode.
+func DecodeCursor(encoded string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Cursor{}, err
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return Cursor{}, err
+	}
+	if cursor.ID <= 0 {
+		return Cursor{}, errors.New("cursor id must be positive")
+	}
+
+	return cursor, nil
+}
+
+// UsesCursor reports whether the request asks for cursor pagination, that is when it has a "cursor" or no "page" query parameter.
+func UsesCursor(r *http.Request) bool {
+	query := r.URL.Query()
+	return query.Has("cursor") || !query.Has("page")
+}
+
+// ParseCursorAndLimit parses the "cursor" and "per_page" query parameters from the request URL.
+// "cursor" parameter is optional, without it the list is read from the start.
+// If "per_page" is not provided or exceeds the maximum allowed, it defaults to the specified defaultPerPage.
+func ParseCursorAndLimit(r *http.Request, maxPerPage int64, defaultPerPage int64) (Cursor, int64, *rest.ErrorResponse) {
+	query := r.URL.Query()
+
+	var cursor Cursor
+	if cursorParam := query.Get("cursor"); cursorParam != "" {
+		decoded, err := DecodeCursor(cursorParam)
+		if err != nil {
+			return Cursor{}, 0, ErrInvalidCursor
+		}
+		cursor = decoded
+	}
+
+	limit := defaultPerPage
+	if perPageParam := query.Get("per_page"); perPageParam != "" {
+		perPage, err := strconv.ParseInt(perPageParam, 10, 64)
+		if err != nil || perPage < 0 {
+			return Cursor{}, 0, ErrInvalidPerPage
+		}
+		if perPage > 0 && perPage <= maxPerPage {
+			limit = perPage
+		}
+	}
+
+	return cursor, limit, nil
+}
+
+// PageCursors returns the encoded cursors of the pages around the page of items read with the cursor and limit.
+// A cursor is left empty when there is nothing to read in its direction, a full page is assumed to have a successor.
+func PageCursors[T any](cursor Cursor, limit int64, items []T, id func(T) int64) (nextCursor string, prevCursor string) {
+	return PageCursorsFunc(cursor, limit, items, func(item T) Cursor { return Cursor{ID: id(item)} })
+}
+
+// PageCursorsFunc is like PageCursors, but the cursor pointing to an item is built by the function,
+// for lists that are not ordered by the id alone.
+func PageCursorsFunc[T any](cursor Cursor, limit int64, items []T, at func(T) Cursor) (nextCursor string, prevCursor string) {
+	if len(items) == 0 {
+		return "", ""
+	}
+
+	full := int64(len(items)) >= limit
+	first := at(items[0])
+	first.Backward = true
+	last := at(items[len(items)-1])
+
+	if cursor.Backward {
+		nextCursor = last.Encode()
+		if full {
+			prevCursor = first.Encode()
+		}
+		return
+	}
+
+	if full {
+		nextCursor = last.Encode()
+	}
+	if cursor.ID != 0 {
+		prevCursor = first.Encode()
+	}
+	return
+}
@@ -0,0 +1,145 @@
+package request_util_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	cursor := request_util.Cursor{ID: 42, Backward: true}
+
+	decoded, err := request_util.DecodeCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestCursor_EncodeDecodeCreatedAt(t *testing.T) {
+	createdAt := time.Date(2024, 10, 1, 10, 0, 0, 123456000, time.UTC)
+	cursor := request_util.Cursor{ID: 42, CreatedAt: &createdAt}
+
+	decoded, err := request_util.DecodeCursor(cursor.Encode())
+	assert.NoError(t, err)
+	if assert.NotNil(t, decoded.CreatedAt) {
+		assert.True(t, createdAt.Equal(*decoded.CreatedAt))
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, encoded := range []string{"not base64!", "bm90IGpzb24", request_util.Cursor{}.Encode()} {
+		_, err := request_util.DecodeCursor(encoded)
+		assert.Error(t, err, encoded)
+	}
+}
+
+func TestUsesCursor(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected bool
+	}{
+		{query: "", expected: true},
+		{query: "?per_page=10", expected: true},
+		{query: "?cursor=abc", expected: true},
+		{query: "?page=1", expected: false},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+		assert.Equal(t, tt.expected, request_util.UsesCursor(req), tt.query)
+	}
+}
+
+func TestParseCursorAndLimit(t *testing.T) {
+	cursor := request_util.Cursor{ID: 7}
+
+	req := httptest.NewRequest(http.MethodGet, "/?cursor="+cursor.Encode()+"&per_page=5", nil)
+	parsed, limit, errResp := request_util.ParseCursorAndLimit(req, 10, 2)
+	assert.Nil(t, errResp)
+	assert.Equal(t, cursor, parsed)
+	assert.Equal(t, int64(5), limit)
+
+	req = httptest.NewRequest(http.MethodGet, "/?per_page=50", nil)
+	parsed, limit, errResp = request_util.ParseCursorAndLimit(req, 10, 2)
+	assert.Nil(t, errResp)
+	assert.Equal(t, request_util.Cursor{}, parsed)
+	assert.Equal(t, int64(2), limit)
+
+	req = httptest.NewRequest(http.MethodGet, "/?cursor=invalid", nil)
+	_, _, errResp = request_util.ParseCursorAndLimit(req, 10, 2)
+	assert.Equal(t, request_util.ErrInvalidCursor, errResp)
+}
+
+func TestPageCursors(t *testing.T) {
+	id := func(i int64) int64 { return i }
+	next := func(id int64) string { return request_util.Cursor{ID: id}.Encode() }
+	prev := func(id int64) string { return request_util.Cursor{ID: id, Backward: true}.Encode() }
+
+	tests := []struct {
+		name         string
+		cursor       request_util.Cursor
+		items        []int64
+		expectedNext string
+		expectedPrev string
+	}{
+		{
+			name:         "First Page",
+			items:        []int64{1, 2},
+			expectedNext: next(2),
+		},
+		{
+			name:  "Only Page",
+			items: []int64{1},
+		},
+		{
+			name:         "Middle Page",
+			cursor:       request_util.Cursor{ID: 2},
+			items:        []int64{3, 4},
+			expectedNext: next(4),
+			expectedPrev: prev(3),
+		},
+		{
+			name:         "Last Page",
+			cursor:       request_util.Cursor{ID: 4},
+			items:        []int64{5},
+			expectedPrev: prev(5),
+		},
+		{
+			name:         "Backward",
+			cursor:       request_util.Cursor{ID: 5, Backward: true},
+			items:        []int64{3, 4},
+			expectedNext: next(4),
+			expectedPrev: prev(3),
+		},
+		{
+			name:         "Backward To Start",
+			cursor:       request_util.Cursor{ID: 2, Backward: true},
+			items:        []int64{1},
+			expectedNext: next(1),
+		},
+		{
+			name:   "Empty",
+			cursor: request_util.Cursor{ID: 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextCursor, prevCursor := request_util.PageCursors(tt.cursor, 2, tt.items, id)
+			assert.Equal(t, tt.expectedNext, nextCursor)
+			assert.Equal(t, tt.expectedPrev, prevCursor)
+		})
+	}
+}
+
+func TestPageCursorsFunc(t *testing.T) {
+	createdAt := time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+	at := func(i int64) request_util.Cursor { return request_util.Cursor{ID: i, CreatedAt: &createdAt} }
+
+	nextCursor, prevCursor := request_util.PageCursorsFunc(request_util.Cursor{ID: 2, CreatedAt: &createdAt}, 2, []int64{3, 4}, at)
+	assert.Equal(t, request_util.Cursor{ID: 4, CreatedAt: &createdAt}.Encode(), nextCursor)
+	assert.Equal(t, request_util.Cursor{ID: 3, CreatedAt: &createdAt, Backward: true}.Encode(), prevCursor)
+}
@@ -2,15 +2,18 @@ package request_util
 
 import (
 	"errors"
+	"net"
 	"net/http"
 	"strconv"
 
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
 	"github.com/hexley21/fixup/pkg/http/rest"
 )
 
 var (
 	ErrInvalidPage = rest.NewBadRequestError(errors.New("invalid page parameter"))
 	ErrInvalidPerPage = rest.NewBadRequestError(errors.New("invalid per_page parameter"))
+	ErrInvalidBefore = rest.NewBadRequestError(errors.New("invalid before parameter"))
 )
 
 // ParseLimitAndOffset parses the "page" and "per_page" query parameters from the request URL.
@@ -45,4 +48,64 @@ func ParseLimitAndOffset(r *http.Request, maxPerPage int64, defaultPerPage int64
 	}
 
 	return perPage, perPage * (page - 1), nil
+}
+
+// ParseBeforeAndLimit parses the "before" id and "per_page" query parameters from the request URL.
+// "before" parameter is optional, if it's provided it must be a valid integer greater than 0.
+// If "per_page" is not provided or exceeds the maximum allowed, it defaults to the specified defaultPerPage.
+func ParseBeforeAndLimit(r *http.Request, maxPerPage int64, defaultPerPage int64) (int64, int64, *rest.ErrorResponse) {
+	query := r.URL.Query()
+
+	var before int64
+	if beforeParam := query.Get("before"); beforeParam != "" {
+		id, err := strconv.ParseInt(beforeParam, 10, 64)
+		if err != nil || id <= 0 {
+			return 0, 0, ErrInvalidBefore
+		}
+		before = id
+	}
+
+	limit := defaultPerPage
+	if perPageParam := query.Get("per_page"); perPageParam != "" {
+		perPage, err := strconv.ParseInt(perPageParam, 10, 64)
+		if err != nil || perPage < 0 {
+			return 0, 0, ErrInvalidPerPage
+		}
+		if perPage > 0 && perPage <= maxPerPage {
+			limit = perPage
+		}
+	}
+
+	return before, limit, nil
+}
+
+// ParseUserData retrieves the JWT user data, set by the JWT middleware, from the request context.
+// It returns the parsed user ID along with the user data.
+func ParseUserData(r *http.Request) (int64, auth_jwt.UserData, *rest.ErrorResponse) {
+	claims, ok := r.Context().Value(auth_jwt.AuthJWTKey).(auth_jwt.UserData)
+	if !ok {
+		return 0, auth_jwt.UserData{}, auth_jwt.ErrJWTNotSet
+	}
+
+	id, err := strconv.ParseInt(claims.ID, 10, 64)
+	if err != nil {
+		return 0, auth_jwt.UserData{}, rest.NewInternalServerErrorf("failed to parse claims id: %w", err)
+	}
+
+	return id, claims, nil
+}
+
+// ClientIP returns the ip address of the client that sent the request.
+// The "X-Real-IP" header set by the reverse proxy is preferred over the remote address of the connection.
+func ClientIP(r *http.Request) string {
+	if ip := r.Header.Get("X-Real-IP"); ip != "" {
+		return ip
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
 }
\ No newline at end of file
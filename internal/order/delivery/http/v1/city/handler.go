@@ -0,0 +1,248 @@
+package city
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+var (
+	ErrInvalidIso2 = errors.New("iso2 must be a two letter country code")
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.CityService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.CityService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Create
+// @Summary Create a new city
+// @Description Creates a new city with the provided data.
+// @Tags City
+// @Param dto body dto.CityInfo true "City data"
+// @Success 201 {object} rest.ApiResponse[dto.City] "Created - Successfully created the city"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while creating the city"
+// @Router /cities [post]
+// @Security access_token
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var infoDTO dto.CityInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapCityInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create city due to wrong validation: %w", err))
+		return
+	}
+
+	cityEntity, err := h.service.Create(r.Context(), infoVO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create city: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Create city: %s, ISO2: %s, ID: %d", cityEntity.Info.Name, cityEntity.Info.Iso2, cityEntity.ID)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapCityToDTO(cityEntity))
+}
+
+// List
+// @Summary Retrieve cities
+// @Description Retrieves a city range ordered by name, optionally filtered by country, iso2 code and name prefix
+// @Tags City
+// @Param country query string false "Country name"
+// @Param iso2 query string false "Two letter country code"
+// @Param name query string false "City name prefix"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.City] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving cities"
+// @Router /cities [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	iso2 := query.Get("iso2")
+	if iso2 != "" && len(iso2) != 2 {
+		h.Writer.WriteError(w, rest.NewBadRequestError(ErrInvalidIso2))
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	filter := domain.NewCityFilter(query.Get("country"), iso2, query.Get("name"))
+
+	cityEntities, err := h.service.List(r.Context(), filter, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch cities: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Fetch cities - %d", len(cityEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCitiesToDTO(cityEntities))
+}
+
+// Get
+// @Summary Retrieve a city by ID
+// @Description Retrieves a city specified by the ID.
+// @Tags City
+// @Param city_id path int true "The ID of the city to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.City] "OK - Successfully retrieved the city"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the city"
+// @Router /cities/{city_id} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "city_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	cityEntity, err := h.service.Get(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCityNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get city - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch city: %s, ID: %d", cityEntity.Info.Name, cityEntity.ID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCityToDTO(cityEntity))
+}
+
+// Update
+// @Summary Update a city by ID
+// @Description Updates a city specified by the ID.
+// @Tags City
+// @Param city_id path int true "The ID of the city to update"
+// @Param dto body dto.CityInfo true "City data"
+// @Success 200 {object} rest.ApiResponse[dto.City] "OK - Successfully updated the city"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while updating the city"
+// @Router /cities/{city_id} [patch]
+// @Security access_token
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "city_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	var infoDTO dto.CityInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapCityInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update city due to wrong validation: %w", err))
+		return
+	}
+
+	cityEntity, err := h.service.Update(r.Context(), int32(id), infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCityNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update city - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Update city: %s, ID: %d", cityEntity.Info.Name, id)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCityToDTO(cityEntity))
+}
+
+// Delete
+// @Summary Delete a city by ID
+// @Description Deletes a city specified by the ID, cities referenced by provider locations can not be deleted.
+// @Tags City
+// @Param city_id path int true "The ID of the city to delete"
+// @Success 204 {string} string "No Content - Successfully deleted the city"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while deleting the city"
+// @Router /cities/{city_id} [delete]
+// @Security access_token
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "city_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	err = h.service.Delete(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCityNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrCityInUse):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to delete city - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Delete city - ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
@@ -0,0 +1,227 @@
+package currency
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.CurrencyService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.CurrencyService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Create
+// @Summary Create a new currency
+// @Description Creates a new currency with the provided data.
+// @Tags Currency
+// @Param dto body dto.CurrencyInfo true "Currency data"
+// @Success 201 {object} rest.ApiResponse[dto.Currency] "Created - Successfully created the currency"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while creating the currency"
+// @Router /currencies [post]
+// @Security access_token
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var infoDTO dto.CurrencyInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	currencyEntity, err := h.service.Create(r.Context(), mapper.MapCurrencyInfoToVO(infoDTO))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCurrencyCodeTaken):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create currency: %w", err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Create currency: %s, ID: %d", currencyEntity.Info.Code, currencyEntity.ID)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapCurrencyToDTO(currencyEntity))
+}
+
+// List
+// @Summary Retrieve currencies
+// @Description Retrieves a currency range ordered by code
+// @Tags Currency
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Currency] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving currencies"
+// @Router /currencies [get]
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	currencyEntities, err := h.service.List(r.Context(), limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch currencies: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Fetch currencies - %d", len(currencyEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCurrenciesToDTO(currencyEntities))
+}
+
+// Get
+// @Summary Retrieve a currency by ID
+// @Description Retrieves a currency specified by the ID.
+// @Tags Currency
+// @Param currency_id path int true "The ID of the currency to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Currency] "OK - Successfully retrieved the currency"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the currency"
+// @Router /currencies/{currency_id} [get]
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "currency_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	currencyEntity, err := h.service.Get(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCurrencyNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get currency - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch currency: %s, ID: %d", currencyEntity.Info.Code, currencyEntity.ID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCurrencyToDTO(currencyEntity))
+}
+
+// Update
+// @Summary Update a currency by ID
+// @Description Updates a currency specified by the ID.
+// @Tags Currency
+// @Param currency_id path int true "The ID of the currency to update"
+// @Param dto body dto.CurrencyInfo true "Currency data"
+// @Success 200 {object} rest.ApiResponse[dto.Currency] "OK - Successfully updated the currency"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while updating the currency"
+// @Router /currencies/{currency_id} [patch]
+// @Security access_token
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "currency_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	var infoDTO dto.CurrencyInfo
+	errResp := h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	currencyEntity, err := h.service.Update(r.Context(), int32(id), mapper.MapCurrencyInfoToVO(infoDTO))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCurrencyNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrCurrencyCodeTaken):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update currency - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Update currency: %s, ID: %d", currencyEntity.Info.Code, id)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapCurrencyToDTO(currencyEntity))
+}
+
+// Delete
+// @Summary Delete a currency by ID
+// @Description Deletes a currency specified by the ID, currencies referenced by offers can not be deleted.
+// @Tags Currency
+// @Param currency_id path int true "The ID of the currency to delete"
+// @Success 204 {string} string "No Content - Successfully deleted the currency"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while deleting the currency"
+// @Router /currencies/{currency_id} [delete]
+// @Security access_token
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "currency_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	err = h.service.Delete(r.Context(), int32(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCurrencyNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrCurrencyInUse):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to delete currency - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Delete currency - ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
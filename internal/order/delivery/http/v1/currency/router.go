@@ -0,0 +1,28 @@
+package currency
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyAdminMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Route("/currencies", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(jWTAccessMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware)
+
+			r.Post("/", h.Create)
+			r.Patch("/{currency_id}", h.Update)
+			r.Delete("/{currency_id}", h.Delete)
+		})
+
+		r.Get("/", h.List)
+		r.Get("/{currency_id}", h.Get)
+	})
+}
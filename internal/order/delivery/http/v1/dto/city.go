@@ -0,0 +1,16 @@
+package dto
+
+type (
+	City struct {
+		ID string `json:"id"`
+		CityInfo
+	} // @name City
+	CityInfo struct {
+		Name      string    `json:"name" validate:"required,min=1,max=120"`
+		Location  *Location `json:"location,omitempty" validate:"omitempty"`
+		Country   string    `json:"country" validate:"required,min=2,max=120"`
+		Iso2      string    `json:"iso2" validate:"required,len=2,alpha"`
+		AdminName string    `json:"admin_name,omitempty" validate:"omitempty,max=120"`
+		Capital   string    `json:"capital,omitempty" validate:"omitempty,oneof=PRIMARY ADMIN MINOR"`
+	} // @name CityInfo
+)
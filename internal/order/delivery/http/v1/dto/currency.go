@@ -0,0 +1,13 @@
+package dto
+
+type (
+	Currency struct {
+		ID string `json:"id"`
+		CurrencyInfo
+	} // @name Currency
+	CurrencyInfo struct {
+		Name   string `json:"name" validate:"required,min=2,max=100"`
+		Code   string `json:"code" validate:"required,min=2,max=10,alphanum"`
+		Symbol string `json:"symbol" validate:"required,min=1,max=10"`
+	} // @name CurrencyInfo
+)
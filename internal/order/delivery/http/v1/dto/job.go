@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type (
+	Job struct {
+		ID         string    `json:"id"`
+		OfferID    string    `json:"offer_id"`
+		OrderID    string    `json:"order_id"`
+		UserID     string    `json:"user_id"`
+		ProviderID string    `json:"provider_id"`
+		ServiceID  string    `json:"service_id"`
+		Status     string    `json:"status"`
+		CreatedAt  time.Time `json:"created_at"`
+		UpdatedAt  time.Time `json:"updated_at"`
+	} // @name Job
+	JobStatusChange struct {
+		Status    string    `json:"status"`
+		ChangedBy string    `json:"changed_by"`
+		CreatedAt time.Time `json:"created_at"`
+	} // @name JobStatusChange
+	UpdateJobStatus struct {
+		Status string `json:"status" validate:"required,oneof=PENDING PAUSED CANCELLED COMPLETED"`
+	} // @name UpdateJobStatus
+)
@@ -0,0 +1,6 @@
+package dto
+
+type Location struct {
+	Longitude float64 `json:"longitude" validate:"longitude"`
+	Latitude  float64 `json:"latitude" validate:"latitude"`
+} // @name Location
@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+type (
+	Offer struct {
+		ID         string `json:"id"`
+		ProviderID string `json:"provider_id"`
+		OrderID    string `json:"order_id"`
+		OfferInfo
+		Status    string    `json:"status"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	} // @name Offer
+	OfferInfo struct {
+		Price      float64   `json:"price" validate:"required,gt=0,lt=1000000"`
+		CurrencyID string    `json:"currency_id" validate:"required,number"`
+		BookTime   time.Time `json:"book_time" validate:"required"`
+	} // @name OfferInfo
+	CreateOffer struct {
+		OrderID string `json:"order_id" validate:"required,number"`
+		OfferInfo
+	} // @name CreateOffer
+)
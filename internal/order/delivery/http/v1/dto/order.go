@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type (
+	Order struct {
+		ID     string `json:"id"`
+		UserID string `json:"user_id"`
+		OrderInfo
+		Status    string    `json:"status"`
+		CreatedAt time.Time `json:"created_at"`
+	} // @name Order
+	OrderInfo struct {
+		ServiceID   string    `json:"service_id" validate:"required,number"`
+		Location    *Location `json:"location,omitempty" validate:"omitempty"`
+		TimeStart   time.Time `json:"time_start" validate:"required"`
+		TimeEnd     time.Time `json:"time_end" validate:"required,gtfield=TimeStart"`
+		Description string    `json:"description" validate:"required,min=10,max=2000"`
+	} // @name OrderInfo
+)
@@ -0,0 +1,20 @@
+package dto
+
+type (
+	ProviderLocation struct {
+		ProviderID string `json:"provider_id"`
+		ProviderLocationInfo
+	} // @name ProviderLocation
+	ProviderLocationInfo struct {
+		CityID   string    `json:"city_id" validate:"required,number"`
+		Location *Location `json:"location" validate:"required"`
+		Address  string    `json:"address" validate:"required,min=3,max=255"`
+	} // @name ProviderLocationInfo
+	NearbyProvider struct {
+		ProviderLocation
+		Distance float64 `json:"distance"`
+	} // @name NearbyProvider
+	ProviderId struct {
+		ProviderID string `json:"provider_id"`
+	} // @name ProviderId
+)
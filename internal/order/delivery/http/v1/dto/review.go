@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+type (
+	Review struct {
+		ID         string `json:"id"`
+		JobID      string `json:"job_id"`
+		UserID     string `json:"user_id"`
+		ProviderID string `json:"provider_id"`
+		ReviewInfo
+		CreatedAt time.Time `json:"created_at"`
+	} // @name Review
+	ReviewInfo struct {
+		Rating  float64 `json:"rating" validate:"min=0,max=5"`
+		Comment string  `json:"comment" validate:"max=2000"`
+	} // @name ReviewInfo
+	CreateReview struct {
+		JobID string `json:"job_id" validate:"required,number"`
+		ReviewInfo
+	} // @name CreateReview
+	ProviderRating struct {
+		ProviderID string  `json:"provider_id"`
+		Average    float64 `json:"average"`
+		Count      int32   `json:"count"`
+	} // @name ProviderRating
+	ProviderReviews struct {
+		ProviderRating
+		Reviews []Review `json:"reviews"`
+	} // @name ProviderReviews
+)
@@ -0,0 +1,317 @@
+package job
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.JobService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.JobService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Get
+// @Summary Retrieve a job by ID
+// @Description Retrieves a job specified by the ID, visible to its customer and assigned provider.
+// @Tags Job
+// @Param job_id path int true "The ID of the job to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Job] "OK - Successfully retrieved the job"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the job"
+// @Router /jobs/{job_id} [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "job_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	jobEntity, err := h.service.Get(r.Context(), id, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrJobNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get job - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch job - ID: %d, U-ID: %d", id, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapJobToDTO(jobEntity))
+}
+
+// ListStatusHistory
+// @Summary Retrieve job status history
+// @Description Retrieves every status change of the job in chronological order.
+// @Tags Job
+// @Param job_id path int true "Job id"
+// @Success 200 {object} rest.ApiResponse[[]dto.JobStatusChange] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the job history"
+// @Router /jobs/{job_id}/history [get]
+// @Security access_token
+func (h *Handler) ListStatusHistory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "job_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	history, err := h.service.ListStatusHistory(r.Context(), id, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrJobNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch job history - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch job history - ID: %d, %d", id, len(history))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapJobStatusHistoryToDTO(history))
+}
+
+// UpdateStatus
+// @Summary Change a job status
+// @Description Pauses, resumes, cancels or completes a job, only valid transitions are allowed.
+// @Tags Job
+// @Param job_id path int true "The ID of the job to update"
+// @Param dto body dto.UpdateJobStatus true "Job status"
+// @Success 200 {object} rest.ApiResponse[dto.Job] "OK - Successfully updated the job"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while updating the job"
+// @Router /jobs/{job_id} [patch]
+// @Security access_token
+func (h *Handler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "job_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var statusDTO dto.UpdateJobStatus
+	errResp = h.Binder.BindJSON(r, &statusDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(statusDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	status, err := domain.ParseStatus(statusDTO.Status)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update job due to wrong validation: %w", err))
+		return
+	}
+
+	jobEntity, err := h.service.UpdateStatus(r.Context(), id, userId, status)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrJobNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrJobNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrInvalidJobTransition):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update job - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Update job status - ID: %d, Status: %s, U-ID: %d", id, status, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapJobToDTO(jobEntity))
+}
+
+// ListByProviderId
+// @Summary Retrieve jobs of a provider
+// @Description Retrieves a range of jobs assigned to the provider
+// @Tags Job
+// @Param provider_id path int true "Provider id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Job] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving jobs"
+// @Router /providers/{provider_id}/jobs [get]
+// @Security access_token
+func (h *Handler) ListByProviderId(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+// ListMineAsProvider
+// @Summary Retrieve own jobs as a provider
+// @Description Retrieves a range of jobs assigned to the authenticated provider
+// @Tags Job
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Job] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving jobs"
+// @Router /providers/me/jobs [get]
+// @Security access_token
+func (h *Handler) ListMineAsProvider(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+func (h *Handler) listByProviderId(w http.ResponseWriter, r *http.Request, providerId int64) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	jobEntities, err := h.service.ListByProviderId(r.Context(), providerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch jobs - provider id: %d, error: %w", providerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch jobs - P-ID: %d, %d", providerId, len(jobEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapJobsToDTO(jobEntities))
+}
+
+// ListByCustomerId
+// @Summary Retrieve jobs of a customer
+// @Description Retrieves a range of jobs of the customer
+// @Tags Job
+// @Param customer_id path int true "Customer id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Job] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving jobs"
+// @Router /customers/{customer_id}/jobs [get]
+// @Security access_token
+func (h *Handler) ListByCustomerId(w http.ResponseWriter, r *http.Request) {
+	customerId, err := strconv.ParseInt(chi.URLParam(r, "customer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByCustomerId(w, r, customerId)
+}
+
+// ListMineAsCustomer
+// @Summary Retrieve own jobs as a customer
+// @Description Retrieves a range of jobs of the authenticated customer
+// @Tags Job
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Job] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving jobs"
+// @Router /customers/me/jobs [get]
+// @Security access_token
+func (h *Handler) ListMineAsCustomer(w http.ResponseWriter, r *http.Request) {
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByCustomerId(w, r, customerId)
+}
+
+func (h *Handler) listByCustomerId(w http.ResponseWriter, r *http.Request, customerId int64) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	jobEntities, err := h.service.ListByUserId(r.Context(), customerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch jobs - customer id: %d, error: %w", customerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch jobs - U-ID: %d, %d", customerId, len(jobEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapJobsToDTO(jobEntities))
+}
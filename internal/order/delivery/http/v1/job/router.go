@@ -0,0 +1,36 @@
+package job
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyCustomerMiddleware func(http.Handler) http.Handler,
+	onlyProviderMiddleware func(http.Handler) http.Handler,
+	onlyModeratorMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Route("/jobs/{job_id}", func(r chi.Router) {
+			r.Get("/", h.Get)
+			r.Patch("/", h.UpdateStatus)
+			r.Get("/history", h.ListStatusHistory)
+		})
+
+		r.With(onlyProviderMiddleware).Get("/providers/me/jobs", h.ListMineAsProvider)
+		r.With(onlyCustomerMiddleware).Get("/customers/me/jobs", h.ListMineAsCustomer)
+
+		r.Group(func(r chi.Router) {
+			r.Use(onlyModeratorMiddleware)
+
+			r.Get("/providers/{provider_id}/jobs", h.ListByProviderId)
+			r.Get("/customers/{customer_id}/jobs", h.ListByCustomerId)
+		})
+	})
+}
@@ -0,0 +1,354 @@
+package location
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+const (
+	defaultSearchRadius float64 = 10_000
+	maxSearchRadius     float64 = 100_000
+)
+
+var (
+	ErrInvalidLongitude = errors.New("longitude must be a number between -180 and 180")
+	ErrInvalidLatitude  = errors.New("latitude must be a number between -90 and 90")
+	ErrInvalidRadius    = errors.New("radius must be a positive number of meters up to 100000")
+	ErrInvalidServiceId = errors.New("invalid service id")
+
+	errNotFinite = errors.New("number is not finite")
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.ProviderLocationService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.ProviderLocationService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// SetMine
+// @Summary Set own provider location
+// @Description Creates or replaces the location of the authenticated provider.
+// @Tags Location
+// @Param dto body dto.ProviderLocationInfo true "Location data"
+// @Success 200 {object} rest.ApiResponse[dto.ProviderLocation] "OK - Successfully set the location"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while setting the location"
+// @Router /providers/me/location [patch]
+// @Security access_token
+func (h *Handler) SetMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var infoDTO dto.ProviderLocationInfo
+	errResp = h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapProviderLocationInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to set location due to wrong validation: %w", err))
+		return
+	}
+
+	locationEntity, err := h.service.Set(r.Context(), providerId, infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCityNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to set location - provider id: %d, error: %w", providerId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Set provider location - P-ID: %d, City-ID: %d", providerId, infoVO.CityID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapProviderLocationToDTO(locationEntity))
+}
+
+// GetMine
+// @Summary Retrieve own provider location
+// @Description Retrieves the location of the authenticated provider.
+// @Tags Location
+// @Success 200 {object} rest.ApiResponse[dto.ProviderLocation] "OK - Successfully retrieved the location"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the location"
+// @Router /providers/me/location [get]
+// @Security access_token
+func (h *Handler) GetMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.get(w, r, providerId)
+}
+
+// Get
+// @Summary Retrieve a provider location
+// @Description Retrieves the location of the provider specified by the ID.
+// @Tags Location
+// @Param provider_id path int true "Provider id"
+// @Success 200 {object} rest.ApiResponse[dto.ProviderLocation] "OK - Successfully retrieved the location"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the location"
+// @Router /providers/{provider_id}/location [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.get(w, r, providerId)
+}
+
+func (h *Handler) get(w http.ResponseWriter, r *http.Request, providerId int64) {
+	locationEntity, err := h.service.Get(r.Context(), providerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProviderLocationNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get location - provider id: %d, error: %w", providerId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch provider location - P-ID: %d", providerId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapProviderLocationToDTO(locationEntity))
+}
+
+// ListByCityId
+// @Summary Retrieve providers of a city
+// @Description Retrieves a range of provider ids located in the city
+// @Tags Location
+// @Param city_id path int true "City id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.ProviderId] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving providers"
+// @Router /cities/{city_id}/providers [get]
+// @Security access_token
+func (h *Handler) ListByCityId(w http.ResponseWriter, r *http.Request) {
+	cityId, err := strconv.ParseInt(chi.URLParam(r, "city_id"), 10, 32)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	providerIds, err := h.service.ListProviderIdsByCityId(r.Context(), int32(cityId), limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch providers - city id: %d, error: %w", cityId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch city providers - City-ID: %d, %d", cityId, len(providerIds))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapProviderIdsToDTO(providerIds))
+}
+
+// SearchNearby
+// @Summary Search providers near a point
+// @Description Retrieves a range of providers within the radius of the point, nearest first, optionally only the ones offering the service
+// @Tags Location
+// @Param longitude query number true "Longitude of the point"
+// @Param latitude query number true "Latitude of the point"
+// @Param radius query number false "Search radius in meters" default(10000) maximum(100000)
+// @Param service_id query int false "Service id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.NearbyProvider] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while searching providers"
+// @Router /providers/nearby [get]
+// @Security access_token
+func (h *Handler) SearchNearby(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	longitude, err := parseFiniteFloat(query.Get("longitude"))
+	if err != nil || longitude < -180 || longitude > 180 {
+		h.Writer.WriteError(w, rest.NewBadRequestError(ErrInvalidLongitude))
+		return
+	}
+
+	latitude, err := parseFiniteFloat(query.Get("latitude"))
+	if err != nil || latitude < -90 || latitude > 90 {
+		h.Writer.WriteError(w, rest.NewBadRequestError(ErrInvalidLatitude))
+		return
+	}
+
+	var serviceId *int32
+	if serviceParam := query.Get("service_id"); serviceParam != "" {
+		id, err := strconv.ParseInt(serviceParam, 10, 32)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewBadRequestError(ErrInvalidServiceId))
+			return
+		}
+		id32 := int32(id)
+		serviceId = &id32
+	}
+
+	radius, errResp := parseRadius(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	providers, err := h.service.SearchNearby(r.Context(), *domain.NewLocation(longitude, latitude), radius, serviceId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to search providers: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Search nearby providers - Radius: %.0f, %d", radius, len(providers))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapNearbyProvidersToDTO(providers))
+}
+
+// SearchNearbyOrder
+// @Summary Search providers near an order
+// @Description Retrieves a range of providers of the order service within the radius of the order location, nearest first
+// @Tags Location
+// @Param order_id path int true "Order id"
+// @Param radius query number false "Search radius in meters" default(10000) maximum(100000)
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.NearbyProvider] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while searching providers"
+// @Router /orders/{order_id}/providers [get]
+// @Security access_token
+func (h *Handler) SearchNearbyOrder(w http.ResponseWriter, r *http.Request) {
+	orderId, err := strconv.ParseInt(chi.URLParam(r, "order_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	radius, errResp := parseRadius(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	providers, err := h.service.SearchNearbyOrder(r.Context(), orderId, customerId, radius, limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOrderNoLocation):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to search providers - order id: %d, error: %w", orderId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Search order providers - Order-ID: %d, Radius: %.0f, %d", orderId, radius, len(providers))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapNearbyProvidersToDTO(providers))
+}
+
+// parseRadius parses the "radius" query parameter in meters, falling back to the default radius if it's missing.
+func parseRadius(r *http.Request) (float64, *rest.ErrorResponse) {
+	radiusParam := r.URL.Query().Get("radius")
+	if radiusParam == "" {
+		return defaultSearchRadius, nil
+	}
+
+	radius, err := parseFiniteFloat(radiusParam)
+	if err != nil || radius <= 0 || radius > maxSearchRadius {
+		return 0, rest.NewBadRequestError(ErrInvalidRadius)
+	}
+
+	return radius, nil
+}
+
+// parseFiniteFloat parses a float, rejecting NaN and infinities, which would slip through range checks.
+func parseFiniteFloat(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errNotFinite
+	}
+
+	return f, nil
+}
@@ -0,0 +1,59 @@
+package location_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/location"
+	mock_service "github.com/hexley21/fixup/internal/order/service/mock"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchNearby_NotFinite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	// the service must not be reached
+	h := location.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mock_validator.NewMockValidator(ctrl), json_writer.New(logger, jsonManager)),
+		mock_service.NewMockProviderLocationService(ctrl),
+		10,
+		50,
+	)
+
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"NaN Longitude", url.Values{"longitude": {"NaN"}, "latitude": {"41.7"}}},
+		{"Infinite Longitude", url.Values{"longitude": {"Inf"}, "latitude": {"41.7"}}},
+		{"NaN Latitude", url.Values{"longitude": {"44.8"}, "latitude": {"nan"}}},
+		{"Infinite Latitude", url.Values{"longitude": {"44.8"}, "latitude": {"-Inf"}}},
+		{"NaN Radius", url.Values{"longitude": {"44.8"}, "latitude": {"41.7"}, "radius": {"NaN"}}},
+		{"Infinite Radius", url.Values{"longitude": {"44.8"}, "latitude": {"41.7"}, "radius": {"+Inf"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Set("page", "1")
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+
+			h.SearchNearby(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
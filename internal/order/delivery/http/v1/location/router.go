@@ -0,0 +1,31 @@
+package location
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyCustomerMiddleware func(http.Handler) http.Handler,
+	onlyProviderMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Route("/providers/me/location", func(r chi.Router) {
+			r.Use(onlyProviderMiddleware)
+
+			r.Get("/", h.GetMine)
+			r.Patch("/", h.SetMine)
+		})
+
+		r.Get("/providers/nearby", h.SearchNearby)
+		r.Get("/providers/{provider_id}/location", h.Get)
+		r.Get("/cities/{city_id}/providers", h.ListByCityId)
+		r.With(onlyCustomerMiddleware).Get("/orders/{order_id}/providers", h.SearchNearbyOrder)
+	})
+}
@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapCityInfoToVO(infoDTO dto.CityInfo) (domain.CityInfo, error) {
+	var capital *domain.CapitalType
+	if infoDTO.Capital != "" {
+		c, err := domain.ParseCapitalType(infoDTO.Capital)
+		if err != nil {
+			return domain.CityInfo{}, err
+		}
+		capital = &c
+	}
+
+	return domain.NewCityInfo(
+		infoDTO.Name,
+		MapLocationToVO(infoDTO.Location),
+		infoDTO.Country,
+		strings.ToUpper(infoDTO.Iso2),
+		infoDTO.AdminName,
+		capital,
+	), nil
+}
+
+func MapCityToDTO(entity domain.City) dto.City {
+	var capital string
+	if entity.Info.Capital != nil {
+		capital = string(*entity.Info.Capital)
+	}
+
+	return dto.City{
+		ID: strconv.FormatInt(int64(entity.ID), 10),
+		CityInfo: dto.CityInfo{
+			Name:      entity.Info.Name,
+			Location:  MapLocationToDTO(entity.Info.Location),
+			Country:   entity.Info.Country,
+			Iso2:      entity.Info.Iso2,
+			AdminName: entity.Info.AdminName,
+			Capital:   capital,
+		},
+	}
+}
+
+func MapCitiesToDTO(entities []domain.City) []dto.City {
+	citiesDTO := make([]dto.City, len(entities))
+	for i, c := range entities {
+		citiesDTO[i] = MapCityToDTO(c)
+	}
+
+	return citiesDTO
+}
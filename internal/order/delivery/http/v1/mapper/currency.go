@@ -0,0 +1,33 @@
+package mapper
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapCurrencyInfoToVO(infoDTO dto.CurrencyInfo) domain.CurrencyInfo {
+	return domain.NewCurrencyInfo(infoDTO.Name, strings.ToUpper(infoDTO.Code), infoDTO.Symbol)
+}
+
+func MapCurrencyToDTO(entity domain.Currency) dto.Currency {
+	return dto.Currency{
+		ID: strconv.FormatInt(int64(entity.ID), 10),
+		CurrencyInfo: dto.CurrencyInfo{
+			Name:   entity.Info.Name,
+			Code:   entity.Info.Code,
+			Symbol: entity.Info.Symbol,
+		},
+	}
+}
+
+func MapCurrenciesToDTO(entities []domain.Currency) []dto.Currency {
+	currenciesDTO := make([]dto.Currency, len(entities))
+	for i, c := range entities {
+		currenciesDTO[i] = MapCurrencyToDTO(c)
+	}
+
+	return currenciesDTO
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapJobToDTO(entity domain.Job) dto.Job {
+	return dto.Job{
+		ID:         strconv.FormatInt(entity.ID, 10),
+		OfferID:    strconv.FormatInt(entity.OfferID, 10),
+		OrderID:    strconv.FormatInt(entity.OrderID, 10),
+		UserID:     strconv.FormatInt(entity.UserID, 10),
+		ProviderID: strconv.FormatInt(entity.ProviderID, 10),
+		ServiceID:  strconv.FormatInt(int64(entity.ServiceID), 10),
+		Status:     string(entity.Status),
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+	}
+}
+
+func MapJobsToDTO(entities []domain.Job) []dto.Job {
+	jobsDTO := make([]dto.Job, len(entities))
+	for i, j := range entities {
+		jobsDTO[i] = MapJobToDTO(j)
+	}
+
+	return jobsDTO
+}
+
+func MapJobStatusHistoryToDTO(history []domain.JobStatusChange) []dto.JobStatusChange {
+	historyDTO := make([]dto.JobStatusChange, len(history))
+	for i, h := range history {
+		historyDTO[i] = dto.JobStatusChange{
+			Status:    string(h.Status),
+			ChangedBy: strconv.FormatInt(h.ChangedBy, 10),
+			CreatedAt: h.CreatedAt,
+		}
+	}
+
+	return historyDTO
+}
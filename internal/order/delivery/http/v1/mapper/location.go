@@ -0,0 +1,25 @@
+package mapper
+
+import (
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapLocationToVO(locationDTO *dto.Location) *domain.Location {
+	if locationDTO == nil {
+		return nil
+	}
+
+	return domain.NewLocation(locationDTO.Longitude, locationDTO.Latitude)
+}
+
+func MapLocationToDTO(vo *domain.Location) *dto.Location {
+	if vo == nil {
+		return nil
+	}
+
+	return &dto.Location{
+		Longitude: vo.Longitude,
+		Latitude:  vo.Latitude,
+	}
+}
@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapOfferInfoToVO(infoDTO dto.OfferInfo) (domain.OfferInfo, error) {
+	currencyId, err := strconv.ParseInt(infoDTO.CurrencyID, 10, 32)
+	if err != nil {
+		return domain.OfferInfo{}, err
+	}
+
+	return domain.NewOfferInfo(infoDTO.Price, int32(currencyId), infoDTO.BookTime), nil
+}
+
+func MapOfferToDTO(entity domain.Offer) dto.Offer {
+	return dto.Offer{
+		ID:         strconv.FormatInt(entity.ID, 10),
+		ProviderID: strconv.FormatInt(entity.ProviderID, 10),
+		OrderID:    strconv.FormatInt(entity.OrderID, 10),
+		OfferInfo: dto.OfferInfo{
+			Price:      entity.Info.Price,
+			CurrencyID: strconv.FormatInt(int64(entity.Info.CurrencyID), 10),
+			BookTime:   entity.Info.BookTime,
+		},
+		Status:    string(entity.Status),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func MapOffersToDTO(entities []domain.Offer) []dto.Offer {
+	offersDTO := make([]dto.Offer, len(entities))
+	for i, o := range entities {
+		offersDTO[i] = MapOfferToDTO(o)
+	}
+
+	return offersDTO
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapOrderInfoToVO(infoDTO dto.OrderInfo) (domain.OrderInfo, error) {
+	serviceId, err := strconv.ParseInt(infoDTO.ServiceID, 10, 32)
+	if err != nil {
+		return domain.OrderInfo{}, err
+	}
+
+	return domain.NewOrderInfo(
+		int32(serviceId),
+		MapLocationToVO(infoDTO.Location),
+		infoDTO.TimeStart,
+		infoDTO.TimeEnd,
+		infoDTO.Description,
+	), nil
+}
+
+func MapOrderToDTO(entity domain.Order) dto.Order {
+	return dto.Order{
+		ID:     strconv.FormatInt(entity.ID, 10),
+		UserID: strconv.FormatInt(entity.UserID, 10),
+		OrderInfo: dto.OrderInfo{
+			ServiceID:   strconv.FormatInt(int64(entity.Info.ServiceID), 10),
+			Location:    MapLocationToDTO(entity.Info.Location),
+			TimeStart:   entity.Info.TimeStart,
+			TimeEnd:     entity.Info.TimeEnd,
+			Description: entity.Info.Description,
+		},
+		Status:    string(entity.Status),
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func MapOrdersToDTO(entities []domain.Order) []dto.Order {
+	ordersDTO := make([]dto.Order, len(entities))
+	for i, o := range entities {
+		ordersDTO[i] = MapOrderToDTO(o)
+	}
+
+	return ordersDTO
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapProviderLocationInfoToVO(infoDTO dto.ProviderLocationInfo) (domain.ProviderLocationInfo, error) {
+	cityId, err := strconv.ParseInt(infoDTO.CityID, 10, 32)
+	if err != nil {
+		return domain.ProviderLocationInfo{}, err
+	}
+
+	return domain.NewProviderLocationInfo(
+		int32(cityId),
+		*MapLocationToVO(infoDTO.Location),
+		infoDTO.Address,
+	), nil
+}
+
+func MapProviderLocationToDTO(entity domain.ProviderLocation) dto.ProviderLocation {
+	return dto.ProviderLocation{
+		ProviderID: strconv.FormatInt(entity.ProviderID, 10),
+		ProviderLocationInfo: dto.ProviderLocationInfo{
+			CityID:   strconv.FormatInt(int64(entity.Info.CityID), 10),
+			Location: MapLocationToDTO(&entity.Info.Location),
+			Address:  entity.Info.Address,
+		},
+	}
+}
+
+func MapNearbyProvidersToDTO(vos []domain.NearbyProvider) []dto.NearbyProvider {
+	providersDTO := make([]dto.NearbyProvider, len(vos))
+	for i, p := range vos {
+		providersDTO[i] = dto.NearbyProvider{
+			ProviderLocation: MapProviderLocationToDTO(p.ProviderLocation),
+			Distance:         p.Distance,
+		}
+	}
+
+	return providersDTO
+}
+
+func MapProviderIdsToDTO(ids []int64) []dto.ProviderId {
+	idsDTO := make([]dto.ProviderId, len(ids))
+	for i, id := range ids {
+		idsDTO[i] = dto.ProviderId{ProviderID: strconv.FormatInt(id, 10)}
+	}
+
+	return idsDTO
+}
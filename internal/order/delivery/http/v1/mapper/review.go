@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+func MapReviewInfoToVO(infoDTO dto.ReviewInfo) domain.ReviewInfo {
+	return domain.NewReviewInfo(infoDTO.Rating, infoDTO.Comment)
+}
+
+func MapReviewToDTO(entity domain.Review) dto.Review {
+	return dto.Review{
+		ID:         strconv.FormatInt(entity.ID, 10),
+		JobID:      strconv.FormatInt(entity.JobID, 10),
+		UserID:     strconv.FormatInt(entity.UserID, 10),
+		ProviderID: strconv.FormatInt(entity.ProviderID, 10),
+		ReviewInfo: dto.ReviewInfo{
+			Rating:  entity.Info.Rating,
+			Comment: entity.Info.Comment,
+		},
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func MapReviewsToDTO(entities []domain.Review) []dto.Review {
+	reviewsDTO := make([]dto.Review, len(entities))
+	for i, r := range entities {
+		reviewsDTO[i] = MapReviewToDTO(r)
+	}
+
+	return reviewsDTO
+}
+
+func MapProviderRatingToDTO(vo domain.ProviderRating) dto.ProviderRating {
+	return dto.ProviderRating{
+		ProviderID: strconv.FormatInt(vo.ProviderID, 10),
+		Average:    vo.Average,
+		Count:      vo.Count,
+	}
+}
+
+func MapProviderRatingsToDTO(vos []domain.ProviderRating) []dto.ProviderRating {
+	ratingsDTO := make([]dto.ProviderRating, len(vos))
+	for i, r := range vos {
+		ratingsDTO[i] = MapProviderRatingToDTO(r)
+	}
+
+	return ratingsDTO
+}
+
+func MapProviderReviewsToDTO(reviews []domain.Review, rating domain.ProviderRating) dto.ProviderReviews {
+	return dto.ProviderReviews{
+		ProviderRating: MapProviderRatingToDTO(rating),
+		Reviews:        MapReviewsToDTO(reviews),
+	}
+}
@@ -0,0 +1,415 @@
+package offer
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.OfferService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.OfferService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Submit
+// @Summary Submit an offer
+// @Description Submits an offer of the authenticated provider on an open order, the provider's phone number has to be verified.
+// @Tags Offer
+// @Param dto body dto.CreateOffer true "Offer data"
+// @Success 201 {object} rest.ApiResponse[dto.Offer] "Created - Successfully submitted the offer"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden - The phone number is not verified"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while submitting the offer"
+// @Router /offers [post]
+// @Security access_token
+func (h *Handler) Submit(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var createDTO dto.CreateOffer
+	errResp = h.Binder.BindJSON(r, &createDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(createDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	orderId, err := strconv.ParseInt(createDTO.OrderID, 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	infoVO, err := mapper.MapOfferInfoToVO(createDTO.OfferInfo)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to submit offer due to wrong validation: %w", err))
+		return
+	}
+
+	offerEntity, err := h.service.Submit(r.Context(), providerId, orderId, infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound) || errors.Is(err, service.ErrCurrencyNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOpen) || errors.Is(err, service.ErrOfferAlreadySubmitted):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to submit offer: %w", err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Submit offer - ID: %d, P-ID: %d, Order-ID: %d", offerEntity.ID, providerId, orderId)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapOfferToDTO(offerEntity))
+}
+
+// Get
+// @Summary Retrieve an offer by ID
+// @Description Retrieves an offer specified by the ID, visible to its provider and the customer of the order.
+// @Tags Offer
+// @Param offer_id path int true "The ID of the offer to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Offer] "OK - Successfully retrieved the offer"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the offer"
+// @Router /offers/{offer_id} [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "offer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	offerEntity, err := h.service.Get(r.Context(), id, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOfferNotFound) || errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOfferNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get offer - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch offer - ID: %d, U-ID: %d", id, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOfferToDTO(offerEntity))
+}
+
+// ListByOrderId
+// @Summary Retrieve offers of an order
+// @Description Retrieves a range of offers submitted on the authenticated customer's order
+// @Tags Offer
+// @Param order_id path int true "Order id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Offer] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving offers"
+// @Router /orders/{order_id}/offers [get]
+// @Security access_token
+func (h *Handler) ListByOrderId(w http.ResponseWriter, r *http.Request) {
+	orderId, err := strconv.ParseInt(chi.URLParam(r, "order_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	offerEntities, err := h.service.ListByOrderId(r.Context(), orderId, customerId, limit, offset)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch offers - order id: %d, error: %w", orderId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch offers - Order-ID: %d, %d", orderId, len(offerEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOffersToDTO(offerEntities))
+}
+
+// ListByProviderId
+// @Summary Retrieve offers of a provider
+// @Description Retrieves a range of offers submitted by the provider
+// @Tags Offer
+// @Param provider_id path int true "Provider id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Offer] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving offers"
+// @Router /providers/{provider_id}/offers [get]
+// @Security access_token
+func (h *Handler) ListByProviderId(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+// ListMine
+// @Summary Retrieve own offers
+// @Description Retrieves a range of offers submitted by the authenticated provider
+// @Tags Offer
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Offer] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving offers"
+// @Router /providers/me/offers [get]
+// @Security access_token
+func (h *Handler) ListMine(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+func (h *Handler) listByProviderId(w http.ResponseWriter, r *http.Request, providerId int64) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	offerEntities, err := h.service.ListByProviderId(r.Context(), providerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch offers - provider id: %d, error: %w", providerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch offers - P-ID: %d, %d", providerId, len(offerEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOffersToDTO(offerEntities))
+}
+
+// Revise
+// @Summary Revise an offer by ID
+// @Description Revises a pending offer of the authenticated provider.
+// @Tags Offer
+// @Param offer_id path int true "The ID of the offer to revise"
+// @Param dto body dto.OfferInfo true "Offer data"
+// @Success 200 {object} rest.ApiResponse[dto.Offer] "OK - Successfully revised the offer"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while revising the offer"
+// @Router /offers/{offer_id} [patch]
+// @Security access_token
+func (h *Handler) Revise(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "offer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var infoDTO dto.OfferInfo
+	errResp = h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapOfferInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to revise offer due to wrong validation: %w", err))
+		return
+	}
+
+	offerEntity, err := h.service.Revise(r.Context(), id, providerId, infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOfferNotFound) || errors.Is(err, service.ErrOrderNotFound) || errors.Is(err, service.ErrCurrencyNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOfferNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOfferNotPending) || errors.Is(err, service.ErrOrderNotOpen):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to revise offer - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Revise offer - ID: %d, P-ID: %d", id, providerId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOfferToDTO(offerEntity))
+}
+
+// Withdraw
+// @Summary Withdraw an offer by ID
+// @Description Withdraws a pending offer of the authenticated provider.
+// @Tags Offer
+// @Param offer_id path int true "The ID of the offer to withdraw"
+// @Success 204 {string} string "No Content - Successfully withdrew the offer"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while withdrawing the offer"
+// @Router /offers/{offer_id} [delete]
+// @Security access_token
+func (h *Handler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "offer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	err = h.service.Withdraw(r.Context(), id, providerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOfferNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOfferNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOfferNotPending):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to withdraw offer - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Withdraw offer - ID: %d, P-ID: %d", id, providerId)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// Accept
+// @Summary Accept an offer by ID
+// @Description Accepts a pending offer on the authenticated customer's order, competing offers get rejected and a job is created.
+// @Tags Offer
+// @Param offer_id path int true "The ID of the offer to accept"
+// @Success 201 {object} rest.ApiResponse[dto.Job] "Created - Successfully accepted the offer and created the job"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while accepting the offer"
+// @Router /offers/{offer_id}/accept [post]
+// @Security access_token
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "offer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	jobEntity, err := h.service.Accept(r.Context(), id, customerId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOfferNotFound) || errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOfferNotPending) || errors.Is(err, service.ErrOrderNotOpen):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to accept offer - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Accept offer - ID: %d, Order-ID: %d, Job-ID: %d, U-ID: %d", id, jobEntity.OrderID, jobEntity.ID, customerId)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapJobToDTO(jobEntity))
+}
@@ -0,0 +1,39 @@
+package offer
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyPhoneVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyCustomerMiddleware func(http.Handler) http.Handler,
+	onlyProviderMiddleware func(http.Handler) http.Handler,
+	onlyModeratorMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Route("/offers", func(r chi.Router) {
+			r.Group(func(r chi.Router) {
+				r.Use(onlyVerifiedMiddleware, onlyProviderMiddleware)
+
+				r.With(onlyPhoneVerifiedMiddleware).Post("/", h.Submit)
+				r.Patch("/{offer_id}", h.Revise)
+				r.Delete("/{offer_id}", h.Withdraw)
+			})
+
+			r.With(onlyVerifiedMiddleware, onlyCustomerMiddleware).Post("/{offer_id}/accept", h.Accept)
+			r.Get("/{offer_id}", h.Get)
+		})
+
+		r.With(onlyCustomerMiddleware).Get("/orders/{order_id}/offers", h.ListByOrderId)
+		r.With(onlyProviderMiddleware).Get("/providers/me/offers", h.ListMine)
+		r.With(onlyModeratorMiddleware).Get("/providers/{provider_id}/offers", h.ListByProviderId)
+	})
+}
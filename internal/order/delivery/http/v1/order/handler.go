@@ -0,0 +1,322 @@
+package order
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.OrderService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.OrderService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Create
+// @Summary Create a new order
+// @Description Places a new order on behalf of the authenticated customer.
+// @Tags Order
+// @Param dto body dto.OrderInfo true "Order data"
+// @Success 201 {object} rest.ApiResponse[dto.Order] "Created - Successfully created the order"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while creating the order"
+// @Router /orders [post]
+// @Security access_token
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var infoDTO dto.OrderInfo
+	errResp = h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapOrderInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create order due to wrong validation: %w", err))
+		return
+	}
+
+	orderEntity, err := h.service.Create(r.Context(), userId, infoVO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create order: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Create order - ID: %d, U-ID: %d, Service-ID: %d", orderEntity.ID, userId, infoVO.ServiceID)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapOrderToDTO(orderEntity))
+}
+
+// List
+// @Summary Retrieve pending orders
+// @Description Retrieves a range of orders which are still open for offers
+// @Tags Order
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Order] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving orders"
+// @Router /orders [get]
+// @Security access_token
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	orderEntities, err := h.service.List(r.Context(), limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch orders: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Fetch orders - %d", len(orderEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOrdersToDTO(orderEntities))
+}
+
+// ListByCustomerId
+// @Summary Retrieve orders of a customer
+// @Description Retrieves a range of orders placed by the customer
+// @Tags Order
+// @Param customer_id path int true "Customer id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Order] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving orders"
+// @Router /customers/{customer_id}/orders [get]
+// @Security access_token
+func (h *Handler) ListByCustomerId(w http.ResponseWriter, r *http.Request) {
+	customerId, err := strconv.ParseInt(chi.URLParam(r, "customer_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByUserId(w, r, customerId)
+}
+
+// ListMine
+// @Summary Retrieve own orders
+// @Description Retrieves a range of orders placed by the authenticated customer
+// @Tags Order
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Order] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving orders"
+// @Router /customers/me/orders [get]
+// @Security access_token
+func (h *Handler) ListMine(w http.ResponseWriter, r *http.Request) {
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByUserId(w, r, userId)
+}
+
+func (h *Handler) listByUserId(w http.ResponseWriter, r *http.Request, userId int64) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	orderEntities, err := h.service.ListByUserId(r.Context(), userId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch orders - user id: %d, error: %w", userId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch orders - U-ID: %d, %d", userId, len(orderEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOrdersToDTO(orderEntities))
+}
+
+// Get
+// @Summary Retrieve an order by ID
+// @Description Retrieves an order specified by the ID.
+// @Tags Order
+// @Param order_id path int true "The ID of the order to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Order] "OK - Successfully retrieved the order"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the order"
+// @Router /orders/{order_id} [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "order_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	orderEntity, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get order - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch order - ID: %d", orderEntity.ID)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOrderToDTO(orderEntity))
+}
+
+// Update
+// @Summary Update an order by ID
+// @Description Updates a pending order of the authenticated customer.
+// @Tags Order
+// @Param order_id path int true "The ID of the order to update"
+// @Param dto body dto.OrderInfo true "Order data"
+// @Success 200 {object} rest.ApiResponse[dto.Order] "OK - Successfully updated the order"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while updating the order"
+// @Router /orders/{order_id} [patch]
+// @Security access_token
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "order_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var infoDTO dto.OrderInfo
+	errResp = h.Binder.BindJSON(r, &infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(infoDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	infoVO, err := mapper.MapOrderInfoToVO(infoDTO)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update order due to wrong validation: %w", err))
+		return
+	}
+
+	orderEntity, err := h.service.Update(r.Context(), id, userId, infoVO)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOrderNotEditable):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to update order - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Update order - ID: %d, U-ID: %d", id, userId)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapOrderToDTO(orderEntity))
+}
+
+// Cancel
+// @Summary Cancel an order by ID
+// @Description Cancels a pending order of the authenticated customer.
+// @Tags Order
+// @Param order_id path int true "The ID of the order to cancel"
+// @Success 204 {string} string "No Content - Successfully cancelled the order"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while cancelling the order"
+// @Router /orders/{order_id} [delete]
+// @Security access_token
+func (h *Handler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "order_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	userId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	err = h.service.Cancel(r.Context(), id, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrOrderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrOrderNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrOrderNotEditable):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to cancel order - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Cancel order - ID: %d, U-ID: %d", id, userId)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
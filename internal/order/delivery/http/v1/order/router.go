@@ -0,0 +1,36 @@
+package order
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyCustomerMiddleware func(http.Handler) http.Handler,
+	onlyModeratorMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Route("/orders", func(r chi.Router) {
+			r.Group(func(r chi.Router) {
+				r.Use(onlyVerifiedMiddleware, onlyCustomerMiddleware)
+
+				r.Post("/", h.Create)
+				r.Patch("/{order_id}", h.Update)
+				r.Delete("/{order_id}", h.Cancel)
+			})
+
+			r.Get("/", h.List)
+			r.Get("/{order_id}", h.Get)
+		})
+
+		r.With(onlyCustomerMiddleware).Get("/customers/me/orders", h.ListMine)
+		r.With(onlyModeratorMiddleware).Get("/customers/{customer_id}/orders", h.ListByCustomerId)
+	})
+}
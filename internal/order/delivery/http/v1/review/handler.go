@@ -0,0 +1,271 @@
+package review
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.ReviewService
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	handlerComponents *handler.Components,
+	service service.ReviewService,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     handlerComponents,
+		service:        service,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// Create
+// @Summary Review a job
+// @Description Reviews a completed job of the authenticated customer, every job can be reviewed once.
+// @Tags Review
+// @Param dto body dto.CreateReview true "Review data"
+// @Success 201 {object} rest.ApiResponse[dto.Review] "Created - Successfully created the review"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while creating the review"
+// @Router /reviews [post]
+// @Security access_token
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var createDTO dto.CreateReview
+	errResp = h.Binder.BindJSON(r, &createDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	errResp = h.Validator.Validate(createDTO)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	jobId, err := strconv.ParseInt(createDTO.JobID, 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	reviewEntity, err := h.service.Create(r.Context(), jobId, customerId, mapper.MapReviewInfoToVO(createDTO.ReviewInfo))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidRating):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrJobNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrJobNotOwned):
+			h.Writer.WriteError(w, rest.NewForbiddenError(err))
+		case errors.Is(err, service.ErrJobNotCompleted) || errors.Is(err, service.ErrJobAlreadyReviewed):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to create review - job id: %d, error: %w", jobId, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Create review - ID: %d, Job-ID: %d, U-ID: %d", reviewEntity.ID, jobId, customerId)
+	h.Writer.WriteData(w, http.StatusCreated, mapper.MapReviewToDTO(reviewEntity))
+}
+
+// Get
+// @Summary Retrieve a review by ID
+// @Description Retrieves a review specified by the ID.
+// @Tags Review
+// @Param review_id path int true "The ID of the review to retrieve"
+// @Success 200 {object} rest.ApiResponse[dto.Review] "OK - Successfully retrieved the review"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving the review"
+// @Router /reviews/{review_id} [get]
+// @Security access_token
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "review_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	reviewEntity, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrReviewNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to get review - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Fetch review - ID: %d", id)
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapReviewToDTO(reviewEntity))
+}
+
+// ListByProviderId
+// @Summary Retrieve reviews of a provider
+// @Description Retrieves a range of reviews of the provider together with the average rating and review count
+// @Tags Review
+// @Param provider_id path int true "Provider id"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[dto.ProviderReviews] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving reviews"
+// @Router /providers/{provider_id}/reviews [get]
+// @Security access_token
+func (h *Handler) ListByProviderId(w http.ResponseWriter, r *http.Request) {
+	providerId, err := strconv.ParseInt(chi.URLParam(r, "provider_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+// ListMineAsProvider
+// @Summary Retrieve own reviews as a provider
+// @Description Retrieves a range of reviews of the authenticated provider together with the average rating and review count
+// @Tags Review
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[dto.ProviderReviews] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving reviews"
+// @Router /providers/me/reviews [get]
+// @Security access_token
+func (h *Handler) ListMineAsProvider(w http.ResponseWriter, r *http.Request) {
+	providerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.listByProviderId(w, r, providerId)
+}
+
+func (h *Handler) listByProviderId(w http.ResponseWriter, r *http.Request, providerId int64) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	reviewEntities, rating, err := h.service.ListByProviderId(r.Context(), providerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch reviews - provider id: %d, error: %w", providerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch reviews - P-ID: %d, %d", providerId, len(reviewEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapProviderReviewsToDTO(reviewEntities, rating))
+}
+
+// ListMineAsCustomer
+// @Summary Retrieve own reviews as a customer
+// @Description Retrieves a range of reviews written by the authenticated customer
+// @Tags Review
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.Review] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving reviews"
+// @Router /customers/me/reviews [get]
+// @Security access_token
+func (h *Handler) ListMineAsCustomer(w http.ResponseWriter, r *http.Request) {
+	customerId, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	reviewEntities, err := h.service.ListByUserId(r.Context(), customerId, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch reviews - customer id: %d, error: %w", customerId, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch reviews - U-ID: %d, %d", customerId, len(reviewEntities))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapReviewsToDTO(reviewEntities))
+}
+
+// ListProviderRatings
+// @Summary Retrieve provider ratings
+// @Description Retrieves a range of provider rating aggregates, sorted by the average rating or by the review count
+// @Tags Review
+// @Param sort query string false "Sort order" Enums(rating, reviews) default(rating)
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.ProviderRating] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error - An error occurred while retrieving provider ratings"
+// @Router /providers/ratings [get]
+// @Security access_token
+func (h *Handler) ListProviderRatings(w http.ResponseWriter, r *http.Request) {
+	sort := domain.RatingSortRATING
+	if sortParam := r.URL.Query().Get("sort"); sortParam != "" {
+		var err error
+		sort, err = domain.ParseRatingSort(sortParam)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+			return
+		}
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	ratings, err := h.service.ListProviderRatings(r.Context(), sort, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch provider ratings: %w", err))
+		return
+	}
+
+	h.Logger.Infof("Fetch provider ratings - Sort: %s, %d", sort, len(ratings))
+	h.Writer.WriteData(w, http.StatusOK, mapper.MapProviderRatingsToDTO(ratings))
+}
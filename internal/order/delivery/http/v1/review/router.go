@@ -0,0 +1,30 @@
+package review
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func MapRoutes(
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	onlyCustomerMiddleware func(http.Handler) http.Handler,
+	onlyProviderMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Group(func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Route("/reviews", func(r chi.Router) {
+			r.With(onlyVerifiedMiddleware, onlyCustomerMiddleware).Post("/", h.Create)
+			r.Get("/{review_id}", h.Get)
+		})
+
+		r.Get("/providers/ratings", h.ListProviderRatings)
+		r.Get("/providers/{provider_id}/reviews", h.ListByProviderId)
+		r.With(onlyProviderMiddleware).Get("/providers/me/reviews", h.ListMineAsProvider)
+		r.With(onlyCustomerMiddleware).Get("/customers/me/reviews", h.ListMineAsCustomer)
+	})
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/city"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/currency"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/job"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/location"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/offer"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/order"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1/review"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/http/handler"
+)
+
+type RouterArgs struct {
+	OrderService            service.OrderService
+	OfferService            service.OfferService
+	JobService              service.JobService
+	ReviewService           service.ReviewService
+	ProviderLocationService service.ProviderLocationService
+	CityService             service.CityService
+	CurrencyService         service.CurrencyService
+	Middleware              *middleware.Middleware
+	HandlerComponents       *handler.Components
+	AccessJWTManager        auth_jwt.Manager
+	PaginationConfig        *config.Pagination
+}
+
+func MapV1Routes(args RouterArgs, router chi.Router) {
+	accessJWTMiddleware := args.Middleware.NewJWT(args.AccessJWTManager)
+	onlyVerifiedMiddleware := args.Middleware.NewAllowVerified(true)
+	onlyPhoneVerifiedMiddleware := args.Middleware.NewAllowPhoneVerified()
+	onlyCustomerMiddleware := args.Middleware.NewAllowRoles(enum.UserRoleCUSTOMER)
+	onlyProviderMiddleware := args.Middleware.NewAllowRoles(enum.UserRolePROVIDER)
+	onlyModeratorMiddleware := args.Middleware.NewAllowRoles(enum.UserRoleMODERATOR, enum.UserRoleADMIN)
+	onlyAdminMiddleware := args.Middleware.NewAllowRoles(enum.UserRoleADMIN)
+
+	orderHandler := order.NewHandler(
+		args.HandlerComponents,
+		args.OrderService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	offerHandler := offer.NewHandler(
+		args.HandlerComponents,
+		args.OfferService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	jobHandler := job.NewHandler(
+		args.HandlerComponents,
+		args.JobService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	reviewHandler := review.NewHandler(
+		args.HandlerComponents,
+		args.ReviewService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	locationHandler := location.NewHandler(
+		args.HandlerComponents,
+		args.ProviderLocationService,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	cityHandler := city.NewHandler(
+		args.HandlerComponents,
+		args.CityService,
+		args.PaginationConfig.XLargePages,
+		args.PaginationConfig.XXLargePages,
+	)
+
+	currencyHandler := currency.NewHandler(
+		args.HandlerComponents,
+		args.CurrencyService,
+		args.PaginationConfig.XLargePages,
+		args.PaginationConfig.XXLargePages,
+	)
+
+	router.Route("/v1", func(r chi.Router) {
+		order.MapRoutes(orderHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyCustomerMiddleware, onlyModeratorMiddleware, r)
+		offer.MapRoutes(offerHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyPhoneVerifiedMiddleware, onlyCustomerMiddleware, onlyProviderMiddleware, onlyModeratorMiddleware, r)
+		job.MapRoutes(jobHandler, accessJWTMiddleware, onlyCustomerMiddleware, onlyProviderMiddleware, onlyModeratorMiddleware, r)
+		review.MapRoutes(reviewHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyCustomerMiddleware, onlyProviderMiddleware, r)
+		location.MapRoutes(locationHandler, accessJWTMiddleware, onlyCustomerMiddleware, onlyProviderMiddleware, r)
+		city.MapRoutes(cityHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, r)
+		currency.MapRoutes(currencyHandler, accessJWTMiddleware, onlyVerifiedMiddleware, onlyAdminMiddleware, r)
+	})
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidCapitalType = errors.New("invalid capital type")
+)
+
+type CapitalType string
+
+const (
+	CapitalTypePRIMARY CapitalType = "PRIMARY"
+	CapitalTypeADMIN   CapitalType = "ADMIN"
+	CapitalTypeMINOR   CapitalType = "MINOR"
+)
+
+func (e CapitalType) Valid() bool {
+	switch e {
+	case CapitalTypePRIMARY,
+		CapitalTypeADMIN,
+		CapitalTypeMINOR:
+		return true
+	}
+	return false
+}
+
+func ParseCapitalType(s string) (CapitalType, error) {
+	capital := CapitalType(s)
+	if !capital.Valid() {
+		return "", ErrInvalidCapitalType
+	}
+
+	return capital, nil
+}
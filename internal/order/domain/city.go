@@ -0,0 +1,47 @@
+package domain
+
+type (
+	City struct {
+		ID   int32
+		Info CityInfo
+	} // City Domain Entity
+	CityInfo struct {
+		Name      string
+		Location  *Location
+		Country   string
+		Iso2      string
+		AdminName string
+		Capital   *CapitalType
+	} // City info Value Object
+	CityFilter struct {
+		Country    string
+		Iso2       string
+		NamePrefix string
+	} // City search filter Value Object, empty fields are ignored
+)
+
+func NewCity(id int32, info CityInfo) City {
+	return City{
+		ID:   id,
+		Info: info,
+	}
+}
+
+func NewCityInfo(name string, location *Location, country string, iso2 string, adminName string, capital *CapitalType) CityInfo {
+	return CityInfo{
+		Name:      name,
+		Location:  location,
+		Country:   country,
+		Iso2:      iso2,
+		AdminName: adminName,
+		Capital:   capital,
+	}
+}
+
+func NewCityFilter(country string, iso2 string, namePrefix string) CityFilter {
+	return CityFilter{
+		Country:    country,
+		Iso2:       iso2,
+		NamePrefix: namePrefix,
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+type (
+	Currency struct {
+		ID   int32
+		Info CurrencyInfo
+	} // Currency Domain Entity
+	CurrencyInfo struct {
+		Name   string
+		Code   string
+		Symbol string
+	} // Currency info Value Object
+)
+
+func NewCurrency(id int32, info CurrencyInfo) Currency {
+	return Currency{
+		ID:   id,
+		Info: info,
+	}
+}
+
+func NewCurrencyInfo(name string, code string, symbol string) CurrencyInfo {
+	return CurrencyInfo{
+		Name:   name,
+		Code:   code,
+		Symbol: symbol,
+	}
+}
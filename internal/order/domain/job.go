@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+type (
+	Job struct {
+		ID         int64
+		OfferID    int64
+		OrderID    int64
+		UserID     int64
+		ProviderID int64
+		ServiceID  int32
+		Status     Status
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+	} // Job Domain Entity
+	JobStatusChange struct {
+		Status    Status
+		ChangedBy int64
+		CreatedAt time.Time
+	} // Job status change Value Object
+)
+
+func NewJob(
+	id int64,
+	offerID int64,
+	orderID int64,
+	userID int64,
+	providerID int64,
+	serviceID int32,
+	status Status,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Job {
+	return Job{
+		ID:         id,
+		OfferID:    offerID,
+		OrderID:    orderID,
+		UserID:     userID,
+		ProviderID: providerID,
+		ServiceID:  serviceID,
+		Status:     status,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func NewJobStatusChange(status Status, changedBy int64, createdAt time.Time) JobStatusChange {
+	return JobStatusChange{
+		Status:    status,
+		ChangedBy: changedBy,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+type Location struct {
+	Longitude float64
+	Latitude  float64
+} // Geographic point Value Object
+
+func NewLocation(longitude float64, latitude float64) *Location {
+	return &Location{
+		Longitude: longitude,
+		Latitude:  latitude,
+	}
+}
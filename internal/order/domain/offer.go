@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type (
+	Offer struct {
+		ID         int64
+		ProviderID int64
+		OrderID    int64
+		Info       OfferInfo
+		Status     OfferStatus
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+	} // Offer Domain Entity
+	OfferInfo struct {
+		Price      float64
+		CurrencyID int32
+		BookTime   time.Time
+	} // Offer info Value Object
+)
+
+func NewOffer(id int64, providerID int64, orderID int64, info OfferInfo, status OfferStatus, createdAt time.Time, updatedAt time.Time) Offer {
+	return Offer{
+		ID:         id,
+		ProviderID: providerID,
+		OrderID:    orderID,
+		Info:       info,
+		Status:     status,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func NewOfferInfo(price float64, currencyID int32, bookTime time.Time) OfferInfo {
+	return OfferInfo{
+		Price:      price,
+		CurrencyID: currencyID,
+		BookTime:   bookTime,
+	}
+}
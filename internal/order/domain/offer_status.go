@@ -0,0 +1,21 @@
+package domain
+
+type OfferStatus string
+
+const (
+	OfferStatusPENDING   OfferStatus = "PENDING"
+	OfferStatusACCEPTED  OfferStatus = "ACCEPTED"
+	OfferStatusREJECTED  OfferStatus = "REJECTED"
+	OfferStatusWITHDRAWN OfferStatus = "WITHDRAWN"
+)
+
+func (e OfferStatus) Valid() bool {
+	switch e {
+	case OfferStatusPENDING,
+		OfferStatusACCEPTED,
+		OfferStatusREJECTED,
+		OfferStatusWITHDRAWN:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type (
+	Order struct {
+		ID        int64
+		UserID    int64
+		Info      OrderInfo
+		Status    Status
+		CreatedAt time.Time
+	} // Order Domain Entity
+	OrderInfo struct {
+		ServiceID   int32
+		Location    *Location
+		TimeStart   time.Time
+		TimeEnd     time.Time
+		Description string
+	} // Order info Value Object
+)
+
+func NewOrder(id int64, userID int64, info OrderInfo, status Status, createdAt time.Time) Order {
+	return Order{
+		ID:        id,
+		UserID:    userID,
+		Info:      info,
+		Status:    status,
+		CreatedAt: createdAt,
+	}
+}
+
+func NewOrderInfo(serviceID int32, location *Location, timeStart time.Time, timeEnd time.Time, description string) OrderInfo {
+	return OrderInfo{
+		ServiceID:   serviceID,
+		Location:    location,
+		TimeStart:   timeStart,
+		TimeEnd:     timeEnd,
+		Description: description,
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+type (
+	ProviderLocation struct {
+		ProviderID int64
+		Info       ProviderLocationInfo
+	} // Provider location Domain Entity
+	ProviderLocationInfo struct {
+		CityID   int32
+		Location Location
+		Address  string
+	} // Provider location info Value Object
+	NearbyProvider struct {
+		ProviderLocation
+		Distance float64
+	} // Provider found by a radius search, distance is in meters
+)
+
+func NewProviderLocation(providerID int64, info ProviderLocationInfo) ProviderLocation {
+	return ProviderLocation{
+		ProviderID: providerID,
+		Info:       info,
+	}
+}
+
+func NewProviderLocationInfo(cityID int32, location Location, address string) ProviderLocationInfo {
+	return ProviderLocationInfo{
+		CityID:   cityID,
+		Location: location,
+		Address:  address,
+	}
+}
+
+func NewNearbyProvider(location ProviderLocation, distance float64) NearbyProvider {
+	return NearbyProvider{
+		ProviderLocation: location,
+		Distance:         distance,
+	}
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidRatingSort = errors.New("invalid rating sort")
+)
+
+type RatingSort string
+
+const (
+	RatingSortRATING  RatingSort = "rating"
+	RatingSortREVIEWS RatingSort = "reviews"
+)
+
+func (e RatingSort) Valid() bool {
+	switch e {
+	case RatingSortRATING,
+		RatingSortREVIEWS:
+		return true
+	}
+	return false
+}
+
+func ParseRatingSort(s string) (RatingSort, error) {
+	sort := RatingSort(s)
+	if !sort.Valid() {
+		return "", ErrInvalidRatingSort
+	}
+
+	return sort, nil
+}
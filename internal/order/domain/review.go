@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var (
+	ErrInvalidRating = errors.New("rating must be between 0 and 5 in half-star steps")
+)
+
+type (
+	Review struct {
+		ID         int64
+		JobID      int64
+		UserID     int64
+		ProviderID int64
+		Info       ReviewInfo
+		CreatedAt  time.Time
+	} // Review Domain Entity
+	ReviewInfo struct {
+		Rating  float64
+		Comment string
+	} // Review info Value Object
+	ProviderRating struct {
+		ProviderID int64
+		Average    float64
+		Count      int32
+	} // Provider rating aggregate Value Object
+)
+
+func NewReview(id int64, jobID int64, userID int64, providerID int64, info ReviewInfo, createdAt time.Time) Review {
+	return Review{
+		ID:         id,
+		JobID:      jobID,
+		UserID:     userID,
+		ProviderID: providerID,
+		Info:       info,
+		CreatedAt:  createdAt,
+	}
+}
+
+func NewReviewInfo(rating float64, comment string) ReviewInfo {
+	return ReviewInfo{
+		Rating:  rating,
+		Comment: comment,
+	}
+}
+
+func NewProviderRating(providerID int64, average float64, count int32) ProviderRating {
+	return ProviderRating{
+		ProviderID: providerID,
+		Average:    average,
+		Count:      count,
+	}
+}
+
+// ValidRating reports whether the rating is between 0 and 5 in half-star steps.
+func ValidRating(rating float64) bool {
+	return rating >= 0 && rating <= 5 && rating*2 == math.Trunc(rating*2)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidStatus = errors.New("invalid status")
+)
+
+type Status string
+
+const (
+	StatusPENDING   Status = "PENDING"
+	StatusPAUSED    Status = "PAUSED"
+	StatusCANCELLED Status = "CANCELLED"
+	StatusCOMPLETED Status = "COMPLETED"
+)
+
+func (e Status) Valid() bool {
+	switch e {
+	case StatusPENDING,
+		StatusPAUSED,
+		StatusCANCELLED,
+		StatusCOMPLETED:
+		return true
+	}
+	return false
+}
+
+func ParseStatus(s string) (Status, error) {
+	status := Status(s)
+	if !status.Valid() {
+		return "", ErrInvalidStatus
+	}
+
+	return status, nil
+}
+
+// transitions lists the statuses reachable from each status,
+// CANCELLED and COMPLETED are terminal.
+var transitions = map[Status][]Status{
+	StatusPENDING: {StatusPAUSED, StatusCANCELLED, StatusCOMPLETED},
+	StatusPAUSED:  {StatusPENDING, StatusCANCELLED},
+}
+
+// CanTransitionTo reports whether the status may be changed to the next one.
+func (e Status) CanTransitionTo(next Status) bool {
+	for _, s := range transitions[e] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/gocql/gocql"
+)
+
+var errChannelIdNotScanned = errors.New("existing channel id was not scanned")
+
+// ChannelRepository writes chat channels to the chat keyspace,
+// it can't take part in order database transactions.
+type ChannelRepository interface {
+	CreateDirect(ctx context.Context, orderID int64, customerID int64, providerID int64) (int64, error)
+}
+
+type cassandraChannelRepository struct {
+	session   *gocql.Session
+	snowflake *snowflake.Node
+}
+
+func NewChannelRepository(session *gocql.Session, snowflake *snowflake.Node) *cassandraChannelRepository {
+	return &cassandraChannelRepository{
+		session,
+		snowflake,
+	}
+}
+
+const claimOrderChannel = `
+INSERT INTO order_channels (order_id, provider_id, channel_id) VALUES (?, ?, ?) IF NOT EXISTS
+`
+
+const createChannel = `
+INSERT INTO channels (channel_id, order_id, customer_id, provider_id) VALUES (?, ?, ?, ?)
+`
+
+const createChannelMember = `
+INSERT INTO user_channels (user_id, channel_id) VALUES (?, ?)
+`
+
+// CreateDirect creates a direct channel between the customer and the provider of the order and returns its id.
+// The channel id is claimed per order and provider, so if the channel already exists, its id is returned.
+// Channel and membership rows are written on every call, so a partially created channel is completed on retry.
+func (r *cassandraChannelRepository) CreateDirect(ctx context.Context, orderID int64, customerID int64, providerID int64) (int64, error) {
+	channelID := r.snowflake.Generate().Int64()
+
+	existing := map[string]any{}
+	applied, err := r.session.Query(claimOrderChannel, orderID, providerID, channelID).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return 0, err
+	}
+	if !applied {
+		id, ok := existing["channel_id"].(int64)
+		if !ok {
+			return 0, errChannelIdNotScanned
+		}
+		channelID = id
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(createChannel, channelID, orderID, customerID, providerID)
+	batch.Query(createChannelMember, customerID, channelID)
+	batch.Query(createChannelMember, providerID, channelID)
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return 0, err
+	}
+
+	return channelID, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CityRepository interface {
+	postgres.Repository[CityRepository]
+	Create(ctx context.Context, info domain.CityInfo) (CityModel, error)
+	Get(ctx context.Context, id int32) (CityModel, error)
+	List(ctx context.Context, filter domain.CityFilter, limit int64, offset int64) ([]CityModel, error)
+	Update(ctx context.Context, id int32, info domain.CityInfo) (CityModel, error)
+	Delete(ctx context.Context, id int32) (bool, error)
+}
+
+type postgresCityRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewCityRepository(dbtx postgres.PGXQuerier) *postgresCityRepository {
+	return &postgresCityRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresCityRepository) WithTx(tx postgres.PGXQuerier) CityRepository {
+	return NewCityRepository(tx)
+}
+
+const createCity = `-- name: CreateCity :one
+INSERT INTO cities (
+  name, location, country, iso2, admin_name, capital
+) VALUES (
+  $1, ST_SetSRID(ST_MakePoint($6, $7), 4326)::geography, $2, $3, $4, $5
+)
+RETURNING city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+`
+
+func (r *postgresCityRepository) Create(ctx context.Context, info domain.CityInfo) (CityModel, error) {
+	longitude, latitude := locationParams(info.Location)
+	adminName, capital := cityParams(info)
+
+	row := r.db.QueryRow(ctx, createCity,
+		info.Name,
+		info.Country,
+		info.Iso2,
+		adminName,
+		capital,
+		longitude,
+		latitude,
+	)
+	return scanCity(row)
+}
+
+const getCity = `-- name: GetCity :one
+SELECT city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+FROM cities WHERE city_id = $1
+`
+
+func (r *postgresCityRepository) Get(ctx context.Context, id int32) (CityModel, error) {
+	return scanCity(r.db.QueryRow(ctx, getCity, id))
+}
+
+const listCities = `-- name: ListCities :many
+SELECT city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+FROM cities
+WHERE ($3::TEXT IS NULL OR lower(country) = lower($3))
+  AND ($4::TEXT IS NULL OR iso2 = upper($4))
+  AND ($5::TEXT IS NULL OR lower(name) LIKE lower($5) || '%')
+ORDER BY name, city_id LIMIT $1 OFFSET $2
+`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// List retrieves cities matching the filter ordered by name, empty filter fields are ignored.
+func (r *postgresCityRepository) List(ctx context.Context, filter domain.CityFilter, limit int64, offset int64) ([]CityModel, error) {
+	rows, err := r.db.Query(ctx, listCities,
+		limit,
+		offset,
+		pgtype.Text{String: filter.Country, Valid: filter.Country != ""},
+		pgtype.Text{String: filter.Iso2, Valid: filter.Iso2 != ""},
+		pgtype.Text{String: likeEscaper.Replace(filter.NamePrefix), Valid: filter.NamePrefix != ""},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []CityModel
+	for rows.Next() {
+		i, err := scanCity(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCity = `-- name: UpdateCity :one
+UPDATE cities
+SET name = $2, location = ST_SetSRID(ST_MakePoint($7, $8), 4326)::geography, country = $3, iso2 = $4, admin_name = $5, capital = $6
+WHERE city_id = $1
+RETURNING city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+`
+
+func (r *postgresCityRepository) Update(ctx context.Context, id int32, info domain.CityInfo) (CityModel, error) {
+	longitude, latitude := locationParams(info.Location)
+	adminName, capital := cityParams(info)
+
+	row := r.db.QueryRow(ctx, updateCity,
+		id,
+		info.Name,
+		info.Country,
+		info.Iso2,
+		adminName,
+		capital,
+		longitude,
+		latitude,
+	)
+	return scanCity(row)
+}
+
+const deleteCity = `-- name: DeleteCity :exec
+DELETE FROM cities WHERE city_id = $1
+`
+
+func (r *postgresCityRepository) Delete(ctx context.Context, id int32) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteCity, id)
+	return result.RowsAffected() > 0, err
+}
+
+func cityParams(info domain.CityInfo) (pgtype.Text, pgtype.Text) {
+	capital := pgtype.Text{}
+	if info.Capital != nil {
+		capital = pgtype.Text{String: string(*info.Capital), Valid: true}
+	}
+
+	return pgtype.Text{String: info.AdminName, Valid: info.AdminName != ""}, capital
+}
+
+func scanCity(row pgx.Row) (CityModel, error) {
+	var i CityModel
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Longitude,
+		&i.Latitude,
+		&i.Country,
+		&i.Iso2,
+		&i.AdminName,
+		&i.Capital,
+	)
+	return i, err
+}
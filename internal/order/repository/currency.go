@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type CurrencyRepository interface {
+	postgres.Repository[CurrencyRepository]
+	Create(ctx context.Context, info domain.CurrencyInfo) (CurrencyModel, error)
+	Get(ctx context.Context, id int32) (CurrencyModel, error)
+	List(ctx context.Context, limit int64, offset int64) ([]CurrencyModel, error)
+	Update(ctx context.Context, id int32, info domain.CurrencyInfo) (CurrencyModel, error)
+	Delete(ctx context.Context, id int32) (bool, error)
+}
+
+type postgresCurrencyRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewCurrencyRepository(dbtx postgres.PGXQuerier) *postgresCurrencyRepository {
+	return &postgresCurrencyRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresCurrencyRepository) WithTx(tx postgres.PGXQuerier) CurrencyRepository {
+	return NewCurrencyRepository(tx)
+}
+
+const createCurrency = `-- name: CreateCurrency :one
+INSERT INTO currencies (currency, code, symbol) VALUES ($1, $2, $3)
+RETURNING id, currency, code, symbol
+`
+
+func (r *postgresCurrencyRepository) Create(ctx context.Context, info domain.CurrencyInfo) (CurrencyModel, error) {
+	return scanCurrency(r.db.QueryRow(ctx, createCurrency, info.Name, info.Code, info.Symbol))
+}
+
+const getCurrency = `-- name: GetCurrency :one
+SELECT id, currency, code, symbol FROM currencies WHERE id = $1
+`
+
+func (r *postgresCurrencyRepository) Get(ctx context.Context, id int32) (CurrencyModel, error) {
+	return scanCurrency(r.db.QueryRow(ctx, getCurrency, id))
+}
+
+const listCurrencies = `-- name: ListCurrencies :many
+SELECT id, currency, code, symbol FROM currencies ORDER BY code LIMIT $1 OFFSET $2
+`
+
+func (r *postgresCurrencyRepository) List(ctx context.Context, limit int64, offset int64) ([]CurrencyModel, error) {
+	rows, err := r.db.Query(ctx, listCurrencies, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []CurrencyModel
+	for rows.Next() {
+		i, err := scanCurrency(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCurrency = `-- name: UpdateCurrency :one
+UPDATE currencies SET currency = $2, code = $3, symbol = $4 WHERE id = $1
+RETURNING id, currency, code, symbol
+`
+
+func (r *postgresCurrencyRepository) Update(ctx context.Context, id int32, info domain.CurrencyInfo) (CurrencyModel, error) {
+	return scanCurrency(r.db.QueryRow(ctx, updateCurrency, id, info.Name, info.Code, info.Symbol))
+}
+
+const deleteCurrency = `-- name: DeleteCurrency :exec
+DELETE FROM currencies WHERE id = $1
+`
+
+func (r *postgresCurrencyRepository) Delete(ctx context.Context, id int32) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteCurrency, id)
+	return result.RowsAffected() > 0, err
+}
+
+func scanCurrency(row pgx.Row) (CurrencyModel, error) {
+	var i CurrencyModel
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Code,
+		&i.Symbol,
+	)
+	return i, err
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type JobRepository interface {
+	postgres.Repository[JobRepository]
+	Create(ctx context.Context, arg CreateJobParams) (JobModel, error)
+	Get(ctx context.Context, id int64) (JobModel, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]JobModel, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]JobModel, error)
+	UpdateStatus(ctx context.Context, id int64, from domain.Status, to domain.Status) (JobModel, error)
+	CreateStatusHistory(ctx context.Context, jobID int64, status domain.Status, changedBy int64) error
+	ListStatusHistory(ctx context.Context, jobID int64) ([]JobStatusHistoryModel, error)
+}
+
+type postgresJobRepository struct {
+	db        postgres.PGXQuerier
+	snowflake *snowflake.Node
+}
+
+func NewJobRepository(dbtx postgres.PGXQuerier, snowflake *snowflake.Node) *postgresJobRepository {
+	return &postgresJobRepository{
+		dbtx,
+		snowflake,
+	}
+}
+
+func (r *postgresJobRepository) WithTx(tx postgres.PGXQuerier) JobRepository {
+	return NewJobRepository(tx, r.snowflake)
+}
+
+const createJob = `-- name: CreateJob :one
+INSERT INTO jobs (
+  id, offer_id, order_id, user_id, provider_id, service_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+`
+
+type CreateJobParams struct {
+	OfferID    int64
+	OrderID    int64
+	UserID     int64
+	ProviderID int64
+	ServiceID  int32
+}
+
+func (r *postgresJobRepository) Create(ctx context.Context, arg CreateJobParams) (JobModel, error) {
+	row := r.db.QueryRow(ctx, createJob,
+		r.snowflake.Generate(),
+		arg.OfferID,
+		arg.OrderID,
+		arg.UserID,
+		arg.ProviderID,
+		arg.ServiceID,
+	)
+	return scanJob(row)
+}
+
+const getJob = `-- name: GetJob :one
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE id = $1
+`
+
+func (r *postgresJobRepository) Get(ctx context.Context, id int64) (JobModel, error) {
+	return scanJob(r.db.QueryRow(ctx, getJob, id))
+}
+
+const listJobsByProviderId = `-- name: ListJobsByProviderId :many
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresJobRepository) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]JobModel, error) {
+	rows, err := r.db.Query(ctx, listJobsByProviderId, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanJobs(rows)
+}
+
+const listJobsByUserId = `-- name: ListJobsByUserId :many
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresJobRepository) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]JobModel, error) {
+	rows, err := r.db.Query(ctx, listJobsByUserId, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanJobs(rows)
+}
+
+const updateJobStatus = `-- name: UpdateJobStatus :one
+UPDATE jobs SET status = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = $2
+RETURNING id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+`
+
+// UpdateStatus changes the job status only if it still matches the expected one,
+// returns pgx.ErrNoRows if the job is missing or its status was changed in the meantime.
+func (r *postgresJobRepository) UpdateStatus(ctx context.Context, id int64, from domain.Status, to domain.Status) (JobModel, error) {
+	return scanJob(r.db.QueryRow(ctx, updateJobStatus, id, string(from), string(to)))
+}
+
+const createJobStatusHistory = `-- name: CreateJobStatusHistory :exec
+INSERT INTO job_status_history (job_id, status, changed_by) VALUES ($1, $2, $3)
+`
+
+func (r *postgresJobRepository) CreateStatusHistory(ctx context.Context, jobID int64, status domain.Status, changedBy int64) error {
+	_, err := r.db.Exec(ctx, createJobStatusHistory, jobID, string(status), changedBy)
+	return err
+}
+
+const listJobStatusHistory = `-- name: ListJobStatusHistory :many
+SELECT id, job_id, status, changed_by, created_at
+FROM job_status_history WHERE job_id = $1 ORDER BY id
+`
+
+func (r *postgresJobRepository) ListStatusHistory(ctx context.Context, jobID int64) ([]JobStatusHistoryModel, error) {
+	rows, err := r.db.Query(ctx, listJobStatusHistory, jobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []JobStatusHistoryModel
+	for rows.Next() {
+		var i JobStatusHistoryModel
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobID,
+			&i.Status,
+			&i.ChangedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func scanJob(row pgx.Row) (JobModel, error) {
+	var i JobModel
+	err := row.Scan(
+		&i.ID,
+		&i.OfferID,
+		&i.OrderID,
+		&i.UserID,
+		&i.ProviderID,
+		&i.ServiceID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+func scanJobs(rows pgx.Rows) ([]JobModel, error) {
+	defer rows.Close()
+	var items []JobModel
+	for rows.Next() {
+		i, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+type OrderModel struct {
+	ID          int64
+	UserID      int64
+	ServiceID   int32
+	Longitude   pgtype.Float8
+	Latitude    pgtype.Float8
+	TimeStart   pgtype.Timestamp
+	TimeEnd     pgtype.Timestamp
+	Description string
+	Status      string
+	CreatedAt   pgtype.Timestamp
+}
+
+type OfferModel struct {
+	ID         int64
+	ProviderID int64
+	OrderID    int64
+	Price      float64
+	CurrencyID int32
+	BookTime   pgtype.Timestamp
+	Status     string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
+type JobModel struct {
+	ID         int64
+	OfferID    int64
+	OrderID    int64
+	UserID     int64
+	ProviderID int64
+	ServiceID  int32
+	Status     string
+	CreatedAt  pgtype.Timestamp
+	UpdatedAt  pgtype.Timestamp
+}
+
+type JobStatusHistoryModel struct {
+	ID        int64
+	JobID     int64
+	Status    string
+	ChangedBy int64
+	CreatedAt pgtype.Timestamp
+}
+
+type ReviewModel struct {
+	ID         int64
+	JobID      int64
+	UserID     int64
+	ProviderID int64
+	Rating     float64
+	Comment    pgtype.Text
+	CreatedAt  pgtype.Timestamp
+}
+
+type ProviderRatingModel struct {
+	ProviderID  int64
+	RatingAvg   float64
+	ReviewCount int32
+}
+
+type ProviderLocationModel struct {
+	ProviderID int64
+	CityID     int32
+	Longitude  float64
+	Latitude   float64
+	Address    string
+}
+
+type NearbyProviderModel struct {
+	ProviderLocationModel
+	Distance float64
+}
+
+type CityModel struct {
+	ID        int32
+	Name      string
+	Longitude pgtype.Float8
+	Latitude  pgtype.Float8
+	Country   string
+	Iso2      string
+	AdminName pgtype.Text
+	Capital   pgtype.Text
+}
+
+type CurrencyModel struct {
+	ID       int32
+	Currency string
+	Code     string
+	Symbol   string
+}
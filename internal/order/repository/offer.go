@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type OfferRepository interface {
+	postgres.Repository[OfferRepository]
+	Create(ctx context.Context, providerID int64, orderID int64, info domain.OfferInfo) (OfferModel, error)
+	Get(ctx context.Context, id int64) (OfferModel, error)
+	GetForUpdate(ctx context.Context, id int64) (OfferModel, error)
+	ListByOrderId(ctx context.Context, orderID int64, limit int64, offset int64) ([]OfferModel, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]OfferModel, error)
+	Update(ctx context.Context, id int64, info domain.OfferInfo) (OfferModel, error)
+	UpdateStatus(ctx context.Context, id int64, status domain.OfferStatus) (bool, error)
+	RejectCompeting(ctx context.Context, orderID int64, acceptedID int64) error
+}
+
+type postgresOfferRepository struct {
+	db        postgres.PGXQuerier
+	snowflake *snowflake.Node
+}
+
+func NewOfferRepository(dbtx postgres.PGXQuerier, snowflake *snowflake.Node) *postgresOfferRepository {
+	return &postgresOfferRepository{
+		dbtx,
+		snowflake,
+	}
+}
+
+func (r *postgresOfferRepository) WithTx(tx postgres.PGXQuerier) OfferRepository {
+	return NewOfferRepository(tx, r.snowflake)
+}
+
+const createOffer = `-- name: CreateOffer :one
+INSERT INTO offers (
+  id, provider_id, order_id, price, currency_id, book_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+`
+
+func (r *postgresOfferRepository) Create(ctx context.Context, providerID int64, orderID int64, info domain.OfferInfo) (OfferModel, error) {
+	row := r.db.QueryRow(ctx, createOffer,
+		r.snowflake.Generate(),
+		providerID,
+		orderID,
+		info.Price,
+		info.CurrencyID,
+		pgtype.Timestamp{Time: info.BookTime, Valid: true},
+	)
+	return scanOffer(row)
+}
+
+const getOffer = `-- name: GetOffer :one
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE id = $1
+`
+
+func (r *postgresOfferRepository) Get(ctx context.Context, id int64) (OfferModel, error) {
+	return scanOffer(r.db.QueryRow(ctx, getOffer, id))
+}
+
+const getOfferForUpdate = `-- name: GetOfferForUpdate :one
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE id = $1 FOR UPDATE
+`
+
+// GetForUpdate retrieves the offer and locks it until the end of the transaction, so it can't be revised or withdrawn meanwhile.
+func (r *postgresOfferRepository) GetForUpdate(ctx context.Context, id int64) (OfferModel, error) {
+	return scanOffer(r.db.QueryRow(ctx, getOfferForUpdate, id))
+}
+
+const listOffersByOrderId = `-- name: ListOffersByOrderId :many
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE order_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresOfferRepository) ListByOrderId(ctx context.Context, orderID int64, limit int64, offset int64) ([]OfferModel, error) {
+	rows, err := r.db.Query(ctx, listOffersByOrderId, orderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanOffers(rows)
+}
+
+const listOffersByProviderId = `-- name: ListOffersByProviderId :many
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresOfferRepository) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]OfferModel, error) {
+	rows, err := r.db.Query(ctx, listOffersByProviderId, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanOffers(rows)
+}
+
+const updateOffer = `-- name: UpdateOffer :one
+UPDATE offers SET
+  price = $2,
+  currency_id = $3,
+  book_time = $4,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'PENDING'
+RETURNING id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+`
+
+// Update changes the terms of a pending offer.
+// If the offer is not found or is no longer pending, it returns pgx.ErrNoRows.
+func (r *postgresOfferRepository) Update(ctx context.Context, id int64, info domain.OfferInfo) (OfferModel, error) {
+	row := r.db.QueryRow(ctx, updateOffer,
+		id,
+		info.Price,
+		info.CurrencyID,
+		pgtype.Timestamp{Time: info.BookTime, Valid: true},
+	)
+	return scanOffer(row)
+}
+
+const updateOfferStatus = `-- name: UpdateOfferStatus :exec
+UPDATE offers SET status = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1 AND status = 'PENDING'
+`
+
+// UpdateStatus moves a pending offer to the status, it returns false if the offer is not found or is no longer pending.
+func (r *postgresOfferRepository) UpdateStatus(ctx context.Context, id int64, status domain.OfferStatus) (bool, error) {
+	result, err := r.db.Exec(ctx, updateOfferStatus, id, string(status))
+	return result.RowsAffected() > 0, err
+}
+
+const rejectCompetingOffers = `-- name: RejectCompetingOffers :exec
+UPDATE offers SET status = 'REJECTED', updated_at = CURRENT_TIMESTAMP
+WHERE order_id = $1 AND id <> $2 AND status = 'PENDING'
+`
+
+// RejectCompeting rejects every pending offer of the order, except the accepted one.
+func (r *postgresOfferRepository) RejectCompeting(ctx context.Context, orderID int64, acceptedID int64) error {
+	_, err := r.db.Exec(ctx, rejectCompetingOffers, orderID, acceptedID)
+	return err
+}
+
+func scanOffer(row pgx.Row) (OfferModel, error) {
+	var i OfferModel
+	err := row.Scan(
+		&i.ID,
+		&i.ProviderID,
+		&i.OrderID,
+		&i.Price,
+		&i.CurrencyID,
+		&i.BookTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+func scanOffers(rows pgx.Rows) ([]OfferModel, error) {
+	defer rows.Close()
+	var items []OfferModel
+	for rows.Next() {
+		i, err := scanOffer(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
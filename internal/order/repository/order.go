@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type OrderRepository interface {
+	postgres.Repository[OrderRepository]
+	Create(ctx context.Context, userID int64, info domain.OrderInfo) (OrderModel, error)
+	Get(ctx context.Context, id int64) (OrderModel, error)
+	GetForUpdate(ctx context.Context, id int64) (OrderModel, error)
+	List(ctx context.Context, limit int64, offset int64) ([]OrderModel, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]OrderModel, error)
+	Update(ctx context.Context, id int64, info domain.OrderInfo) (OrderModel, error)
+	UpdateStatus(ctx context.Context, id int64, status domain.Status) (bool, error)
+	IsAssigned(ctx context.Context, id int64) (bool, error)
+}
+
+type postgresOrderRepository struct {
+	db        postgres.PGXQuerier
+	snowflake *snowflake.Node
+}
+
+func NewOrderRepository(dbtx postgres.PGXQuerier, snowflake *snowflake.Node) *postgresOrderRepository {
+	return &postgresOrderRepository{
+		dbtx,
+		snowflake,
+	}
+}
+
+func (r *postgresOrderRepository) WithTx(tx postgres.PGXQuerier) OrderRepository {
+	return NewOrderRepository(tx, r.snowflake)
+}
+
+const createOrder = `-- name: CreateOrder :one
+INSERT INTO orders (
+  id, user_id, service_id, location, time_start, time_end, description
+) VALUES (
+  $1, $2, $3, ST_SetSRID(ST_MakePoint($7, $8), 4326)::geography, $4, $5, $6
+)
+RETURNING id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+`
+
+func (r *postgresOrderRepository) Create(ctx context.Context, userID int64, info domain.OrderInfo) (OrderModel, error) {
+	longitude, latitude := locationParams(info.Location)
+
+	row := r.db.QueryRow(ctx, createOrder,
+		r.snowflake.Generate(),
+		userID,
+		info.ServiceID,
+		pgtype.Timestamp{Time: info.TimeStart, Valid: true},
+		pgtype.Timestamp{Time: info.TimeEnd, Valid: true},
+		info.Description,
+		longitude,
+		latitude,
+	)
+	return scanOrder(row)
+}
+
+const getOrder = `-- name: GetOrder :one
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE id = $1
+`
+
+func (r *postgresOrderRepository) Get(ctx context.Context, id int64) (OrderModel, error) {
+	return scanOrder(r.db.QueryRow(ctx, getOrder, id))
+}
+
+const getOrderForUpdate = `-- name: GetOrderForUpdate :one
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE id = $1 FOR UPDATE
+`
+
+// GetForUpdate retrieves the order and locks it until the end of the transaction, so it can't be cancelled or edited meanwhile.
+func (r *postgresOrderRepository) GetForUpdate(ctx context.Context, id int64) (OrderModel, error) {
+	return scanOrder(r.db.QueryRow(ctx, getOrderForUpdate, id))
+}
+
+const listOrders = `-- name: ListOrders :many
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE status = 'PENDING' AND NOT EXISTS (
+  SELECT 1 FROM offers WHERE offers.order_id = orders.id AND offers.status = 'ACCEPTED'
+)
+ORDER BY id DESC LIMIT $1 OFFSET $2
+`
+
+func (r *postgresOrderRepository) List(ctx context.Context, limit int64, offset int64) ([]OrderModel, error) {
+	rows, err := r.db.Query(ctx, listOrders, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanOrders(rows)
+}
+
+const listOrdersByUserId = `-- name: ListOrdersByUserId :many
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresOrderRepository) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]OrderModel, error) {
+	rows, err := r.db.Query(ctx, listOrdersByUserId, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanOrders(rows)
+}
+
+const updateOrder = `-- name: UpdateOrder :one
+UPDATE orders SET
+  service_id = $2,
+  location = ST_SetSRID(ST_MakePoint($6, $7), 4326)::geography,
+  time_start = $3,
+  time_end = $4,
+  description = $5
+WHERE id = $1
+RETURNING id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+`
+
+func (r *postgresOrderRepository) Update(ctx context.Context, id int64, info domain.OrderInfo) (OrderModel, error) {
+	longitude, latitude := locationParams(info.Location)
+
+	row := r.db.QueryRow(ctx, updateOrder,
+		id,
+		info.ServiceID,
+		pgtype.Timestamp{Time: info.TimeStart, Valid: true},
+		pgtype.Timestamp{Time: info.TimeEnd, Valid: true},
+		info.Description,
+		longitude,
+		latitude,
+	)
+	return scanOrder(row)
+}
+
+const updateOrderStatus = `-- name: UpdateOrderStatus :exec
+UPDATE orders SET status = $2 WHERE id = $1
+`
+
+func (r *postgresOrderRepository) UpdateStatus(ctx context.Context, id int64, status domain.Status) (bool, error) {
+	result, err := r.db.Exec(ctx, updateOrderStatus, id, string(status))
+	return result.RowsAffected() > 0, err
+}
+
+const isOrderAssigned = `-- name: IsOrderAssigned :one
+SELECT EXISTS (
+  SELECT 1 FROM offers WHERE order_id = $1 AND status = 'ACCEPTED'
+)
+`
+
+// IsAssigned reports whether an offer has already been accepted for the order.
+func (r *postgresOrderRepository) IsAssigned(ctx context.Context, id int64) (bool, error) {
+	var assigned bool
+	err := r.db.QueryRow(ctx, isOrderAssigned, id).Scan(&assigned)
+	return assigned, err
+}
+
+// locationParams converts an optional location into nullable longitude and latitude query parameters.
+func locationParams(location *domain.Location) (pgtype.Float8, pgtype.Float8) {
+	if location == nil {
+		return pgtype.Float8{}, pgtype.Float8{}
+	}
+
+	return pgtype.Float8{Float64: location.Longitude, Valid: true}, pgtype.Float8{Float64: location.Latitude, Valid: true}
+}
+
+func scanOrder(row pgx.Row) (OrderModel, error) {
+	var i OrderModel
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ServiceID,
+		&i.Longitude,
+		&i.Latitude,
+		&i.TimeStart,
+		&i.TimeEnd,
+		&i.Description,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+func scanOrders(rows pgx.Rows) ([]OrderModel, error) {
+	defer rows.Close()
+	var items []OrderModel
+	for rows.Next() {
+		i, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type ProviderLocationRepository interface {
+	postgres.Repository[ProviderLocationRepository]
+	Upsert(ctx context.Context, providerID int64, info domain.ProviderLocationInfo) (ProviderLocationModel, error)
+	Get(ctx context.Context, providerID int64) (ProviderLocationModel, error)
+	ListProviderIdsByCityId(ctx context.Context, cityID int32, limit int64, offset int64) ([]int64, error)
+	ListNearby(ctx context.Context, arg ListNearbyProvidersParams) ([]NearbyProviderModel, error)
+}
+
+type postgresProviderLocationRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewProviderLocationRepository(dbtx postgres.PGXQuerier) *postgresProviderLocationRepository {
+	return &postgresProviderLocationRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresProviderLocationRepository) WithTx(tx postgres.PGXQuerier) ProviderLocationRepository {
+	return NewProviderLocationRepository(tx)
+}
+
+const upsertProviderLocation = `-- name: UpsertProviderLocation :one
+INSERT INTO provider_locations (
+  provider_id, city_id, location, address
+) VALUES (
+  $1, $2, ST_SetSRID(ST_MakePoint($3, $4), 4326)::geography, $5
+)
+ON CONFLICT (provider_id) DO UPDATE
+SET city_id = EXCLUDED.city_id, location = EXCLUDED.location, address = EXCLUDED.address
+RETURNING provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address
+`
+
+func (r *postgresProviderLocationRepository) Upsert(ctx context.Context, providerID int64, info domain.ProviderLocationInfo) (ProviderLocationModel, error) {
+	row := r.db.QueryRow(ctx, upsertProviderLocation,
+		providerID,
+		info.CityID,
+		info.Location.Longitude,
+		info.Location.Latitude,
+		info.Address,
+	)
+	return scanProviderLocation(row)
+}
+
+const getProviderLocation = `-- name: GetProviderLocation :one
+SELECT provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address
+FROM provider_locations WHERE provider_id = $1
+`
+
+func (r *postgresProviderLocationRepository) Get(ctx context.Context, providerID int64) (ProviderLocationModel, error) {
+	return scanProviderLocation(r.db.QueryRow(ctx, getProviderLocation, providerID))
+}
+
+const listProviderIdsByCityId = `-- name: ListProviderIdsByCityId :many
+SELECT provider_id FROM provider_locations WHERE city_id = $1 ORDER BY provider_id LIMIT $2 OFFSET $3
+`
+
+func (r *postgresProviderLocationRepository) ListProviderIdsByCityId(ctx context.Context, cityID int32, limit int64, offset int64) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listProviderIdsByCityId, cityID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []int64
+	for rows.Next() {
+		var providerID int64
+		if err := rows.Scan(&providerID); err != nil {
+			return nil, err
+		}
+		items = append(items, providerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNearbyProviders = `-- name: ListNearbyProviders :many
+SELECT provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address,
+  ST_Distance(location, ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography) AS distance
+FROM provider_locations
+WHERE ST_DWithin(location, ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography, $3)
+  AND ($6::BIGINT[] IS NULL OR provider_id = ANY($6::BIGINT[]))
+ORDER BY distance, provider_id LIMIT $4 OFFSET $5
+`
+
+type ListNearbyProvidersParams struct {
+	Longitude float64
+	Latitude  float64
+	// Radius of the search in meters
+	Radius float64
+	Limit  int64
+	Offset int64
+	// ProviderIDs restricts the search to the given providers, nil means no restriction
+	ProviderIDs []int64
+}
+
+// ListNearby retrieves providers located within the radius of the point, nearest first.
+func (r *postgresProviderLocationRepository) ListNearby(ctx context.Context, arg ListNearbyProvidersParams) ([]NearbyProviderModel, error) {
+	rows, err := r.db.Query(ctx, listNearbyProviders,
+		arg.Longitude,
+		arg.Latitude,
+		arg.Radius,
+		arg.Limit,
+		arg.Offset,
+		arg.ProviderIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []NearbyProviderModel
+	for rows.Next() {
+		var i NearbyProviderModel
+		if err := rows.Scan(
+			&i.ProviderID,
+			&i.CityID,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Address,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func scanProviderLocation(row pgx.Row) (ProviderLocationModel, error) {
+	var i ProviderLocationModel
+	err := row.Scan(
+		&i.ProviderID,
+		&i.CityID,
+		&i.Longitude,
+		&i.Latitude,
+		&i.Address,
+	)
+	return i, err
+}
@@ -0,0 +1,145 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	cityLongitude = 44.7930
+	cityLatitude  = 41.7151
+	address       = "Rustaveli Ave. 1"
+)
+
+var (
+	nearLocation = domain.NewLocation(44.8000, 41.7200)
+	midLocation  = domain.NewLocation(44.8500, 41.7500)
+	farLocation  = domain.NewLocation(41.6367, 41.6168)
+)
+
+func setupProviderLocation() (
+	ctx context.Context,
+	pgPool *pgxpool.Pool,
+	repo repository.ProviderLocationRepository,
+) {
+	ctx = context.Background()
+
+	pgPool = getPgPool(ctx)
+	repo = repository.NewProviderLocationRepository(pgPool)
+
+	return
+}
+
+func insertCity(pgPool *pgxpool.Pool, ctx context.Context) (int32, error) {
+	var cityId int32
+	err := pgPool.QueryRow(ctx,
+		`INSERT INTO cities (name, location, country, iso2) VALUES ('Tbilisi', ST_SetSRID(ST_MakePoint($1, $2), 4326)::geography, 'Georgia', 'GE') RETURNING city_id`,
+		cityLongitude, cityLatitude,
+	).Scan(&cityId)
+	return cityId, err
+}
+
+func TestUpsertProviderLocation_Success(t *testing.T) {
+	ctx, pgPool, repo := setupProviderLocation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	cityId, err := insertCity(pgPool, ctx)
+	if err != nil {
+		t.Fatalf("failed to insert city: %v", err)
+	}
+
+	model, err := repo.Upsert(ctx, 1, domain.NewProviderLocationInfo(cityId, *nearLocation, address))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), model.ProviderID)
+	assert.InDelta(t, nearLocation.Longitude, model.Longitude, 1e-9)
+	assert.InDelta(t, nearLocation.Latitude, model.Latitude, 1e-9)
+
+	model, err = repo.Upsert(ctx, 1, domain.NewProviderLocationInfo(cityId, *midLocation, address))
+	assert.NoError(t, err)
+	assert.InDelta(t, midLocation.Longitude, model.Longitude, 1e-9)
+
+	got, err := repo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, model, got)
+}
+
+func TestUpsertProviderLocation_NonexistentCity(t *testing.T) {
+	ctx, pgPool, repo := setupProviderLocation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	model, err := repo.Upsert(ctx, 1, domain.NewProviderLocationInfo(0, *nearLocation, address))
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.ForeignKeyViolation, pgErr.Code)
+	}
+	assert.Empty(t, model)
+}
+
+func TestGetProviderLocation_NotFound(t *testing.T) {
+	ctx, pgPool, repo := setupProviderLocation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	model, err := repo.Get(ctx, 1)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+	assert.Empty(t, model)
+}
+
+func TestListNearbyProviders(t *testing.T) {
+	ctx, pgPool, repo := setupProviderLocation()
+	defer cleanupPostgres(ctx, pgPool)
+
+	cityId, err := insertCity(pgPool, ctx)
+	if err != nil {
+		t.Fatalf("failed to insert city: %v", err)
+	}
+
+	for providerId, location := range map[int64]*domain.Location{1: midLocation, 2: nearLocation, 3: farLocation} {
+		if _, err := repo.Upsert(ctx, providerId, domain.NewProviderLocationInfo(cityId, *location, address)); err != nil {
+			t.Fatalf("failed to insert provider location: %v", err)
+		}
+	}
+
+	params := repository.ListNearbyProvidersParams{
+		Longitude: cityLongitude,
+		Latitude:  cityLatitude,
+		Radius:    10_000,
+		Limit:     10,
+		Offset:    0,
+	}
+
+	t.Run("sorted by distance", func(t *testing.T) {
+		models, err := repo.ListNearby(ctx, params)
+		assert.NoError(t, err)
+		if assert.Len(t, models, 2) {
+			assert.Equal(t, int64(2), models[0].ProviderID)
+			assert.Equal(t, int64(1), models[1].ProviderID)
+			assert.Less(t, models[0].Distance, models[1].Distance)
+		}
+	})
+
+	t.Run("filtered by provider ids", func(t *testing.T) {
+		filtered := params
+		filtered.ProviderIDs = []int64{1, 3}
+
+		models, err := repo.ListNearby(ctx, filtered)
+		assert.NoError(t, err)
+		if assert.Len(t, models, 1) {
+			assert.Equal(t, int64(1), models[0].ProviderID)
+		}
+	})
+
+	t.Run("list by city", func(t *testing.T) {
+		ids, err := repo.ListProviderIdsByCityId(ctx, cityId, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, ids)
+	})
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type ProviderRatingRepository interface {
+	postgres.Repository[ProviderRatingRepository]
+	Add(ctx context.Context, providerID int64, rating float64) (ProviderRatingModel, error)
+	Get(ctx context.Context, providerID int64) (ProviderRatingModel, error)
+	List(ctx context.Context, sort domain.RatingSort, limit int64, offset int64) ([]ProviderRatingModel, error)
+}
+
+type postgresProviderRatingRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewProviderRatingRepository(dbtx postgres.PGXQuerier) *postgresProviderRatingRepository {
+	return &postgresProviderRatingRepository{
+		dbtx,
+	}
+}
+
+func (r *postgresProviderRatingRepository) WithTx(tx postgres.PGXQuerier) ProviderRatingRepository {
+	return NewProviderRatingRepository(tx)
+}
+
+const addProviderRating = `-- name: AddProviderRating :one
+INSERT INTO provider_ratings (provider_id, rating_sum, review_count)
+VALUES ($1, $2, 1)
+ON CONFLICT (provider_id) DO UPDATE
+SET rating_sum = provider_ratings.rating_sum + EXCLUDED.rating_sum,
+    review_count = provider_ratings.review_count + 1
+RETURNING provider_id, rating_avg, review_count
+`
+
+// Add folds a single review rating into the provider aggregate, creating it on the first review.
+func (r *postgresProviderRatingRepository) Add(ctx context.Context, providerID int64, rating float64) (ProviderRatingModel, error) {
+	return scanProviderRating(r.db.QueryRow(ctx, addProviderRating, providerID, rating))
+}
+
+const getProviderRating = `-- name: GetProviderRating :one
+SELECT provider_id, rating_avg, review_count FROM provider_ratings WHERE provider_id = $1
+`
+
+func (r *postgresProviderRatingRepository) Get(ctx context.Context, providerID int64) (ProviderRatingModel, error) {
+	return scanProviderRating(r.db.QueryRow(ctx, getProviderRating, providerID))
+}
+
+const listProviderRatingsByRating = `-- name: ListProviderRatingsByRating :many
+SELECT provider_id, rating_avg, review_count FROM provider_ratings
+ORDER BY rating_avg DESC, review_count DESC, provider_id LIMIT $1 OFFSET $2
+`
+
+const listProviderRatingsByReviews = `-- name: ListProviderRatingsByReviews :many
+SELECT provider_id, rating_avg, review_count FROM provider_ratings
+ORDER BY review_count DESC, rating_avg DESC, provider_id LIMIT $1 OFFSET $2
+`
+
+// List retrieves provider aggregates ordered by the average rating or by the number of reviews.
+func (r *postgresProviderRatingRepository) List(ctx context.Context, sort domain.RatingSort, limit int64, offset int64) ([]ProviderRatingModel, error) {
+	query := listProviderRatingsByRating
+	if sort == domain.RatingSortREVIEWS {
+		query = listProviderRatingsByReviews
+	}
+
+	rows, err := r.db.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []ProviderRatingModel
+	for rows.Next() {
+		i, err := scanProviderRating(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func scanProviderRating(row pgx.Row) (ProviderRatingModel, error) {
+	var i ProviderRatingModel
+	err := row.Scan(
+		&i.ProviderID,
+		&i.RatingAvg,
+		&i.ReviewCount,
+	)
+	return i, err
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+// ProviderServiceRepository reads provider offerings from the catalog database,
+// it can't take part in order database transactions.
+type ProviderServiceRepository interface {
+	ListProviderIds(ctx context.Context, serviceID int32) ([]int64, error)
+}
+
+type postgresProviderServiceRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewProviderServiceRepository(dbtx postgres.PGXQuerier) *postgresProviderServiceRepository {
+	return &postgresProviderServiceRepository{
+		dbtx,
+	}
+}
+
+const listProviderIdsByServiceId = `-- name: ListProviderIdsByServiceId :many
+SELECT provider_id FROM provider_services WHERE service_id = $1
+`
+
+func (r *postgresProviderServiceRepository) ListProviderIds(ctx context.Context, serviceID int32) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listProviderIdsByServiceId, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []int64{}
+	for rows.Next() {
+		var providerID int64
+		if err := rows.Scan(&providerID); err != nil {
+			return nil, err
+		}
+		items = append(items, providerID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
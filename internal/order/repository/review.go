@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReviewRepository interface {
+	postgres.Repository[ReviewRepository]
+	Create(ctx context.Context, arg CreateReviewParams) (ReviewModel, error)
+	Get(ctx context.Context, id int64) (ReviewModel, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]ReviewModel, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]ReviewModel, error)
+}
+
+type postgresReviewRepository struct {
+	db        postgres.PGXQuerier
+	snowflake *snowflake.Node
+}
+
+func NewReviewRepository(dbtx postgres.PGXQuerier, snowflake *snowflake.Node) *postgresReviewRepository {
+	return &postgresReviewRepository{
+		dbtx,
+		snowflake,
+	}
+}
+
+func (r *postgresReviewRepository) WithTx(tx postgres.PGXQuerier) ReviewRepository {
+	return NewReviewRepository(tx, r.snowflake)
+}
+
+const createReview = `-- name: CreateReview :one
+INSERT INTO reviews (
+  id, job_id, user_id, provider_id, rating, comment
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, job_id, user_id, provider_id, rating, comment, created_at
+`
+
+type CreateReviewParams struct {
+	JobID      int64
+	UserID     int64
+	ProviderID int64
+	Rating     float64
+	Comment    pgtype.Text
+}
+
+func (r *postgresReviewRepository) Create(ctx context.Context, arg CreateReviewParams) (ReviewModel, error) {
+	row := r.db.QueryRow(ctx, createReview,
+		r.snowflake.Generate(),
+		arg.JobID,
+		arg.UserID,
+		arg.ProviderID,
+		arg.Rating,
+		arg.Comment,
+	)
+	return scanReview(row)
+}
+
+const getReview = `-- name: GetReview :one
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE id = $1
+`
+
+func (r *postgresReviewRepository) Get(ctx context.Context, id int64) (ReviewModel, error) {
+	return scanReview(r.db.QueryRow(ctx, getReview, id))
+}
+
+const listReviewsByProviderId = `-- name: ListReviewsByProviderId :many
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresReviewRepository) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]ReviewModel, error) {
+	rows, err := r.db.Query(ctx, listReviewsByProviderId, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanReviews(rows)
+}
+
+const listReviewsByUserId = `-- name: ListReviewsByUserId :many
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3
+`
+
+func (r *postgresReviewRepository) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]ReviewModel, error) {
+	rows, err := r.db.Query(ctx, listReviewsByUserId, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanReviews(rows)
+}
+
+func scanReview(row pgx.Row) (ReviewModel, error) {
+	var i ReviewModel
+	err := row.Scan(
+		&i.ID,
+		&i.JobID,
+		&i.UserID,
+		&i.ProviderID,
+		&i.Rating,
+		&i.Comment,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+func scanReviews(rows pgx.Rows) ([]ReviewModel, error) {
+	defer rows.Close()
+	var items []ReviewModel
+	for rows.Next() {
+		i, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
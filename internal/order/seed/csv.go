@@ -0,0 +1,144 @@
+package seed
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+)
+
+var (
+	ErrMissingColumn = errors.New("missing required column")
+	ErrInvalidRecord = errors.New("invalid record")
+)
+
+var (
+	cityColumns     = []string{"city", "lat", "lng", "country", "iso2", "admin_name", "capital"}
+	currencyColumns = []string{"currency", "code", "symbol"}
+)
+
+// ReadCities parses a cities csv file in the format of the public world cities dataset.
+// Columns are matched by header name, so extra columns (e.g. population) are ignored.
+// Rows without coordinates are kept with a nil location.
+func ReadCities(r io.Reader) ([]domain.CityInfo, error) {
+	var cities []domain.CityInfo
+
+	err := readRecords(r, cityColumns, func(line int, get func(column string) string) error {
+		location, err := parseLocation(get("lng"), get("lat"))
+		if err != nil {
+			return fmt.Errorf("%w on line %d: %w", ErrInvalidRecord, line, err)
+		}
+
+		var capital *domain.CapitalType
+		if c := get("capital"); c != "" {
+			capitalType, err := domain.ParseCapitalType(strings.ToUpper(c))
+			if err != nil {
+				return fmt.Errorf("%w on line %d: %w", ErrInvalidRecord, line, err)
+			}
+			capital = &capitalType
+		}
+
+		name, iso2 := get("city"), strings.ToUpper(get("iso2"))
+		if name == "" || len(iso2) != 2 {
+			return fmt.Errorf("%w on line %d: name and iso2 are required", ErrInvalidRecord, line)
+		}
+
+		cities = append(cities, domain.NewCityInfo(name, location, get("country"), iso2, get("admin_name"), capital))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cities, nil
+}
+
+// ReadCurrencies parses a currencies csv file with "currency", "code" and "symbol" columns.
+func ReadCurrencies(r io.Reader) ([]domain.CurrencyInfo, error) {
+	var currencies []domain.CurrencyInfo
+
+	err := readRecords(r, currencyColumns, func(line int, get func(column string) string) error {
+		code := strings.ToUpper(get("code"))
+		if code == "" {
+			return fmt.Errorf("%w on line %d: code is required", ErrInvalidRecord, line)
+		}
+
+		currencies = append(currencies, domain.NewCurrencyInfo(get("currency"), code, get("symbol")))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return currencies, nil
+}
+
+// readRecords reads the header of the csv, makes sure every required column is present
+// and calls fn for each record with a getter of trimmed values by column name.
+func readRecords(r io.Reader, columns []string, fn func(line int, get func(column string) string) error) error {
+	// Spreadsheet exports often start with a byte order mark, which breaks quoted headers
+	br := bufio.NewReader(r)
+	if bom, _, err := br.ReadRune(); err == nil && bom != '\ufeff' {
+		_ = br.UnreadRune()
+	}
+
+	reader := csv.NewReader(br)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header: %w", err)
+	}
+
+	indexes := make(map[string]int, len(header))
+	for i, h := range header {
+		indexes[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	for _, c := range columns {
+		if _, ok := indexes[c]; !ok {
+			return fmt.Errorf("%w: %s", ErrMissingColumn, c)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		get := func(column string) string {
+			return strings.TrimSpace(record[indexes[column]])
+		}
+
+		if err := fn(line, get); err != nil {
+			return err
+		}
+	}
+}
+
+func parseLocation(longitude string, latitude string) (*domain.Location, error) {
+	if longitude == "" && latitude == "" {
+		return nil, nil
+	}
+
+	lng, err := strconv.ParseFloat(longitude, 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return nil, fmt.Errorf("invalid longitude: %q", longitude)
+	}
+
+	lat, err := strconv.ParseFloat(latitude, 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return nil, fmt.Errorf("invalid latitude: %q", latitude)
+	}
+
+	return domain.NewLocation(lng, lat), nil
+}
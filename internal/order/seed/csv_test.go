@@ -0,0 +1,67 @@
+package seed_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/seed"
+	"github.com/stretchr/testify/assert"
+)
+
+const worldCities = "\ufeff" + `"city","city_ascii","lat","lng","country","iso2","iso3","admin_name","capital","population","id"
+"Tbilisi","Tbilisi","41.7225","44.7925","Georgia","GE","GEO","Tbilisi","primary","1118035","1268203969"
+"Batumi","Batumi","41.6458","41.6417","Georgia","GE","GEO","Ajaria","admin","152839","1268918394"
+"Rustavi","Rustavi","","","Georgia","ge","GEO","","","125103","1268445123"
+`
+
+func TestReadCities_Success(t *testing.T) {
+	cities, err := seed.ReadCities(strings.NewReader(worldCities))
+	assert.NoError(t, err)
+
+	primary, admin := domain.CapitalTypePRIMARY, domain.CapitalTypeADMIN
+	assert.Equal(t, []domain.CityInfo{
+		domain.NewCityInfo("Tbilisi", domain.NewLocation(44.7925, 41.7225), "Georgia", "GE", "Tbilisi", &primary),
+		domain.NewCityInfo("Batumi", domain.NewLocation(41.6417, 41.6458), "Georgia", "GE", "Ajaria", &admin),
+		domain.NewCityInfo("Rustavi", nil, "Georgia", "GE", "", nil),
+	}, cities)
+}
+
+func TestReadCities_MissingColumn(t *testing.T) {
+	_, err := seed.ReadCities(strings.NewReader("city,lat,lng,country,iso2\nTbilisi,41.7,44.8,Georgia,GE\n"))
+	assert.ErrorIs(t, err, seed.ErrMissingColumn)
+}
+
+func TestReadCities_InvalidRecord(t *testing.T) {
+	tests := []struct {
+		name string
+		row  string
+	}{
+		{"invalid latitude", "Tbilisi,91,44.8,Georgia,GE,Tbilisi,primary"},
+		{"invalid longitude", "Tbilisi,41.7,abc,Georgia,GE,Tbilisi,primary"},
+		{"invalid capital", "Tbilisi,41.7,44.8,Georgia,GE,Tbilisi,capital"},
+		{"invalid iso2", "Tbilisi,41.7,44.8,Georgia,GEO,Tbilisi,primary"},
+		{"missing name", ",41.7,44.8,Georgia,GE,Tbilisi,primary"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := seed.ReadCities(strings.NewReader("city,lat,lng,country,iso2,admin_name,capital\n" + tt.row + "\n"))
+			assert.ErrorIs(t, err, seed.ErrInvalidRecord)
+		})
+	}
+}
+
+func TestReadCurrencies_Success(t *testing.T) {
+	currencies, err := seed.ReadCurrencies(strings.NewReader("Currency,Code,Symbol\nGeorgian lari,gel,₾\nEuro,EUR,€\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CurrencyInfo{
+		domain.NewCurrencyInfo("Georgian lari", "GEL", "₾"),
+		domain.NewCurrencyInfo("Euro", "EUR", "€"),
+	}, currencies)
+}
+
+func TestReadCurrencies_MissingCode(t *testing.T) {
+	_, err := seed.ReadCurrencies(strings.NewReader("currency,code,symbol\nGeorgian lari,,₾\n"))
+	assert.ErrorIs(t, err, seed.ErrInvalidRecord)
+}
@@ -0,0 +1,124 @@
+package seed
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// Seeder bulk loads reference data into the order database.
+// Rows are copied into a temporary staging table first, so that records which already exist are skipped
+// and the whole import is applied in a single transaction.
+type Seeder struct {
+	pgx postgres.PGX
+}
+
+func NewSeeder(pgx postgres.PGX) *Seeder {
+	return &Seeder{pgx: pgx}
+}
+
+const createCitiesStaging = `
+CREATE TEMP TABLE cities_staging (
+  name VARCHAR(120) NOT NULL,
+  longitude DOUBLE PRECISION,
+  latitude DOUBLE PRECISION,
+  country VARCHAR(120) NOT NULL,
+  iso2 VARCHAR(2) NOT NULL,
+  admin_name VARCHAR(120),
+  capital TEXT
+) ON COMMIT DROP
+`
+
+const insertStagedCities = `
+INSERT INTO cities (name, location, country, iso2, admin_name, capital)
+SELECT DISTINCT ON (s.name, s.iso2, s.admin_name)
+  s.name,
+  CASE WHEN s.longitude IS NULL OR s.latitude IS NULL THEN NULL
+    ELSE ST_SetSRID(ST_MakePoint(s.longitude, s.latitude), 4326)::geography END,
+  s.country, s.iso2, s.admin_name, s.capital::CAPITAL_TYPE
+FROM cities_staging s
+WHERE NOT EXISTS (
+  SELECT 1 FROM cities c
+  WHERE c.name = s.name AND c.iso2 = s.iso2 AND c.admin_name IS NOT DISTINCT FROM s.admin_name
+)
+ORDER BY s.name, s.iso2, s.admin_name
+`
+
+var cityStagingColumns = []string{"name", "longitude", "latitude", "country", "iso2", "admin_name", "capital"}
+
+// SeedCities copies the cities into the database, skipping the ones with an existing name, iso2 and admin name.
+// It returns the number of inserted cities.
+func (s *Seeder) SeedCities(ctx context.Context, cities []domain.CityInfo) (int64, error) {
+	rows := make([][]any, len(cities))
+	for i, c := range cities {
+		var longitude, latitude, adminName, capital any
+		if c.Location != nil {
+			longitude, latitude = c.Location.Longitude, c.Location.Latitude
+		}
+		if c.AdminName != "" {
+			adminName = c.AdminName
+		}
+		if c.Capital != nil {
+			capital = string(*c.Capital)
+		}
+
+		rows[i] = []any{c.Name, longitude, latitude, c.Country, c.Iso2, adminName, capital}
+	}
+
+	return s.copy(ctx, createCitiesStaging, "cities_staging", cityStagingColumns, rows, insertStagedCities)
+}
+
+const createCurrenciesStaging = `
+CREATE TEMP TABLE currencies_staging (
+  currency VARCHAR(100) NOT NULL,
+  code VARCHAR(10) NOT NULL,
+  symbol VARCHAR(10) NOT NULL
+) ON COMMIT DROP
+`
+
+const insertStagedCurrencies = `
+INSERT INTO currencies (currency, code, symbol)
+SELECT DISTINCT ON (code) currency, code, symbol FROM currencies_staging ORDER BY code
+ON CONFLICT (code) DO NOTHING
+`
+
+var currencyStagingColumns = []string{"currency", "code", "symbol"}
+
+// SeedCurrencies copies the currencies into the database, skipping the ones with an existing code.
+// It returns the number of inserted currencies.
+func (s *Seeder) SeedCurrencies(ctx context.Context, currencies []domain.CurrencyInfo) (int64, error) {
+	rows := make([][]any, len(currencies))
+	for i, c := range currencies {
+		rows[i] = []any{c.Name, c.Code, c.Symbol}
+	}
+
+	return s.copy(ctx, createCurrenciesStaging, "currencies_staging", currencyStagingColumns, rows, insertStagedCurrencies)
+}
+
+func (s *Seeder) copy(ctx context.Context, createStaging string, staging string, columns []string, rows [][]any, insert string) (int64, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(ctx, createStaging); err != nil {
+		return 0, postgres.Rollback(tx, ctx, err)
+	}
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{staging}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return 0, postgres.Rollback(tx, ctx, err)
+	}
+
+	result, err := tx.Exec(ctx, insert)
+	if err != nil {
+		return 0, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-chi/chi/v5"
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/cors"
+	"github.com/gocql/gocql"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/internal/order/delivery/http/v1"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/infra/cassandra"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/logger"
+	"github.com/hexley21/fixup/pkg/validator"
+)
+
+type services struct {
+	order            service.OrderService
+	offer            service.OfferService
+	job              service.JobService
+	review           service.ReviewService
+	providerLocation service.ProviderLocationService
+	city             service.CityService
+	currency         service.CurrencyService
+}
+
+type jWTManagers struct {
+	accessJWTManager auth_jwt.Manager
+}
+
+type server struct {
+	router            chi.Router
+	metricsRouter     chi.Router
+	mux               *http.Server
+	metricsMux        *http.Server
+	cfg               *config.Config
+	dbPool            *pgxpool.Pool
+	catalogDbPool     *pgxpool.Pool
+	chatSession       *gocql.Session
+	handlerComponents *handler.Components
+	jWTManagers       *jWTManagers
+	services          *services
+}
+
+// NewServer initializes and returns a new server instance with the provided configuration and dependencies.
+// It sets up repositories, services, JWT managers, handler components, and HTTP servers for both main and metrics endpoints.
+func NewServer(
+	cfg *config.Config,
+	dbPool *pgxpool.Pool,
+	catalogDbPool *pgxpool.Pool,
+	chatSession *gocql.Session,
+	logger logger.Logger,
+	snowflakeNode *snowflake.Node,
+	validator validator.Validator,
+) *server {
+	orderRepository := repository.NewOrderRepository(dbPool, snowflakeNode)
+	offerRepository := repository.NewOfferRepository(dbPool, snowflakeNode)
+	jobRepository := repository.NewJobRepository(dbPool, snowflakeNode)
+	reviewRepository := repository.NewReviewRepository(dbPool, snowflakeNode)
+	providerRatingRepository := repository.NewProviderRatingRepository(dbPool)
+	providerLocationRepository := repository.NewProviderLocationRepository(dbPool)
+	providerServiceRepository := repository.NewProviderServiceRepository(catalogDbPool)
+	cityRepository := repository.NewCityRepository(dbPool)
+	currencyRepository := repository.NewCurrencyRepository(dbPool)
+	channelRepository := repository.NewChannelRepository(chatSession, snowflakeNode)
+
+	services := &services{
+		order:            service.NewOrderService(orderRepository, dbPool),
+		offer:            service.NewOfferService(offerRepository, orderRepository, jobRepository, channelRepository, dbPool),
+		job:              service.NewJobService(jobRepository, orderRepository, dbPool),
+		review:           service.NewReviewService(reviewRepository, providerRatingRepository, jobRepository, dbPool),
+		providerLocation: service.NewProviderLocationService(providerLocationRepository, providerServiceRepository, orderRepository),
+		city:             service.NewCityService(cityRepository),
+		currency:         service.NewCurrencyService(currencyRepository),
+	}
+
+	jWTManagers := &jWTManagers{
+		accessJWTManager: auth_jwt.NewManager(cfg.JWT.AccessSecret, cfg.JWT.AccessTTL),
+	}
+
+	jsonManager := std_json.New()
+	handlerComponents := &handler.Components{
+		Logger:    logger,
+		Binder:    std_binder.New(jsonManager),
+		Validator: validator,
+		Writer:    json_writer.New(logger, jsonManager),
+	}
+
+	router := chi.NewMux()
+	mux := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.HTTP.Port),
+		Handler:      router,
+		IdleTimeout:  cfg.HTTP.IdleTimeout,
+		ReadTimeout:  cfg.HTTP.ReadTimeout,
+		WriteTimeout: cfg.HTTP.WriteTimeout,
+	}
+
+	metricsRouter := chi.NewMux()
+	metricsMux := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.Metrics.Port),
+		Handler:      metricsRouter,
+		IdleTimeout:  cfg.HTTP.IdleTimeout,
+		ReadTimeout:  cfg.HTTP.ReadTimeout,
+		WriteTimeout: cfg.HTTP.WriteTimeout,
+	}
+
+	return &server{
+		router:            router,
+		metricsRouter:     metricsRouter,
+		mux:               mux,
+		metricsMux:        metricsMux,
+		cfg:               cfg,
+		dbPool:            dbPool,
+		catalogDbPool:     catalogDbPool,
+		chatSession:       chatSession,
+		handlerComponents: handlerComponents,
+		jWTManagers:       jWTManagers,
+		services:          services,
+	}
+}
+
+func (s *server) Run() error {
+	// Initialize middleware with binder and writer components
+	Middleware := middleware.NewMiddleware(s.handlerComponents.Binder, s.handlerComponents.Writer)
+
+	// Set up logging middleware for chi router
+	chiLogger := &chi_middleware.DefaultLogFormatter{
+		Logger:  s.handlerComponents.Logger,
+		NoColor: false,
+	}
+
+	// TODO: Add CSRF middleware
+	s.router.Use(cors.Handler(cors.Options{
+		AllowedOrigins:   strings.Split(s.cfg.HTTP.CorsOrigins, ","),
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Idempotency-Key", "X-CSRF-Token"},
+		AllowCredentials: true,
+		MaxAge:           300,
+	}))
+	s.router.Use(chi_middleware.Recoverer)
+	s.router.Use(chi_middleware.RequestLogger(chiLogger))
+
+	v1.MapV1Routes(v1.RouterArgs{
+		OrderService:            s.services.order,
+		OfferService:            s.services.offer,
+		JobService:              s.services.job,
+		ReviewService:           s.services.review,
+		ProviderLocationService: s.services.providerLocation,
+		CityService:             s.services.city,
+		CurrencyService:         s.services.currency,
+		Middleware:              Middleware,
+		HandlerComponents:       s.handlerComponents,
+		AccessJWTManager:        s.jWTManagers.accessJWTManager,
+		PaginationConfig:        &s.cfg.Pagination,
+	}, s.router)
+
+	// Setup metrics endpoint
+	s.metricsRouter.Use(chi_middleware.Recoverer)
+	s.metricsRouter.Handle("/metrics", promhttp.Handler())
+
+	mainErrChan := make(chan error, 1)
+	metricsErrChan := make(chan error, 1)
+
+	go func() {
+		mainErrChan <- s.mux.ListenAndServe()
+	}()
+
+	go func() {
+		metricsErrChan <- s.metricsMux.ListenAndServe()
+	}()
+
+	select {
+	case mainErr := <-mainErrChan:
+		return mainErr
+	case metricsErr := <-metricsErrChan:
+		return metricsErr
+	}
+}
+
+// Close gracefully shuts down the server, including its HTTP mux, metrics mux, database pools and chat cassandra session.
+// Errors during shutdown are logged, but the function returns nil to ensure all components attempt to close.
+// Complies to io.Closer interface.
+func (s *server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	err := s.mux.Shutdown(ctx)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	err = s.metricsMux.Shutdown(ctx)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+		err = nil
+	}
+
+	err = postgres.Close(s.dbPool)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+	}
+
+	err = postgres.Close(s.catalogDbPool)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+	}
+
+	err = cassandra.Close(s.chatSession)
+	if err != nil {
+		s.handlerComponents.Logger.Error(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CityService interface {
+	Create(ctx context.Context, info domain.CityInfo) (domain.City, error)
+	Get(ctx context.Context, id int32) (domain.City, error)
+	List(ctx context.Context, filter domain.CityFilter, limit int64, offset int64) ([]domain.City, error)
+	Update(ctx context.Context, id int32, info domain.CityInfo) (domain.City, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+type cityServiceImpl struct {
+	cityRepository repository.CityRepository
+}
+
+func NewCityService(cityRepository repository.CityRepository) *cityServiceImpl {
+	return &cityServiceImpl{
+		cityRepository: cityRepository,
+	}
+}
+
+// Create inserts a new city and returns it.
+func (s *cityServiceImpl) Create(ctx context.Context, info domain.CityInfo) (domain.City, error) {
+	model, err := s.cityRepository.Create(ctx, info)
+	if err != nil {
+		return domain.City{}, err
+	}
+
+	return MapCityModelToEntity(model), nil
+}
+
+// Get retrieves a city by its ID.
+// If the city is not found, it returns ErrCityNotFound.
+func (s *cityServiceImpl) Get(ctx context.Context, id int32) (domain.City, error) {
+	model, err := s.cityRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.City{}, ErrCityNotFound
+		}
+		return domain.City{}, err
+	}
+
+	return MapCityModelToEntity(model), nil
+}
+
+// List retrieves cities matching the filter with the specified limit and offset.
+func (s *cityServiceImpl) List(ctx context.Context, filter domain.CityFilter, limit int64, offset int64) ([]domain.City, error) {
+	models, err := s.cityRepository.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCityModels(models), nil
+}
+
+// Update replaces the info of an existing city.
+// If the city is not found, it returns ErrCityNotFound.
+func (s *cityServiceImpl) Update(ctx context.Context, id int32, info domain.CityInfo) (domain.City, error) {
+	model, err := s.cityRepository.Update(ctx, id, info)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.City{}, ErrCityNotFound
+		}
+		return domain.City{}, err
+	}
+
+	return MapCityModelToEntity(model), nil
+}
+
+// Delete removes a city by its ID.
+// If the city is not found, it returns ErrCityNotFound.
+// If the city is still referenced (e.g. by provider locations), it returns ErrCityInUse.
+func (s *cityServiceImpl) Delete(ctx context.Context, id int32) error {
+	ok, err := s.cityRepository.Delete(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return ErrCityInUse
+		}
+		return err
+	}
+	if !ok {
+		return ErrCityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	primaryCapital = domain.CapitalTypePRIMARY
+
+	cityInfoVO = domain.NewCityInfo("Tbilisi", domain.NewLocation(44.8, 41.7), "Georgia", "GE", "Tbilisi", &primaryCapital)
+	cityFilter = domain.NewCityFilter("Georgia", "GE", "Tbi")
+
+	cityModel = repository.CityModel{
+		ID:        cityId,
+		Name:      "Tbilisi",
+		Longitude: pgtype.Float8{Float64: 44.8, Valid: true},
+		Latitude:  pgtype.Float8{Float64: 41.7, Valid: true},
+		Country:   "Georgia",
+		Iso2:      "GE",
+		AdminName: pgtype.Text{String: "Tbilisi", Valid: true},
+		Capital:   pgtype.Text{String: "PRIMARY", Valid: true},
+	}
+)
+
+func setupCity(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.CityService,
+	mockCityRepository *mock_repository.MockCityRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockCityRepository = mock_repository.NewMockCityRepository(ctrl)
+	svc = service.NewCityService(mockCityRepository)
+
+	return
+}
+
+func TestCreateCity_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Create(ctx, cityInfoVO).Return(cityModel, nil)
+
+	cityEntity, err := svc.Create(ctx, cityInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCity(cityId, cityInfoVO), cityEntity)
+}
+
+func TestCreateCity_Error(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Create(ctx, cityInfoVO).Return(repository.CityModel{}, errors.New(""))
+
+	_, err := svc.Create(ctx, cityInfoVO)
+	assert.Error(t, err)
+}
+
+func TestGetCity_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Get(ctx, cityId).Return(cityModel, nil)
+
+	cityEntity, err := svc.Get(ctx, cityId)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCity(cityId, cityInfoVO), cityEntity)
+}
+
+func TestGetCity_WithoutLocationAndCapital(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	model := repository.CityModel{ID: cityId, Name: "Rustavi", Country: "Georgia", Iso2: "GE"}
+	mockCityRepository.EXPECT().Get(ctx, cityId).Return(model, nil)
+
+	cityEntity, err := svc.Get(ctx, cityId)
+	assert.NoError(t, err)
+	assert.Nil(t, cityEntity.Info.Location)
+	assert.Nil(t, cityEntity.Info.Capital)
+	assert.Empty(t, cityEntity.Info.AdminName)
+}
+
+func TestGetCity_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Get(ctx, cityId).Return(repository.CityModel{}, pgx.ErrNoRows)
+
+	_, err := svc.Get(ctx, cityId)
+	assert.ErrorIs(t, err, service.ErrCityNotFound)
+}
+
+func TestListCities_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().List(ctx, cityFilter, limit, offset).Return([]repository.CityModel{cityModel}, nil)
+
+	cities, err := svc.List(ctx, cityFilter, limit, offset)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.City{domain.NewCity(cityId, cityInfoVO)}, cities)
+}
+
+func TestListCities_Error(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().List(ctx, cityFilter, limit, offset).Return(nil, errors.New(""))
+
+	cities, err := svc.List(ctx, cityFilter, limit, offset)
+	assert.Error(t, err)
+	assert.Nil(t, cities)
+}
+
+func TestUpdateCity_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Update(ctx, cityId, cityInfoVO).Return(cityModel, nil)
+
+	cityEntity, err := svc.Update(ctx, cityId, cityInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCity(cityId, cityInfoVO), cityEntity)
+}
+
+func TestUpdateCity_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Update(ctx, cityId, cityInfoVO).Return(repository.CityModel{}, pgx.ErrNoRows)
+
+	_, err := svc.Update(ctx, cityId, cityInfoVO)
+	assert.ErrorIs(t, err, service.ErrCityNotFound)
+}
+
+func TestDeleteCity_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Delete(ctx, cityId).Return(true, nil)
+
+	assert.NoError(t, svc.Delete(ctx, cityId))
+}
+
+func TestDeleteCity_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Delete(ctx, cityId).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Delete(ctx, cityId), service.ErrCityNotFound)
+}
+
+func TestDeleteCity_InUse(t *testing.T) {
+	ctrl, ctx, svc, mockCityRepository := setupCity(t)
+	defer ctrl.Finish()
+
+	mockCityRepository.EXPECT().Delete(ctx, cityId).Return(false, &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation})
+
+	assert.ErrorIs(t, svc.Delete(ctx, cityId), service.ErrCityInUse)
+}
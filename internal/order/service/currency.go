@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CurrencyService interface {
+	Create(ctx context.Context, info domain.CurrencyInfo) (domain.Currency, error)
+	Get(ctx context.Context, id int32) (domain.Currency, error)
+	List(ctx context.Context, limit int64, offset int64) ([]domain.Currency, error)
+	Update(ctx context.Context, id int32, info domain.CurrencyInfo) (domain.Currency, error)
+	Delete(ctx context.Context, id int32) error
+}
+
+type currencyServiceImpl struct {
+	currencyRepository repository.CurrencyRepository
+}
+
+func NewCurrencyService(currencyRepository repository.CurrencyRepository) *currencyServiceImpl {
+	return &currencyServiceImpl{
+		currencyRepository: currencyRepository,
+	}
+}
+
+// Create inserts a new currency and returns it.
+// If the currency code is already taken, it returns ErrCurrencyCodeTaken.
+func (s *currencyServiceImpl) Create(ctx context.Context, info domain.CurrencyInfo) (domain.Currency, error) {
+	model, err := s.currencyRepository.Create(ctx, info)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return domain.Currency{}, ErrCurrencyCodeTaken
+		}
+		return domain.Currency{}, err
+	}
+
+	return MapCurrencyModelToEntity(model), nil
+}
+
+// Get retrieves a currency by its ID.
+// If the currency is not found, it returns ErrCurrencyNotFound.
+func (s *currencyServiceImpl) Get(ctx context.Context, id int32) (domain.Currency, error) {
+	model, err := s.currencyRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Currency{}, ErrCurrencyNotFound
+		}
+		return domain.Currency{}, err
+	}
+
+	return MapCurrencyModelToEntity(model), nil
+}
+
+// List retrieves currencies ordered by code with the specified limit and offset.
+func (s *currencyServiceImpl) List(ctx context.Context, limit int64, offset int64) ([]domain.Currency, error) {
+	models, err := s.currencyRepository.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapCurrencyModels(models), nil
+}
+
+// Update replaces the info of an existing currency.
+// If the currency is not found, it returns ErrCurrencyNotFound.
+// If the currency code is already taken, it returns ErrCurrencyCodeTaken.
+func (s *currencyServiceImpl) Update(ctx context.Context, id int32, info domain.CurrencyInfo) (domain.Currency, error) {
+	model, err := s.currencyRepository.Update(ctx, id, info)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Currency{}, ErrCurrencyNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return domain.Currency{}, ErrCurrencyCodeTaken
+		}
+		return domain.Currency{}, err
+	}
+
+	return MapCurrencyModelToEntity(model), nil
+}
+
+// Delete removes a currency by its ID.
+// If the currency is not found, it returns ErrCurrencyNotFound.
+// If the currency is still referenced by offers, it returns ErrCurrencyInUse.
+func (s *currencyServiceImpl) Delete(ctx context.Context, id int32) error {
+	ok, err := s.currencyRepository.Delete(ctx, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return ErrCurrencyInUse
+		}
+		return err
+	}
+	if !ok {
+		return ErrCurrencyNotFound
+	}
+
+	return nil
+}
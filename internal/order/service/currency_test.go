@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	currencyInfoVO = domain.NewCurrencyInfo("Georgian lari", "GEL", "₾")
+
+	currencyModel = repository.CurrencyModel{
+		ID:       currencyId,
+		Currency: "Georgian lari",
+		Code:     "GEL",
+		Symbol:   "₾",
+	}
+)
+
+func setupCurrency(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.CurrencyService,
+	mockCurrencyRepository *mock_repository.MockCurrencyRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockCurrencyRepository = mock_repository.NewMockCurrencyRepository(ctrl)
+	svc = service.NewCurrencyService(mockCurrencyRepository)
+
+	return
+}
+
+func TestCreateCurrency_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Create(ctx, currencyInfoVO).Return(currencyModel, nil)
+
+	currencyEntity, err := svc.Create(ctx, currencyInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCurrency(currencyId, currencyInfoVO), currencyEntity)
+}
+
+func TestCreateCurrency_CodeTaken(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Create(ctx, currencyInfoVO).Return(repository.CurrencyModel{}, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+
+	_, err := svc.Create(ctx, currencyInfoVO)
+	assert.ErrorIs(t, err, service.ErrCurrencyCodeTaken)
+}
+
+func TestGetCurrency_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Get(ctx, currencyId).Return(currencyModel, nil)
+
+	currencyEntity, err := svc.Get(ctx, currencyId)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCurrency(currencyId, currencyInfoVO), currencyEntity)
+}
+
+func TestGetCurrency_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Get(ctx, currencyId).Return(repository.CurrencyModel{}, pgx.ErrNoRows)
+
+	_, err := svc.Get(ctx, currencyId)
+	assert.ErrorIs(t, err, service.ErrCurrencyNotFound)
+}
+
+func TestListCurrencies_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().List(ctx, limit, offset).Return([]repository.CurrencyModel{currencyModel}, nil)
+
+	currencies, err := svc.List(ctx, limit, offset)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Currency{domain.NewCurrency(currencyId, currencyInfoVO)}, currencies)
+}
+
+func TestListCurrencies_Error(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().List(ctx, limit, offset).Return(nil, errors.New(""))
+
+	currencies, err := svc.List(ctx, limit, offset)
+	assert.Error(t, err)
+	assert.Nil(t, currencies)
+}
+
+func TestUpdateCurrency_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Update(ctx, currencyId, currencyInfoVO).Return(currencyModel, nil)
+
+	currencyEntity, err := svc.Update(ctx, currencyId, currencyInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewCurrency(currencyId, currencyInfoVO), currencyEntity)
+}
+
+func TestUpdateCurrency_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Update(ctx, currencyId, currencyInfoVO).Return(repository.CurrencyModel{}, pgx.ErrNoRows)
+
+	_, err := svc.Update(ctx, currencyId, currencyInfoVO)
+	assert.ErrorIs(t, err, service.ErrCurrencyNotFound)
+}
+
+func TestUpdateCurrency_CodeTaken(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Update(ctx, currencyId, currencyInfoVO).Return(repository.CurrencyModel{}, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+
+	_, err := svc.Update(ctx, currencyId, currencyInfoVO)
+	assert.ErrorIs(t, err, service.ErrCurrencyCodeTaken)
+}
+
+func TestDeleteCurrency_Success(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Delete(ctx, currencyId).Return(true, nil)
+
+	assert.NoError(t, svc.Delete(ctx, currencyId))
+}
+
+func TestDeleteCurrency_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Delete(ctx, currencyId).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Delete(ctx, currencyId), service.ErrCurrencyNotFound)
+}
+
+func TestDeleteCurrency_InUse(t *testing.T) {
+	ctrl, ctx, svc, mockCurrencyRepository := setupCurrency(t)
+	defer ctrl.Finish()
+
+	mockCurrencyRepository.EXPECT().Delete(ctx, currencyId).Return(false, &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation})
+
+	assert.ErrorIs(t, svc.Delete(ctx, currencyId), service.ErrCurrencyInUse)
+}
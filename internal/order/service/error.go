@@ -0,0 +1,32 @@
+package service
+
+import "errors"
+
+var (
+	ErrOrderNotFound    = errors.New("order not found")
+	ErrOrderNotOwned    = errors.New("order does not belong to user")
+	ErrOrderNotEditable = errors.New("order is not pending")
+	ErrOrderNotOpen     = errors.New("order is not open for offers")
+	ErrOrderNoLocation  = errors.New("order has no location")
+
+	ErrOfferNotFound         = errors.New("offer not found")
+	ErrOfferNotOwned         = errors.New("offer does not belong to user")
+	ErrOfferNotPending       = errors.New("offer is not pending")
+	ErrOfferAlreadySubmitted = errors.New("offer was already submitted for this order")
+	ErrCurrencyNotFound      = errors.New("currency not found")
+
+	ErrJobNotFound          = errors.New("job not found")
+	ErrJobNotOwned          = errors.New("job does not belong to user")
+	ErrInvalidJobTransition = errors.New("invalid job status transition")
+
+	ErrReviewNotFound     = errors.New("review not found")
+	ErrJobNotCompleted    = errors.New("job is not completed")
+	ErrJobAlreadyReviewed = errors.New("job was already reviewed")
+
+	ErrProviderLocationNotFound = errors.New("provider location not found")
+	ErrCityNotFound             = errors.New("city not found")
+
+	ErrCityInUse         = errors.New("city is referenced by other records")
+	ErrCurrencyInUse     = errors.New("currency is referenced by other records")
+	ErrCurrencyCodeTaken = errors.New("currency code is already taken")
+)
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type JobService interface {
+	Get(ctx context.Context, id int64, userID int64) (domain.Job, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Job, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Job, error)
+	ListStatusHistory(ctx context.Context, id int64, userID int64) ([]domain.JobStatusChange, error)
+	UpdateStatus(ctx context.Context, id int64, userID int64, status domain.Status) (domain.Job, error)
+}
+
+type jobServiceImpl struct {
+	jobRepository   repository.JobRepository
+	orderRepository repository.OrderRepository
+	pgx             postgres.PGX
+}
+
+func NewJobService(
+	jobRepository repository.JobRepository,
+	orderRepository repository.OrderRepository,
+	pgx postgres.PGX,
+) *jobServiceImpl {
+	return &jobServiceImpl{
+		jobRepository:   jobRepository,
+		orderRepository: orderRepository,
+		pgx:             pgx,
+	}
+}
+
+// Get retrieves a job by its ID, the job is only visible to its customer and assigned provider.
+// If the job is not found, it returns ErrJobNotFound.
+// If the user does not participate in the job, it returns ErrJobNotOwned.
+func (s *jobServiceImpl) Get(ctx context.Context, id int64, userID int64) (domain.Job, error) {
+	model, err := getParticipatedJob(ctx, s.jobRepository, id, userID)
+	if err != nil {
+		return domain.Job{}, err
+	}
+
+	return MapJobModelToEntity(model), nil
+}
+
+// ListByProviderId retrieves the jobs assigned to the provider with the specified limit and offset.
+func (s *jobServiceImpl) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Job, error) {
+	list, err := s.jobRepository.ListByProviderId(ctx, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapJobModels(list), nil
+}
+
+// ListByUserId retrieves the jobs of the customer with the specified limit and offset.
+func (s *jobServiceImpl) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Job, error) {
+	list, err := s.jobRepository.ListByUserId(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapJobModels(list), nil
+}
+
+// ListStatusHistory retrieves every status change of the job in chronological order.
+// If the job is not found, it returns ErrJobNotFound.
+// If the user does not participate in the job, it returns ErrJobNotOwned.
+func (s *jobServiceImpl) ListStatusHistory(ctx context.Context, id int64, userID int64) ([]domain.JobStatusChange, error) {
+	if _, err := getParticipatedJob(ctx, s.jobRepository, id, userID); err != nil {
+		return nil, err
+	}
+
+	list, err := s.jobRepository.ListStatusHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]domain.JobStatusChange, len(list))
+	for i, h := range list {
+		history[i] = MapJobStatusHistoryModelToVO(h)
+	}
+
+	return history, nil
+}
+
+// UpdateStatus moves the job to the given status, records the change in the status history
+// and mirrors the status on the order, all within a single transaction.
+// If the job is not found, it returns ErrJobNotFound.
+// If the user does not participate in the job, it returns ErrJobNotOwned.
+// If the transition is not allowed from the current status, it returns ErrInvalidJobTransition.
+func (s *jobServiceImpl) UpdateStatus(ctx context.Context, id int64, userID int64, status domain.Status) (domain.Job, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.Job{}, err
+	}
+
+	jobRepository := s.jobRepository.WithTx(tx)
+
+	model, err := getParticipatedJob(ctx, jobRepository, id, userID)
+	if err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	current := domain.Status(model.Status)
+	if !current.CanTransitionTo(status) {
+		return domain.Job{}, postgres.Rollback(tx, ctx, ErrInvalidJobTransition)
+	}
+
+	// the update is guarded by the current status, so a concurrent transition makes this one invalid
+	model, err = jobRepository.UpdateStatus(ctx, id, current, status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Job{}, postgres.Rollback(tx, ctx, ErrInvalidJobTransition)
+		}
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := jobRepository.CreateStatusHistory(ctx, id, status, userID); err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if _, err := s.orderRepository.WithTx(tx).UpdateStatus(ctx, model.OrderID, status); err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Job{}, err
+	}
+
+	return MapJobModelToEntity(model), nil
+}
+
+// getParticipatedJob fetches a job and checks that the user is either its customer or assigned provider.
+func getParticipatedJob(ctx context.Context, jobRepository repository.JobRepository, id int64, userID int64) (repository.JobModel, error) {
+	model, err := jobRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.JobModel{}, ErrJobNotFound
+		}
+		return repository.JobModel{}, err
+	}
+
+	if model.UserID != userID && model.ProviderID != userID {
+		return repository.JobModel{}, ErrJobNotOwned
+	}
+
+	return model, nil
+}
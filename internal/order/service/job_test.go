@@ -0,0 +1,247 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const jobId int64 = 7
+
+var (
+	jobModel = repository.JobModel{
+		ID:         jobId,
+		OfferID:    offerId,
+		OrderID:    orderId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		ServiceID:  serviceId,
+		Status:     string(domain.StatusPENDING),
+	}
+
+	pausedJobModel = repository.JobModel{
+		ID:         jobId,
+		OfferID:    offerId,
+		OrderID:    orderId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		ServiceID:  serviceId,
+		Status:     string(domain.StatusPAUSED),
+	}
+
+	completedJobModel = repository.JobModel{
+		ID:         jobId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		Status:     string(domain.StatusCOMPLETED),
+	}
+)
+
+func setupJob(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.JobService,
+	mockJobRepository *mock_repository.MockJobRepository,
+	mockOrderRepository *mock_repository.MockOrderRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockJobRepository = mock_repository.NewMockJobRepository(ctrl)
+	mockOrderRepository = mock_repository.NewMockOrderRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	svc = service.NewJobService(mockJobRepository, mockOrderRepository, mockPgx)
+
+	return
+}
+
+func TestGetJob_Success(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+
+	jobEntity, err := svc.Get(ctx, jobId, providerId)
+	assert.NoError(t, err)
+	assert.Equal(t, jobId, jobEntity.ID)
+	assert.Equal(t, domain.StatusPENDING, jobEntity.Status)
+}
+
+func TestGetJob_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(repository.JobModel{}, pgx.ErrNoRows)
+
+	jobEntity, err := svc.Get(ctx, jobId, customerId)
+	assert.ErrorIs(t, err, service.ErrJobNotFound)
+	assert.Empty(t, jobEntity)
+}
+
+func TestGetJob_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+
+	jobEntity, err := svc.Get(ctx, jobId, customerId+providerId)
+	assert.ErrorIs(t, err, service.ErrJobNotOwned)
+	assert.Empty(t, jobEntity)
+}
+
+func TestListJobsByProviderId_Success(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().ListByProviderId(ctx, providerId, limit, offset).Return([]repository.JobModel{jobModel, jobModel}, nil)
+
+	jobEntities, err := svc.ListByProviderId(ctx, providerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, jobEntities, int(limit))
+}
+
+func TestListJobsByUserId_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().ListByUserId(ctx, customerId, limit, offset).Return(nil, errors.New(""))
+
+	jobEntities, err := svc.ListByUserId(ctx, customerId, limit, offset)
+	assert.Error(t, err)
+	assert.Empty(t, jobEntities)
+}
+
+func TestListJobStatusHistory_Success(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+	mockJobRepository.EXPECT().ListStatusHistory(ctx, jobId).Return([]repository.JobStatusHistoryModel{
+		{ID: 1, JobID: jobId, Status: string(domain.StatusPENDING), ChangedBy: customerId, CreatedAt: pgtype.Timestamp{Time: timeStart, Valid: true}},
+		{ID: 2, JobID: jobId, Status: string(domain.StatusPAUSED), ChangedBy: providerId, CreatedAt: pgtype.Timestamp{Time: timeEnd, Valid: true}},
+	}, nil)
+
+	history, err := svc.ListStatusHistory(ctx, jobId, customerId)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, domain.StatusPAUSED, history[1].Status)
+		assert.Equal(t, providerId, history[1].ChangedBy)
+	}
+}
+
+func TestListJobStatusHistory_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, _, _ := setupJob(t)
+	defer ctrl.Finish()
+
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+
+	history, err := svc.ListStatusHistory(ctx, jobId, customerId+providerId)
+	assert.ErrorIs(t, err, service.ErrJobNotOwned)
+	assert.Empty(t, history)
+}
+
+func TestUpdateJobStatus_Pause(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, mockOrderRepository, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+	mockJobRepository.EXPECT().UpdateStatus(ctx, jobId, domain.StatusPENDING, domain.StatusPAUSED).Return(pausedJobModel, nil)
+	mockJobRepository.EXPECT().CreateStatusHistory(ctx, jobId, domain.StatusPAUSED, providerId).Return(nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().UpdateStatus(ctx, orderId, domain.StatusPAUSED).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, providerId, domain.StatusPAUSED)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusPAUSED, jobEntity.Status)
+}
+
+func TestUpdateJobStatus_Resume(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, mockOrderRepository, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(pausedJobModel, nil)
+	mockJobRepository.EXPECT().UpdateStatus(ctx, jobId, domain.StatusPAUSED, domain.StatusPENDING).Return(jobModel, nil)
+	mockJobRepository.EXPECT().CreateStatusHistory(ctx, jobId, domain.StatusPENDING, customerId).Return(nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().UpdateStatus(ctx, orderId, domain.StatusPENDING).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, customerId, domain.StatusPENDING)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusPENDING, jobEntity.Status)
+}
+
+func TestUpdateJobStatus_InvalidTransition(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(completedJobModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, customerId, domain.StatusPAUSED)
+	assert.ErrorIs(t, err, service.ErrInvalidJobTransition)
+	assert.Empty(t, jobEntity)
+}
+
+func TestUpdateJobStatus_PausedToCompleted(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(pausedJobModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, customerId, domain.StatusCOMPLETED)
+	assert.ErrorIs(t, err, service.ErrInvalidJobTransition)
+	assert.Empty(t, jobEntity)
+}
+
+func TestUpdateJobStatus_ConcurrentChange(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+	mockJobRepository.EXPECT().UpdateStatus(ctx, jobId, domain.StatusPENDING, domain.StatusCOMPLETED).Return(repository.JobModel{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, customerId, domain.StatusCOMPLETED)
+	assert.ErrorIs(t, err, service.ErrInvalidJobTransition)
+	assert.Empty(t, jobEntity)
+}
+
+func TestUpdateJobStatus_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockJobRepository, _, mockPgx, mockTx := setupJob(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.UpdateStatus(ctx, jobId, customerId+providerId, domain.StatusCANCELLED)
+	assert.ErrorIs(t, err, service.ErrJobNotOwned)
+	assert.Empty(t, jobEntity)
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func MapOrderModelToEntity(model repository.OrderModel) domain.Order {
+	return domain.NewOrder(
+		model.ID,
+		model.UserID,
+		domain.NewOrderInfo(
+			model.ServiceID,
+			mapLocation(model.Longitude, model.Latitude),
+			model.TimeStart.Time,
+			model.TimeEnd.Time,
+			model.Description,
+		),
+		domain.Status(model.Status),
+		model.CreatedAt.Time,
+	)
+}
+
+func mapLocation(longitude pgtype.Float8, latitude pgtype.Float8) *domain.Location {
+	if !longitude.Valid || !latitude.Valid {
+		return nil
+	}
+
+	return domain.NewLocation(longitude.Float64, latitude.Float64)
+}
+
+func MapOfferModelToEntity(model repository.OfferModel) domain.Offer {
+	return domain.NewOffer(
+		model.ID,
+		model.ProviderID,
+		model.OrderID,
+		domain.NewOfferInfo(model.Price, model.CurrencyID, model.BookTime.Time),
+		domain.OfferStatus(model.Status),
+		model.CreatedAt.Time,
+		model.UpdatedAt.Time,
+	)
+}
+
+func mapOfferModels(models []repository.OfferModel) []domain.Offer {
+	offers := make([]domain.Offer, len(models))
+	for i, o := range models {
+		offers[i] = MapOfferModelToEntity(o)
+	}
+
+	return offers
+}
+
+func MapJobModelToEntity(model repository.JobModel) domain.Job {
+	return domain.NewJob(
+		model.ID,
+		model.OfferID,
+		model.OrderID,
+		model.UserID,
+		model.ProviderID,
+		model.ServiceID,
+		domain.Status(model.Status),
+		model.CreatedAt.Time,
+		model.UpdatedAt.Time,
+	)
+}
+
+func mapJobModels(models []repository.JobModel) []domain.Job {
+	jobs := make([]domain.Job, len(models))
+	for i, j := range models {
+		jobs[i] = MapJobModelToEntity(j)
+	}
+
+	return jobs
+}
+
+func MapJobStatusHistoryModelToVO(model repository.JobStatusHistoryModel) domain.JobStatusChange {
+	return domain.NewJobStatusChange(domain.Status(model.Status), model.ChangedBy, model.CreatedAt.Time)
+}
+
+func MapReviewModelToEntity(model repository.ReviewModel) domain.Review {
+	return domain.NewReview(
+		model.ID,
+		model.JobID,
+		model.UserID,
+		model.ProviderID,
+		domain.NewReviewInfo(model.Rating, model.Comment.String),
+		model.CreatedAt.Time,
+	)
+}
+
+func mapReviewModels(models []repository.ReviewModel) []domain.Review {
+	reviews := make([]domain.Review, len(models))
+	for i, r := range models {
+		reviews[i] = MapReviewModelToEntity(r)
+	}
+
+	return reviews
+}
+
+func MapProviderRatingModelToVO(model repository.ProviderRatingModel) domain.ProviderRating {
+	return domain.NewProviderRating(model.ProviderID, model.RatingAvg, model.ReviewCount)
+}
+
+func MapProviderLocationModelToEntity(model repository.ProviderLocationModel) domain.ProviderLocation {
+	return domain.NewProviderLocation(
+		model.ProviderID,
+		domain.NewProviderLocationInfo(
+			model.CityID,
+			*domain.NewLocation(model.Longitude, model.Latitude),
+			model.Address,
+		),
+	)
+}
+
+func MapNearbyProviderModelToVO(model repository.NearbyProviderModel) domain.NearbyProvider {
+	return domain.NewNearbyProvider(MapProviderLocationModelToEntity(model.ProviderLocationModel), model.Distance)
+}
+
+func MapCityModelToEntity(model repository.CityModel) domain.City {
+	var capital *domain.CapitalType
+	if model.Capital.Valid {
+		c := domain.CapitalType(model.Capital.String)
+		capital = &c
+	}
+
+	return domain.NewCity(
+		model.ID,
+		domain.NewCityInfo(
+			model.Name,
+			mapLocation(model.Longitude, model.Latitude),
+			model.Country,
+			model.Iso2,
+			model.AdminName.String,
+			capital,
+		),
+	)
+}
+
+func mapCityModels(models []repository.CityModel) []domain.City {
+	cities := make([]domain.City, len(models))
+	for i, c := range models {
+		cities[i] = MapCityModelToEntity(c)
+	}
+
+	return cities
+}
+
+func MapCurrencyModelToEntity(model repository.CurrencyModel) domain.Currency {
+	return domain.NewCurrency(model.ID, domain.NewCurrencyInfo(model.Currency, model.Code, model.Symbol))
+}
+
+func mapCurrencyModels(models []repository.CurrencyModel) []domain.Currency {
+	currencies := make([]domain.Currency, len(models))
+	for i, c := range models {
+		currencies[i] = MapCurrencyModelToEntity(c)
+	}
+
+	return currencies
+}
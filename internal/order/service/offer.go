@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type OfferService interface {
+	Submit(ctx context.Context, providerID int64, orderID int64, info domain.OfferInfo) (domain.Offer, error)
+	Get(ctx context.Context, id int64, userID int64) (domain.Offer, error)
+	ListByOrderId(ctx context.Context, orderID int64, customerID int64, limit int64, offset int64) ([]domain.Offer, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Offer, error)
+	Revise(ctx context.Context, id int64, providerID int64, info domain.OfferInfo) (domain.Offer, error)
+	Withdraw(ctx context.Context, id int64, providerID int64) error
+	Accept(ctx context.Context, id int64, customerID int64) (domain.Job, error)
+}
+
+type offerServiceImpl struct {
+	offerRepository   repository.OfferRepository
+	orderRepository   repository.OrderRepository
+	jobRepository     repository.JobRepository
+	channelRepository repository.ChannelRepository
+	pgx               postgres.PGX
+}
+
+func NewOfferService(
+	offerRepository repository.OfferRepository,
+	orderRepository repository.OrderRepository,
+	jobRepository repository.JobRepository,
+	channelRepository repository.ChannelRepository,
+	pgx postgres.PGX,
+) *offerServiceImpl {
+	return &offerServiceImpl{
+		offerRepository:   offerRepository,
+		orderRepository:   orderRepository,
+		jobRepository:     jobRepository,
+		channelRepository: channelRepository,
+		pgx:               pgx,
+	}
+}
+
+// Submit places a provider's offer on an open order and opens a direct chat channel between the customer and the provider.
+// The offer is committed only after the channel is created, the channel creation is idempotent,
+// so a provider who resubmits on the same order keeps the same channel.
+// If the order is not found, it returns ErrOrderNotFound.
+// If the order is no longer pending or an offer was already accepted for it, it returns ErrOrderNotOpen.
+// If the provider already has a pending offer on the order, it returns ErrOfferAlreadySubmitted.
+// If the currency does not exist, it returns ErrCurrencyNotFound.
+func (s *offerServiceImpl) Submit(ctx context.Context, providerID int64, orderID int64, info domain.OfferInfo) (domain.Offer, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.Offer{}, err
+	}
+
+	orderRepository := s.orderRepository.WithTx(tx)
+
+	// the order is locked, so it can't be cancelled or assigned before the offer is inserted
+	orderModel, err := orderRepository.GetForUpdate(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Offer{}, postgres.Rollback(tx, ctx, ErrOrderNotFound)
+		}
+		return domain.Offer{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := ensureOrderOpen(ctx, orderRepository, orderModel); err != nil {
+		return domain.Offer{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	model, err := s.offerRepository.WithTx(tx).Create(ctx, providerID, orderID, info)
+	if err != nil {
+		return domain.Offer{}, postgres.Rollback(tx, ctx, mapOfferWriteError(err))
+	}
+
+	if _, err := s.channelRepository.CreateDirect(ctx, orderID, orderModel.UserID, providerID); err != nil {
+		return domain.Offer{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Offer{}, err
+	}
+
+	return MapOfferModelToEntity(model), nil
+}
+
+// Get retrieves an offer by its ID.
+// The offer is only visible to the provider who submitted it and the customer who placed the order.
+// If the offer is not found, it returns ErrOfferNotFound.
+// If the user is neither of them, it returns ErrOfferNotOwned.
+func (s *offerServiceImpl) Get(ctx context.Context, id int64, userID int64) (domain.Offer, error) {
+	model, err := s.offerRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Offer{}, ErrOfferNotFound
+		}
+		return domain.Offer{}, err
+	}
+
+	if model.ProviderID == userID {
+		return MapOfferModelToEntity(model), nil
+	}
+
+	orderModel, err := s.orderRepository.Get(ctx, model.OrderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Offer{}, ErrOrderNotFound
+		}
+		return domain.Offer{}, err
+	}
+
+	if orderModel.UserID != userID {
+		return domain.Offer{}, ErrOfferNotOwned
+	}
+
+	return MapOfferModelToEntity(model), nil
+}
+
+// ListByOrderId retrieves the offers submitted on the customer's order with the specified limit and offset.
+// If the order is not found, it returns ErrOrderNotFound.
+// If the order belongs to another user, it returns ErrOrderNotOwned.
+func (s *offerServiceImpl) ListByOrderId(ctx context.Context, orderID int64, customerID int64, limit int64, offset int64) ([]domain.Offer, error) {
+	orderModel, err := s.orderRepository.Get(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	if orderModel.UserID != customerID {
+		return nil, ErrOrderNotOwned
+	}
+
+	list, err := s.offerRepository.ListByOrderId(ctx, orderID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapOfferModels(list), nil
+}
+
+// ListByProviderId retrieves the offers submitted by the provider with the specified limit and offset.
+func (s *offerServiceImpl) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Offer, error) {
+	list, err := s.offerRepository.ListByProviderId(ctx, providerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapOfferModels(list), nil
+}
+
+// Revise changes the price, currency or booking time of a pending offer.
+// If the offer is not found, it returns ErrOfferNotFound.
+// If the offer was submitted by another provider, it returns ErrOfferNotOwned.
+// If the offer is no longer pending, it returns ErrOfferNotPending.
+// If the order is not open anymore, it returns ErrOrderNotOpen.
+func (s *offerServiceImpl) Revise(ctx context.Context, id int64, providerID int64, info domain.OfferInfo) (domain.Offer, error) {
+	model, err := s.getOwnedPending(ctx, id, providerID)
+	if err != nil {
+		return domain.Offer{}, err
+	}
+
+	if err := s.checkOrderOpen(ctx, model.OrderID); err != nil {
+		return domain.Offer{}, err
+	}
+
+	// the update only applies to a pending offer, in case it was accepted or withdrawn since the check
+	model, err = s.offerRepository.Update(ctx, id, info)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Offer{}, ErrOfferNotPending
+		}
+		return domain.Offer{}, mapOfferWriteError(err)
+	}
+
+	return MapOfferModelToEntity(model), nil
+}
+
+// Withdraw sets a pending offer status to WITHDRAWN.
+// If the offer is not found, it returns ErrOfferNotFound.
+// If the offer was submitted by another provider, it returns ErrOfferNotOwned.
+// If the offer is no longer pending, it returns ErrOfferNotPending.
+func (s *offerServiceImpl) Withdraw(ctx context.Context, id int64, providerID int64) error {
+	if _, err := s.getOwnedPending(ctx, id, providerID); err != nil {
+		return err
+	}
+
+	ok, err := s.offerRepository.UpdateStatus(ctx, id, domain.OfferStatusWITHDRAWN)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrOfferNotPending
+	}
+
+	return nil
+}
+
+// Accept accepts a pending offer on the customer's order, rejects every competing offer
+// and creates a job for the assigned provider, all within a single transaction.
+// If the offer is not found, it returns ErrOfferNotFound.
+// If the offer is no longer pending, it returns ErrOfferNotPending.
+// If the order belongs to another user, it returns ErrOrderNotOwned.
+// If the order is not open anymore, it returns ErrOrderNotOpen.
+func (s *offerServiceImpl) Accept(ctx context.Context, id int64, customerID int64) (domain.Job, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.Job{}, err
+	}
+
+	offerRepository := s.offerRepository.WithTx(tx)
+	orderRepository := s.orderRepository.WithTx(tx)
+	jobRepository := s.jobRepository.WithTx(tx)
+
+	// the offer is locked, so the job is created on the terms the customer accepted
+	model, err := offerRepository.GetForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Job{}, postgres.Rollback(tx, ctx, ErrOfferNotFound)
+		}
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if domain.OfferStatus(model.Status) != domain.OfferStatusPENDING {
+		return domain.Job{}, postgres.Rollback(tx, ctx, ErrOfferNotPending)
+	}
+
+	// the order is locked as well, so it can't be cancelled or edited before the job is created
+	orderModel, err := orderRepository.GetForUpdate(ctx, model.OrderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Job{}, postgres.Rollback(tx, ctx, ErrOrderNotFound)
+		}
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if orderModel.UserID != customerID {
+		return domain.Job{}, postgres.Rollback(tx, ctx, ErrOrderNotOwned)
+	}
+
+	if err := ensureOrderOpen(ctx, orderRepository, orderModel); err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	// accept the offer first, the unique index on accepted offers guards against concurrent accepts
+	ok, err := offerRepository.UpdateStatus(ctx, id, domain.OfferStatusACCEPTED)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return domain.Job{}, postgres.Rollback(tx, ctx, ErrOrderNotOpen)
+		}
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+	if !ok {
+		return domain.Job{}, postgres.Rollback(tx, ctx, ErrOfferNotPending)
+	}
+
+	if err := offerRepository.RejectCompeting(ctx, model.OrderID, id); err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	jobModel, err := jobRepository.Create(ctx, repository.CreateJobParams{
+		OfferID:    id,
+		OrderID:    model.OrderID,
+		UserID:     orderModel.UserID,
+		ProviderID: model.ProviderID,
+		ServiceID:  orderModel.ServiceID,
+	})
+	if err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := jobRepository.CreateStatusHistory(ctx, jobModel.ID, domain.Status(jobModel.Status), customerID); err != nil {
+		return domain.Job{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Job{}, err
+	}
+
+	return MapJobModelToEntity(jobModel), nil
+}
+
+// getOwnedPending fetches an offer and checks that it was submitted by the provider and is still pending.
+func (s *offerServiceImpl) getOwnedPending(ctx context.Context, id int64, providerID int64) (repository.OfferModel, error) {
+	model, err := s.offerRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.OfferModel{}, ErrOfferNotFound
+		}
+		return repository.OfferModel{}, err
+	}
+
+	if model.ProviderID != providerID {
+		return repository.OfferModel{}, ErrOfferNotOwned
+	}
+
+	if domain.OfferStatus(model.Status) != domain.OfferStatusPENDING {
+		return repository.OfferModel{}, ErrOfferNotPending
+	}
+
+	return model, nil
+}
+
+// checkOrderOpen checks that the order exists, is pending and has no accepted offer yet.
+func (s *offerServiceImpl) checkOrderOpen(ctx context.Context, orderID int64) error {
+	orderModel, err := s.orderRepository.Get(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrOrderNotFound
+		}
+		return err
+	}
+
+	return ensureOrderOpen(ctx, s.orderRepository, orderModel)
+}
+
+// ensureOrderOpen checks that the fetched order is pending and has no accepted offer yet.
+func ensureOrderOpen(ctx context.Context, orderRepository repository.OrderRepository, orderModel repository.OrderModel) error {
+	if domain.Status(orderModel.Status) != domain.StatusPENDING {
+		return ErrOrderNotOpen
+	}
+
+	assigned, err := orderRepository.IsAssigned(ctx, orderModel.ID)
+	if err != nil {
+		return err
+	}
+	if assigned {
+		return ErrOrderNotOpen
+	}
+
+	return nil
+}
+
+// mapOfferWriteError maps constraint violations, raised on offer insert or update, to service errors.
+func mapOfferWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.UniqueViolation:
+			return ErrOfferAlreadySubmitted
+		case pgerrcode.ForeignKeyViolation:
+			return ErrCurrencyNotFound
+		}
+	}
+
+	return err
+}
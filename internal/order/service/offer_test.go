@@ -0,0 +1,477 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	offerId    int64 = 4
+	providerId int64 = 5
+	currencyId int32 = 6
+	price            = 120.5
+	channelId  int64 = 10
+)
+
+var (
+	offerInfoVO = domain.NewOfferInfo(price, currencyId, timeStart)
+
+	offerModel = repository.OfferModel{
+		ID:         offerId,
+		ProviderID: providerId,
+		OrderID:    orderId,
+		Price:      price,
+		CurrencyID: currencyId,
+		BookTime:   pgtype.Timestamp{Time: timeStart, Valid: true},
+		Status:     string(domain.OfferStatusPENDING),
+	}
+
+	withdrawnOfferModel = repository.OfferModel{
+		ID:         offerId,
+		ProviderID: providerId,
+		OrderID:    orderId,
+		Status:     string(domain.OfferStatusWITHDRAWN),
+	}
+)
+
+func setupOffer(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.OfferService,
+	mockOfferRepository *mock_repository.MockOfferRepository,
+	mockOrderRepository *mock_repository.MockOrderRepository,
+	mockJobRepository *mock_repository.MockJobRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	mockChannelRepository *mock_repository.MockChannelRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockOfferRepository = mock_repository.NewMockOfferRepository(ctrl)
+	mockOrderRepository = mock_repository.NewMockOrderRepository(ctrl)
+	mockJobRepository = mock_repository.NewMockJobRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	mockChannelRepository = mock_repository.NewMockChannelRepository(ctrl)
+	svc = service.NewOfferService(mockOfferRepository, mockOrderRepository, mockJobRepository, mockChannelRepository, mockPgx)
+
+	return
+}
+
+func TestSubmitOffer_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, mockPgx, mockTx, mockChannelRepository := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOfferRepository.EXPECT().Create(ctx, providerId, orderId, offerInfoVO).Return(offerModel, nil)
+	mockChannelRepository.EXPECT().CreateDirect(ctx, orderId, customerId, providerId).Return(channelId, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, offerId, offerEntity.ID)
+	assert.Equal(t, offerInfoVO, offerEntity.Info)
+	assert.Equal(t, domain.OfferStatusPENDING, offerEntity.Status)
+}
+
+func TestSubmitOffer_ChannelError(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, mockPgx, mockTx, mockChannelRepository := setupOffer(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("cassandra error")
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOfferRepository.EXPECT().Create(ctx, providerId, orderId, offerInfoVO).Return(offerModel, nil)
+	mockChannelRepository.EXPECT().CreateDirect(ctx, orderId, customerId, providerId).Return(int64(0), expectedErr)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Empty(t, offerEntity)
+}
+
+func TestSubmitOffer_OrderNotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockOrderRepository, _, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(repository.OrderModel{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	assert.Empty(t, offerEntity)
+}
+
+func TestSubmitOffer_OrderNotPending(t *testing.T) {
+	ctrl, ctx, svc, _, mockOrderRepository, _, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(cancelledOrderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotOpen)
+	assert.Empty(t, offerEntity)
+}
+
+func TestSubmitOffer_OrderAssigned(t *testing.T) {
+	ctrl, ctx, svc, _, mockOrderRepository, _, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(true, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotOpen)
+	assert.Empty(t, offerEntity)
+}
+
+func TestSubmitOffer_AlreadySubmitted(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOfferRepository.EXPECT().Create(ctx, providerId, orderId, offerInfoVO).Return(repository.OfferModel{}, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOfferAlreadySubmitted)
+	assert.Empty(t, offerEntity)
+}
+
+func TestSubmitOffer_CurrencyNotFound(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOfferRepository.EXPECT().Create(ctx, providerId, orderId, offerInfoVO).Return(repository.OfferModel{}, &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation})
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	offerEntity, err := svc.Submit(ctx, providerId, orderId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrCurrencyNotFound)
+	assert.Empty(t, offerEntity)
+}
+
+func TestGetOffer_Provider(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+
+	offerEntity, err := svc.Get(ctx, offerId, providerId)
+	assert.NoError(t, err)
+	assert.Equal(t, offerId, offerEntity.ID)
+}
+
+func TestGetOffer_Customer(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+
+	offerEntity, err := svc.Get(ctx, offerId, customerId)
+	assert.NoError(t, err)
+	assert.Equal(t, offerId, offerEntity.ID)
+}
+
+func TestGetOffer_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+
+	offerEntity, err := svc.Get(ctx, offerId, customerId+providerId)
+	assert.ErrorIs(t, err, service.ErrOfferNotOwned)
+	assert.Empty(t, offerEntity)
+}
+
+func TestGetOffer_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(repository.OfferModel{}, pgx.ErrNoRows)
+
+	offerEntity, err := svc.Get(ctx, offerId, providerId)
+	assert.ErrorIs(t, err, service.ErrOfferNotFound)
+	assert.Empty(t, offerEntity)
+}
+
+func TestListOffersByOrderId_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+	mockOfferRepository.EXPECT().ListByOrderId(ctx, orderId, limit, offset).Return([]repository.OfferModel{offerModel, offerModel}, nil)
+
+	offerEntities, err := svc.ListByOrderId(ctx, orderId, customerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, offerEntities, int(limit))
+}
+
+func TestListOffersByOrderId_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, _, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+
+	offerEntities, err := svc.ListByOrderId(ctx, orderId, customerId+1, limit, offset)
+	assert.ErrorIs(t, err, service.ErrOrderNotOwned)
+	assert.Empty(t, offerEntities)
+}
+
+func TestListOffersByProviderId_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().ListByProviderId(ctx, providerId, limit, offset).Return([]repository.OfferModel{offerModel, offerModel}, nil)
+
+	offerEntities, err := svc.ListByProviderId(ctx, providerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, offerEntities, int(limit))
+}
+
+func TestListOffersByProviderId_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().ListByProviderId(ctx, providerId, limit, offset).Return(nil, errors.New(""))
+
+	offerEntities, err := svc.ListByProviderId(ctx, providerId, limit, offset)
+	assert.Error(t, err)
+	assert.Empty(t, offerEntities)
+}
+
+func TestReviseOffer_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().Update(ctx, offerId, offerInfoVO).Return(offerModel, nil)
+
+	offerEntity, err := svc.Revise(ctx, offerId, providerId, offerInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, offerInfoVO, offerEntity.Info)
+}
+
+func TestReviseOffer_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+
+	offerEntity, err := svc.Revise(ctx, offerId, providerId+1, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOfferNotOwned)
+	assert.Empty(t, offerEntity)
+}
+
+func TestReviseOffer_NotPending(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(withdrawnOfferModel, nil)
+
+	offerEntity, err := svc.Revise(ctx, offerId, providerId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOfferNotPending)
+	assert.Empty(t, offerEntity)
+}
+
+func TestReviseOffer_AcceptedMeanwhile(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().Update(ctx, offerId, offerInfoVO).Return(repository.OfferModel{}, pgx.ErrNoRows)
+
+	offerEntity, err := svc.Revise(ctx, offerId, providerId, offerInfoVO)
+	assert.ErrorIs(t, err, service.ErrOfferNotPending)
+	assert.Empty(t, offerEntity)
+}
+
+func TestWithdrawOffer_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOfferRepository.EXPECT().UpdateStatus(ctx, offerId, domain.OfferStatusWITHDRAWN).Return(true, nil)
+
+	assert.NoError(t, svc.Withdraw(ctx, offerId, providerId))
+}
+
+func TestWithdrawOffer_NotPending(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(withdrawnOfferModel, nil)
+
+	assert.ErrorIs(t, svc.Withdraw(ctx, offerId, providerId), service.ErrOfferNotPending)
+}
+
+func TestWithdrawOffer_AcceptedMeanwhile(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, _, _, _, _, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockOfferRepository.EXPECT().Get(ctx, offerId).Return(offerModel, nil)
+	mockOfferRepository.EXPECT().UpdateStatus(ctx, offerId, domain.OfferStatusWITHDRAWN).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Withdraw(ctx, offerId, providerId), service.ErrOfferNotPending)
+}
+
+func TestAcceptOffer_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().UpdateStatus(ctx, offerId, domain.OfferStatusACCEPTED).Return(true, nil)
+	mockOfferRepository.EXPECT().RejectCompeting(ctx, orderId, offerId).Return(nil)
+	mockJobRepository.EXPECT().Create(ctx, repository.CreateJobParams{
+		OfferID:    offerId,
+		OrderID:    orderId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		ServiceID:  serviceId,
+	}).Return(jobModel, nil)
+	mockJobRepository.EXPECT().CreateStatusHistory(ctx, jobId, domain.StatusPENDING, customerId).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId)
+	assert.NoError(t, err)
+	assert.Equal(t, jobId, jobEntity.ID)
+	assert.Equal(t, domain.StatusPENDING, jobEntity.Status)
+}
+
+func TestAcceptOffer_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId+1)
+	assert.ErrorIs(t, err, service.ErrOrderNotOwned)
+	assert.Empty(t, jobEntity)
+}
+
+func TestAcceptOffer_NotPending(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(withdrawnOfferModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId)
+	assert.ErrorIs(t, err, service.ErrOfferNotPending)
+	assert.Empty(t, jobEntity)
+}
+
+func TestAcceptOffer_OrderCancelled(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(cancelledOrderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId)
+	assert.ErrorIs(t, err, service.ErrOrderNotOpen)
+	assert.Empty(t, jobEntity)
+}
+
+func TestAcceptOffer_ConcurrentAccept(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().UpdateStatus(ctx, offerId, domain.OfferStatusACCEPTED).Return(false, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId)
+	assert.ErrorIs(t, err, service.ErrOrderNotOpen)
+	assert.Empty(t, jobEntity)
+}
+
+func TestAcceptOffer_RejectError(t *testing.T) {
+	ctrl, ctx, svc, mockOfferRepository, mockOrderRepository, mockJobRepository, mockPgx, mockTx, _ := setupOffer(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOfferRepository.EXPECT().WithTx(mockTx).Return(mockOfferRepository)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockOfferRepository.EXPECT().GetForUpdate(ctx, offerId).Return(offerModel, nil)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOfferRepository.EXPECT().UpdateStatus(ctx, offerId, domain.OfferStatusACCEPTED).Return(true, nil)
+	mockOfferRepository.EXPECT().RejectCompeting(ctx, orderId, offerId).Return(errors.New(""))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	jobEntity, err := svc.Accept(ctx, offerId, customerId)
+	assert.Error(t, err)
+	assert.Empty(t, jobEntity)
+}
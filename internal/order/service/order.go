@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type OrderService interface {
+	Create(ctx context.Context, userID int64, info domain.OrderInfo) (domain.Order, error)
+	Get(ctx context.Context, id int64) (domain.Order, error)
+	List(ctx context.Context, limit int64, offset int64) ([]domain.Order, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Order, error)
+	Update(ctx context.Context, id int64, userID int64, info domain.OrderInfo) (domain.Order, error)
+	Cancel(ctx context.Context, id int64, userID int64) error
+}
+
+type orderServiceImpl struct {
+	orderRepository repository.OrderRepository
+	pgx             postgres.PGX
+}
+
+func NewOrderService(orderRepository repository.OrderRepository, pgx postgres.PGX) *orderServiceImpl {
+	return &orderServiceImpl{
+		orderRepository: orderRepository,
+		pgx:             pgx,
+	}
+}
+
+// Create places a new order on behalf of the user.
+func (s *orderServiceImpl) Create(ctx context.Context, userID int64, info domain.OrderInfo) (domain.Order, error) {
+	model, err := s.orderRepository.Create(ctx, userID, info)
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	return MapOrderModelToEntity(model), nil
+}
+
+// Get retrieves an order by its ID from the repository.
+// If the order is not found, it returns ErrOrderNotFound.
+func (s *orderServiceImpl) Get(ctx context.Context, id int64) (domain.Order, error) {
+	model, err := s.orderRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Order{}, ErrOrderNotFound
+		}
+		return domain.Order{}, err
+	}
+
+	return MapOrderModelToEntity(model), nil
+}
+
+// List retrieves a list of pending orders from the repository with the specified limit and offset.
+func (s *orderServiceImpl) List(ctx context.Context, limit int64, offset int64) ([]domain.Order, error) {
+	list, err := s.orderRepository.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.Order, len(list))
+	for i, o := range list {
+		orders[i] = MapOrderModelToEntity(o)
+	}
+
+	return orders, nil
+}
+
+// ListByUserId retrieves a list of orders placed by the user with the specified limit and offset.
+func (s *orderServiceImpl) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Order, error) {
+	list, err := s.orderRepository.ListByUserId(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.Order, len(list))
+	for i, o := range list {
+		orders[i] = MapOrderModelToEntity(o)
+	}
+
+	return orders, nil
+}
+
+// Update modifies an existing order using the provided OrderInfo.
+// The order is locked while it is checked and updated, so an offer can't be accepted on it meanwhile.
+// If the order is not found, it returns ErrOrderNotFound.
+// If the order belongs to another user, it returns ErrOrderNotOwned.
+// If the order is no longer pending or an offer was already accepted, it returns ErrOrderNotEditable.
+func (s *orderServiceImpl) Update(ctx context.Context, id int64, userID int64, info domain.OrderInfo) (domain.Order, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	orderRepository := s.orderRepository.WithTx(tx)
+
+	if _, err := getOwnedPendingOrder(ctx, orderRepository, id, userID); err != nil {
+		return domain.Order{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	model, err := orderRepository.Update(ctx, id, info)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Order{}, postgres.Rollback(tx, ctx, ErrOrderNotFound)
+		}
+		return domain.Order{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Order{}, err
+	}
+
+	return MapOrderModelToEntity(model), nil
+}
+
+// Cancel sets the order status to CANCELLED.
+// The order is locked while it is checked and cancelled, so an offer can't be accepted on it meanwhile.
+// If the order is not found, it returns ErrOrderNotFound.
+// If the order belongs to another user, it returns ErrOrderNotOwned.
+// If the order is no longer pending or an offer was already accepted, it returns ErrOrderNotEditable.
+func (s *orderServiceImpl) Cancel(ctx context.Context, id int64, userID int64) error {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	orderRepository := s.orderRepository.WithTx(tx)
+
+	if _, err := getOwnedPendingOrder(ctx, orderRepository, id, userID); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	ok, err := orderRepository.UpdateStatus(ctx, id, domain.StatusCANCELLED)
+	if err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+	if !ok {
+		return postgres.Rollback(tx, ctx, ErrOrderNotFound)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// getOwnedPendingOrder locks an order and checks that it belongs to the user and is still pending,
+// meaning that no offer was accepted for it yet. It must be called within a transaction.
+func getOwnedPendingOrder(ctx context.Context, orderRepository repository.OrderRepository, id int64, userID int64) (repository.OrderModel, error) {
+	model, err := orderRepository.GetForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.OrderModel{}, ErrOrderNotFound
+		}
+		return repository.OrderModel{}, err
+	}
+
+	if model.UserID != userID {
+		return repository.OrderModel{}, ErrOrderNotOwned
+	}
+
+	if domain.Status(model.Status) != domain.StatusPENDING {
+		return repository.OrderModel{}, ErrOrderNotEditable
+	}
+
+	assigned, err := orderRepository.IsAssigned(ctx, id)
+	if err != nil {
+		return repository.OrderModel{}, err
+	}
+	if assigned {
+		return repository.OrderModel{}, ErrOrderNotEditable
+	}
+
+	return model, nil
+}
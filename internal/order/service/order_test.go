@@ -0,0 +1,318 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	orderId     int64 = 1
+	customerId  int64 = 2
+	serviceId   int32 = 3
+	description       = "Fix the kitchen sink"
+
+	limit  int64 = 2
+	offset int64 = 0
+)
+
+var (
+	timeStart = time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+	timeEnd   = timeStart.Add(2 * time.Hour)
+
+	orderInfoVO = domain.NewOrderInfo(serviceId, domain.NewLocation(44.8, 41.7), timeStart, timeEnd, description)
+
+	orderModel = repository.OrderModel{
+		ID:          orderId,
+		UserID:      customerId,
+		ServiceID:   serviceId,
+		Longitude:   pgtype.Float8{Float64: 44.8, Valid: true},
+		Latitude:    pgtype.Float8{Float64: 41.7, Valid: true},
+		TimeStart:   pgtype.Timestamp{Time: timeStart, Valid: true},
+		TimeEnd:     pgtype.Timestamp{Time: timeEnd, Valid: true},
+		Description: description,
+		Status:      string(domain.StatusPENDING),
+		CreatedAt:   pgtype.Timestamp{Time: timeStart, Valid: true},
+	}
+
+	cancelledOrderModel = repository.OrderModel{
+		ID:     orderId,
+		UserID: customerId,
+		Status: string(domain.StatusCANCELLED),
+	}
+)
+
+func setupOrder(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.OrderService,
+	mockOrderRepository *mock_repository.MockOrderRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockOrderRepository = mock_repository.NewMockOrderRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	svc = service.NewOrderService(mockOrderRepository, mockPgx)
+
+	return
+}
+
+func TestCreateOrder_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Create(ctx, customerId, orderInfoVO).Return(orderModel, nil)
+
+	orderEntity, err := svc.Create(ctx, customerId, orderInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, orderId, orderEntity.ID)
+	assert.Equal(t, customerId, orderEntity.UserID)
+	assert.Equal(t, orderInfoVO, orderEntity.Info)
+	assert.Equal(t, domain.StatusPENDING, orderEntity.Status)
+}
+
+func TestCreateOrder_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Create(ctx, customerId, orderInfoVO).Return(repository.OrderModel{}, errors.New(""))
+
+	orderEntity, err := svc.Create(ctx, customerId, orderInfoVO)
+	assert.Error(t, err)
+	assert.Empty(t, orderEntity)
+}
+
+func TestGetOrder_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+
+	orderEntity, err := svc.Get(ctx, orderId)
+	assert.NoError(t, err)
+	assert.Equal(t, orderId, orderEntity.ID)
+	assert.Equal(t, orderInfoVO, orderEntity.Info)
+}
+
+func TestGetOrder_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(repository.OrderModel{}, pgx.ErrNoRows)
+
+	orderEntity, err := svc.Get(ctx, orderId)
+	assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	assert.Empty(t, orderEntity)
+}
+
+func TestGetOrder_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(repository.OrderModel{}, errors.New(""))
+
+	orderEntity, err := svc.Get(ctx, orderId)
+	assert.Error(t, err)
+	assert.Empty(t, orderEntity)
+}
+
+func TestListOrders_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().List(ctx, limit, offset).Return([]repository.OrderModel{orderModel, orderModel}, nil)
+
+	orderEntities, err := svc.List(ctx, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, orderEntities, int(limit))
+}
+
+func TestListOrders_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().List(ctx, limit, offset).Return(nil, errors.New(""))
+
+	orderEntities, err := svc.List(ctx, limit, offset)
+	assert.Error(t, err)
+	assert.Empty(t, orderEntities)
+}
+
+func TestListOrdersByUserId_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().ListByUserId(ctx, customerId, limit, offset).Return([]repository.OrderModel{orderModel, orderModel}, nil)
+
+	orderEntities, err := svc.ListByUserId(ctx, customerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, orderEntities, int(limit))
+}
+
+func TestListOrdersByUserId_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, _, _ := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().ListByUserId(ctx, customerId, limit, offset).Return(nil, errors.New(""))
+
+	orderEntities, err := svc.ListByUserId(ctx, customerId, limit, offset)
+	assert.Error(t, err)
+	assert.Empty(t, orderEntities)
+}
+
+func TestUpdateOrder_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOrderRepository.EXPECT().Update(ctx, orderId, orderInfoVO).Return(orderModel, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId, orderInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, orderId, orderEntity.ID)
+	assert.Equal(t, orderInfoVO, orderEntity.Info)
+}
+
+func TestUpdateOrder_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(repository.OrderModel{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId, orderInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotFound)
+	assert.Empty(t, orderEntity)
+}
+
+func TestUpdateOrder_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId+1, orderInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotOwned)
+	assert.Empty(t, orderEntity)
+}
+
+func TestUpdateOrder_NotEditable(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(cancelledOrderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId, orderInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotEditable)
+	assert.Empty(t, orderEntity)
+}
+
+func TestUpdateOrder_Assigned(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(true, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId, orderInfoVO)
+	assert.ErrorIs(t, err, service.ErrOrderNotEditable)
+	assert.Empty(t, orderEntity)
+}
+
+func TestUpdateOrder_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOrderRepository.EXPECT().Update(ctx, orderId, orderInfoVO).Return(repository.OrderModel{}, errors.New(""))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	orderEntity, err := svc.Update(ctx, orderId, customerId, orderInfoVO)
+	assert.Error(t, err)
+	assert.Empty(t, orderEntity)
+}
+
+func TestCancelOrder_Success(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOrderRepository.EXPECT().UpdateStatus(ctx, orderId, domain.StatusCANCELLED).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	assert.NoError(t, svc.Cancel(ctx, orderId, customerId))
+}
+
+func TestCancelOrder_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Cancel(ctx, orderId, customerId+1), service.ErrOrderNotOwned)
+}
+
+func TestCancelOrder_NotEditable(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(cancelledOrderModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Cancel(ctx, orderId, customerId), service.ErrOrderNotEditable)
+}
+
+func TestCancelOrder_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockOrderRepository, mockPgx, mockTx := setupOrder(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockOrderRepository.EXPECT().WithTx(mockTx).Return(mockOrderRepository)
+	mockOrderRepository.EXPECT().GetForUpdate(ctx, orderId).Return(orderModel, nil)
+	mockOrderRepository.EXPECT().IsAssigned(ctx, orderId).Return(false, nil)
+	mockOrderRepository.EXPECT().UpdateStatus(ctx, orderId, domain.StatusCANCELLED).Return(false, errors.New(""))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.Error(t, svc.Cancel(ctx, orderId, customerId))
+}
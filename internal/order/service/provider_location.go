@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type ProviderLocationService interface {
+	Set(ctx context.Context, providerID int64, info domain.ProviderLocationInfo) (domain.ProviderLocation, error)
+	Get(ctx context.Context, providerID int64) (domain.ProviderLocation, error)
+	ListProviderIdsByCityId(ctx context.Context, cityID int32, limit int64, offset int64) ([]int64, error)
+	SearchNearby(ctx context.Context, location domain.Location, radius float64, serviceID *int32, limit int64, offset int64) ([]domain.NearbyProvider, error)
+	SearchNearbyOrder(ctx context.Context, orderID int64, userID int64, radius float64, limit int64, offset int64) ([]domain.NearbyProvider, error)
+}
+
+type providerLocationServiceImpl struct {
+	providerLocationRepository repository.ProviderLocationRepository
+	providerServiceRepository  repository.ProviderServiceRepository
+	orderRepository            repository.OrderRepository
+}
+
+func NewProviderLocationService(
+	providerLocationRepository repository.ProviderLocationRepository,
+	providerServiceRepository repository.ProviderServiceRepository,
+	orderRepository repository.OrderRepository,
+) *providerLocationServiceImpl {
+	return &providerLocationServiceImpl{
+		providerLocationRepository: providerLocationRepository,
+		providerServiceRepository:  providerServiceRepository,
+		orderRepository:            orderRepository,
+	}
+}
+
+// Set creates or replaces the location of the provider.
+// If the city does not exist, it returns ErrCityNotFound.
+func (s *providerLocationServiceImpl) Set(ctx context.Context, providerID int64, info domain.ProviderLocationInfo) (domain.ProviderLocation, error) {
+	model, err := s.providerLocationRepository.Upsert(ctx, providerID, info)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return domain.ProviderLocation{}, ErrCityNotFound
+		}
+		return domain.ProviderLocation{}, err
+	}
+
+	return MapProviderLocationModelToEntity(model), nil
+}
+
+// Get retrieves the location of the provider.
+// If the provider has not set a location, it returns ErrProviderLocationNotFound.
+func (s *providerLocationServiceImpl) Get(ctx context.Context, providerID int64) (domain.ProviderLocation, error) {
+	model, err := s.providerLocationRepository.Get(ctx, providerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProviderLocation{}, ErrProviderLocationNotFound
+		}
+		return domain.ProviderLocation{}, err
+	}
+
+	return MapProviderLocationModelToEntity(model), nil
+}
+
+// ListProviderIdsByCityId retrieves the ids of providers located in the city with the specified limit and offset.
+func (s *providerLocationServiceImpl) ListProviderIdsByCityId(ctx context.Context, cityID int32, limit int64, offset int64) ([]int64, error) {
+	return s.providerLocationRepository.ListProviderIdsByCityId(ctx, cityID, limit, offset)
+}
+
+// SearchNearby retrieves providers within the radius (in meters) of the location, nearest first.
+// If serviceID is set, only providers offering the service are returned.
+func (s *providerLocationServiceImpl) SearchNearby(
+	ctx context.Context,
+	location domain.Location,
+	radius float64,
+	serviceID *int32,
+	limit int64,
+	offset int64,
+) ([]domain.NearbyProvider, error) {
+	arg := repository.ListNearbyProvidersParams{
+		Longitude: location.Longitude,
+		Latitude:  location.Latitude,
+		Radius:    radius,
+		Limit:     limit,
+		Offset:    offset,
+	}
+
+	if serviceID != nil {
+		providerIDs, err := s.providerServiceRepository.ListProviderIds(ctx, *serviceID)
+		if err != nil {
+			return nil, err
+		}
+		if len(providerIDs) == 0 {
+			return []domain.NearbyProvider{}, nil
+		}
+		arg.ProviderIDs = providerIDs
+	}
+
+	list, err := s.providerLocationRepository.ListNearby(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]domain.NearbyProvider, len(list))
+	for i, p := range list {
+		providers[i] = MapNearbyProviderModelToVO(p)
+	}
+
+	return providers, nil
+}
+
+// SearchNearbyOrder retrieves providers of the order service within the radius (in meters) of the order location.
+// If the order is not found, it returns ErrOrderNotFound.
+// If the order does not belong to the user, it returns ErrOrderNotOwned.
+// If the order has no location, it returns ErrOrderNoLocation.
+func (s *providerLocationServiceImpl) SearchNearbyOrder(ctx context.Context, orderID int64, userID int64, radius float64, limit int64, offset int64) ([]domain.NearbyProvider, error) {
+	orderModel, err := s.orderRepository.Get(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	if orderModel.UserID != userID {
+		return nil, ErrOrderNotOwned
+	}
+
+	location := mapLocation(orderModel.Longitude, orderModel.Latitude)
+	if location == nil {
+		return nil, ErrOrderNoLocation
+	}
+
+	return s.SearchNearby(ctx, *location, radius, &orderModel.ServiceID, limit, offset)
+}
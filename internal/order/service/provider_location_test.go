@@ -0,0 +1,191 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	cityId int32   = 9
+	radius float64 = 5000
+)
+
+var (
+	providerLocationInfoVO = domain.NewProviderLocationInfo(cityId, *domain.NewLocation(44.8, 41.7), "Rustaveli Ave. 1")
+
+	providerLocationModel = repository.ProviderLocationModel{
+		ProviderID: providerId,
+		CityID:     cityId,
+		Longitude:  44.8,
+		Latitude:   41.7,
+		Address:    "Rustaveli Ave. 1",
+	}
+
+	nearbyProviderModel = repository.NearbyProviderModel{
+		ProviderLocationModel: providerLocationModel,
+		Distance:              120.5,
+	}
+
+	nearbyParams = repository.ListNearbyProvidersParams{
+		Longitude: 44.8,
+		Latitude:  41.7,
+		Radius:    radius,
+		Limit:     limit,
+		Offset:    offset,
+	}
+)
+
+func setupProviderLocation(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.ProviderLocationService,
+	mockProviderLocationRepository *mock_repository.MockProviderLocationRepository,
+	mockProviderServiceRepository *mock_repository.MockProviderServiceRepository,
+	mockOrderRepository *mock_repository.MockOrderRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockProviderLocationRepository = mock_repository.NewMockProviderLocationRepository(ctrl)
+	mockProviderServiceRepository = mock_repository.NewMockProviderServiceRepository(ctrl)
+	mockOrderRepository = mock_repository.NewMockOrderRepository(ctrl)
+	svc = service.NewProviderLocationService(mockProviderLocationRepository, mockProviderServiceRepository, mockOrderRepository)
+
+	return
+}
+
+func TestSetProviderLocation_Success(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, _, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderLocationRepository.EXPECT().Upsert(ctx, providerId, providerLocationInfoVO).Return(providerLocationModel, nil)
+
+	locationEntity, err := svc.Set(ctx, providerId, providerLocationInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.NewProviderLocation(providerId, providerLocationInfoVO), locationEntity)
+}
+
+func TestSetProviderLocation_CityNotFound(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, _, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderLocationRepository.EXPECT().Upsert(ctx, providerId, providerLocationInfoVO).Return(repository.ProviderLocationModel{}, &pgconn.PgError{Code: pgerrcode.ForeignKeyViolation})
+
+	locationEntity, err := svc.Set(ctx, providerId, providerLocationInfoVO)
+	assert.ErrorIs(t, err, service.ErrCityNotFound)
+	assert.Empty(t, locationEntity)
+}
+
+func TestGetProviderLocation_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, _, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderLocationRepository.EXPECT().Get(ctx, providerId).Return(repository.ProviderLocationModel{}, pgx.ErrNoRows)
+
+	locationEntity, err := svc.Get(ctx, providerId)
+	assert.ErrorIs(t, err, service.ErrProviderLocationNotFound)
+	assert.Empty(t, locationEntity)
+}
+
+func TestSearchNearby_Success(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, _, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderLocationRepository.EXPECT().ListNearby(ctx, nearbyParams).Return([]repository.NearbyProviderModel{nearbyProviderModel}, nil)
+
+	providers, err := svc.SearchNearby(ctx, *domain.NewLocation(44.8, 41.7), radius, nil, limit, offset)
+	assert.NoError(t, err)
+	if assert.Len(t, providers, 1) {
+		assert.Equal(t, providerId, providers[0].ProviderID)
+		assert.Equal(t, nearbyProviderModel.Distance, providers[0].Distance)
+	}
+}
+
+func TestSearchNearby_FilteredByService(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, mockProviderServiceRepository, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	filteredParams := nearbyParams
+	filteredParams.ProviderIDs = []int64{providerId}
+
+	mockProviderServiceRepository.EXPECT().ListProviderIds(ctx, serviceId).Return([]int64{providerId}, nil)
+	mockProviderLocationRepository.EXPECT().ListNearby(ctx, filteredParams).Return([]repository.NearbyProviderModel{nearbyProviderModel}, nil)
+
+	filterServiceId := serviceId
+	providers, err := svc.SearchNearby(ctx, *domain.NewLocation(44.8, 41.7), radius, &filterServiceId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, providers, 1)
+}
+
+func TestSearchNearby_NoServiceProviders(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderServiceRepository, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderServiceRepository.EXPECT().ListProviderIds(ctx, serviceId).Return([]int64{}, nil)
+
+	filterServiceId := serviceId
+	providers, err := svc.SearchNearby(ctx, *domain.NewLocation(44.8, 41.7), radius, &filterServiceId, limit, offset)
+	assert.NoError(t, err)
+	assert.Empty(t, providers)
+}
+
+func TestSearchNearby_CatalogError(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderServiceRepository, _ := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockProviderServiceRepository.EXPECT().ListProviderIds(ctx, serviceId).Return(nil, errors.New(""))
+
+	filterServiceId := serviceId
+	providers, err := svc.SearchNearby(ctx, *domain.NewLocation(44.8, 41.7), radius, &filterServiceId, limit, offset)
+	assert.Error(t, err)
+	assert.Empty(t, providers)
+}
+
+func TestSearchNearbyOrder_Success(t *testing.T) {
+	ctrl, ctx, svc, mockProviderLocationRepository, mockProviderServiceRepository, mockOrderRepository := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	filteredParams := nearbyParams
+	filteredParams.ProviderIDs = []int64{providerId}
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+	mockProviderServiceRepository.EXPECT().ListProviderIds(ctx, serviceId).Return([]int64{providerId}, nil)
+	mockProviderLocationRepository.EXPECT().ListNearby(ctx, filteredParams).Return([]repository.NearbyProviderModel{nearbyProviderModel}, nil)
+
+	providers, err := svc.SearchNearbyOrder(ctx, orderId, customerId, radius, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, providers, 1)
+}
+
+func TestSearchNearbyOrder_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOrderRepository := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(orderModel, nil)
+
+	providers, err := svc.SearchNearbyOrder(ctx, orderId, providerId, radius, limit, offset)
+	assert.ErrorIs(t, err, service.ErrOrderNotOwned)
+	assert.Empty(t, providers)
+}
+
+func TestSearchNearbyOrder_NoLocation(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOrderRepository := setupProviderLocation(t)
+	defer ctrl.Finish()
+
+	mockOrderRepository.EXPECT().Get(ctx, orderId).Return(repository.OrderModel{ID: orderId, UserID: customerId, ServiceID: serviceId}, nil)
+
+	providers, err := svc.SearchNearbyOrder(ctx, orderId, customerId, radius, limit, offset)
+	assert.ErrorIs(t, err, service.ErrOrderNoLocation)
+	assert.Empty(t, providers)
+}
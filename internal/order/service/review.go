@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReviewService interface {
+	Create(ctx context.Context, jobID int64, userID int64, info domain.ReviewInfo) (domain.Review, error)
+	Get(ctx context.Context, id int64) (domain.Review, error)
+	ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Review, domain.ProviderRating, error)
+	ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Review, error)
+	ListProviderRatings(ctx context.Context, sort domain.RatingSort, limit int64, offset int64) ([]domain.ProviderRating, error)
+}
+
+type reviewServiceImpl struct {
+	reviewRepository         repository.ReviewRepository
+	providerRatingRepository repository.ProviderRatingRepository
+	jobRepository            repository.JobRepository
+	pgx                      postgres.PGX
+}
+
+func NewReviewService(
+	reviewRepository repository.ReviewRepository,
+	providerRatingRepository repository.ProviderRatingRepository,
+	jobRepository repository.JobRepository,
+	pgx postgres.PGX,
+) *reviewServiceImpl {
+	return &reviewServiceImpl{
+		reviewRepository:         reviewRepository,
+		providerRatingRepository: providerRatingRepository,
+		jobRepository:            jobRepository,
+		pgx:                      pgx,
+	}
+}
+
+// Create reviews a completed job of the customer and folds the rating into the provider aggregate
+// within a single transaction.
+// If the rating is out of range, it returns domain.ErrInvalidRating.
+// If the job is not found, it returns ErrJobNotFound.
+// If the job does not belong to the customer, it returns ErrJobNotOwned.
+// If the job is not completed, it returns ErrJobNotCompleted.
+// If the job was already reviewed, it returns ErrJobAlreadyReviewed.
+func (s *reviewServiceImpl) Create(ctx context.Context, jobID int64, userID int64, info domain.ReviewInfo) (domain.Review, error) {
+	if !domain.ValidRating(info.Rating) {
+		return domain.Review{}, domain.ErrInvalidRating
+	}
+
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	jobModel, err := s.jobRepository.WithTx(tx).Get(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Review{}, postgres.Rollback(tx, ctx, ErrJobNotFound)
+		}
+		return domain.Review{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if jobModel.UserID != userID {
+		return domain.Review{}, postgres.Rollback(tx, ctx, ErrJobNotOwned)
+	}
+
+	if domain.Status(jobModel.Status) != domain.StatusCOMPLETED {
+		return domain.Review{}, postgres.Rollback(tx, ctx, ErrJobNotCompleted)
+	}
+
+	model, err := s.reviewRepository.WithTx(tx).Create(ctx, repository.CreateReviewParams{
+		JobID:      jobID,
+		UserID:     userID,
+		ProviderID: jobModel.ProviderID,
+		Rating:     info.Rating,
+		Comment:    pgtype.Text{String: info.Comment, Valid: info.Comment != ""},
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return domain.Review{}, postgres.Rollback(tx, ctx, ErrJobAlreadyReviewed)
+		}
+		return domain.Review{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if _, err := s.providerRatingRepository.WithTx(tx).Add(ctx, jobModel.ProviderID, info.Rating); err != nil {
+		return domain.Review{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.Review{}, err
+	}
+
+	return MapReviewModelToEntity(model), nil
+}
+
+// Get retrieves a review by its ID.
+// If the review is not found, it returns ErrReviewNotFound.
+func (s *reviewServiceImpl) Get(ctx context.Context, id int64) (domain.Review, error) {
+	model, err := s.reviewRepository.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Review{}, ErrReviewNotFound
+		}
+		return domain.Review{}, err
+	}
+
+	return MapReviewModelToEntity(model), nil
+}
+
+// ListByProviderId retrieves the reviews of the provider with the specified limit and offset,
+// together with the provider rating aggregate. A provider without reviews has a zero aggregate.
+func (s *reviewServiceImpl) ListByProviderId(ctx context.Context, providerID int64, limit int64, offset int64) ([]domain.Review, domain.ProviderRating, error) {
+	ratingModel, err := s.providerRatingRepository.Get(ctx, providerID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ProviderRating{}, err
+		}
+		ratingModel = repository.ProviderRatingModel{ProviderID: providerID}
+	}
+
+	list, err := s.reviewRepository.ListByProviderId(ctx, providerID, limit, offset)
+	if err != nil {
+		return nil, domain.ProviderRating{}, err
+	}
+
+	return mapReviewModels(list), MapProviderRatingModelToVO(ratingModel), nil
+}
+
+// ListByUserId retrieves the reviews written by the customer with the specified limit and offset.
+func (s *reviewServiceImpl) ListByUserId(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.Review, error) {
+	list, err := s.reviewRepository.ListByUserId(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapReviewModels(list), nil
+}
+
+// ListProviderRatings retrieves the provider rating aggregates ordered by the given sort.
+func (s *reviewServiceImpl) ListProviderRatings(ctx context.Context, sort domain.RatingSort, limit int64, offset int64) ([]domain.ProviderRating, error) {
+	list, err := s.providerRatingRepository.List(ctx, sort, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ratings := make([]domain.ProviderRating, len(list))
+	for i, r := range list {
+		ratings[i] = MapProviderRatingModelToVO(r)
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,232 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/order/domain"
+	"github.com/hexley21/fixup/internal/order/repository"
+	mock_repository "github.com/hexley21/fixup/internal/order/repository/mock"
+	"github.com/hexley21/fixup/internal/order/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	reviewId int64   = 8
+	rating   float64 = 4.5
+	comment          = "Quick and tidy"
+)
+
+var (
+	reviewInfoVO = domain.NewReviewInfo(rating, comment)
+
+	reviewModel = repository.ReviewModel{
+		ID:         reviewId,
+		JobID:      jobId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		Rating:     rating,
+		Comment:    pgtype.Text{String: comment, Valid: true},
+	}
+
+	createReviewParams = repository.CreateReviewParams{
+		JobID:      jobId,
+		UserID:     customerId,
+		ProviderID: providerId,
+		Rating:     rating,
+		Comment:    pgtype.Text{String: comment, Valid: true},
+	}
+
+	providerRatingModel = repository.ProviderRatingModel{
+		ProviderID:  providerId,
+		RatingAvg:   4.25,
+		ReviewCount: 2,
+	}
+)
+
+func setupReview(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.ReviewService,
+	mockReviewRepository *mock_repository.MockReviewRepository,
+	mockProviderRatingRepository *mock_repository.MockProviderRatingRepository,
+	mockJobRepository *mock_repository.MockJobRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockReviewRepository = mock_repository.NewMockReviewRepository(ctrl)
+	mockProviderRatingRepository = mock_repository.NewMockProviderRatingRepository(ctrl)
+	mockJobRepository = mock_repository.NewMockJobRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	svc = service.NewReviewService(mockReviewRepository, mockProviderRatingRepository, mockJobRepository, mockPgx)
+
+	return
+}
+
+func TestCreateReview_Success(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, mockProviderRatingRepository, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(completedJobModel, nil)
+	mockReviewRepository.EXPECT().WithTx(mockTx).Return(mockReviewRepository)
+	mockReviewRepository.EXPECT().Create(ctx, createReviewParams).Return(reviewModel, nil)
+	mockProviderRatingRepository.EXPECT().WithTx(mockTx).Return(mockProviderRatingRepository)
+	mockProviderRatingRepository.EXPECT().Add(ctx, providerId, rating).Return(providerRatingModel, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, customerId, reviewInfoVO)
+	assert.NoError(t, err)
+	assert.Equal(t, reviewId, reviewEntity.ID)
+	assert.Equal(t, providerId, reviewEntity.ProviderID)
+	assert.Equal(t, reviewInfoVO, reviewEntity.Info)
+}
+
+func TestCreateReview_InvalidRating(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _ := setupReview(t)
+	defer ctrl.Finish()
+
+	for _, r := range []float64{-0.5, 4.3, 5.5} {
+		reviewEntity, err := svc.Create(ctx, jobId, customerId, domain.NewReviewInfo(r, comment))
+		assert.ErrorIs(t, err, domain.ErrInvalidRating)
+		assert.Empty(t, reviewEntity)
+	}
+}
+
+func TestCreateReview_JobNotFound(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(repository.JobModel{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, customerId, reviewInfoVO)
+	assert.ErrorIs(t, err, service.ErrJobNotFound)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestCreateReview_NotOwned(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(completedJobModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, providerId, reviewInfoVO)
+	assert.ErrorIs(t, err, service.ErrJobNotOwned)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestCreateReview_JobNotCompleted(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(jobModel, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, customerId, reviewInfoVO)
+	assert.ErrorIs(t, err, service.ErrJobNotCompleted)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestCreateReview_AlreadyReviewed(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, _, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(completedJobModel, nil)
+	mockReviewRepository.EXPECT().WithTx(mockTx).Return(mockReviewRepository)
+	mockReviewRepository.EXPECT().Create(ctx, createReviewParams).Return(repository.ReviewModel{}, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, customerId, reviewInfoVO)
+	assert.ErrorIs(t, err, service.ErrJobAlreadyReviewed)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestCreateReview_RatingError(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, mockProviderRatingRepository, mockJobRepository, mockPgx, mockTx := setupReview(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockJobRepository.EXPECT().WithTx(mockTx).Return(mockJobRepository)
+	mockJobRepository.EXPECT().Get(ctx, jobId).Return(completedJobModel, nil)
+	mockReviewRepository.EXPECT().WithTx(mockTx).Return(mockReviewRepository)
+	mockReviewRepository.EXPECT().Create(ctx, createReviewParams).Return(reviewModel, nil)
+	mockProviderRatingRepository.EXPECT().WithTx(mockTx).Return(mockProviderRatingRepository)
+	mockProviderRatingRepository.EXPECT().Add(ctx, providerId, rating).Return(repository.ProviderRatingModel{}, errors.New(""))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	reviewEntity, err := svc.Create(ctx, jobId, customerId, reviewInfoVO)
+	assert.Error(t, err)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestGetReview_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, _, _, _, _ := setupReview(t)
+	defer ctrl.Finish()
+
+	mockReviewRepository.EXPECT().Get(ctx, reviewId).Return(repository.ReviewModel{}, pgx.ErrNoRows)
+
+	reviewEntity, err := svc.Get(ctx, reviewId)
+	assert.ErrorIs(t, err, service.ErrReviewNotFound)
+	assert.Empty(t, reviewEntity)
+}
+
+func TestListReviewsByProviderId_Success(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, mockProviderRatingRepository, _, _, _ := setupReview(t)
+	defer ctrl.Finish()
+
+	mockProviderRatingRepository.EXPECT().Get(ctx, providerId).Return(providerRatingModel, nil)
+	mockReviewRepository.EXPECT().ListByProviderId(ctx, providerId, limit, offset).Return([]repository.ReviewModel{reviewModel, reviewModel}, nil)
+
+	reviewEntities, providerRating, err := svc.ListByProviderId(ctx, providerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Len(t, reviewEntities, int(limit))
+	assert.Equal(t, domain.NewProviderRating(providerId, 4.25, 2), providerRating)
+}
+
+func TestListReviewsByProviderId_NoReviews(t *testing.T) {
+	ctrl, ctx, svc, mockReviewRepository, mockProviderRatingRepository, _, _, _ := setupReview(t)
+	defer ctrl.Finish()
+
+	mockProviderRatingRepository.EXPECT().Get(ctx, providerId).Return(repository.ProviderRatingModel{}, pgx.ErrNoRows)
+	mockReviewRepository.EXPECT().ListByProviderId(ctx, providerId, limit, offset).Return(nil, nil)
+
+	reviewEntities, providerRating, err := svc.ListByProviderId(ctx, providerId, limit, offset)
+	assert.NoError(t, err)
+	assert.Empty(t, reviewEntities)
+	assert.Equal(t, domain.NewProviderRating(providerId, 0, 0), providerRating)
+}
+
+func TestListProviderRatings_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRatingRepository, _, _, _ := setupReview(t)
+	defer ctrl.Finish()
+
+	mockProviderRatingRepository.EXPECT().List(ctx, domain.RatingSortREVIEWS, limit, offset).Return([]repository.ProviderRatingModel{providerRatingModel}, nil)
+
+	ratings, err := svc.ListProviderRatings(ctx, domain.RatingSortREVIEWS, limit, offset)
+	assert.NoError(t, err)
+	if assert.Len(t, ratings, 1) {
+		assert.Equal(t, int32(2), ratings[0].Count)
+	}
+}
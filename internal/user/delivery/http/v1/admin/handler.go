@@ -0,0 +1,425 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+var (
+	ErrInvalidVerified      = errors.New("verified must be true or false")
+	ErrInvalidCreatedAfter  = errors.New("created_after must be an RFC 3339 date")
+	ErrInvalidCreatedBefore = errors.New("created_before must be an RFC 3339 date")
+)
+
+type Handler struct {
+	*handler.Components
+	service        service.AdminService
+	urlSigner      cdn.URLSigner
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	components *handler.Components,
+	service service.AdminService,
+	urlSigner cdn.URLSigner,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     components,
+		service:        service,
+		urlSigner:      urlSigner,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// SearchUsers
+// @Summary Search users
+// @Description Retrieves a user range ordered from the newest, optionally filtered by role, verification, email prefix and creation date
+// @Tags admin
+// @Produce json
+// @Param role query string false "User role" Enums(CUSTOMER, PROVIDER, MODERATOR, ADMIN)
+// @Param verified query bool false "Verification status"
+// @Param email query string false "Email prefix"
+// @Param created_after query string false "Created at or after the RFC 3339 date"
+// @Param created_before query string false "Created before the RFC 3339 date"
+// @Param page query int false "Page number, selects offset pagination instead of the cursor one"
+// @Param cursor query string false "Cursor of the page, next_cursor or prev_cursor of a previous response"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.User] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users [get]
+func (h *Handler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	filter, errResp := parseUserFilter(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if request_util.UsesCursor(r) {
+		h.searchUsersKeyset(w, r, filter)
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	users, err := h.service.SearchUsers(r.Context(), filter, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to search users: %w", err))
+		return
+	}
+
+	userDTOs, errResp := h.mapUsersToDTO(users)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.Logger.Infof("Search users - %d", len(userDTOs))
+	h.Writer.WriteData(w, http.StatusOK, userDTOs)
+}
+
+func (h *Handler) searchUsersKeyset(w http.ResponseWriter, r *http.Request, filter domain.UserFilter) {
+	cursor, limit, errResp := request_util.ParseCursorAndLimit(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	// users are ordered by their creation time, so a cursor into the list must carry it
+	keyset := domain.NewKeyset(cursor.ID, time.Time{}, cursor.Backward)
+	if cursor.ID != 0 {
+		if cursor.CreatedAt == nil {
+			h.Writer.WriteError(w, request_util.ErrInvalidCursor)
+			return
+		}
+		keyset.CreatedAt = *cursor.CreatedAt
+	}
+
+	users, err := h.service.SearchUsersKeyset(r.Context(), filter, keyset, limit)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to search users: %w", err))
+		return
+	}
+
+	userDTOs, errResp := h.mapUsersToDTO(users)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	nextCursor, prevCursor := request_util.PageCursorsFunc(cursor, limit, users, func(u *domain.User) request_util.Cursor {
+		return request_util.Cursor{ID: u.ID, CreatedAt: &u.CreatedAt}
+	})
+
+	h.Logger.Infof("Search users - %d", len(userDTOs))
+	h.Writer.WriteCursorData(w, http.StatusOK, userDTOs, nextCursor, prevCursor)
+}
+
+func (h *Handler) mapUsersToDTO(users []*domain.User) ([]*dto.User, *rest.ErrorResponse) {
+	userDTOs := make([]*dto.User, len(users))
+	for i, user := range users {
+		userDTO, err := mapper.MapUserToDTO(user, h.urlSigner)
+		if err != nil {
+			return nil, rest.NewInternalServerErrorf("failed to search users due to mapping error - id: %d, error: %w", user.ID, err)
+		}
+		userDTOs[i] = userDTO
+	}
+
+	return userDTOs, nil
+}
+
+// ChangeRole
+// @Summary Change a user's role
+// @Description Change the role of the user, it's applied on the user's next token refresh. Promoting to a provider requires an approved provider registration.
+// @Tags admin
+// @Accept json
+// @Param user_id path string true "User ID"
+// @Param role body dto.ChangeRole true "New role"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - User already has the role or is not an approved provider"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users/{user_id}/role [patch]
+func (h *Handler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var roleDTO dto.ChangeRole
+	if err := h.Binder.BindJSON(r, &roleDTO); err != nil {
+		h.Writer.WriteError(w, err)
+		return
+	}
+
+	if err := h.Validator.Validate(roleDTO); err != nil {
+		h.Writer.WriteError(w, err)
+		return
+	}
+
+	role, err := enum.ParseRole(roleDTO.Role)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		return
+	}
+
+	if err := h.service.ChangeRole(r.Context(), actorID, userID, role); err != nil {
+		h.writeActionError(w, err, "failed to change user role - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Change user role - Role: %s, U-ID: %d, A-ID: %d", role, userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// Suspend
+// @Summary Suspend a user
+// @Description Suspend the user and revoke all of the user's sessions, a suspended user can't log in or refresh the access token
+// @Tags admin
+// @Accept json
+// @Param user_id path string true "User ID"
+// @Param suspension body dto.Suspend false "Reason of the suspension"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - User is already suspended"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users/{user_id}/suspend [post]
+func (h *Handler) Suspend(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var suspendDTO dto.Suspend
+	if r.ContentLength != 0 {
+		if err := h.Binder.BindJSON(r, &suspendDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+	}
+
+	if err := h.Validator.Validate(suspendDTO); err != nil {
+		h.Writer.WriteError(w, err)
+		return
+	}
+
+	if err := h.service.Suspend(r.Context(), actorID, userID, suspendDTO.Reason); err != nil {
+		h.writeActionError(w, err, "failed to suspend user - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Suspend user - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// Unsuspend
+// @Summary Lift a user's suspension
+// @Description Lift the suspension of the user, so the user can log in again
+// @Tags admin
+// @Param user_id path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - User is not suspended"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users/{user_id}/unsuspend [post]
+func (h *Handler) Unsuspend(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.service.Unsuspend(r.Context(), actorID, userID); err != nil {
+		h.writeActionError(w, err, "failed to unsuspend user - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Unsuspend user - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ForceVerify
+// @Summary Verify a user
+// @Description Verify the user without the verification letter
+// @Tags admin
+// @Param user_id path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - User is already verified"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users/{user_id}/verify [post]
+func (h *Handler) ForceVerify(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.service.ForceVerify(r.Context(), actorID, userID); err != nil {
+		h.writeActionError(w, err, "failed to verify user - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Force verify user - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ListAuditLogs
+// @Summary List a user's audit trail
+// @Description Retrieves the administrative actions taken on the user, ordered from the newest
+// @Tags admin
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.AuditLog] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /admin/users/{user_id}/audit-logs [get]
+func (h *Handler) ListAuditLogs(w http.ResponseWriter, r *http.Request) {
+	userID, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	logs, err := h.service.ListAuditLogs(r.Context(), userID, limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to list audit logs - id: %d, error: %w", userID, err))
+		return
+	}
+
+	logDTOs := make([]dto.AuditLog, len(logs))
+	for i, log := range logs {
+		logDTOs[i] = mapper.MapAuditLogToDTO(log)
+	}
+
+	h.Logger.Infof("Fetch user audit logs - U-ID: %d, %d", userID, len(logDTOs))
+	h.Writer.WriteData(w, http.StatusOK, logDTOs)
+}
+
+// writeActionError writes the error of an administrative action, unknown errors are written with the format.
+func (h *Handler) writeActionError(w http.ResponseWriter, err error, format string, userID int64) {
+	switch {
+	case errors.Is(err, service.ErrUserNotFound):
+		h.Writer.WriteError(w, rest.NewNotFoundError(err))
+	case errors.Is(err, service.ErrAdminSelfAction):
+		h.Writer.WriteError(w, rest.NewForbiddenError(err))
+	case errors.Is(err, service.ErrUserRoleUnchanged),
+		errors.Is(err, service.ErrProviderNotRegistered),
+		errors.Is(err, service.ErrProviderNotApproved),
+		errors.Is(err, service.ErrUserSuspended),
+		errors.Is(err, service.ErrUserNotSuspended),
+		errors.Is(err, service.ErrUserVerified):
+		h.Writer.WriteError(w, rest.NewConflictError(err))
+	default:
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf(format, userID, err))
+	}
+}
+
+// parseActorAndUserID returns the id of the current user and the "user_id" path parameter.
+func parseActorAndUserID(r *http.Request) (int64, int64, *rest.ErrorResponse) {
+	actorID, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		return 0, 0, errResp
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 64)
+	if err != nil {
+		return 0, 0, rest.NewInvalidIdError(err)
+	}
+
+	return actorID, userID, nil
+}
+
+// parseUserFilter parses the "role", "verified", "email", "created_after" and "created_before" query parameters, all of them are optional.
+func parseUserFilter(r *http.Request) (domain.UserFilter, *rest.ErrorResponse) {
+	query := r.URL.Query()
+
+	var role enum.UserRole
+	if roleParam := query.Get("role"); roleParam != "" {
+		parsed, err := enum.ParseRole(roleParam)
+		if err != nil {
+			return domain.UserFilter{}, rest.NewBadRequestError(err)
+		}
+		role = parsed
+	}
+
+	var verified *bool
+	if verifiedParam := query.Get("verified"); verifiedParam != "" {
+		parsed, err := strconv.ParseBool(verifiedParam)
+		if err != nil {
+			return domain.UserFilter{}, rest.NewBadRequestError(ErrInvalidVerified)
+		}
+		verified = &parsed
+	}
+
+	var createdAfter, createdBefore time.Time
+	if createdAfterParam := query.Get("created_after"); createdAfterParam != "" {
+		parsed, err := time.Parse(time.RFC3339, createdAfterParam)
+		if err != nil {
+			return domain.UserFilter{}, rest.NewBadRequestError(ErrInvalidCreatedAfter)
+		}
+		createdAfter = parsed.UTC()
+	}
+	if createdBeforeParam := query.Get("created_before"); createdBeforeParam != "" {
+		parsed, err := time.Parse(time.RFC3339, createdBeforeParam)
+		if err != nil {
+			return domain.UserFilter{}, rest.NewBadRequestError(ErrInvalidCreatedBefore)
+		}
+		createdBefore = parsed.UTC()
+	}
+
+	return domain.NewUserFilter(role, verified, query.Get("email"), createdAfter, createdBefore), nil
+}
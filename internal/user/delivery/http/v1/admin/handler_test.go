@@ -0,0 +1,358 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/admin"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_service "github.com/hexley21/fixup/internal/user/service/mock"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	actorId int64 = 1
+	userId  int64 = 2
+	page    int64 = 0
+	perPage int64 = 10
+)
+
+var actorData = auth_jwt.UserData{ID: "1", Role: enum.UserRoleADMIN, Verified: true}
+
+func setup(t *testing.T) (
+	ctrl *gomock.Controller,
+	mockAdminService *mock_service.MockAdminService,
+	mockValidator *mock_validator.MockValidator,
+	h *admin.Handler,
+) {
+	ctrl = gomock.NewController(t)
+	mockAdminService = mock_service.NewMockAdminService(ctrl)
+	mockValidator = mock_validator.NewMockValidator(ctrl)
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	h = admin.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mockValidator, json_writer.New(logger, jsonManager)),
+		mockAdminService,
+		nil,
+		50,
+		100,
+	)
+
+	return
+}
+
+func withActor(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), auth_jwt.AuthJWTKey, actorData))
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, expectedError string) {
+	var errResp rest.ErrorResponse
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp)) {
+		assert.Equal(t, expectedError, errResp.Message)
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	verified := true
+	createdAfter := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         url.Values
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name:  "Success",
+			query: url.Values{"role": {"PROVIDER"}, "verified": {"true"}, "email": {"larry"}, "created_after": {"2024-01-01T00:00:00Z"}},
+			mockSetup: func() {
+				filter := domain.NewUserFilter(enum.UserRolePROVIDER, &verified, "larry", createdAfter, time.Time{})
+				serviceMock.EXPECT().SearchUsers(gomock.Any(), filter, perPage, page).Return([]*domain.User{}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:          "Invalid Role",
+			query:         url.Values{"role": {"OWNER"}},
+			mockSetup:     func() {},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: enum.ErrInvalidRole.Error(),
+		},
+		{
+			name:          "Invalid Verified",
+			query:         url.Values{"verified": {"maybe"}},
+			mockSetup:     func() {},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: admin.ErrInvalidVerified.Error(),
+		},
+		{
+			name:          "Invalid Created Before",
+			query:         url.Values{"created_before": {"yesterday"}},
+			mockSetup:     func() {},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: admin.ErrInvalidCreatedBefore.Error(),
+		},
+		{
+			name:  "Service Error",
+			query: url.Values{},
+			mockSetup: func() {
+				serviceMock.EXPECT().SearchUsers(gomock.Any(), domain.UserFilter{}, perPage, page).Return(nil, errors.New(""))
+			},
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: rest.MsgInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Get("/", h.SearchUsers)
+
+			tt.query.Set("page", "1")
+			tt.query.Set("per_page", "10")
+			req := httptest.NewRequest(http.MethodGet, "/?"+tt.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSearchUsers_Cursor(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	cursorCreatedAt := time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+	createdAt := cursorCreatedAt.Add(-time.Hour)
+
+	filter := domain.NewUserFilter(enum.UserRoleCUSTOMER, nil, "", time.Time{}, time.Time{})
+	personalInfo := domain.NewUserPersonalInfo("larry@email.com", "995555555555", "Larry", "Page")
+	serviceMock.EXPECT().SearchUsersKeyset(gomock.Any(), filter, domain.NewKeyset(userId, cursorCreatedAt, false), int64(1)).Return([]*domain.User{
+		domain.NewUser(userId-1, "", personalInfo, domain.NewUserAccountInfo(enum.UserRoleCUSTOMER, true, false), createdAt),
+	}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/", h.SearchUsers)
+
+	q := url.Values{"role": {"CUSTOMER"}}
+	q.Set("cursor", request_util.Cursor{ID: userId, CreatedAt: &cursorCreatedAt}.Encode())
+	q.Set("per_page", "1")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response rest.ApiResponse[[]dto.User]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) {
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, request_util.Cursor{ID: userId - 1, CreatedAt: &createdAt}.Encode(), response.NextCursor)
+		assert.Equal(t, request_util.Cursor{ID: userId - 1, CreatedAt: &createdAt, Backward: true}.Encode(), response.PrevCursor)
+	}
+}
+
+func TestSearchUsers_InvalidCursor(t *testing.T) {
+	ctrl, _, _, h := setup(t)
+	defer ctrl.Finish()
+
+	r := chi.NewRouter()
+	r.Get("/", h.SearchUsers)
+
+	req := httptest.NewRequest(http.MethodGet, "/?cursor=invalid", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSearchUsers_CursorWithoutCreatedAt(t *testing.T) {
+	ctrl, _, _, h := setup(t)
+	defer ctrl.Finish()
+
+	r := chi.NewRouter()
+	r.Get("/", h.SearchUsers)
+
+	req := httptest.NewRequest(http.MethodGet, "/?cursor="+request_util.Cursor{ID: userId}.Encode(), nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestChangeRole(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().ChangeRole(gomock.Any(), actorId, userId, enum.UserRoleMODERATOR).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func() {
+				serviceMock.EXPECT().ChangeRole(gomock.Any(), actorId, userId, enum.UserRoleMODERATOR).Return(service.ErrUserNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrUserNotFound.Error(),
+		},
+		{
+			name: "Self",
+			mockSetup: func() {
+				serviceMock.EXPECT().ChangeRole(gomock.Any(), actorId, userId, enum.UserRoleMODERATOR).Return(service.ErrAdminSelfAction)
+			},
+			expectedCode:  http.StatusForbidden,
+			expectedError: service.ErrAdminSelfAction.Error(),
+		},
+		{
+			name: "Unchanged",
+			mockSetup: func() {
+				serviceMock.EXPECT().ChangeRole(gomock.Any(), actorId, userId, enum.UserRoleMODERATOR).Return(service.ErrUserRoleUnchanged)
+			},
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrUserRoleUnchanged.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Patch("/{user_id}/role", h.ChangeRole)
+
+			req := httptest.NewRequest(http.MethodPatch, "/2/role", strings.NewReader(`{"role": "MODERATOR"}`))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, withActor(req))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSuspend(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		body          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			body: `{"reason": "spam"}`,
+			mockSetup: func() {
+				serviceMock.EXPECT().Suspend(gomock.Any(), actorId, userId, "spam").Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Without Reason",
+			mockSetup: func() {
+				serviceMock.EXPECT().Suspend(gomock.Any(), actorId, userId, "").Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Already Suspended",
+			mockSetup: func() {
+				serviceMock.EXPECT().Suspend(gomock.Any(), actorId, userId, "").Return(service.ErrUserSuspended)
+			},
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrUserSuspended.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Post("/{user_id}/suspend", h.Suspend)
+
+			req := httptest.NewRequest(http.MethodPost, "/2/suspend", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, withActor(req))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestForceVerify_InvalidId(t *testing.T) {
+	ctrl, _, _, h := setup(t)
+	defer ctrl.Finish()
+
+	r := chi.NewRouter()
+	r.Post("/{user_id}/verify", h.ForceVerify)
+
+	req := httptest.NewRequest(http.MethodPost, "/abc/verify", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, withActor(req))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
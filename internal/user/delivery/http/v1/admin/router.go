@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/middleware"
+)
+
+// MapRoutes maps the user administration routes to the provided router, they are available only to verified admins.
+func MapRoutes(
+	mw *middleware.Middleware,
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Route("/admin/users", func(r chi.Router) {
+		r.Use(
+			jWTAccessMiddleware,
+			onlyVerifiedMiddleware,
+			mw.NewAllowRoles(enum.UserRoleADMIN),
+		)
+
+		r.Get("/", h.SearchUsers)
+		r.Patch("/{user_id}/role", h.ChangeRole)
+		r.Post("/{user_id}/suspend", h.Suspend)
+		r.Post("/{user_id}/unsuspend", h.Unsuspend)
+		r.Post("/{user_id}/verify", h.ForceVerify)
+		r.Get("/{user_id}/audit-logs", h.ListAuditLogs)
+	})
+}
@@ -3,15 +3,20 @@ package auth
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
 	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
 	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/jwt/challenge_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/refresh_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/reset_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/verify_jwt"
 	"github.com/hexley21/fixup/internal/user/service"
 	"github.com/hexley21/fixup/pkg/http/handler"
@@ -25,13 +30,28 @@ const (
 
 type Handler struct {
 	*handler.Components
-	service service.AuthService
+	service            service.AuthService
+	sessionService     service.SessionService
+	twoFactorService   service.TwoFactorService
+	emailChangeService service.EmailChangeService
+	oidcService        service.OIDCService
 }
 
-func NewHandler(components *handler.Components, service service.AuthService) *Handler {
+func NewHandler(
+	components *handler.Components,
+	service service.AuthService,
+	sessionService service.SessionService,
+	twoFactorService service.TwoFactorService,
+	emailChangeService service.EmailChangeService,
+	oidcService service.OIDCService,
+) *Handler {
 	return &Handler{
-		Components: components,
-		service:    service,
+		Components:         components,
+		service:            service,
+		sessionService:     sessionService,
+		twoFactorService:   twoFactorService,
+		emailChangeService: emailChangeService,
+		oidcService:        oidcService,
 	}
 }
 
@@ -224,17 +244,22 @@ func (h *Handler) ResendVerificationLetter(generator verify_jwt.Generator) http.
 
 // Login
 // @Summary Login a user
-// @Description Authenticate a user and set access and refresh tokens
+// @Description Authenticate a user, start a session for the device and set access and refresh tokens.
+// @Description If the user has two-factor authentication enabled, a challenge token for /auth/2fa/verify is returned instead.
+// @Description Failed attempts are counted per email and client IP, too many of them temporarily lock the login.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param user body dto.Login true "User login details"
 // @Success 200 {string} string "Set-Cookie: access_token; HttpOnly, Set-Cookie: refresh_token; HttpOnly"
+// @Success 202 {object} rest.ApiResponse[dto.TwoFactorChallenge] "Accepted - Two-factor check is pending"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
 // @Failure 401 {object} rest.ErrorResponse "Unauthorized - Incorrect email or password"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden - User is suspended"
+// @Failure 429 {object} rest.ErrorResponse "Too Many Requests - Login is locked, see the Retry-After header"
 // @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
 // @Router /auth/login [post]
-func (h *Handler) Login(generator auth_jwt.Generator, refreshGenerator refresh_jwt.Generator) http.HandlerFunc {
+func (h *Handler) Login(generator auth_jwt.Generator, refreshGenerator refresh_jwt.Generator, challengeGenerator challenge_jwt.Generator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var loginDTO dto.Login
 		if err := h.Binder.BindJSON(r, &loginDTO); err != nil {
@@ -247,11 +272,20 @@ func (h *Handler) Login(generator auth_jwt.Generator, refreshGenerator refresh_j
 			return
 		}
 
-		userIdentity, err := h.service.AuthenticateUser(r.Context(), loginDTO.Email, loginDTO.Password)
+		userIdentity, err := h.service.AuthenticateUser(r.Context(), loginDTO.Email, loginDTO.Password, request_util.ClientIP(r))
 		if err != nil {
+			var lockedErr *service.LoginLockedError
 			switch {
+			case errors.As(err, &lockedErr):
+				if err != lockedErr {
+					h.Logger.Errorf("failed to send login lockout letter - email: %s, error: %v", loginDTO.Email, err)
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds()))))
+				h.Writer.WriteError(w, rest.NewTooManyRequestsError(lockedErr))
 			case errors.Is(err, service.ErrIncorrectEmailOrPassword):
 				h.Writer.WriteError(w, rest.NewUnauthorizedError(err))
+			case errors.Is(err, service.ErrUserSuspended):
+				h.Writer.WriteError(w, rest.NewForbiddenError(err))
 			case errors.Is(err, service.ErrUserNotFound):
 				h.Writer.WriteError(w, rest.NewNotFoundMessageError(err, service.ErrIncorrectEmailOrPassword.Error()))
 			default:
@@ -260,58 +294,239 @@ func (h *Handler) Login(generator auth_jwt.Generator, refreshGenerator refresh_j
 			return
 		}
 
-		accessToken, jwtErr := generator.Generate(
-			userIdentity.ID,
-			userIdentity.AccountInfo.Role,
-			userIdentity.AccountInfo.Verified,
-		)
-		if jwtErr != nil {
-			h.Writer.WriteError(w, jwtErr)
+		if userIdentity.TwoFactorPending {
+			challengeToken, jwtErr := challengeGenerator.Generate(userIdentity.ID)
+			if jwtErr != nil {
+				h.Writer.WriteError(w, jwtErr)
+				return
+			}
+
+			h.Logger.Infof("Login user, two-factor pending - U-ID: %d", userIdentity.ID)
+			h.Writer.WriteData(w, http.StatusAccepted, dto.TwoFactorChallenge{ChallengeToken: challengeToken})
 			return
 		}
-		refreshToken, jwtErr := refreshGenerator.Generate(userIdentity.ID)
-		if jwtErr != nil {
-			h.Writer.WriteError(w, jwtErr)
+
+		sessionID, errResp := h.startSession(w, r, userIdentity, generator, refreshGenerator)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
 			return
 		}
 
-		setCookies(w, accessToken, accessTokenCookie)
-		setCookies(w, refreshToken, refreshTokenCookie)
-		h.Logger.Infof("Login user - Role: %s, U-ID: %d", userIdentity.AccountInfo.Role, userIdentity.ID)
+		h.Logger.Infof("Login user - Role: %s, U-ID: %d, S-ID: %s", userIdentity.AccountInfo.Role, userIdentity.ID, sessionID)
+		h.Writer.WriteNoContent(w, http.StatusOK)
+	}
+}
+
+// VerifyTwoFactor
+// @Summary Complete a two-factor login
+// @Description Check the one-time password or a recovery code of the user the challenge token was issued to, start a session and set access and refresh tokens
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param verification body dto.VerifyTwoFactor true "Challenge token and a one-time password or recovery code"
+// @Success 200 {string} string "Set-Cookie: access_token; HttpOnly, Set-Cookie: refresh_token; HttpOnly"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized - Invalid challenge token or code"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden - User is suspended"
+// @Failure 429 {object} rest.ErrorResponse "Too Many Requests - Too many two-factor attempts"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /auth/2fa/verify [post]
+func (h *Handler) VerifyTwoFactor(generator auth_jwt.Generator, refreshGenerator refresh_jwt.Generator, challengeVerifier challenge_jwt.Verifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var verifyDTO dto.VerifyTwoFactor
+		if err := h.Binder.BindJSON(r, &verifyDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		if err := h.Validator.Validate(verifyDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		claims, errResp := challengeVerifier.Verify(verifyDTO.ChallengeToken)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		id, err := strconv.ParseInt(claims.ID, 10, 64)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to verify two-factor due to id parse - uid: %s, error: %w", claims.ID, err))
+			return
+		}
+
+		userIdentity, err := h.twoFactorService.Verify(r.Context(), id, claims.RegisteredClaims.ID, verifyDTO.Code)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrInvalidTwoFactorCode),
+				errors.Is(err, service.ErrTwoFactorNotEnabled),
+				errors.Is(err, service.ErrTwoFactorChallengeUsed):
+				h.Writer.WriteError(w, rest.NewUnauthorizedError(err))
+			case errors.Is(err, service.ErrTwoFactorAttemptsExceeded):
+				h.Writer.WriteError(w, rest.NewTooManyRequestsError(err))
+			case errors.Is(err, service.ErrUserSuspended):
+				h.Writer.WriteError(w, rest.NewForbiddenError(err))
+			case errors.Is(err, service.ErrUserNotFound):
+				h.Writer.WriteError(w, rest.NewNotFoundError(err))
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to verify two-factor - uid: %d, error: %w", id, err))
+			}
+			return
+		}
+
+		sessionID, errResp := h.startSession(w, r, userIdentity, generator, refreshGenerator)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		h.Logger.Infof("Login user, two-factor passed - Role: %s, U-ID: %d, S-ID: %s", userIdentity.AccountInfo.Role, userIdentity.ID, sessionID)
+		h.Writer.WriteNoContent(w, http.StatusOK)
+	}
+}
+
+// AuthorizeOIDC
+// @Summary Begin a login through the identity provider
+// @Description Start an OpenID Connect login and return the URL of the provider's consent page, the client has to navigate to it.
+// @Description The provider redirects back to the client with a code and the state, which are passed to /auth/oidc/callback.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} rest.ApiResponse[dto.OIDCAuthorization] "OK"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /auth/oidc/authorize [get]
+func (h *Handler) AuthorizeOIDC(w http.ResponseWriter, r *http.Request) {
+	authorizationURL, err := h.oidcService.BeginLogin(r.Context())
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to begin oidc login: %w", err))
+		return
+	}
+
+	h.Writer.WriteData(w, http.StatusOK, dto.OIDCAuthorization{AuthorizationURL: authorizationURL})
+}
+
+// OIDCCallback
+// @Summary Complete a login through the identity provider
+// @Description Exchange the code of the provider's redirect, start a session for the device and set access and refresh tokens.
+// @Description A customer is registered on first login, unless a user with the email verified by the provider exists.
+// @Description If the user has two-factor authentication enabled, a challenge token for /auth/2fa/verify is returned instead.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param callback body dto.OIDCCallback true "Code and state of the provider's redirect"
+// @Success 200 {string} string "Set-Cookie: access_token; HttpOnly, Set-Cookie: refresh_token; HttpOnly"
+// @Success 202 {object} rest.ApiResponse[dto.TwoFactorChallenge] "Accepted - Two-factor check is pending"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request - Unknown or expired state, or the provider did not share the email or shared a too long one"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized - Provider rejected the code or the ID token is invalid"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden - User is suspended"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Email is taken"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /auth/oidc/callback [post]
+func (h *Handler) OIDCCallback(generator auth_jwt.Generator, refreshGenerator refresh_jwt.Generator, challengeGenerator challenge_jwt.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var callbackDTO dto.OIDCCallback
+		if err := h.Binder.BindJSON(r, &callbackDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		if err := h.Validator.Validate(callbackDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		userIdentity, err := h.oidcService.CompleteLogin(r.Context(), callbackDTO.State, callbackDTO.Code)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrOIDCStateNotFound), errors.Is(err, service.ErrOIDCEmailMissing), errors.Is(err, service.ErrOIDCEmailTooLong):
+				h.Writer.WriteError(w, rest.NewBadRequestError(err))
+			case errors.Is(err, service.ErrOIDCLoginFailed):
+				h.Logger.Infof("OIDC login failed - error: %v", err)
+				h.Writer.WriteError(w, rest.NewUnauthorizedError(service.ErrOIDCLoginFailed))
+			case errors.Is(err, service.ErrUserEmailTaken):
+				h.Writer.WriteError(w, rest.NewConflictError(err))
+			case errors.Is(err, service.ErrUserSuspended):
+				h.Writer.WriteError(w, rest.NewForbiddenError(err))
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to complete oidc login: %w", err))
+			}
+			return
+		}
+
+		if userIdentity.TwoFactorPending {
+			challengeToken, jwtErr := challengeGenerator.Generate(userIdentity.ID)
+			if jwtErr != nil {
+				h.Writer.WriteError(w, jwtErr)
+				return
+			}
+
+			h.Logger.Infof("Login user through oidc, two-factor pending - U-ID: %d", userIdentity.ID)
+			h.Writer.WriteData(w, http.StatusAccepted, dto.TwoFactorChallenge{ChallengeToken: challengeToken})
+			return
+		}
+
+		sessionID, errResp := h.startSession(w, r, userIdentity, generator, refreshGenerator)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		h.Logger.Infof("Login user through oidc - Role: %s, U-ID: %d, S-ID: %s", userIdentity.AccountInfo.Role, userIdentity.ID, sessionID)
 		h.Writer.WriteNoContent(w, http.StatusOK)
 	}
 }
 
 // Logout
 // @Summary Logout a user
-// @Description Erase access and refresh tokens
+// @Description Revoke the session of the refresh token, taken from the Authorization header or the cookie, and erase access and refresh tokens
 // @Tags auth
 // @Success 200 {string} string "Set-Cookie: access_token; HttpOnly, Set-Cookie: refresh_token; HttpOnly"
 // @Router /auth/logout [post]
-func (h *Handler) Logout(w http.ResponseWriter, _ *http.Request) {
-	eraseCookie(w, accessTokenCookie)
-	eraseCookie(w, refreshTokenCookie)
+func (h *Handler) Logout(verifier refresh_jwt.Verifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		eraseCookie(w, accessTokenCookie)
+		eraseCookie(w, refreshTokenCookie)
+
+		// an invalid or missing refresh token has no session to revoke, the user is logged out anyway
+		claims, ok := refreshClaimsFromRequest(r, verifier)
+		if !ok {
+			h.Logger.Info("Logout user")
+			h.Writer.WriteNoContent(w, http.StatusOK)
+			return
+		}
 
-	h.Logger.Info("Logout user")
-	h.Writer.WriteNoContent(w, http.StatusOK)
+		id, err := strconv.ParseInt(claims.ID, 10, 64)
+		if err == nil && claims.SessionID != "" {
+			err = h.sessionService.Revoke(r.Context(), id, claims.SessionID)
+		}
+		if err != nil && !errors.Is(err, service.ErrSessionNotFound) {
+			h.Logger.Errorf("failed to revoke session on logout - uid: %s, sid: %s, error: %v", claims.ID, claims.SessionID, err)
+		}
+
+		h.Logger.Infof("Logout user - U-ID: %s, S-ID: %s", claims.ID, claims.SessionID)
+		h.Writer.WriteNoContent(w, http.StatusOK)
+	}
 }
 
 // Refresh
 // @Summary Refresh access token
-// @Description Refresh the access token using the refresh token
+// @Description Refresh the access token using the refresh token, the refresh token is rotated on every use.
+// @Description Reusing an already rotated refresh token revokes its session.
 // @Tags auth
-// @Success 200 {string} string "Set-Cookie: access_token; HttpOnly"
+// @Success 200 {string} string "Set-Cookie: access_token; HttpOnly, Set-Cookie: refresh_token; HttpOnly"
 // @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden - User is suspended"
 // @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
 // @Security refresh_token
 // @Router /auth/refresh [post]
-func (h *Handler) Refresh(generator auth_jwt.Generator) http.HandlerFunc {
+func (h *Handler) Refresh(generator auth_jwt.Generator, refreshGenerator refresh_jwt.Generator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		id, ok := r.Context().Value(refreshJwtIdKet).(string)
+		claims, ok := r.Context().Value(refreshJwtClaimsKey).(refresh_jwt.RefreshClaims)
 		if !ok {
 			h.Writer.WriteError(w, ErrRefreshTokenNotSet)
 			return
 		}
+		id := claims.ID
 
 		intId, err := strconv.ParseInt(id, 10, 64)
 		if err != nil {
@@ -319,8 +534,22 @@ func (h *Handler) Refresh(generator auth_jwt.Generator) http.HandlerFunc {
 			return
 		}
 
-		tokenFunc := func(role enum.UserRole, verified bool) (string, error) {
-			return generator.Generate(intId, role, verified)
+		tokenID, err := h.sessionService.Rotate(r.Context(), intId, claims.SessionID, claims.RegisteredClaims.ID)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrSessionNotFound):
+				h.Writer.WriteError(w, rest.NewUnauthorizedError(err))
+			case errors.Is(err, service.ErrRefreshTokenReused):
+				h.Logger.Infof("Refresh token reused, session revoked - U-ID: %s, S-ID: %s", id, claims.SessionID)
+				h.Writer.WriteError(w, rest.NewUnauthorizedError(err))
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to rotate session: %w", err))
+			}
+			return
+		}
+
+		tokenFunc := func(role enum.UserRole, verified bool, phoneVerified bool) (string, error) {
+			return generator.Generate(intId, role, verified, phoneVerified)
 		}
 
 		accessToken, err := h.service.RefreshUserToken(r.Context(), intId, tokenFunc)
@@ -329,6 +558,8 @@ func (h *Handler) Refresh(generator auth_jwt.Generator) http.HandlerFunc {
 			switch {
 			case errors.Is(err, service.ErrUserNotFound):
 				h.Writer.WriteError(w, rest.NewNotFoundError(err))
+			case errors.Is(err, service.ErrUserSuspended):
+				h.Writer.WriteError(w, rest.NewForbiddenError(err))
 			case errors.As(err, &errResp):
 				h.Writer.WriteError(w, errResp)
 			default:
@@ -337,9 +568,16 @@ func (h *Handler) Refresh(generator auth_jwt.Generator) http.HandlerFunc {
 			return
 		}
 
+		refreshToken, jwtErr := refreshGenerator.Generate(intId, claims.SessionID, tokenID)
+		if jwtErr != nil {
+			h.Writer.WriteError(w, jwtErr)
+			return
+		}
+
 		setCookies(w, accessToken, accessTokenCookie)
+		setCookies(w, refreshToken, refreshTokenCookie)
 
-		h.Logger.Infof("Rotate jwt - U-ID: %s", id)
+		h.Logger.Infof("Rotate jwt - U-ID: %s, S-ID: %s", id, claims.SessionID)
 		h.Writer.WriteNoContent(w, http.StatusOK)
 	}
 }
@@ -397,6 +635,199 @@ func (h *Handler) VerifyUser(verifier verify_jwt.Verifier) http.HandlerFunc {
 	}
 }
 
+// ConfirmEmailChange
+// @Summary Confirm an email change
+// @Description Apply the pending email change using a jwt sent to the new email, provided as a query parameter. The user is verified with the new email.
+// @Tags auth
+// @Produce json
+// @Param token query string true "jwt for email change confirmation"
+// @Success 204
+// @Failure 401 {object} rest.ErrorResponse "Invalid token"
+// @Failure 404 {object} rest.ErrorResponse "Email change was not found, already confirmed or replaced"
+// @Failure 409 {object} rest.ErrorResponse "Email is taken"
+// @Failure 500 {object} rest.ErrorResponse "Internal server error"
+// @Router /auth/confirm-email [get]
+func (h *Handler) ConfirmEmailChange(verifier email_change_jwt.Verifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, errResp := verifier.Verify(r.URL.Query().Get("token"))
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		id, err := strconv.ParseInt(claims.ID, 10, 64)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to confirm email change due to id parse - uid: %s, error: %w", claims.ID, err))
+			return
+		}
+
+		if err := h.emailChangeService.ConfirmChange(r.Context(), id, claims.Email); err != nil {
+			switch {
+			case errors.Is(err, service.ErrEmailChangeNotFound), errors.Is(err, service.ErrUserNotFound):
+				h.Writer.WriteError(w, rest.NewNotFoundError(err))
+			case errors.Is(err, service.ErrUserEmailTaken):
+				h.Writer.WriteError(w, rest.NewConflictError(err))
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to confirm email change - uid: %d, error: %w", id, err))
+			}
+			return
+		}
+
+		h.Logger.Infof("Confirm user email change - Email: %s, U-ID: %d", claims.Email, id)
+		h.Writer.WriteNoContent(w, http.StatusNoContent)
+	}
+}
+
+// ForgotPassword
+// @Summary Request a password reset
+// @Description Sends a password reset letter to the email, the response does not reveal whether the email is registered
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param user body dto.Email true "User email"
+// @Success 204
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /auth/forgot-password [post]
+func (h *Handler) ForgotPassword(generator reset_jwt.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var emailDTO dto.Email
+		if err := h.Binder.BindJSON(r, &emailDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		if err := h.Validator.Validate(emailDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		tokenFunc := func(id int64) (string, error) {
+			jwt, err := generator.Generate(id, emailDTO.Email)
+			if err != nil {
+				return "", err
+			}
+
+			return jwt, nil
+		}
+
+		if err := h.service.SendPasswordResetLetter(r.Context(), tokenFunc, emailDTO.Email); err != nil {
+			var errResp *rest.ErrorResponse
+			switch {
+			case errors.Is(err, service.ErrUserNotFound):
+				h.Logger.Infof("Password reset requested for unknown email - Email: %s", emailDTO.Email)
+				h.Writer.WriteNoContent(w, http.StatusNoContent)
+			case errors.As(err, &errResp):
+				h.Writer.WriteError(w, errResp)
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send password reset letter: %w", err))
+			}
+			return
+		}
+
+		h.Logger.Infof("Send password reset letter - Email: %s", emailDTO.Email)
+		h.Writer.WriteNoContent(w, http.StatusNoContent)
+	}
+}
+
+// ResetPassword
+// @Summary Reset password
+// @Description Sets a new password using a password reset token, all of the user's sessions are signed out
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param reset body dto.ResetPassword true "Password reset token and the new password"
+// @Success 204
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Invalid token"
+// @Failure 404 {object} rest.ErrorResponse "User was not found"
+// @Failure 409 {object} rest.ErrorResponse "Token already used"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Router /auth/reset-password [post]
+func (h *Handler) ResetPassword(verifier reset_jwt.Verifier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var resetDTO dto.ResetPassword
+		if err := h.Binder.BindJSON(r, &resetDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		if err := h.Validator.Validate(resetDTO); err != nil {
+			h.Writer.WriteError(w, err)
+			return
+		}
+
+		claims, errResp := verifier.Verify(resetDTO.Token)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		id, err := strconv.ParseInt(claims.ID, 10, 64)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to reset password due to id parse - uid: %s, error: %w", claims.ID, err))
+			return
+		}
+
+		if err := h.service.ResetPassword(r.Context(), resetDTO.Token, time.Until(claims.ExpiresAt.Time), id, resetDTO.Password); err != nil {
+			switch {
+			case errors.Is(err, service.ErrPasswordResetTokenUsed):
+				h.Writer.WriteError(w, rest.NewConflictError(err))
+			case errors.Is(err, service.ErrUserNotFound):
+				h.Writer.WriteError(w, rest.NewNotFoundError(err))
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to reset password - uid: %d, error: %w", id, err))
+			}
+			return
+		}
+
+		eraseCookie(w, accessTokenCookie)
+		eraseCookie(w, refreshTokenCookie)
+
+		h.Logger.Infof("Reset user password - Email: %s, U-ID: %d", claims.Email, id)
+		h.Writer.WriteNoContent(w, http.StatusNoContent)
+	}
+}
+
+// startSession starts a session for the device of the request and sets the access and refresh token cookies.
+// It returns the id of the started session.
+func (h *Handler) startSession(
+	w http.ResponseWriter,
+	r *http.Request,
+	userIdentity domain.UserIdentity,
+	generator auth_jwt.Generator,
+	refreshGenerator refresh_jwt.Generator,
+) (string, *rest.ErrorResponse) {
+	accessToken, jwtErr := generator.Generate(
+		userIdentity.ID,
+		userIdentity.AccountInfo.Role,
+		userIdentity.AccountInfo.Verified,
+		userIdentity.AccountInfo.PhoneVerified,
+	)
+	if jwtErr != nil {
+		return "", jwtErr
+	}
+
+	sessionID, tokenID, err := h.sessionService.Create(
+		r.Context(),
+		userIdentity.ID,
+		domain.NewSessionDevice(r.UserAgent(), request_util.ClientIP(r)),
+	)
+	if err != nil {
+		return "", rest.NewInternalServerErrorf("failed to create session - uid: %d, error: %w", userIdentity.ID, err)
+	}
+
+	refreshToken, jwtErr := refreshGenerator.Generate(userIdentity.ID, sessionID, tokenID)
+	if jwtErr != nil {
+		return "", jwtErr
+	}
+
+	setCookies(w, accessToken, accessTokenCookie)
+	setCookies(w, refreshToken, refreshTokenCookie)
+
+	return sessionID, nil
+}
+
 // sendVerificationLetter generates a verification JWT and sends a verification email.
 // It uses the provided generator to create the JWT and the service to send the email.
 // It logs errors and returns an error if any step fails.
@@ -14,7 +14,7 @@ import (
 
 type ctxKey string
 
-const refreshJwtIdKet ctxKey = "refresh_jwt_id"
+const refreshJwtClaimsKey ctxKey = "refresh_jwt_claims"
 
 var ErrRefreshTokenNotSet = rest.NewInternalServerError(errors.New("refresh token is not set"))
 
@@ -52,7 +52,27 @@ func (m *Middleware) RefreshJWT(jwtVerifier refresh_jwt.Verifier) func(http.Hand
 				return
 			}
 
-			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), refreshJwtIdKet, claims.ID)))
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), refreshJwtClaimsKey, claims)))
 		})
 	}
 }
+
+// refreshClaimsFromRequest verifies the refresh token from the Authorization header, or from the cookie if the header is not set.
+// It returns false if there is no refresh token or it is invalid.
+func refreshClaimsFromRequest(r *http.Request, jwtVerifier refresh_jwt.Verifier) (refresh_jwt.RefreshClaims, bool) {
+	tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if tokenString == "" {
+		cookie, err := r.Cookie(refreshTokenCookie)
+		if err != nil {
+			return refresh_jwt.RefreshClaims{}, false
+		}
+		tokenString = cookie.Value
+	}
+
+	claims, errResp := jwtVerifier.Verify(tokenString)
+	if errResp != nil {
+		return refresh_jwt.RefreshClaims{}, false
+	}
+
+	return claims, true
+}
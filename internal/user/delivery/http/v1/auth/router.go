@@ -3,7 +3,10 @@ package auth
 import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/challenge_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/refresh_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/reset_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/verify_jwt"
 )
 
@@ -14,6 +17,9 @@ func MapRoutes(
 	accessJwtManager auth_jwt.Manager,
 	refreshJwtManager refresh_jwt.Manager,
 	vrfJWTManager verify_jwt.Manager,
+	resetJWTManager reset_jwt.Manager,
+	challengeJWTManager challenge_jwt.Manager,
+	emailChangeJWTManager email_change_jwt.Manager,
 	router chi.Router,
 ) chi.Router {
 	router.Route("/auth", func(r chi.Router) {
@@ -21,11 +27,18 @@ func MapRoutes(
 		r.Post("/register/provider", h.RegisterProvider(vrfJWTManager))
 		r.Post("/resend-confirmation", h.ResendVerificationLetter(vrfJWTManager))
 
-		r.With(NewAuthMiddleware(h.Writer).RefreshJWT(refreshJwtManager)).Post("/refresh", h.Refresh(accessJwtManager))
-		r.Post("/login", h.Login(accessJwtManager, refreshJwtManager))
-		r.Post("/logout", h.Logout)
+		r.With(NewAuthMiddleware(h.Writer).RefreshJWT(refreshJwtManager)).Post("/refresh", h.Refresh(accessJwtManager, refreshJwtManager))
+		r.Post("/login", h.Login(accessJwtManager, refreshJwtManager, challengeJWTManager))
+		r.Post("/2fa/verify", h.VerifyTwoFactor(accessJwtManager, refreshJwtManager, challengeJWTManager))
+		r.Get("/oidc/authorize", h.AuthorizeOIDC)
+		r.Post("/oidc/callback", h.OIDCCallback(accessJwtManager, refreshJwtManager, challengeJWTManager))
+		r.Post("/logout", h.Logout(refreshJwtManager))
 
 		r.Get("/verify", h.VerifyUser(vrfJWTManager))
+		r.Get("/confirm-email", h.ConfirmEmailChange(emailChangeJWTManager))
+
+		r.Post("/forgot-password", h.ForgotPassword(resetJWTManager))
+		r.Post("/reset-password", h.ResetPassword(resetJWTManager))
 	})
 
 	return router
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type ChangeRole struct {
+	Role string `json:"role" validate:"required,oneof=CUSTOMER PROVIDER MODERATOR ADMIN"`
+} // @name ChangeRoleInput
+
+type Suspend struct {
+	Reason string `json:"reason" validate:"max=500"`
+} // @name SuspendInput
+
+type AuditLog struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	ActorID   string    `json:"actor_id"`
+	Action    string    `json:"action"`
+	OldValue  string    `json:"old_value,omitempty"`
+	NewValue  string    `json:"new_value,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+} // @name AuditLog
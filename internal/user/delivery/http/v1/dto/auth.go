@@ -21,3 +21,26 @@ type Login struct {
 type Email struct {
 	Email string `json:"email" validate:"required,email,max=40"`
 } // @name EmailInput
+
+type ResetPassword struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,password"`
+} // @name ResetPasswordInput
+
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challenge_token"`
+} // @name TwoFactorChallenge
+
+type VerifyTwoFactor struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,max=16"`
+} // @name VerifyTwoFactorInput
+
+type OIDCAuthorization struct {
+	AuthorizationURL string `json:"authorization_url"`
+} // @name OIDCAuthorization
+
+type OIDCCallback struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+} // @name OIDCCallbackInput
@@ -0,0 +1,5 @@
+package dto
+
+type PhoneCode struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+} // @name PhoneCodeInput
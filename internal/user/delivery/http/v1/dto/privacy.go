@@ -0,0 +1,5 @@
+package dto
+
+type DataExport struct {
+	Url string `json:"url"`
+} // @name DataExport
@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type ProviderVerification struct {
+	UserID            string             `json:"user_id"`
+	FirstName         string             `json:"first_name"`
+	LastName          string             `json:"last_name"`
+	Email             string             `json:"email"`
+	PersonalIDPreview string             `json:"personal_id_preview"`
+	Status            string             `json:"status"`
+	RejectionReason   string             `json:"rejection_reason,omitempty"`
+	SubmittedAt       *time.Time         `json:"submitted_at,omitempty"`
+	ReviewedAt        *time.Time         `json:"reviewed_at,omitempty"`
+	Documents         []ProviderDocument `json:"documents,omitempty"`
+} // @name ProviderVerification
+
+type ProviderDocument struct {
+	ID        string    `json:"id"`
+	Url       string    `json:"url"`
+	CreatedAt time.Time `json:"created_at"`
+} // @name ProviderDocument
+
+type PersonalIDNumber struct {
+	PersonalIDNumber string `json:"personal_id_number"`
+} // @name PersonalIDNumber
+
+type RejectProvider struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+} // @name RejectProviderInput
@@ -0,0 +1,14 @@
+package dto
+
+type TwoFactorEnrolment struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+} // @name TwoFactorEnrolment
+
+type TwoFactorCode struct {
+	Code string `json:"code" validate:"required,max=16"`
+} // @name TwoFactorCodeInput
+
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+} // @name RecoveryCodes
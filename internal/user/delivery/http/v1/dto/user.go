@@ -3,12 +3,14 @@ package dto
 import "time"
 
 type User struct {
-	ID           string            `json:"id"`
+	ID string `json:"id"`
 	*UserPersonalInfo
-	PictureUrl   string            `json:"picture_url,omitempty"`
-	Role         string            `json:"role"`
-	Verified     bool              `json:"verified"`
-	CreatedAt    time.Time         `json:"created_at"`
+	PictureUrl    string    `json:"picture_url,omitempty"`
+	Role          string    `json:"role"`
+	Verified      bool      `json:"verified"`
+	PhoneVerified bool      `json:"phone_verified"`
+	Suspended     bool      `json:"suspended"`
+	CreatedAt     time.Time `json:"created_at"`
 } // @name User
 
 type UserPersonalInfo struct {
@@ -18,6 +20,11 @@ type UserPersonalInfo struct {
 	LastName    string `json:"last_name" validate:"omitempty,alphaunicode,min=2,max=30"`
 } // @name UserPersonalInfo
 
+type UpdatePersonalInfo struct {
+	PhoneNumber string `json:"phone_number" validate:"omitempty,phone"`
+	FirstName   string `json:"first_name" validate:"omitempty,alphaunicode,min=2,max=30"`
+	LastName    string `json:"last_name" validate:"omitempty,alphaunicode,min=2,max=30"`
+} // @name UpdatePersonalInfoInput
 
 type UpdatePassword struct {
 	OldPassword string `json:"old_password" validate:"required,password"`
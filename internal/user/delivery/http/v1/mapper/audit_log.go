@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/domain"
+)
+
+func MapAuditLogToDTO(entity domain.AuditLog) dto.AuditLog {
+	return dto.AuditLog{
+		ID:        strconv.FormatInt(entity.ID, 10),
+		UserID:    strconv.FormatInt(entity.UserID, 10),
+		ActorID:   strconv.FormatInt(entity.ActorID, 10),
+		Action:    string(entity.Action),
+		OldValue:  entity.OldValue,
+		NewValue:  entity.NewValue,
+		Reason:    entity.Reason,
+		CreatedAt: entity.CreatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+// MapProviderVerificationToDTO maps the verification, the document urls are signed with the urlSigner.
+func MapProviderVerificationToDTO(entity domain.ProviderVerification, urlSigner cdn.URLSigner) (dto.ProviderVerification, error) {
+	documents := make([]dto.ProviderDocument, len(entity.Documents))
+	for i, document := range entity.Documents {
+		url, err := urlSigner.SignURL(document.Path)
+		if err != nil {
+			return dto.ProviderVerification{}, err
+		}
+
+		documents[i] = dto.ProviderDocument{
+			ID:        strconv.FormatInt(document.ID, 10),
+			Url:       url,
+			CreatedAt: document.CreatedAt,
+		}
+	}
+
+	return dto.ProviderVerification{
+		UserID:            strconv.FormatInt(entity.UserID, 10),
+		FirstName:         entity.FirstName,
+		LastName:          entity.LastName,
+		Email:             entity.Email,
+		PersonalIDPreview: entity.PersonalIDPreview,
+		Status:            string(entity.Status),
+		RejectionReason:   entity.RejectionReason,
+		SubmittedAt:       optionalTime(entity.SubmittedAt),
+		ReviewedAt:        optionalTime(entity.ReviewedAt),
+		Documents:         documents,
+	}, nil
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
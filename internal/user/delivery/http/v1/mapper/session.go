@@ -0,0 +1,16 @@
+package mapper
+
+import (
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/domain"
+)
+
+func MapSessionToDTO(entity domain.Session) dto.Session {
+	return dto.Session{
+		ID:         entity.ID,
+		UserAgent:  entity.Device.UserAgent,
+		IP:         entity.Device.IP,
+		CreatedAt:  entity.CreatedAt,
+		LastUsedAt: entity.LastUsedAt,
+	}
+}
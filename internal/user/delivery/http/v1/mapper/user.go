@@ -26,12 +26,14 @@ func MapUserToDTO(entity *domain.User, urlSigner cdn.URLSigner) (*dto.User, erro
 	}
 
 	return &dto.User{
-		ID:           strconv.FormatInt(entity.ID, 10),
+		ID:               strconv.FormatInt(entity.ID, 10),
 		UserPersonalInfo: MapPersonalInfoToDTO(entity.PersonalInfo),
-		PictureUrl:   url,
-		Role:         string(entity.AccountInfo.Role),
-		Verified:     entity.AccountInfo.Verified,
-		CreatedAt:    entity.CreatedAt,
+		PictureUrl:       url,
+		Role:             string(entity.AccountInfo.Role),
+		Verified:         entity.AccountInfo.Verified,
+		PhoneVerified:    entity.AccountInfo.PhoneVerified,
+		Suspended:        entity.Suspended,
+		CreatedAt:        entity.CreatedAt,
 	}, nil
 }
 
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/mapper"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+)
+
+const maxDocumentSize int64 = 5 << 20
+
+type Handler struct {
+	*handler.Components
+	service        service.ProviderVerificationService
+	urlSigner      cdn.URLSigner
+	defaultPerPage int64
+	maxPerPage     int64
+}
+
+func NewHandler(
+	components *handler.Components,
+	service service.ProviderVerificationService,
+	urlSigner cdn.URLSigner,
+	defaultPerPage int64,
+	maxPerPage int64,
+) *Handler {
+	return &Handler{
+		Components:     components,
+		service:        service,
+		urlSigner:      urlSigner,
+		defaultPerPage: defaultPerPage,
+		maxPerPage:     maxPerPage,
+	}
+}
+
+// GetMyVerification
+// @Summary Get the current provider's verification
+// @Description Retrieves the identity verification status of the current provider with the uploaded ID documents
+// @Tags providers
+// @Produce json
+// @Success 200 {object} rest.ApiResponse[dto.ProviderVerification] "OK"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/provider [get]
+func (h *Handler) GetMyVerification(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	h.writeVerification(w, r, id)
+}
+
+// UploadDocument
+// @Summary Upload an ID document
+// @Description Upload an ID document image of the current provider and put the provider into the review queue, a rejected provider is reviewed again
+// @Tags providers
+// @Accept multipart/form-data
+// @Param document formData file true "ID document image"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Provider is already approved"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/provider/documents [post]
+func (h *Handler) UploadDocument(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	form, errResp := h.Binder.BindMultipartForm(r, maxDocumentSize)
+	if errResp != nil {
+		h.Writer.WriteError(w, rest.NewReadFileError(errResp))
+		return
+	}
+
+	formFile := form.File["document"]
+	if len(formFile) < 1 {
+		h.Writer.WriteError(w, rest.NewBadRequestError(rest.ErrNoFile))
+		return
+	}
+
+	documentFile := formFile[0]
+
+	file, err := documentFile.Open()
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewReadFileError(err))
+		return
+	}
+	defer func(file multipart.File) {
+		err := file.Close()
+		if err != nil {
+			h.Logger.Errorf("failed to close file: %v", err)
+		}
+	}(file)
+
+	err = h.service.UploadDocument(r.Context(), id, file, documentFile.Size, documentFile.Header.Get("Content-Type"))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProviderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrProviderApproved):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to upload provider document: %w", err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Upload provider document - U-ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ListPending
+// @Summary List providers pending review
+// @Description Retrieves the providers waiting for the identity verification review, ordered from the oldest submission
+// @Tags moderation
+// @Produce json
+// @Param page query int true "Page number"
+// @Param per_page query int false "Number of items per page"
+// @Success 200 {object} rest.ApiResponse[[]dto.ProviderVerification] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /moderation/providers [get]
+func (h *Handler) ListPending(w http.ResponseWriter, r *http.Request) {
+	limit, offset, errResp := request_util.ParseLimitAndOffset(r, h.maxPerPage, h.defaultPerPage)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	verifications, err := h.service.ListPending(r.Context(), limit, offset)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to list pending providers: %w", err))
+		return
+	}
+
+	verificationDTOs := make([]dto.ProviderVerification, len(verifications))
+	for i, verification := range verifications {
+		verificationDTO, err := mapper.MapProviderVerificationToDTO(verification, h.urlSigner)
+		if err != nil {
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to list pending providers due to mapping error - id: %d, error: %w", verification.UserID, err))
+			return
+		}
+		verificationDTOs[i] = verificationDTO
+	}
+
+	h.Logger.Infof("Fetch pending providers - %d", len(verificationDTOs))
+	h.Writer.WriteData(w, http.StatusOK, verificationDTOs)
+}
+
+// GetVerification
+// @Summary Get a provider's verification
+// @Description Retrieves the identity verification of the provider with the signed urls of the uploaded ID documents
+// @Tags moderation
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {object} rest.ApiResponse[dto.ProviderVerification] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /moderation/providers/{user_id} [get]
+func (h *Handler) GetVerification(w http.ResponseWriter, r *http.Request) {
+	userID, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 64)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInvalidIdError(err))
+		return
+	}
+
+	h.writeVerification(w, r, userID)
+}
+
+// RevealPersonalID
+// @Summary Reveal a provider's personal ID number
+// @Description Decrypts the personal ID number of the provider, each access is written to the provider's audit trail
+// @Tags moderation
+// @Produce json
+// @Param user_id path string true "User ID"
+// @Success 200 {object} rest.ApiResponse[dto.PersonalIDNumber] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /moderation/providers/{user_id}/personal-id [get]
+func (h *Handler) RevealPersonalID(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	personalIDNumber, err := h.service.RevealPersonalIDNumber(r.Context(), actorID, userID)
+	if err != nil {
+		h.writeReviewError(w, err, "failed to reveal personal id number - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Reveal provider personal id number - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteData(w, http.StatusOK, dto.PersonalIDNumber{PersonalIDNumber: personalIDNumber})
+}
+
+// Approve
+// @Summary Approve a provider
+// @Description Approve the identity verification of the provider and email the provider, the customer is promoted to a provider on the next token refresh
+// @Tags moderation
+// @Param user_id path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Provider is not pending review"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /moderation/providers/{user_id}/approve [post]
+func (h *Handler) Approve(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.service.Approve(r.Context(), actorID, userID); err != nil {
+		h.writeReviewError(w, err, "failed to approve provider - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Approve provider - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// Reject
+// @Summary Reject a provider
+// @Description Reject the identity verification of the provider with the reason and email the provider, the provider can upload new documents
+// @Tags moderation
+// @Accept json
+// @Param user_id path string true "User ID"
+// @Param rejection body dto.RejectProvider true "Reason of the rejection"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 403 {object} rest.ErrorResponse "Forbidden"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Provider is not pending review"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /moderation/providers/{user_id}/reject [post]
+func (h *Handler) Reject(w http.ResponseWriter, r *http.Request) {
+	actorID, userID, errResp := parseActorAndUserID(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var rejectDTO dto.RejectProvider
+	if err := h.Binder.BindJSON(r, &rejectDTO); err != nil {
+		h.Writer.WriteError(w, err)
+		return
+	}
+
+	if err := h.Validator.Validate(rejectDTO); err != nil {
+		h.Writer.WriteError(w, err)
+		return
+	}
+
+	if err := h.service.Reject(r.Context(), actorID, userID, rejectDTO.Reason); err != nil {
+		h.writeReviewError(w, err, "failed to reject provider - id: %d, error: %w", userID)
+		return
+	}
+
+	h.Logger.Infof("Reject provider - U-ID: %d, A-ID: %d", userID, actorID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// writeVerification writes the verification of the provider with the signed document urls.
+func (h *Handler) writeVerification(w http.ResponseWriter, r *http.Request, userID int64) {
+	verification, err := h.service.GetVerification(r.Context(), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProviderNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch provider verification - id: %d, error: %w", userID, err))
+		}
+		return
+	}
+
+	verificationDTO, err := mapper.MapProviderVerificationToDTO(verification, h.urlSigner)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to fetch provider verification due to mapping error - id: %d, error: %w", userID, err))
+		return
+	}
+
+	h.Logger.Infof("Fetch provider verification - U-ID: %d", userID)
+	h.Writer.WriteData(w, http.StatusOK, verificationDTO)
+}
+
+// writeReviewError writes the error of a review action, unknown errors are written with the format.
+func (h *Handler) writeReviewError(w http.ResponseWriter, err error, format string, userID int64) {
+	switch {
+	case errors.Is(err, service.ErrProviderNotFound):
+		h.Writer.WriteError(w, rest.NewNotFoundError(err))
+	case errors.Is(err, service.ErrProviderNotPending):
+		h.Writer.WriteError(w, rest.NewConflictError(err))
+	default:
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf(format, userID, err))
+	}
+}
+
+// parseActorAndUserID returns the id of the current user and the "user_id" path parameter.
+func parseActorAndUserID(r *http.Request) (int64, int64, *rest.ErrorResponse) {
+	actorID, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		return 0, 0, errResp
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "user_id"), 10, 64)
+	if err != nil {
+		return 0, 0, rest.NewInvalidIdError(err)
+	}
+
+	return actorID, userID, nil
+}
@@ -0,0 +1,220 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/provider"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_service "github.com/hexley21/fixup/internal/user/service/mock"
+	"github.com/hexley21/fixup/pkg/http/binder/std_binder"
+	"github.com/hexley21/fixup/pkg/http/handler"
+	"github.com/hexley21/fixup/pkg/http/json/std_json"
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/http/writer/json_writer"
+	"github.com/hexley21/fixup/pkg/logger/std_logger"
+	mock_validator "github.com/hexley21/fixup/pkg/validator/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	actorId int64 = 1
+	userId  int64 = 2
+)
+
+var actorData = auth_jwt.UserData{ID: "1", Role: enum.UserRoleMODERATOR, Verified: true}
+
+func setup(t *testing.T) (
+	ctrl *gomock.Controller,
+	mockProviderService *mock_service.MockProviderVerificationService,
+	mockValidator *mock_validator.MockValidator,
+	h *provider.Handler,
+) {
+	ctrl = gomock.NewController(t)
+	mockProviderService = mock_service.NewMockProviderVerificationService(ctrl)
+	mockValidator = mock_validator.NewMockValidator(ctrl)
+
+	logger := std_logger.New()
+	jsonManager := std_json.New()
+
+	h = provider.NewHandler(
+		handler.NewComponents(logger, std_binder.New(jsonManager), mockValidator, json_writer.New(logger, jsonManager)),
+		mockProviderService,
+		nil,
+		50,
+		100,
+	)
+
+	return
+}
+
+func withActor(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), auth_jwt.AuthJWTKey, actorData))
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, expectedError string) {
+	var errResp rest.ErrorResponse
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp)) {
+		assert.Equal(t, expectedError, errResp.Message)
+	}
+}
+
+func TestListPending(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	serviceMock.EXPECT().ListPending(gomock.Any(), int64(10), int64(0)).Return([]domain.ProviderVerification{
+		{UserID: userId, Status: domain.ProviderVerificationPending},
+	}, nil)
+
+	r := chi.NewRouter()
+	r.Get("/", h.ListPending)
+
+	req := httptest.NewRequest(http.MethodGet, "/?page=1&per_page=10", nil)
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response rest.ApiResponse[[]dto.ProviderVerification]
+	if assert.NoError(t, json.NewDecoder(rec.Body).Decode(&response)) && assert.Len(t, response.Data, 1) {
+		assert.Equal(t, "2", response.Data[0].UserID)
+		assert.Equal(t, string(domain.ProviderVerificationPending), response.Data[0].Status)
+		assert.Nil(t, response.Data[0].SubmittedAt)
+	}
+}
+
+func TestRevealPersonalID(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().RevealPersonalIDNumber(gomock.Any(), actorId, userId).Return("01234567890", nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func() {
+				serviceMock.EXPECT().RevealPersonalIDNumber(gomock.Any(), actorId, userId).Return("", service.ErrProviderNotFound)
+			},
+			expectedCode:  http.StatusNotFound,
+			expectedError: service.ErrProviderNotFound.Error(),
+		},
+		{
+			name: "Service Error",
+			mockSetup: func() {
+				serviceMock.EXPECT().RevealPersonalIDNumber(gomock.Any(), actorId, userId).Return("", errors.New(""))
+			},
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: rest.MsgInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Get("/{user_id}/personal-id", h.RevealPersonalID)
+
+			req := httptest.NewRequest(http.MethodGet, "/2/personal-id", nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, withActor(req))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestApprove(t *testing.T) {
+	ctrl, serviceMock, _, h := setup(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name          string
+		mockSetup     func()
+		expectedCode  int
+		expectedError string
+	}{
+		{
+			name: "Success",
+			mockSetup: func() {
+				serviceMock.EXPECT().Approve(gomock.Any(), actorId, userId).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Not Pending",
+			mockSetup: func() {
+				serviceMock.EXPECT().Approve(gomock.Any(), actorId, userId).Return(service.ErrProviderNotPending)
+			},
+			expectedCode:  http.StatusConflict,
+			expectedError: service.ErrProviderNotPending.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			r := chi.NewRouter()
+			r.Post("/{user_id}/approve", h.Approve)
+
+			req := httptest.NewRequest(http.MethodPost, "/2/approve", nil)
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, withActor(req))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			if tt.expectedError != "" {
+				assertError(t, rec, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestReject(t *testing.T) {
+	ctrl, serviceMock, validatorMock, h := setup(t)
+	defer ctrl.Finish()
+
+	validatorMock.EXPECT().Validate(gomock.Any()).Return(nil)
+	serviceMock.EXPECT().Reject(gomock.Any(), actorId, userId, "blurry").Return(nil)
+
+	r := chi.NewRouter()
+	r.Post("/{user_id}/reject", h.Reject)
+
+	req := httptest.NewRequest(http.MethodPost, "/2/reject", strings.NewReader(`{"reason": "blurry"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	r.ServeHTTP(rec, withActor(req))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
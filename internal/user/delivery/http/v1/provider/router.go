@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/common/middleware"
+)
+
+// MapRoutes maps the provider identity verification routes to the provided router.
+// Providers upload their documents through /user/me/provider, the review queue is available only to verified moderators and admins.
+func MapRoutes(
+	mw *middleware.Middleware,
+	h *Handler,
+	jWTAccessMiddleware func(http.Handler) http.Handler,
+	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	router chi.Router,
+) {
+	router.Route("/user/me/provider", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware, onlyVerifiedMiddleware)
+
+		r.Get("/", h.GetMyVerification)
+		r.With(
+			mw.NewAllowFilesAmount(maxDocumentSize, "document", 1),
+			mw.NewAllowContentType(maxDocumentSize, "document", "image/jpeg", "image/png"),
+		).Post("/documents", h.UploadDocument)
+	})
+
+	router.Route("/moderation/providers", func(r chi.Router) {
+		r.Use(
+			jWTAccessMiddleware,
+			onlyVerifiedMiddleware,
+			mw.NewAllowRoles(enum.UserRoleMODERATOR, enum.UserRoleADMIN),
+		)
+
+		r.Get("/", h.ListPending)
+		r.Get("/{user_id}", h.GetVerification)
+		r.Get("/{user_id}/personal-id", h.RevealPersonalID)
+		r.Post("/{user_id}/approve", h.Approve)
+		r.Post("/{user_id}/reject", h.Reject)
+	})
+}
@@ -4,11 +4,17 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
 	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/admin"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1/auth"
+	"github.com/hexley21/fixup/internal/user/delivery/http/v1/provider"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1/user"
+	"github.com/hexley21/fixup/internal/user/jwt/challenge_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/refresh_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/reset_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/verify_jwt"
 	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
 	"github.com/hexley21/fixup/pkg/http/handler"
 	"github.com/hexley21/fixup/pkg/infra/cdn"
 )
@@ -16,11 +22,23 @@ import (
 type RouterArgs struct {
 	AuthService            service.AuthService
 	UserService            service.UserService
+	SessionService         service.SessionService
+	TwoFactorService       service.TwoFactorService
+	EmailChangeService     service.EmailChangeService
+	PhoneService           service.PhoneVerificationService
+	OIDCService            service.OIDCService
+	AdminService           service.AdminService
+	ProviderService        service.ProviderVerificationService
+	PrivacyService         service.PrivacyService
 	Middleware             *middleware.Middleware
 	HandlerComponents      *handler.Components
+	PaginationConfig       *config.Pagination
 	AccessJWTManager       auth_jwt.Manager
 	RefreshJWTManager      refresh_jwt.Manager
 	VerificationJWTManager verify_jwt.Manager
+	ResetJWTManager        reset_jwt.Manager
+	ChallengeJWTManager    challenge_jwt.Manager
+	EmailChangeJWTManager  email_change_jwt.Manager
 	CdnUrlSigner           cdn.URLSigner
 }
 
@@ -31,19 +49,46 @@ func MapV1Routes(args RouterArgs, router chi.Router) {
 	authHandler := auth.NewHandler(
 		args.HandlerComponents,
 		args.AuthService,
+		args.SessionService,
+		args.TwoFactorService,
+		args.EmailChangeService,
+		args.OIDCService,
 	)
 
 	userHandler := user.NewHandler(
 		args.HandlerComponents,
 		args.UserService,
+		args.SessionService,
+		args.TwoFactorService,
+		args.EmailChangeService,
+		args.PhoneService,
+		args.PrivacyService,
 		args.CdnUrlSigner,
 	)
 
+	adminHandler := admin.NewHandler(
+		args.HandlerComponents,
+		args.AdminService,
+		args.CdnUrlSigner,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
+	providerHandler := provider.NewHandler(
+		args.HandlerComponents,
+		args.ProviderService,
+		args.CdnUrlSigner,
+		args.PaginationConfig.LargePages,
+		args.PaginationConfig.XLargePages,
+	)
+
 	accessJWTMiddleware := args.Middleware.NewJWT(args.AccessJWTManager)
 	onlyVerifiedMiddleware := args.Middleware.NewAllowVerified(true)
 
 	router.Route("/v1", func(r chi.Router) {
-		auth.MapRoutes(authHandler, args.AccessJWTManager, args.RefreshJWTManager, args.VerificationJWTManager, r)
-		user.MapRoutes(args.Middleware, userHandler, accessJWTMiddleware, onlyVerifiedMiddleware, r)
+		auth.MapRoutes(authHandler, args.AccessJWTManager, args.RefreshJWTManager, args.VerificationJWTManager, args.ResetJWTManager, args.ChallengeJWTManager, args.EmailChangeJWTManager, r)
+		user.MapRoutes(args.Middleware, userHandler, accessJWTMiddleware, onlyVerifiedMiddleware, args.EmailChangeJWTManager, r)
+		admin.MapRoutes(args.Middleware, adminHandler, accessJWTMiddleware, onlyVerifiedMiddleware, r)
+		provider.MapRoutes(args.Middleware, providerHandler, accessJWTMiddleware, onlyVerifiedMiddleware, r)
 	})
 }
@@ -6,10 +6,13 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
+	"github.com/hexley21/fixup/internal/common/util/request_util"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1/dto"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1/mapper"
 	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 	"github.com/hexley21/fixup/internal/user/service"
 	"github.com/hexley21/fixup/pkg/http/handler"
 	"github.com/hexley21/fixup/pkg/http/rest"
@@ -23,15 +26,34 @@ const maxPfpSize int64 = 1 << 20
 
 type Handler struct {
 	*handler.Components
-	service   service.UserService
-	urlSigner cdn.URLSigner
+	service            service.UserService
+	sessionService     service.SessionService
+	twoFactorService   service.TwoFactorService
+	emailChangeService service.EmailChangeService
+	phoneService       service.PhoneVerificationService
+	privacyService     service.PrivacyService
+	urlSigner          cdn.URLSigner
 }
 
-func NewHandler(components *handler.Components, service service.UserService, urlSigner cdn.URLSigner) *Handler {
+func NewHandler(
+	components *handler.Components,
+	service service.UserService,
+	sessionService service.SessionService,
+	twoFactorService service.TwoFactorService,
+	emailChangeService service.EmailChangeService,
+	phoneService service.PhoneVerificationService,
+	privacyService service.PrivacyService,
+	urlSigner cdn.URLSigner,
+) *Handler {
 	return &Handler{
-		Components: components,
-		service:    service,
-		urlSigner:  urlSigner,
+		Components:         components,
+		service:            service,
+		sessionService:     sessionService,
+		twoFactorService:   twoFactorService,
+		emailChangeService: emailChangeService,
+		phoneService:       phoneService,
+		privacyService:     privacyService,
+		urlSigner:          urlSigner,
 	}
 }
 
@@ -151,12 +173,12 @@ func (h *Handler) UploadProfilePicture(w http.ResponseWriter, r *http.Request) {
 
 // UpdatePersonalInfo
 // @Summary Update user data
-// @Description Update user data by ID
+// @Description Update user data by ID, the email is changed through /user/me/email
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param user_id path string true "User ID"
-// @Param personalInfo body dto.UserPersonalInfo true "User data"
+// @Param personalInfo body dto.UpdatePersonalInfo true "User data"
 // @Success 200 {object} rest.ApiResponse[dto.User] "OK"
 // @Failure 400 {object} rest.ErrorResponse "Bad Request"
 // @Failure 401 {object} rest.ErrorResponse "Unauthorized"
@@ -171,7 +193,7 @@ func (h *Handler) UpdatePersonalInfo(w http.ResponseWriter, r *http.Request) {
 		h.Writer.WriteError(w, ErrParamIdNotSet)
 		return
 	}
-	var infoDTO dto.UserPersonalInfo
+	var infoDTO dto.UpdatePersonalInfo
 	if errResp := h.Binder.BindJSON(r, &infoDTO); errResp != nil {
 		h.Writer.WriteError(w, errResp)
 		return
@@ -185,7 +207,7 @@ func (h *Handler) UpdatePersonalInfo(w http.ResponseWriter, r *http.Request) {
 	personalInfo, err := h.service.UpdatePersonalInfo(
 		r.Context(),
 		id,
-		domain.NewUserPersonalInfo(infoDTO.Email, infoDTO.PhoneNumber, infoDTO.FirstName, infoDTO.LastName),
+		domain.NewUserPersonalInfo("", infoDTO.PhoneNumber, infoDTO.FirstName, infoDTO.LastName),
 	)
 	if err != nil {
 		switch {
@@ -205,7 +227,8 @@ func (h *Handler) UpdatePersonalInfo(w http.ResponseWriter, r *http.Request) {
 
 // Delete
 // @Summary Delete a user
-// @Description Delete a user by ID or the currently authenticated user if "me" is provided
+// @Description Deactivate a user by ID or the currently authenticated user if "me" is provided and sign them out of every device.
+// @Description Logging in again restores the account, otherwise it is purged once the deletion grace period passes.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -296,3 +319,403 @@ func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	h.Logger.Infof("Change user password - U-ID: %d", id)
 	h.Writer.WriteNoContent(w, http.StatusNoContent)
 }
+
+// ListSessions
+// @Summary List active sessions
+// @Description List the devices the current user is signed in on
+// @Tags users
+// @Produce json
+// @Success 200 {object} rest.ApiResponse[[]dto.Session] "OK"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/sessions [get]
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	sessions, err := h.sessionService.List(r.Context(), id)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to list sessions - id: %d, error: %w", id, err))
+		return
+	}
+
+	sessionDTOs := make([]dto.Session, len(sessions))
+	for i, session := range sessions {
+		sessionDTOs[i] = mapper.MapSessionToDTO(session)
+	}
+
+	h.Logger.Infof("Fetch user sessions - U-ID: %d", id)
+	h.Writer.WriteData(w, http.StatusOK, sessionDTOs)
+}
+
+// RevokeSession
+// @Summary Revoke a session
+// @Description Sign the current user out of a device, the refresh token of the session can no longer be used
+// @Tags users
+// @Param session_id path string true "Session ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/sessions/{session_id} [delete]
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	sessionID := chi.URLParam(r, "session_id")
+
+	if err := h.sessionService.Revoke(r.Context(), id, sessionID); err != nil {
+		switch {
+		case errors.Is(err, service.ErrSessionNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to revoke session - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Revoke user session - U-ID: %d, S-ID: %s", id, sessionID)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// EnrollTwoFactor
+// @Summary Enrol two-factor authentication
+// @Description Generate a TOTP secret for the current user, the enrolment is pending until it's confirmed with a code
+// @Tags users
+// @Produce json
+// @Success 200 {object} rest.ApiResponse[dto.TwoFactorEnrolment] "OK"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Two-factor authentication is already enabled"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/2fa/enroll [post]
+func (h *Handler) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	enrolment, err := h.twoFactorService.Enroll(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTwoFactorEnabled):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		case errors.Is(err, service.ErrUserNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to enroll two-factor - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Enroll user two-factor - U-ID: %d", id)
+	h.Writer.WriteData(w, http.StatusOK, dto.TwoFactorEnrolment{Secret: enrolment.Secret, OtpauthURI: enrolment.URI})
+}
+
+// ConfirmTwoFactor
+// @Summary Confirm two-factor authentication
+// @Description Enable the pending two-factor enrolment of the current user with a code from the authenticator, recovery codes are returned once
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param code body dto.TwoFactorCode true "One-time password"
+// @Success 200 {object} rest.ApiResponse[dto.RecoveryCodes] "OK"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found - Two-factor authentication is not enrolled"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Two-factor authentication is already enabled"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/2fa/confirm [post]
+func (h *Handler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var codeDTO dto.TwoFactorCode
+	if errResp := h.Binder.BindJSON(r, &codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if errResp := h.Validator.Validate(codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	recoveryCodes, err := h.twoFactorService.Confirm(r.Context(), id, codeDTO.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTwoFactorCode):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrTwoFactorNotEnrolled):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrTwoFactorEnabled):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to confirm two-factor - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Enable user two-factor - U-ID: %d", id)
+	h.Writer.WriteData(w, http.StatusOK, dto.RecoveryCodes{RecoveryCodes: recoveryCodes})
+}
+
+// DisableTwoFactor
+// @Summary Disable two-factor authentication
+// @Description Disable two-factor authentication of the current user with a one-time password or a recovery code
+// @Tags users
+// @Accept json
+// @Param code body dto.TwoFactorCode true "One-time password or recovery code"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found - Two-factor authentication is not enabled"
+// @Failure 429 {object} rest.ErrorResponse "Too Many Requests - Too many two-factor attempts"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/2fa [delete]
+func (h *Handler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var codeDTO dto.TwoFactorCode
+	if errResp := h.Binder.BindJSON(r, &codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if errResp := h.Validator.Validate(codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.twoFactorService.Disable(r.Context(), id, codeDTO.Code); err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTwoFactorCode):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrTwoFactorNotEnabled):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrTwoFactorAttemptsExceeded):
+			h.Writer.WriteError(w, rest.NewTooManyRequestsError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to disable two-factor - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Disable user two-factor - U-ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// RequestEmailChange
+// @Summary Request an email change
+// @Description Send a confirmation link to the new email of the current user and a notice to the current one.
+// @Description The email is changed only once the link is confirmed through /auth/confirm-email.
+// @Tags users
+// @Accept json
+// @Param email body dto.Email true "New email"
+// @Success 204
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Email is taken"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/email [post]
+func (h *Handler) RequestEmailChange(generator email_change_jwt.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, _, errResp := request_util.ParseUserData(r)
+		if errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		var emailDTO dto.Email
+		if errResp := h.Binder.BindJSON(r, &emailDTO); errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		if errResp := h.Validator.Validate(emailDTO); errResp != nil {
+			h.Writer.WriteError(w, errResp)
+			return
+		}
+
+		tokenFunc := func(id int64, email string) (string, error) {
+			jwt, err := generator.Generate(id, email)
+			if err != nil {
+				return "", err
+			}
+
+			return jwt, nil
+		}
+
+		if err := h.emailChangeService.RequestChange(r.Context(), id, emailDTO.Email, tokenFunc); err != nil {
+			var errResp *rest.ErrorResponse
+			switch {
+			case errors.Is(err, service.ErrEmailUnchanged):
+				h.Writer.WriteError(w, rest.NewBadRequestError(err))
+			case errors.Is(err, service.ErrUserEmailTaken):
+				h.Writer.WriteError(w, rest.NewConflictError(err))
+			case errors.Is(err, service.ErrUserNotFound):
+				h.Writer.WriteError(w, rest.NewNotFoundError(err))
+			case errors.As(err, &errResp):
+				h.Writer.WriteError(w, errResp)
+			default:
+				h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to request email change - id: %d, error: %w", id, err))
+			}
+			return
+		}
+
+		h.Logger.Infof("Request user email change - U-ID: %d, New-Email: %s", id, emailDTO.Email)
+		h.Writer.WriteNoContent(w, http.StatusNoContent)
+	}
+}
+
+// SendPhoneCode
+// @Summary Send a phone verification code
+// @Description Send a one-time code to the phone number of the current user by SMS, a new code replaces the previous one
+// @Tags users
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request - User has no phone number"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 409 {object} rest.ErrorResponse "Conflict - Phone number is already verified"
+// @Failure 429 {object} rest.ErrorResponse "Too Many Requests - Code was sent recently"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/phone/send-code [post]
+func (h *Handler) SendPhoneCode(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.phoneService.SendCode(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, service.ErrPhoneVerified):
+			h.Writer.WriteError(w, rest.NewConflictError(err))
+		case errors.Is(err, service.ErrPhoneNumberMissing):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrPhoneCodeRecentlySent):
+			h.Writer.WriteError(w, rest.NewTooManyRequestsError(err))
+		case errors.Is(err, service.ErrUserNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to send phone verification code - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Send user phone verification code - U-ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// VerifyPhone
+// @Summary Verify the phone number
+// @Description Verify the phone number of the current user with the code sent by SMS.
+// @Description The access token carries the verification only once it's refreshed.
+// @Tags users
+// @Accept json
+// @Param code body dto.PhoneCode true "Verification code"
+// @Success 204 "No Content"
+// @Failure 400 {object} rest.ErrorResponse "Bad Request"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found - No pending code"
+// @Failure 429 {object} rest.ErrorResponse "Too Many Requests - Attempts ran out, a new code has to be requested"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/phone/verify [post]
+func (h *Handler) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	var codeDTO dto.PhoneCode
+	if errResp := h.Binder.BindJSON(r, &codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if errResp := h.Validator.Validate(codeDTO); errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	if err := h.phoneService.VerifyCode(r.Context(), id, codeDTO.Code); err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidPhoneCode):
+			h.Writer.WriteError(w, rest.NewBadRequestError(err))
+		case errors.Is(err, service.ErrPhoneCodeNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		case errors.Is(err, service.ErrPhoneCodeAttemptsExceeded):
+			h.Writer.WriteError(w, rest.NewTooManyRequestsError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to verify phone - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	h.Logger.Infof("Verify user phone - U-ID: %d", id)
+	h.Writer.WriteNoContent(w, http.StatusNoContent)
+}
+
+// ExportData
+// @Summary Export personal data
+// @Description Assemble the profile, the provider record and the pictures of the current user into a ZIP archive.
+// @Description The archive replaces the previous export and is downloaded with the returned signed url.
+// @Tags users
+// @Produce json
+// @Success 200 {object} rest.ApiResponse[dto.DataExport] "OK"
+// @Failure 401 {object} rest.ErrorResponse "Unauthorized"
+// @Failure 404 {object} rest.ErrorResponse "Not Found"
+// @Failure 500 {object} rest.ErrorResponse "Internal Server Error"
+// @Security access_token
+// @Router /user/me/export [post]
+func (h *Handler) ExportData(w http.ResponseWriter, r *http.Request) {
+	id, _, errResp := request_util.ParseUserData(r)
+	if errResp != nil {
+		h.Writer.WriteError(w, errResp)
+		return
+	}
+
+	exportPath, err := h.privacyService.Export(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			h.Writer.WriteError(w, rest.NewNotFoundError(err))
+		default:
+			h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to export user data - id: %d, error: %w", id, err))
+		}
+		return
+	}
+
+	url, err := h.urlSigner.SignURL(exportPath)
+	if err != nil {
+		h.Writer.WriteError(w, rest.NewInternalServerErrorf("failed to sign data export url - id: %d, error: %w", id, err))
+		return
+	}
+
+	h.Logger.Infof("Export user data - U-ID: %d", id)
+	h.Writer.WriteData(w, http.StatusOK, dto.DataExport{Url: url})
+}
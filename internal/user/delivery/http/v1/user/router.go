@@ -6,6 +6,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hexley21/fixup/internal/common/enum"
 	"github.com/hexley21/fixup/internal/common/middleware"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 )
 
 // TODO: Move to config
@@ -17,6 +18,7 @@ func MapRoutes(
 	h *Handler,
 	jWTAccessMiddleware func(http.Handler) http.Handler,
 	onlyVerifiedMiddleware func(http.Handler) http.Handler,
+	emailChangeJWTManager email_change_jwt.Manager,
 	router chi.Router,
 ) {
 	router.Route("/user", func(r chi.Router) {
@@ -44,5 +46,31 @@ func MapRoutes(
 		r.Patch("/me/change-password", h.ChangePassword)
 	})
 
+	router.Route("/user/me/sessions", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Get("/", h.ListSessions)
+		r.Delete("/{session_id}", h.RevokeSession)
+	})
+
+	router.Route("/user/me/2fa", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Post("/enroll", h.EnrollTwoFactor)
+		r.Post("/confirm", h.ConfirmTwoFactor)
+		r.Delete("/", h.DisableTwoFactor)
+	})
+
+	router.With(jWTAccessMiddleware).Post("/user/me/email", h.RequestEmailChange(emailChangeJWTManager))
+
+	router.Route("/user/me/phone", func(r chi.Router) {
+		r.Use(jWTAccessMiddleware)
+
+		r.Post("/send-code", h.SendPhoneCode)
+		r.Post("/verify", h.VerifyPhone)
+	})
+
+	router.With(jWTAccessMiddleware).Post("/user/me/export", h.ExportData)
+
 	// router.Get("/profile/{id}", h.FindUserProfileById)
 }
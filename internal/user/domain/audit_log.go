@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionRoleChange  AuditAction = "ROLE_CHANGE"
+	AuditActionSuspend     AuditAction = "SUSPEND"
+	AuditActionUnsuspend   AuditAction = "UNSUSPEND"
+	AuditActionForceVerify AuditAction = "FORCE_VERIFY"
+
+	AuditActionPersonalIDView  AuditAction = "PERSONAL_ID_VIEW"
+	AuditActionProviderApprove AuditAction = "PROVIDER_APPROVE"
+	AuditActionProviderReject  AuditAction = "PROVIDER_REJECT"
+)
+
+type AuditLog struct {
+	ID        int64
+	UserID    int64
+	ActorID   int64
+	Action    AuditAction
+	OldValue  string
+	NewValue  string
+	Reason    string
+	CreatedAt time.Time
+} // Audit Log Domain Entity, an administrative action of the actor on the user
+
+func NewAuditLog(id int64, userID int64, actorID int64, action AuditAction, oldValue string, newValue string, reason string, createdAt time.Time) AuditLog {
+	return AuditLog{
+		ID:        id,
+		UserID:    userID,
+		ActorID:   actorID,
+		Action:    action,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		Reason:    reason,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+type Keyset struct {
+	ID        int64
+	CreatedAt time.Time
+	Backward  bool
+} // Keyset pagination Value Object, selects the items following the item with the creation time and ID in the order of the list, or preceding it if backward. The zero value selects the start of the list
+
+func NewKeyset(id int64, createdAt time.Time, backward bool) Keyset {
+	return Keyset{
+		ID:        id,
+		CreatedAt: createdAt,
+		Backward:  backward,
+	}
+}
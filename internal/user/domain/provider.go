@@ -1,5 +1,15 @@
 package domain
 
+import "time"
+
+type ProviderVerificationStatus string
+
+const (
+	ProviderVerificationPending  ProviderVerificationStatus = "PENDING"
+	ProviderVerificationApproved ProviderVerificationStatus = "APPROVED"
+	ProviderVerificationRejected ProviderVerificationStatus = "REJECTED"
+)
+
 type (
 	Provider struct {
 		UserID       int64
@@ -9,4 +19,55 @@ type (
 		PersonalIDNumber  []byte
 		PersonalIDPreview string
 	} // Provider personal info value object
+	ProviderVerification struct {
+		UserID            int64
+		FirstName         string
+		LastName          string
+		Email             string
+		PersonalIDPreview string
+		Status            ProviderVerificationStatus
+		RejectionReason   string
+		SubmittedAt       time.Time
+		ReviewedAt        time.Time
+		Documents         []ProviderDocument
+	} // Provider identity verification value object, submitted once the provider uploads an ID document
+	ProviderDocument struct {
+		ID        int64
+		Path      string
+		CreatedAt time.Time
+	} // Provider ID document image value object
 )
+
+func NewProviderVerification(
+	userID int64,
+	firstName string,
+	lastName string,
+	email string,
+	personalIDPreview string,
+	status ProviderVerificationStatus,
+	rejectionReason string,
+	submittedAt time.Time,
+	reviewedAt time.Time,
+	documents []ProviderDocument,
+) ProviderVerification {
+	return ProviderVerification{
+		UserID:            userID,
+		FirstName:         firstName,
+		LastName:          lastName,
+		Email:             email,
+		PersonalIDPreview: personalIDPreview,
+		Status:            status,
+		RejectionReason:   rejectionReason,
+		SubmittedAt:       submittedAt,
+		ReviewedAt:        reviewedAt,
+		Documents:         documents,
+	}
+}
+
+func NewProviderDocument(id int64, path string, createdAt time.Time) ProviderDocument {
+	return ProviderDocument{
+		ID:        id,
+		Path:      path,
+		CreatedAt: createdAt,
+	}
+}
\ No newline at end of file
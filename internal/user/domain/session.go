@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+type (
+	Session struct {
+		ID         string
+		UserID     int64
+		Device     SessionDevice
+		CreatedAt  time.Time
+		LastUsedAt time.Time
+	} // Session Domain Entity, one per signed in device
+
+	SessionDevice struct {
+		UserAgent string
+		IP        string
+	} // Session device Value Object
+)
+
+func NewSession(id string, userID int64, device SessionDevice, createdAt time.Time, lastUsedAt time.Time) Session {
+	return Session{
+		ID:         id,
+		UserID:     userID,
+		Device:     device,
+		CreatedAt:  createdAt,
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func NewSessionDevice(userAgent string, ip string) SessionDevice {
+	return SessionDevice{
+		UserAgent: userAgent,
+		IP:        ip,
+	}
+}
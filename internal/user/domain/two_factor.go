@@ -0,0 +1,13 @@
+package domain
+
+type TwoFactorEnrolment struct {
+	Secret string
+	URI    string
+} // Two-factor enrolment Value Object, the secret and its otpauth URI are shown to the user once
+
+func NewTwoFactorEnrolment(secret string, uri string) TwoFactorEnrolment {
+	return TwoFactorEnrolment{
+		Secret: secret,
+		URI:    uri,
+	}
+}
@@ -12,6 +12,7 @@ type (
 		Picture      string
 		PersonalInfo *UserPersonalInfo
 		AccountInfo  UserAccountInfo
+		Suspended    bool
 		CreatedAt    time.Time
 	} // User Domain Entity
 
@@ -23,14 +24,24 @@ type (
 	} // User personal info Value Object
 
 	UserAccountInfo struct {
-		Role     enum.UserRole
-		Verified bool
+		Role          enum.UserRole
+		Verified      bool
+		PhoneVerified bool
 	} // User account info Value Object
 
 	UserIdentity struct {
-		ID          int64
-		AccountInfo UserAccountInfo
-	} // Partial User domain entity representation
+		ID               int64
+		AccountInfo      UserAccountInfo
+		TwoFactorPending bool
+	} // Partial User domain entity representation, two-factor is pending if the user still has to pass the two-factor check
+
+	UserFilter struct {
+		Role          enum.UserRole
+		Verified      *bool
+		EmailPrefix   string
+		CreatedAfter  time.Time
+		CreatedBefore time.Time
+	} // User search filter Value Object, empty fields are ignored
 )
 
 func NewUser(id int64, picture string, personalInfo *UserPersonalInfo, accountInfo UserAccountInfo, createdAt time.Time) *User {
@@ -52,10 +63,21 @@ func NewUserPersonalInfo(email string, phoneNumber string, firstName string, las
 	}
 }
 
-func NewUserAccountInfo(role enum.UserRole, verified bool) UserAccountInfo {
+func NewUserAccountInfo(role enum.UserRole, verified bool, phoneVerified bool) UserAccountInfo {
 	return UserAccountInfo{
-		Role:     role,
-		Verified: verified,
+		Role:          role,
+		Verified:      verified,
+		PhoneVerified: phoneVerified,
+	}
+}
+
+func NewUserFilter(role enum.UserRole, verified *bool, emailPrefix string, createdAfter time.Time, createdBefore time.Time) UserFilter {
+	return UserFilter{
+		Role:          role,
+		Verified:      verified,
+		EmailPrefix:   emailPrefix,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
 	}
 }
 
@@ -0,0 +1,44 @@
+package challenge_jwt
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ChallengeClaims identify a user that passed the password check and still has to pass the two-factor check.
+// The token id (jti) lets the challenge be used for a single login only.
+type ChallengeClaims struct {
+	ID string `json:"id"`
+	jwt.RegisteredClaims
+}
+
+func newClaims(id string, tokenID string, expiry time.Duration) ChallengeClaims {
+	return ChallengeClaims{
+		ID: id,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+		},
+	}
+}
+
+func mapToClaim(mapClaims any) ChallengeClaims {
+	claims, ok := mapClaims.(jwt.MapClaims)
+	if !ok {
+		return ChallengeClaims{}
+	}
+
+	challengeClaims := ChallengeClaims{
+		ID: claims["id"].(string),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Unix(int64(claims["exp"].(float64)), 0)),
+		},
+	}
+
+	if jti, ok := claims["jti"].(string); ok {
+		challengeClaims.RegisteredClaims.ID = jti
+	}
+
+	return challengeClaims
+}
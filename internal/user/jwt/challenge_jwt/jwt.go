@@ -0,0 +1,58 @@
+package challenge_jwt
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/hexley21/fixup/pkg/http/rest"
+	"github.com/hexley21/fixup/pkg/jwt"
+)
+
+type Manager interface {
+	Generator
+	Verifier
+}
+
+type Generator interface {
+	Generate(id int64) (string, *rest.ErrorResponse)
+}
+
+type Verifier interface {
+	Verify(tokenString string) (ChallengeClaims, *rest.ErrorResponse)
+}
+
+const tokenIDBytes = 16
+
+type managerImpl struct {
+	secretKey string
+	ttl       time.Duration
+}
+
+func NewManager(secretKey string, ttl time.Duration) *managerImpl {
+	return &managerImpl{secretKey, ttl}
+}
+
+func (j *managerImpl) Generate(id int64) (string, *rest.ErrorResponse) {
+	b := make([]byte, tokenIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", rest.NewInternalServerError(err)
+	}
+
+	token, err := jwt.Generate(newClaims(strconv.FormatInt(id, 10), hex.EncodeToString(b), j.ttl), j.secretKey)
+	if err != nil {
+		return "", rest.NewInternalServerError(err)
+	}
+
+	return token, nil
+}
+
+func (j *managerImpl) Verify(tokenString string) (ChallengeClaims, *rest.ErrorResponse) {
+	mapClaims, err := jwt.Verify(tokenString, j.secretKey)
+	if err != nil {
+		return ChallengeClaims{}, rest.NewUnauthorizedError(err)
+	}
+
+	return mapToClaim(mapClaims), nil
+}
@@ -0,0 +1,38 @@
+package email_change_jwt
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type EmailChangeClaims struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+func newClaims(id string, email string, expiry time.Duration) EmailChangeClaims {
+	return EmailChangeClaims{
+		ID:    id,
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+		},
+	}
+}
+
+func mapToClaim(mapClaims any) EmailChangeClaims {
+	claims, ok := mapClaims.(jwt.MapClaims)
+	if !ok {
+		return EmailChangeClaims{}
+	}
+
+	return EmailChangeClaims{
+		ID:    claims["id"].(string),
+		Email: claims["email"].(string),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Unix(int64(claims["exp"].(float64)), 0)),
+		},
+	}
+}
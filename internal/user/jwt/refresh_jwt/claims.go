@@ -6,16 +6,23 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// RefreshClaims carries the id of the session the token belongs to,
+// the token id (jti) changes on every rotation of the session.
 type RefreshClaims struct {
-	ID       string        `json:"id"`
+	ID        string        `json:"id"`
+	SessionID string        `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func NewClaims(id string, expiry time.Duration) RefreshClaims {
+func NewClaims(id string, sessionID string, tokenID string, expiry time.Duration) RefreshClaims {
+	now := time.Now()
 	return RefreshClaims{
-		ID:       id,
+		ID:        id,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 }
@@ -26,10 +33,23 @@ func mapToClaim(mapClaims any) RefreshClaims {
 		return RefreshClaims{}
 	}
 
-	return RefreshClaims{
+	refreshClaims := RefreshClaims{
 		ID: claims["id"].(string),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Unix(int64(claims["exp"].(float64)), 0)),
 		},
 	}
+
+	// tokens issued before sessions were added have no session
+	if sid, ok := claims["sid"].(string); ok {
+		refreshClaims.SessionID = sid
+	}
+	if jti, ok := claims["jti"].(string); ok {
+		refreshClaims.RegisteredClaims.ID = jti
+	}
+	if iat, ok := claims["iat"].(float64); ok {
+		refreshClaims.IssuedAt = jwt.NewNumericDate(time.Unix(int64(iat), 0))
+	}
+
+	return refreshClaims
 }
@@ -14,7 +14,7 @@ type Manager interface {
 }
 
 type Generator interface {
-	Generate(id int64) (string, *rest.ErrorResponse)
+	Generate(id int64, sessionID string, tokenID string) (string, *rest.ErrorResponse)
 }
 
 type Verifier interface {
@@ -30,8 +30,8 @@ func NewManager(secretKey string, ttl time.Duration) *managerImpl {
 	return &managerImpl{secretKey: secretKey, ttl: ttl}
 }
 
-func (j *managerImpl) Generate(id int64) (string, *rest.ErrorResponse) {
-	token, err := jwt.Generate(NewClaims(strconv.FormatInt(id, 10), j.ttl), j.secretKey)
+func (j *managerImpl) Generate(id int64, sessionID string, tokenID string) (string, *rest.ErrorResponse) {
+	token, err := jwt.Generate(NewClaims(strconv.FormatInt(id, 10), sessionID, tokenID, j.ttl), j.secretKey)
 	if err != nil {
 		return "", rest.NewInternalServerError(err)
 	}
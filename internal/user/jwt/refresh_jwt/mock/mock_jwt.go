@@ -41,18 +41,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockManager) Generate(id string) (string, *rest.ErrorResponse) {
+func (m *MockManager) Generate(id int64, sessionID, tokenID string) (string, *rest.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generate", id)
+	ret := m.ctrl.Call(m, "Generate", id, sessionID, tokenID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(*rest.ErrorResponse)
 	return ret0, ret1
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockManagerMockRecorder) Generate(id any) *gomock.Call {
+func (mr *MockManagerMockRecorder) Generate(id, sessionID, tokenID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockManager)(nil).Generate), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockManager)(nil).Generate), id, sessionID, tokenID)
 }
 
 // Verify mocks base method.
@@ -94,18 +94,18 @@ func (m *MockGenerator) EXPECT() *MockGeneratorMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockGenerator) Generate(id string) (string, *rest.ErrorResponse) {
+func (m *MockGenerator) Generate(id int64, sessionID, tokenID string) (string, *rest.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generate", id)
+	ret := m.ctrl.Call(m, "Generate", id, sessionID, tokenID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(*rest.ErrorResponse)
 	return ret0, ret1
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockGeneratorMockRecorder) Generate(id any) *gomock.Call {
+func (mr *MockGeneratorMockRecorder) Generate(id, sessionID, tokenID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), id, sessionID, tokenID)
 }
 
 // MockVerifier is a mock of Verifier interface.
@@ -0,0 +1,38 @@
+package reset_jwt
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type ResetClaims struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+func newClaims(id string, email string, expiry time.Duration) ResetClaims {
+	return ResetClaims{
+		ID:    id,
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
+		},
+	}
+}
+
+func mapToClaim(mapClaims any) ResetClaims {
+	claims, ok := mapClaims.(jwt.MapClaims)
+	if !ok {
+		return ResetClaims{}
+	}
+
+	return ResetClaims{
+		ID:    claims["id"].(string),
+		Email: claims["email"].(string),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Unix(int64(claims["exp"].(float64)), 0)),
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AuditLogRepository interface {
+	postgres.Repository[AuditLogRepository]
+	Create(ctx context.Context, arg CreateUserAuditLogParams) error
+	List(ctx context.Context, userID int64, limit int64, offset int64) ([]UserAuditLog, error)
+}
+
+type pgsqlAuditLogRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewAuditLogRepository(dbtx postgres.PGXQuerier) *pgsqlAuditLogRepository {
+	return &pgsqlAuditLogRepository{
+		dbtx,
+	}
+}
+
+func (r *pgsqlAuditLogRepository) WithTx(tx postgres.PGXQuerier) AuditLogRepository {
+	return NewAuditLogRepository(tx)
+}
+
+type UserAuditLog struct {
+	ID        int64
+	UserID    int64
+	ActorID   int64
+	Action    string
+	OldValue  pgtype.Text
+	NewValue  pgtype.Text
+	Reason    pgtype.Text
+	CreatedAt pgtype.Timestamp
+}
+
+const createUserAuditLog = `-- name: CreateUserAuditLog :exec
+INSERT INTO user_audit_logs (user_id, actor_id, action, old_value, new_value, reason) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateUserAuditLogParams struct {
+	UserID   int64
+	ActorID  int64
+	Action   string
+	OldValue pgtype.Text
+	NewValue pgtype.Text
+	Reason   pgtype.Text
+}
+
+// Create writes an action of the actor on the user to the audit trail.
+func (r *pgsqlAuditLogRepository) Create(ctx context.Context, arg CreateUserAuditLogParams) error {
+	_, err := r.db.Exec(ctx, createUserAuditLog,
+		arg.UserID,
+		arg.ActorID,
+		arg.Action,
+		arg.OldValue,
+		arg.NewValue,
+		arg.Reason,
+	)
+	return err
+}
+
+const listUserAuditLogs = `-- name: ListUserAuditLogs :many
+SELECT id, user_id, actor_id, action, old_value, new_value, reason, created_at
+FROM user_audit_logs WHERE user_id = $1
+ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3
+`
+
+// List retrieves the audit trail of the user, newest first.
+func (r *pgsqlAuditLogRepository) List(ctx context.Context, userID int64, limit int64, offset int64) ([]UserAuditLog, error) {
+	rows, err := r.db.Query(ctx, listUserAuditLogs, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []UserAuditLog
+	for rows.Next() {
+		var i UserAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ActorID,
+			&i.Action,
+			&i.OldValue,
+			&i.NewValue,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAuditLog_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewAuditLogRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:   user.ID,
+		ActorID:  2,
+		Action:   "ROLE_CHANGE",
+		OldValue: pgtype.Text{String: "CUSTOMER", Valid: true},
+		NewValue: pgtype.Text{String: "MODERATOR", Valid: true},
+	}))
+	assert.NoError(t, repo.Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:  user.ID,
+		ActorID: 2,
+		Action:  "SUSPEND",
+		Reason:  pgtype.Text{String: "spam", Valid: true},
+	}))
+
+	logs, err := repo.List(ctx, user.ID, 10, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, logs, 2) {
+		assert.Equal(t, "SUSPEND", logs[0].Action)
+		assert.Equal(t, "spam", logs[0].Reason.String)
+		assert.False(t, logs[0].OldValue.Valid)
+
+		assert.Equal(t, "ROLE_CHANGE", logs[1].Action)
+		assert.Equal(t, int64(2), logs[1].ActorID)
+		assert.Equal(t, "CUSTOMER", logs[1].OldValue.String)
+		assert.Equal(t, "MODERATOR", logs[1].NewValue.String)
+	}
+}
+
+func TestListAuditLogs_KeptAfterUserDeletion(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewAuditLogRepository(dbPool)
+	userRepo := repository.NewUserRepository(dbPool, nil)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, repository.CreateUserAuditLogParams{UserID: user.ID, ActorID: 2, Action: "FORCE_VERIFY"}))
+
+	ok, err := userRepo.Delete(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	logs, err := repo.List(ctx, user.ID, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+type EmailChangeRepository interface {
+	postgres.Repository[EmailChangeRepository]
+	Upsert(ctx context.Context, userID int64, newEmail string) error
+	Delete(ctx context.Context, userID int64, newEmail string) (bool, error)
+}
+
+type pgsqlEmailChangeRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewEmailChangeRepository(dbtx postgres.PGXQuerier) *pgsqlEmailChangeRepository {
+	return &pgsqlEmailChangeRepository{
+		dbtx,
+	}
+}
+
+func (r *pgsqlEmailChangeRepository) WithTx(tx postgres.PGXQuerier) EmailChangeRepository {
+	return NewEmailChangeRepository(tx)
+}
+
+const upsertEmailChange = `-- name: UpsertEmailChange :exec
+INSERT INTO email_changes (user_id, new_email) VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = EXCLUDED.new_email, created_at = CURRENT_TIMESTAMP
+`
+
+// Upsert stores a pending email change of the user, replacing the previous one.
+func (r *pgsqlEmailChangeRepository) Upsert(ctx context.Context, userID int64, newEmail string) error {
+	_, err := r.db.Exec(ctx, upsertEmailChange, userID, newEmail)
+	return err
+}
+
+const deleteEmailChange = `-- name: DeleteEmailChange :exec
+DELETE FROM email_changes WHERE user_id = $1 AND new_email = $2
+`
+
+// Delete removes the pending email change of the user to the new email.
+// It returns false if there is no such change, it was already applied or replaced by another one.
+func (r *pgsqlEmailChangeRepository) Delete(ctx context.Context, userID int64, newEmail string) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteEmailChange, userID, newEmail)
+	return result.RowsAffected() > 0, err
+}
@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+const newEmail = "new@email.com"
+
+func TestUpsertEmailChange_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewEmailChangeRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Upsert(ctx, user.ID, "old@email.com"))
+	assert.NoError(t, repo.Upsert(ctx, user.ID, newEmail))
+
+	row := dbPool.QueryRow(ctx, "SELECT new_email FROM email_changes WHERE user_id = $1", user.ID)
+	var email string
+	assert.NoError(t, row.Scan(&email))
+	assert.Equal(t, newEmail, email)
+}
+
+func TestDeleteEmailChange_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewEmailChangeRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Upsert(ctx, user.ID, newEmail))
+
+	ok, err := repo.Delete(ctx, user.ID, "old@email.com")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.Delete(ctx, user.ID, newEmail)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.Delete(ctx, user.ID, newEmail)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
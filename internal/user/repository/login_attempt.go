@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFailuresKeyPrefix = "login_failures:"
+	loginLockKeyPrefix     = "login_lock:"
+)
+
+// LoginAttemptRepository keeps track of failed logins of a subject, such as an email or a client IP.
+type LoginAttemptRepository interface {
+	GetLock(ctx context.Context, subject string) (time.Duration, error)
+	Lock(ctx context.Context, subject string, ttl time.Duration) error
+	AddFailure(ctx context.Context, subject string, window time.Duration) (int64, error)
+	Reset(ctx context.Context, subject string) error
+}
+
+type loginAttemptRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewLoginAttemptRepository(redis redis.UniversalClient) *loginAttemptRepositoryImpl {
+	return &loginAttemptRepositoryImpl{
+		redis: redis,
+	}
+}
+
+// GetLock returns for how long logins of the subject remain locked, zero if they are not locked.
+func (r *loginAttemptRepositoryImpl) GetLock(ctx context.Context, subject string) (time.Duration, error) {
+	ttl, err := r.redis.PTTL(ctx, loginLockKeyPrefix+subject).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// negative ttl means there is no lock
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// Lock locks logins of the subject for the ttl, replacing the previous lock.
+func (r *loginAttemptRepositoryImpl) Lock(ctx context.Context, subject string, ttl time.Duration) error {
+	return r.redis.Set(ctx, loginLockKeyPrefix+subject, "", ttl).Err()
+}
+
+// AddFailure counts a failed login of the subject and returns the number of failures.
+// The failures are forgotten once the window passes without another failure.
+func (r *loginAttemptRepositoryImpl) AddFailure(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	key := loginFailuresKeyPrefix + subject
+
+	var incr *redis.IntCmd
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.PExpire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// Reset forgets the failed logins of the subject, an active lock is kept.
+func (r *loginAttemptRepositoryImpl) Reset(ctx context.Context, subject string) error {
+	return r.redis.Del(ctx, loginFailuresKeyPrefix+subject).Err()
+}
@@ -0,0 +1,63 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+const loginSubject = "email:larry@page.com"
+
+func TestAddLoginFailure_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewLoginAttemptRepository(redisClient)
+
+	for i := int64(1); i <= 3; i++ {
+		failures, err := repo.AddFailure(ctx, loginSubject, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, i, failures)
+	}
+
+	failures, err := repo.AddFailure(ctx, "ip:127.0.0.1", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), failures)
+
+	if assert.NoError(t, repo.Reset(ctx, loginSubject)) {
+		failures, err := repo.AddFailure(ctx, loginSubject, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), failures)
+	}
+}
+
+func TestLoginLock_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewLoginAttemptRepository(redisClient)
+
+	lock, err := repo.GetLock(ctx, loginSubject)
+	assert.NoError(t, err)
+	assert.Zero(t, lock)
+
+	assert.NoError(t, repo.Lock(ctx, loginSubject, time.Minute))
+
+	lock, err = repo.GetLock(ctx, loginSubject)
+	if assert.NoError(t, err) {
+		assert.Greater(t, lock, 50*time.Second)
+		assert.LessOrEqual(t, lock, time.Minute)
+	}
+
+	// reset forgets the failures only
+	assert.NoError(t, repo.Reset(ctx, loginSubject))
+
+	lock, err = repo.GetLock(ctx, loginSubject)
+	assert.NoError(t, err)
+	assert.Positive(t, lock)
+}
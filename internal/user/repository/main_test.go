@@ -54,7 +54,7 @@ func TestMain(m *testing.M) {
 }
 
 func cleanupPostgres(ctx context.Context, dbPool *pgxpool.Pool) {
-	_, err := dbPool.Exec(ctx, "TRUNCATE TABLE users CASCADE")
+	_, err := dbPool.Exec(ctx, "TRUNCATE TABLE users, user_audit_logs CASCADE")
 	dbPool.Close()
 	if err != nil {
 		log.Fatalln("failed to cleanup database:", err)
@@ -71,6 +71,81 @@ func (mr *MockProviderRepositoryMockRecorder) Get(ctx, userID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProviderRepository)(nil).Get), ctx, userID)
 }
 
+// GetVerification mocks base method.
+func (m *MockProviderRepository) GetVerification(ctx context.Context, userID int64) (repository.ProviderVerificationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerification", ctx, userID)
+	ret0, _ := ret[0].(repository.ProviderVerificationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerification indicates an expected call of GetVerification.
+func (mr *MockProviderRepositoryMockRecorder) GetVerification(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerification", reflect.TypeOf((*MockProviderRepository)(nil).GetVerification), ctx, userID)
+}
+
+// GetVerificationForUpdate mocks base method.
+func (m *MockProviderRepository) GetVerificationForUpdate(ctx context.Context, userID int64) (repository.ProviderVerificationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationForUpdate", ctx, userID)
+	ret0, _ := ret[0].(repository.ProviderVerificationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationForUpdate indicates an expected call of GetVerificationForUpdate.
+func (mr *MockProviderRepositoryMockRecorder) GetVerificationForUpdate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationForUpdate", reflect.TypeOf((*MockProviderRepository)(nil).GetVerificationForUpdate), ctx, userID)
+}
+
+// ListPendingVerifications mocks base method.
+func (m *MockProviderRepository) ListPendingVerifications(ctx context.Context, limit, offset int64) ([]repository.ProviderVerificationRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingVerifications", ctx, limit, offset)
+	ret0, _ := ret[0].([]repository.ProviderVerificationRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingVerifications indicates an expected call of ListPendingVerifications.
+func (mr *MockProviderRepositoryMockRecorder) ListPendingVerifications(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingVerifications", reflect.TypeOf((*MockProviderRepository)(nil).ListPendingVerifications), ctx, limit, offset)
+}
+
+// SubmitVerification mocks base method.
+func (m *MockProviderRepository) SubmitVerification(ctx context.Context, userID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitVerification", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitVerification indicates an expected call of SubmitVerification.
+func (mr *MockProviderRepositoryMockRecorder) SubmitVerification(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitVerification", reflect.TypeOf((*MockProviderRepository)(nil).SubmitVerification), ctx, userID)
+}
+
+// UpdateVerification mocks base method.
+func (m *MockProviderRepository) UpdateVerification(ctx context.Context, arg repository.UpdateProviderVerificationParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVerification", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVerification indicates an expected call of UpdateVerification.
+func (mr *MockProviderRepositoryMockRecorder) UpdateVerification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerification", reflect.TypeOf((*MockProviderRepository)(nil).UpdateVerification), ctx, arg)
+}
+
 // WithTx mocks base method.
 func (m *MockProviderRepository) WithTx(q postgres.PGXQuerier) repository.ProviderRepository {
 	m.ctrl.T.Helper()
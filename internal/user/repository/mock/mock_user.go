@@ -12,7 +12,9 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	domain "github.com/hexley21/fixup/internal/user/domain"
 	repository "github.com/hexley21/fixup/internal/user/repository"
 	postgres "github.com/hexley21/fixup/pkg/infra/postgres"
 	pgtype "github.com/jackc/pgx/v5/pgtype"
@@ -42,6 +44,21 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimDeactivated mocks base method.
+func (m *MockUserRepository) ClaimDeactivated(ctx context.Context, id int64, deactivatedBefore time.Time) (pgtype.Text, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDeactivated", ctx, id, deactivatedBefore)
+	ret0, _ := ret[0].(pgtype.Text)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDeactivated indicates an expected call of ClaimDeactivated.
+func (mr *MockUserRepositoryMockRecorder) ClaimDeactivated(ctx, id, deactivatedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDeactivated", reflect.TypeOf((*MockUserRepository)(nil).ClaimDeactivated), ctx, id, deactivatedBefore)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, arg repository.CreateUserParams) (repository.User, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +74,21 @@ func (mr *MockUserRepositoryMockRecorder) Create(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), ctx, arg)
 }
 
+// Deactivate mocks base method.
+func (m *MockUserRepository) Deactivate(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockUserRepositoryMockRecorder) Deactivate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockUserRepository)(nil).Deactivate), ctx, id)
+}
+
 // Delete mocks base method.
 func (m *MockUserRepository) Delete(ctx context.Context, id int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +134,21 @@ func (mr *MockUserRepositoryMockRecorder) GetAccountInfo(ctx, id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInfo", reflect.TypeOf((*MockUserRepository)(nil).GetAccountInfo), ctx, id)
 }
 
+// GetAccountInfoForUpdate mocks base method.
+func (m *MockUserRepository) GetAccountInfoForUpdate(ctx context.Context, id int64) (repository.GetUserAccountInfoRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInfoForUpdate", ctx, id)
+	ret0, _ := ret[0].(repository.GetUserAccountInfoRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInfoForUpdate indicates an expected call of GetAccountInfoForUpdate.
+func (mr *MockUserRepositoryMockRecorder) GetAccountInfoForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInfoForUpdate", reflect.TypeOf((*MockUserRepository)(nil).GetAccountInfoForUpdate), ctx, id)
+}
+
 // GetAuthInfoByEmail mocks base method.
 func (m *MockUserRepository) GetAuthInfoByEmail(ctx context.Context, email string) (repository.GetUserAuthInfoByEmailRow, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +209,81 @@ func (mr *MockUserRepositoryMockRecorder) GetVerificationInfo(ctx, email any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationInfo", reflect.TypeOf((*MockUserRepository)(nil).GetVerificationInfo), ctx, email)
 }
 
+// ListDeactivated mocks base method.
+func (m *MockUserRepository) ListDeactivated(ctx context.Context, deactivatedBefore time.Time, limit int32) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeactivated", ctx, deactivatedBefore, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeactivated indicates an expected call of ListDeactivated.
+func (mr *MockUserRepositoryMockRecorder) ListDeactivated(ctx, deactivatedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeactivated", reflect.TypeOf((*MockUserRepository)(nil).ListDeactivated), ctx, deactivatedBefore, limit)
+}
+
+// PurgeDeactivated mocks base method.
+func (m *MockUserRepository) PurgeDeactivated(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeactivated", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeactivated indicates an expected call of PurgeDeactivated.
+func (mr *MockUserRepositoryMockRecorder) PurgeDeactivated(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeactivated", reflect.TypeOf((*MockUserRepository)(nil).PurgeDeactivated), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockUserRepository) Restore(ctx context.Context, id int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUserRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserRepository)(nil).Restore), ctx, id)
+}
+
+// Search mocks base method.
+func (m *MockUserRepository) Search(ctx context.Context, filter domain.UserFilter, limit, offset int64) ([]repository.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]repository.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUserRepositoryMockRecorder) Search(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUserRepository)(nil).Search), ctx, filter, limit, offset)
+}
+
+// SearchKeyset mocks base method.
+func (m *MockUserRepository) SearchKeyset(ctx context.Context, filter domain.UserFilter, keyset domain.Keyset, limit int64) ([]repository.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchKeyset", ctx, filter, keyset, limit)
+	ret0, _ := ret[0].([]repository.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchKeyset indicates an expected call of SearchKeyset.
+func (mr *MockUserRepositoryMockRecorder) SearchKeyset(ctx, filter, keyset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchKeyset", reflect.TypeOf((*MockUserRepository)(nil).SearchKeyset), ctx, filter, keyset, limit)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, id int64, arg repository.UpdateUserRow) (repository.UpdateUserRow, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +299,21 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, id, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, id, arg)
 }
 
+// UpdateEmail mocks base method.
+func (m *MockUserRepository) UpdateEmail(ctx context.Context, id int64, email string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, id, email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail.
+func (mr *MockUserRepositoryMockRecorder) UpdateEmail(ctx, id, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockUserRepository)(nil).UpdateEmail), ctx, id, email)
+}
+
 // UpdateHash mocks base method.
 func (m *MockUserRepository) UpdateHash(ctx context.Context, id int64, hash string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +329,21 @@ func (mr *MockUserRepositoryMockRecorder) UpdateHash(ctx, id, hash any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHash", reflect.TypeOf((*MockUserRepository)(nil).UpdateHash), ctx, id, hash)
 }
 
+// UpdatePhoneVerification mocks base method.
+func (m *MockUserRepository) UpdatePhoneVerification(ctx context.Context, id int64, phoneNumber string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoneVerification", ctx, id, phoneNumber)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhoneVerification indicates an expected call of UpdatePhoneVerification.
+func (mr *MockUserRepositoryMockRecorder) UpdatePhoneVerification(ctx, id, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoneVerification", reflect.TypeOf((*MockUserRepository)(nil).UpdatePhoneVerification), ctx, id, phoneNumber)
+}
+
 // UpdatePicture mocks base method.
 func (m *MockUserRepository) UpdatePicture(ctx context.Context, id int64, picture string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -207,6 +359,36 @@ func (mr *MockUserRepositoryMockRecorder) UpdatePicture(ctx, id, picture any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePicture", reflect.TypeOf((*MockUserRepository)(nil).UpdatePicture), ctx, id, picture)
 }
 
+// UpdateRole mocks base method.
+func (m *MockUserRepository) UpdateRole(ctx context.Context, id int64, role string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, id, role)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockUserRepositoryMockRecorder) UpdateRole(ctx, id, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockUserRepository)(nil).UpdateRole), ctx, id, role)
+}
+
+// UpdateSuspension mocks base method.
+func (m *MockUserRepository) UpdateSuspension(ctx context.Context, id int64, suspended bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSuspension", ctx, id, suspended)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSuspension indicates an expected call of UpdateSuspension.
+func (mr *MockUserRepositoryMockRecorder) UpdateSuspension(ctx, id, suspended any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSuspension", reflect.TypeOf((*MockUserRepository)(nil).UpdateSuspension), ctx, id, suspended)
+}
+
 // UpdateVerification mocks base method.
 func (m *MockUserRepository) UpdateVerification(ctx context.Context, id int64, verified bool) (bool, error) {
 	m.ctrl.T.Helper()
@@ -19,6 +19,8 @@ type User struct {
 	Picture     pgtype.Text      `json:"picture"`
 	Hash        string           `json:"hash"`
 	Role        string           `json:"role"`
-	Verified    pgtype.Bool      `json:"verified"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	Verified      pgtype.Bool      `json:"verified"`
+	PhoneVerified pgtype.Bool      `json:"phone_verified"`
+	Suspended     bool             `json:"suspended"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
 }
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const oidcStateKeyPrefix = "oidc_state:"
+
+// OIDCState is kept between the redirect to the provider and the callback, the state itself is the key.
+type OIDCState struct {
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+type OIDCStateRepository interface {
+	Set(ctx context.Context, state string, value OIDCState, ttl time.Duration) error
+	Pop(ctx context.Context, state string) (OIDCState, error)
+}
+
+type oidcStateRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewOIDCStateRepository(redis redis.UniversalClient) *oidcStateRepositoryImpl {
+	return &oidcStateRepositoryImpl{
+		redis: redis,
+	}
+}
+
+// Set stores the login state until the ttl passes.
+func (r *oidcStateRepositoryImpl) Set(ctx context.Context, state string, value OIDCState, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return r.redis.Set(ctx, oidcStateKeyPrefix+state, data, ttl).Err()
+}
+
+// Pop retrieves and removes the login state, so it can be used only once.
+// If there is no such state or it has expired, it returns redis.Nil.
+func (r *oidcStateRepositoryImpl) Pop(ctx context.Context, state string) (OIDCState, error) {
+	var value OIDCState
+
+	data, err := r.redis.GetDel(ctx, oidcStateKeyPrefix+state).Bytes()
+	if err != nil {
+		return value, err
+	}
+
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
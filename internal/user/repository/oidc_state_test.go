@@ -0,0 +1,29 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopOIDCState_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewOIDCStateRepository(redisClient)
+	value := repository.OIDCState{CodeVerifier: "verifier", Nonce: "nonce"}
+
+	assert.NoError(t, repo.Set(ctx, "state", value, time.Minute))
+
+	state, err := repo.Pop(ctx, "state")
+	assert.NoError(t, err)
+	assert.Equal(t, value, state)
+
+	_, err = repo.Pop(ctx, "state")
+	assert.ErrorIs(t, err, redis.Nil)
+}
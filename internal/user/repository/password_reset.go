@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const passwordResetKeyPrefix = "password_reset:"
+
+type PasswordResetRepository interface {
+	SetTokenUsed(ctx context.Context, token string, ttl time.Duration) error
+}
+
+type passwordResetRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewPasswordResetRepository(redis redis.UniversalClient) *passwordResetRepositoryImpl {
+	return &passwordResetRepositoryImpl{
+		redis: redis,
+	}
+}
+
+// SetTokenUsed marks the password reset token as used until it expires.
+// If the token was already used, it returns redis.TxFailedErr.
+func (r *passwordResetRepositoryImpl) SetTokenUsed(ctx context.Context, token string, ttl time.Duration) error {
+	success, err := r.redis.SetNX(ctx, passwordResetKeyPrefix+token, "", ttl).Result()
+	if err != nil {
+		return err
+	}
+
+	if !success {
+		return redis.TxFailedErr
+	}
+
+	return nil
+}
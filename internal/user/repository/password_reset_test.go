@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetResetTokenUsed_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPasswordResetRepository(redisClient)
+
+	err := repo.SetTokenUsed(ctx, token, tokenTTL)
+	if assert.NoError(t, err) {
+		ttl, err := redisClient.TTL(ctx, "password_reset:"+token).Result()
+		assert.NoError(t, err)
+		assert.Positive(t, ttl)
+	}
+}
+
+func TestSetResetTokenUsed_AlreadyUsed(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPasswordResetRepository(redisClient)
+
+	if assert.NoError(t, repo.SetTokenUsed(ctx, token, tokenTTL)) {
+		assert.ErrorIs(t, repo.SetTokenUsed(ctx, token, tokenTTL), redis.TxFailedErr)
+	}
+}
+
+func TestSetResetTokenUsed_VerificationTokenUsed(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	if assert.NoError(t, repository.NewVerificationRepository(redisClient).SetTokenUsed(ctx, token, tokenTTL)) {
+		assert.NoError(t, repository.NewPasswordResetRepository(redisClient).SetTokenUsed(ctx, token, tokenTTL))
+	}
+}
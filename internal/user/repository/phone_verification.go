@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	phoneVerificationKeyPrefix         = "phone_verification:"
+	phoneVerificationCooldownKeyPrefix = "phone_verification_cooldown:"
+)
+
+// PhoneVerificationCode is a pending verification of the phone number, the code is stored hashed.
+type PhoneVerificationCode struct {
+	PhoneNumber string `redis:"phone_number"`
+	Hash        string `redis:"hash"`
+}
+
+type PhoneVerificationRepository interface {
+	Set(ctx context.Context, userID int64, code PhoneVerificationCode, ttl time.Duration) error
+	Get(ctx context.Context, userID int64) (PhoneVerificationCode, error)
+	AddAttempt(ctx context.Context, userID int64) (int64, error)
+	Delete(ctx context.Context, userID int64) error
+	SetCooldown(ctx context.Context, userID int64, ttl time.Duration) (bool, error)
+}
+
+type phoneVerificationRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewPhoneVerificationRepository(redis redis.UniversalClient) *phoneVerificationRepositoryImpl {
+	return &phoneVerificationRepositoryImpl{
+		redis: redis,
+	}
+}
+
+func phoneVerificationKey(userID int64) string {
+	return phoneVerificationKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// Set stores the verification code of the user until the ttl passes, replacing the previous one along with its attempts.
+func (r *phoneVerificationRepositoryImpl) Set(ctx context.Context, userID int64, code PhoneVerificationCode, ttl time.Duration) error {
+	key := phoneVerificationKey(userID)
+
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, code)
+		pipe.PExpire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+// Get retrieves the pending verification code of the user.
+// If there is no code or it has expired, it returns redis.Nil.
+func (r *phoneVerificationRepositoryImpl) Get(ctx context.Context, userID int64) (PhoneVerificationCode, error) {
+	var code PhoneVerificationCode
+
+	res := r.redis.HGetAll(ctx, phoneVerificationKey(userID))
+	if err := res.Err(); err != nil {
+		return code, err
+	}
+	if len(res.Val()) == 0 {
+		return code, redis.Nil
+	}
+
+	err := res.Scan(&code)
+	return code, err
+}
+
+// KEYS[1] - phone verification key
+// Returns nil if there is no code, so an expired code is not brought back without a ttl.
+var addPhoneVerificationAttemptScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+`)
+
+// AddAttempt counts an attempt to verify the pending code of the user and returns the number of attempts.
+// If there is no code or it has expired, it returns redis.Nil.
+func (r *phoneVerificationRepositoryImpl) AddAttempt(ctx context.Context, userID int64) (int64, error) {
+	return addPhoneVerificationAttemptScript.Run(ctx, r.redis, []string{phoneVerificationKey(userID)}).Int64()
+}
+
+// Delete removes the pending verification code of the user.
+func (r *phoneVerificationRepositoryImpl) Delete(ctx context.Context, userID int64) error {
+	return r.redis.Del(ctx, phoneVerificationKey(userID)).Err()
+}
+
+// SetCooldown prevents sending another code to the user until the ttl passes.
+// It returns false if the previous cooldown is still active.
+func (r *phoneVerificationRepositoryImpl) SetCooldown(ctx context.Context, userID int64, ttl time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, phoneVerificationCooldownKeyPrefix+strconv.FormatInt(userID, 10), "", ttl).Result()
+}
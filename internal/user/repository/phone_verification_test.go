@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+const phoneVerificationUserId int64 = 1
+
+var phoneVerificationCode = repository.PhoneVerificationCode{PhoneNumber: "995555555555", Hash: "hash"}
+
+func TestSetPhoneVerificationCode_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPhoneVerificationRepository(redisClient)
+
+	assert.NoError(t, repo.Set(ctx, phoneVerificationUserId, phoneVerificationCode, time.Minute))
+
+	code, err := repo.Get(ctx, phoneVerificationUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, phoneVerificationCode, code)
+}
+
+func TestGetPhoneVerificationCode_NotFound(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPhoneVerificationRepository(redisClient)
+
+	_, err := repo.Get(ctx, phoneVerificationUserId)
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestAddPhoneVerificationAttempt_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPhoneVerificationRepository(redisClient)
+
+	_, err := repo.AddAttempt(ctx, phoneVerificationUserId)
+	assert.ErrorIs(t, err, redis.Nil)
+
+	assert.NoError(t, repo.Set(ctx, phoneVerificationUserId, phoneVerificationCode, time.Minute))
+	for i := int64(1); i <= 3; i++ {
+		attempts, err := repo.AddAttempt(ctx, phoneVerificationUserId)
+		assert.NoError(t, err)
+		assert.Equal(t, i, attempts)
+	}
+
+	// a new code starts over
+	assert.NoError(t, repo.Set(ctx, phoneVerificationUserId, phoneVerificationCode, time.Minute))
+	attempts, err := repo.AddAttempt(ctx, phoneVerificationUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), attempts)
+
+	assert.NoError(t, repo.Delete(ctx, phoneVerificationUserId))
+	_, err = repo.Get(ctx, phoneVerificationUserId)
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestSetPhoneVerificationCooldown_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewPhoneVerificationRepository(redisClient)
+
+	ok, err := repo.SetCooldown(ctx, phoneVerificationUserId, time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.SetCooldown(ctx, phoneVerificationUserId, time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
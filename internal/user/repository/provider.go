@@ -4,12 +4,19 @@ import (
 	"context"
 
 	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type ProviderRepository interface {
 	postgres.Repository[ProviderRepository]
 	Create(ctx context.Context, arg CreateProviderParams) (bool, error)
 	Get(ctx context.Context, userID int64) (Provider, error)
+	GetVerification(ctx context.Context, userID int64) (ProviderVerificationRow, error)
+	GetVerificationForUpdate(ctx context.Context, userID int64) (ProviderVerificationRow, error)
+	ListPendingVerifications(ctx context.Context, limit int64, offset int64) ([]ProviderVerificationRow, error)
+	SubmitVerification(ctx context.Context, userID int64) (bool, error)
+	UpdateVerification(ctx context.Context, arg UpdateProviderVerificationParams) (bool, error)
 }
 
 type pgsqlProviderRepository struct {
@@ -62,3 +69,157 @@ func (r *pgsqlProviderRepository) Get(ctx context.Context, userID int64) (Provid
 	err := row.Scan(&i.PersonalIDNumber, &i.PersonalIDPreview, &i.UserID)
 	return i, err
 }
+
+type ProviderVerificationRow struct {
+	UserID             int64
+	FirstName          string
+	LastName           string
+	Email              string
+	PersonalIDPreview  string
+	VerificationStatus string
+	RejectionReason    pgtype.Text
+	SubmittedAt        pgtype.Timestamp
+	ReviewedAt         pgtype.Timestamp
+}
+
+const getProviderVerification = `-- name: GetProviderVerification :one
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.user_id = $1
+`
+
+// GetVerification retrieves the identity verification of the provider.
+func (r *pgsqlProviderRepository) GetVerification(ctx context.Context, userID int64) (ProviderVerificationRow, error) {
+	return scanProviderVerification(r.db.QueryRow(ctx, getProviderVerification, userID))
+}
+
+const getProviderVerificationForUpdate = `-- name: GetProviderVerificationForUpdate :one
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.user_id = $1
+FOR UPDATE OF p
+`
+
+// GetVerificationForUpdate retrieves the identity verification of the provider and locks the provider until the transaction ends.
+func (r *pgsqlProviderRepository) GetVerificationForUpdate(ctx context.Context, userID int64) (ProviderVerificationRow, error) {
+	return scanProviderVerification(r.db.QueryRow(ctx, getProviderVerificationForUpdate, userID))
+}
+
+const listPendingProviderVerifications = `-- name: ListPendingProviderVerifications :many
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.verification_status = 'PENDING' AND p.submitted_at IS NOT NULL
+ORDER BY p.submitted_at, p.user_id
+LIMIT $1 OFFSET $2
+`
+
+// ListPendingVerifications retrieves the providers that submitted their documents and wait for the review, oldest submission first.
+func (r *pgsqlProviderRepository) ListPendingVerifications(ctx context.Context, limit int64, offset int64) ([]ProviderVerificationRow, error) {
+	rows, err := r.db.Query(ctx, listPendingProviderVerifications, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []ProviderVerificationRow
+	for rows.Next() {
+		i, err := scanProviderVerification(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const submitProviderVerification = `-- name: SubmitProviderVerification :exec
+UPDATE providers
+SET verification_status = 'PENDING', rejection_reason = NULL, submitted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1 AND verification_status <> 'APPROVED'
+`
+
+// SubmitVerification puts the provider into the review queue, a rejected provider is reviewed again.
+// It returns false if the provider does not exist or is already approved.
+func (r *pgsqlProviderRepository) SubmitVerification(ctx context.Context, userID int64) (bool, error) {
+	result, err := r.db.Exec(ctx, submitProviderVerification, userID)
+	return result.RowsAffected() > 0, err
+}
+
+const updateProviderVerification = `-- name: UpdateProviderVerification :exec
+UPDATE providers
+SET verification_status = $2, rejection_reason = $3, reviewed_by = $4, reviewed_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+`
+
+type UpdateProviderVerificationParams struct {
+	UserID             int64
+	VerificationStatus string
+	RejectionReason    pgtype.Text
+	ReviewedBy         int64
+}
+
+// UpdateVerification writes the outcome of the review of the provider.
+func (r *pgsqlProviderRepository) UpdateVerification(ctx context.Context, arg UpdateProviderVerificationParams) (bool, error) {
+	result, err := r.db.Exec(ctx, updateProviderVerification,
+		arg.UserID,
+		arg.VerificationStatus,
+		arg.RejectionReason,
+		arg.ReviewedBy,
+	)
+	return result.RowsAffected() > 0, err
+}
+
+func scanProviderVerification(row pgx.Row) (ProviderVerificationRow, error) {
+	var i ProviderVerificationRow
+	err := row.Scan(
+		&i.UserID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Email,
+		&i.PersonalIDPreview,
+		&i.VerificationStatus,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+	)
+	return i, err
+}
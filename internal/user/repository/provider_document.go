@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ProviderDocumentRepository interface {
+	postgres.Repository[ProviderDocumentRepository]
+	Create(ctx context.Context, userID int64, path string) (ProviderDocument, error)
+	List(ctx context.Context, userID int64) ([]ProviderDocument, error)
+}
+
+type pgsqlProviderDocumentRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewProviderDocumentRepository(dbtx postgres.PGXQuerier) *pgsqlProviderDocumentRepository {
+	return &pgsqlProviderDocumentRepository{
+		dbtx,
+	}
+}
+
+func (r *pgsqlProviderDocumentRepository) WithTx(tx postgres.PGXQuerier) ProviderDocumentRepository {
+	return NewProviderDocumentRepository(tx)
+}
+
+type ProviderDocument struct {
+	ID        int64
+	UserID    int64
+	Path      string
+	CreatedAt pgtype.Timestamp
+}
+
+const createProviderDocument = `-- name: CreateProviderDocument :one
+INSERT INTO provider_documents (user_id, path) VALUES ($1, $2)
+RETURNING id, user_id, path, created_at
+`
+
+// Create writes the s3 path of an ID document image of the provider.
+func (r *pgsqlProviderDocumentRepository) Create(ctx context.Context, userID int64, path string) (ProviderDocument, error) {
+	row := r.db.QueryRow(ctx, createProviderDocument, userID, path)
+	var i ProviderDocument
+	err := row.Scan(&i.ID, &i.UserID, &i.Path, &i.CreatedAt)
+	return i, err
+}
+
+const listProviderDocuments = `-- name: ListProviderDocuments :many
+SELECT id, user_id, path, created_at
+FROM provider_documents WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+// List retrieves the ID document images of the provider, oldest first.
+func (r *pgsqlProviderDocumentRepository) List(ctx context.Context, userID int64) ([]ProviderDocument, error) {
+	rows, err := r.db.Query(ctx, listProviderDocuments, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []ProviderDocument
+	for rows.Next() {
+		var i ProviderDocument
+		if err := rows.Scan(&i.ID, &i.UserID, &i.Path, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
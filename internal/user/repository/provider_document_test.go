@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateProviderDocument_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderDocumentRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	front, err := repo.Create(ctx, user.ID, "kyc/1/front")
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, front.UserID)
+	assert.Equal(t, "kyc/1/front", front.Path)
+
+	_, err = repo.Create(ctx, user.ID, "kyc/1/back")
+	assert.NoError(t, err)
+
+	documents, err := repo.List(ctx, user.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, documents, 2) {
+		assert.Equal(t, front.ID, documents[0].ID)
+		assert.Equal(t, "kyc/1/back", documents[1].Path)
+	}
+}
+
+func TestCreateProviderDocument_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderDocumentRepository(dbPool)
+
+	_, err := repo.Create(ctx, 1, "kyc/1/front")
+	assert.Error(t, err)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,7 +36,7 @@ func TestCreateProvider_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, true, ok)
 
-	row := dbPool.QueryRow(ctx, "SELECT * FROM  providers WHERE user_id = $1", args.UserID)
+	row := dbPool.QueryRow(ctx, "SELECT personal_id_number, personal_id_preview, user_id FROM providers WHERE user_id = $1", args.UserID)
 	var p repository.Provider
 	err = row.Scan(&p.PersonalIDNumber, &p.PersonalIDPreview, &p.UserID)
 	assert.NoError(t, err)
@@ -91,7 +93,7 @@ func TestCreateProvider_NotFound(t *testing.T) {
 	}
 	assert.False(t, ok)
 
-	row := dbPool.QueryRow(ctx, "SELECT * FROM  providers WHERE user_id = $1", args.UserID)
+	row := dbPool.QueryRow(ctx, "SELECT personal_id_number, personal_id_preview, user_id FROM providers WHERE user_id = $1", args.UserID)
 	var p repository.Provider
 	err = row.Scan(&p.PersonalIDNumber, &p.PersonalIDPreview, &p.UserID)
 	assert.ErrorIs(t, err, pgx.ErrNoRows)
@@ -111,7 +113,7 @@ func TestGetByUserId_Success(t *testing.T) {
 
 	row := dbPool.QueryRow(
 		ctx,
-		"INSERT INTO providers (personal_id_number, personal_id_preview, user_id) VALUES ($1, $2, $3) RETURNING *",
+		"INSERT INTO providers (personal_id_number, personal_id_preview, user_id) VALUES ($1, $2, $3) RETURNING personal_id_number, personal_id_preview, user_id",
 		[]byte("123456789"),
 		"12345",
 		user.ID,
@@ -150,3 +152,142 @@ func TestGetByUserId_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, pgx.ErrNoRows)
 	assert.Empty(t, provider)
 }
+
+// insertProvider inserts a provider for the user, submitted for the review if submitted is true.
+func insertProvider(dbPool *pgxpool.Pool, ctx context.Context, userID int64, submitted bool) error {
+	_, err := dbPool.Exec(
+		ctx,
+		"INSERT INTO providers (personal_id_number, personal_id_preview, user_id, submitted_at) VALUES ($1, $2, $3, CASE WHEN $4 THEN CURRENT_TIMESTAMP END)",
+		[]byte("123456789"),
+		"12345",
+		userID,
+		submitted,
+	)
+	return err
+}
+
+func TestGetVerification_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	if err := insertProvider(dbPool, ctx, user.ID, false); err != nil {
+		t.Fatalf("failed to insert provider: %v", err)
+	}
+
+	verification, err := repo.GetVerification(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, verification.UserID)
+	assert.Equal(t, user.Email, verification.Email)
+	assert.Equal(t, "12345", verification.PersonalIDPreview)
+	assert.Equal(t, "PENDING", verification.VerificationStatus)
+	assert.False(t, verification.SubmittedAt.Valid)
+}
+
+func TestGetVerification_NotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderRepository(dbPool)
+
+	_, err := repo.GetVerification(ctx, 1)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestListPendingVerifications(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderRepository(dbPool)
+
+	submittedUser, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	notSubmittedArgs := userCreateArgs
+	notSubmittedArgs.Email = "provider_2@email.com"
+	notSubmittedUser, err := insertUser(dbPool, ctx, notSubmittedArgs, 2)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	if err := insertProvider(dbPool, ctx, submittedUser.ID, true); err != nil {
+		t.Fatalf("failed to insert provider: %v", err)
+	}
+	if err := insertProvider(dbPool, ctx, notSubmittedUser.ID, false); err != nil {
+		t.Fatalf("failed to insert provider: %v", err)
+	}
+
+	verifications, err := repo.ListPendingVerifications(ctx, 10, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, verifications, 1) {
+		assert.Equal(t, submittedUser.ID, verifications[0].UserID)
+		assert.True(t, verifications[0].SubmittedAt.Valid)
+	}
+}
+
+func TestSubmitVerification(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	if err := insertProvider(dbPool, ctx, user.ID, false); err != nil {
+		t.Fatalf("failed to insert provider: %v", err)
+	}
+
+	ok, err := repo.UpdateVerification(ctx, repository.UpdateProviderVerificationParams{
+		UserID:             user.ID,
+		VerificationStatus: "REJECTED",
+		RejectionReason:    pgtype.Text{String: "blurry", Valid: true},
+		ReviewedBy:         1,
+	})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.SubmitVerification(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	verification, err := repo.GetVerification(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", verification.VerificationStatus)
+	assert.False(t, verification.RejectionReason.Valid)
+	assert.True(t, verification.SubmittedAt.Valid)
+	assert.True(t, verification.ReviewedAt.Valid)
+}
+
+func TestSubmitVerification_Approved(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewProviderRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	if err := insertProvider(dbPool, ctx, user.ID, true); err != nil {
+		t.Fatalf("failed to insert provider: %v", err)
+	}
+
+	_, err = repo.UpdateVerification(ctx, repository.UpdateProviderVerificationParams{UserID: user.ID, VerificationStatus: "APPROVED", ReviewedBy: 1})
+	assert.NoError(t, err)
+
+	ok, err := repo.SubmitVerification(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
\ No newline at end of file
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Session keys of a user share a hash tag, so scripts touching them run on a single cluster slot.
+const (
+	sessionKeyPrefix      = "session:"
+	userSessionsKeyPrefix = "user_sessions:"
+)
+
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+type SessionModel struct {
+	ID         string
+	UserID     int64
+	TokenID    string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+type SessionRepository interface {
+	Create(ctx context.Context, session SessionModel, ttl time.Duration) error
+	Rotate(ctx context.Context, userID int64, sessionID string, tokenID string, newTokenID string, ttl time.Duration) error
+	List(ctx context.Context, userID int64) ([]SessionModel, error)
+	Delete(ctx context.Context, userID int64, sessionID string) (bool, error)
+	DeleteAll(ctx context.Context, userID int64) error
+}
+
+type sessionRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewSessionRepository(redis redis.UniversalClient) *sessionRepositoryImpl {
+	return &sessionRepositoryImpl{
+		redis: redis,
+	}
+}
+
+// KEYS[1] - session key, KEYS[2] - user sessions key
+// ARGV[1] - session id, ARGV[2] - ttl in milliseconds
+var createSessionScript = redis.NewScript(`
+redis.call('HSET', KEYS[1], unpack(ARGV, 3))
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+redis.call('SADD', KEYS[2], ARGV[1])
+if redis.call('PTTL', KEYS[2]) < tonumber(ARGV[2]) then
+	redis.call('PEXPIRE', KEYS[2], ARGV[2])
+end
+return 1
+`)
+
+// KEYS[1] - session key, KEYS[2] - user sessions key
+// ARGV[1] - session id, ARGV[2] - current token id, ARGV[3] - new token id, ARGV[4] - last used at, ARGV[5] - ttl in milliseconds
+// Returns 0 if the session does not exist, -1 if the token was already rotated and the session got deleted.
+var rotateSessionScript = redis.NewScript(`
+local current = redis.call('HGET', KEYS[1], 'token_id')
+if not current then
+	redis.call('SREM', KEYS[2], ARGV[1])
+	return 0
+end
+if current ~= ARGV[2] then
+	redis.call('DEL', KEYS[1])
+	redis.call('SREM', KEYS[2], ARGV[1])
+	return -1
+end
+redis.call('HSET', KEYS[1], 'token_id', ARGV[3], 'last_used_at', ARGV[4])
+redis.call('PEXPIRE', KEYS[1], ARGV[5])
+if redis.call('PTTL', KEYS[2]) < tonumber(ARGV[5]) then
+	redis.call('PEXPIRE', KEYS[2], ARGV[5])
+end
+return 1
+`)
+
+// KEYS[1] - session key, KEYS[2] - user sessions key
+// ARGV[1] - session id
+var deleteSessionScript = redis.NewScript(`
+redis.call('SREM', KEYS[2], ARGV[1])
+return redis.call('DEL', KEYS[1])
+`)
+
+// Create stores the session and adds it to the user's sessions, both expire after the ttl.
+func (r *sessionRepositoryImpl) Create(ctx context.Context, session SessionModel, ttl time.Duration) error {
+	return createSessionScript.Run(
+		ctx,
+		r.redis,
+		[]string{sessionKey(session.UserID, session.ID), userSessionsKey(session.UserID)},
+		session.ID,
+		ttl.Milliseconds(),
+		"token_id", session.TokenID,
+		"user_agent", session.UserAgent,
+		"ip", session.IP,
+		"created_at", session.CreatedAt.Unix(),
+		"last_used_at", session.LastUsedAt.Unix(),
+	).Err()
+}
+
+// Rotate replaces the current token id of the session with a new one and extends the session by the ttl.
+// If the session does not exist, it returns redis.Nil.
+// If the token id is not the current one, the token was already rotated, so the session is deleted and ErrRefreshTokenReused is returned.
+func (r *sessionRepositoryImpl) Rotate(ctx context.Context, userID int64, sessionID string, tokenID string, newTokenID string, ttl time.Duration) error {
+	result, err := rotateSessionScript.Run(
+		ctx,
+		r.redis,
+		[]string{sessionKey(userID, sessionID), userSessionsKey(userID)},
+		sessionID,
+		tokenID,
+		newTokenID,
+		time.Now().Unix(),
+		ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 0:
+		return redis.Nil
+	case -1:
+		return ErrRefreshTokenReused
+	}
+
+	return nil
+}
+
+// List returns the active sessions of the user.
+// Expired sessions that are still referenced by the user are removed from the user's sessions.
+func (r *sessionRepositoryImpl) List(ctx context.Context, userID int64) ([]SessionModel, error) {
+	ids, err := r.redis.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []SessionModel{}, nil
+	}
+
+	pipe := r.redis.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HGetAll(ctx, sessionKey(userID, id))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]SessionModel, 0, len(ids))
+	var expired []any
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expired = append(expired, ids[i])
+			continue
+		}
+
+		sessions = append(sessions, mapSessionModel(ids[i], userID, fields))
+	}
+
+	if len(expired) > 0 {
+		if err := r.redis.SRem(ctx, userSessionsKey(userID), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+// Delete deletes the session of the user, it returns false if the user has no such session.
+func (r *sessionRepositoryImpl) Delete(ctx context.Context, userID int64, sessionID string) (bool, error) {
+	deleted, err := deleteSessionScript.Run(
+		ctx,
+		r.redis,
+		[]string{sessionKey(userID, sessionID), userSessionsKey(userID)},
+		sessionID,
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return deleted > 0, nil
+}
+
+// DeleteAll deletes every session of the user.
+func (r *sessionRepositoryImpl) DeleteAll(ctx context.Context, userID int64) error {
+	ids, err := r.redis.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, len(ids)+1)
+	for i, id := range ids {
+		keys[i] = sessionKey(userID, id)
+	}
+	keys[len(ids)] = userSessionsKey(userID)
+
+	return r.redis.Del(ctx, keys...).Err()
+}
+
+func mapSessionModel(id string, userID int64, fields map[string]string) SessionModel {
+	createdAt, _ := strconv.ParseInt(fields["created_at"], 10, 64)
+	lastUsedAt, _ := strconv.ParseInt(fields["last_used_at"], 10, 64)
+
+	return SessionModel{
+		ID:         id,
+		UserID:     userID,
+		TokenID:    fields["token_id"],
+		UserAgent:  fields["user_agent"],
+		IP:         fields["ip"],
+		CreatedAt:  time.Unix(createdAt, 0),
+		LastUsedAt: time.Unix(lastUsedAt, 0),
+	}
+}
+
+func sessionKey(userID int64, sessionID string) string {
+	return sessionKeyPrefix + "{" + strconv.FormatInt(userID, 10) + "}:" + sessionID
+}
+
+func userSessionsKey(userID int64) string {
+	return userSessionsKeyPrefix + "{" + strconv.FormatInt(userID, 10) + "}"
+}
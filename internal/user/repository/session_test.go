@@ -0,0 +1,132 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	sessionUserId int64 = 1
+	sessionTTL          = 168 * time.Hour
+)
+
+func newSessionModel(id string, tokenID string) repository.SessionModel {
+	now := time.Unix(1700000000, 0)
+	return repository.SessionModel{
+		ID:         id,
+		UserID:     sessionUserId,
+		TokenID:    tokenID,
+		UserAgent:  "Mozilla/5.0",
+		IP:         "127.0.0.1",
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+}
+
+func TestCreateSession_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	session := newSessionModel("s1", "t1")
+	if assert.NoError(t, repo.Create(ctx, session, sessionTTL)) {
+		sessions, err := repo.List(ctx, sessionUserId)
+		assert.NoError(t, err)
+		assert.Equal(t, []repository.SessionModel{session}, sessions)
+
+		otherSessions, err := repo.List(ctx, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, otherSessions)
+	}
+}
+
+func TestRotateSession_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s1", "t1"), sessionTTL))
+	assert.NoError(t, repo.Rotate(ctx, sessionUserId, "s1", "t1", "t2", sessionTTL))
+	assert.NoError(t, repo.Rotate(ctx, sessionUserId, "s1", "t2", "t3", sessionTTL))
+
+	sessions, err := repo.List(ctx, sessionUserId)
+	if assert.NoError(t, err) && assert.Len(t, sessions, 1) {
+		assert.Equal(t, "t3", sessions[0].TokenID)
+	}
+}
+
+func TestRotateSession_NotFound(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	assert.ErrorIs(t, repo.Rotate(ctx, sessionUserId, "s1", "t1", "t2", sessionTTL), redis.Nil)
+}
+
+func TestRotateSession_ReusedTokenRevokesSession(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s1", "t1"), sessionTTL))
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s2", "t1"), sessionTTL))
+	assert.NoError(t, repo.Rotate(ctx, sessionUserId, "s1", "t1", "t2", sessionTTL))
+
+	assert.ErrorIs(t, repo.Rotate(ctx, sessionUserId, "s1", "t1", "t3", sessionTTL), repository.ErrRefreshTokenReused)
+	assert.ErrorIs(t, repo.Rotate(ctx, sessionUserId, "s1", "t2", "t3", sessionTTL), redis.Nil)
+
+	sessions, err := repo.List(ctx, sessionUserId)
+	if assert.NoError(t, err) && assert.Len(t, sessions, 1) {
+		assert.Equal(t, "s2", sessions[0].ID)
+	}
+}
+
+func TestDeleteSession_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s1", "t1"), sessionTTL))
+
+	ok, err := repo.Delete(ctx, 2, "s1")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.Delete(ctx, sessionUserId, "s1")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.ErrorIs(t, repo.Rotate(ctx, sessionUserId, "s1", "t1", "t2", sessionTTL), redis.Nil)
+}
+
+func TestDeleteAllSessions_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewSessionRepository(redisClient)
+
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s1", "t1"), sessionTTL))
+	assert.NoError(t, repo.Create(ctx, newSessionModel("s2", "t1"), sessionTTL))
+
+	if assert.NoError(t, repo.DeleteAll(ctx, sessionUserId)) {
+		sessions, err := repo.List(ctx, sessionUserId)
+		assert.NoError(t, err)
+		assert.Empty(t, sessions)
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+)
+
+type TwoFactorRepository interface {
+	postgres.Repository[TwoFactorRepository]
+	Upsert(ctx context.Context, userID int64, secret []byte) (bool, error)
+	Get(ctx context.Context, userID int64) (TwoFactor, error)
+	Enable(ctx context.Context, userID int64, step int64) (bool, error)
+	UseStep(ctx context.Context, userID int64, step int64) (bool, error)
+	Delete(ctx context.Context, userID int64) (bool, error)
+	CreateRecoveryCodes(ctx context.Context, userID int64, hashes []string) error
+	ListUnusedRecoveryCodes(ctx context.Context, userID int64) ([]RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, id int64) (bool, error)
+	DeleteRecoveryCodes(ctx context.Context, userID int64) error
+}
+
+type TwoFactor struct {
+	UserID       int64
+	Secret       []byte
+	Enabled      bool
+	LastUsedStep int64
+}
+
+type RecoveryCode struct {
+	ID   int64
+	Hash string
+}
+
+type pgsqlTwoFactorRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewTwoFactorRepository(dbtx postgres.PGXQuerier) *pgsqlTwoFactorRepository {
+	return &pgsqlTwoFactorRepository{
+		dbtx,
+	}
+}
+
+func (r *pgsqlTwoFactorRepository) WithTx(tx postgres.PGXQuerier) TwoFactorRepository {
+	return NewTwoFactorRepository(tx)
+}
+
+const upsertTwoFactor = `-- name: UpsertTwoFactor :exec
+INSERT INTO user_two_factor (user_id, secret) VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, last_used_step = 0, created_at = CURRENT_TIMESTAMP
+WHERE user_two_factor.enabled = FALSE
+`
+
+// Upsert stores a pending enrolment with the secret, replacing the previous pending one.
+// It returns false if the two-factor authentication is already enabled.
+func (r *pgsqlTwoFactorRepository) Upsert(ctx context.Context, userID int64, secret []byte) (bool, error) {
+	result, err := r.db.Exec(ctx, upsertTwoFactor, userID, secret)
+	return result.RowsAffected() > 0, err
+}
+
+const getTwoFactor = `-- name: GetTwoFactor :one
+SELECT user_id, secret, enabled, last_used_step FROM user_two_factor WHERE user_id = $1
+`
+
+func (r *pgsqlTwoFactorRepository) Get(ctx context.Context, userID int64) (TwoFactor, error) {
+	row := r.db.QueryRow(ctx, getTwoFactor, userID)
+	var i TwoFactor
+	err := row.Scan(&i.UserID, &i.Secret, &i.Enabled, &i.LastUsedStep)
+	return i, err
+}
+
+const enableTwoFactor = `-- name: EnableTwoFactor :exec
+UPDATE user_two_factor SET enabled = TRUE, last_used_step = $2
+WHERE user_id = $1 AND enabled = FALSE AND last_used_step < $2
+`
+
+// Enable enables the pending enrolment, step is the time step of the code that confirmed it.
+// It returns false if there is no pending enrolment or the step was already used.
+func (r *pgsqlTwoFactorRepository) Enable(ctx context.Context, userID int64, step int64) (bool, error) {
+	result, err := r.db.Exec(ctx, enableTwoFactor, userID, step)
+	return result.RowsAffected() > 0, err
+}
+
+const useTwoFactorStep = `-- name: UseTwoFactorStep :exec
+UPDATE user_two_factor SET last_used_step = $2
+WHERE user_id = $1 AND enabled = TRUE AND last_used_step < $2
+`
+
+// UseStep marks the time step as used, so a code can't be replayed.
+// It returns false if the two-factor authentication is not enabled or the step, or a later one, was already used.
+func (r *pgsqlTwoFactorRepository) UseStep(ctx context.Context, userID int64, step int64) (bool, error) {
+	result, err := r.db.Exec(ctx, useTwoFactorStep, userID, step)
+	return result.RowsAffected() > 0, err
+}
+
+const deleteTwoFactor = `-- name: DeleteTwoFactor :exec
+DELETE FROM user_two_factor WHERE user_id = $1
+`
+
+func (r *pgsqlTwoFactorRepository) Delete(ctx context.Context, userID int64) (bool, error) {
+	result, err := r.db.Exec(ctx, deleteTwoFactor, userID)
+	return result.RowsAffected() > 0, err
+}
+
+const createRecoveryCodes = `-- name: CreateRecoveryCodes :exec
+INSERT INTO user_recovery_codes (user_id, hash) SELECT $1, unnest($2::VARCHAR[])
+`
+
+func (r *pgsqlTwoFactorRepository) CreateRecoveryCodes(ctx context.Context, userID int64, hashes []string) error {
+	_, err := r.db.Exec(ctx, createRecoveryCodes, userID, hashes)
+	return err
+}
+
+const listUnusedRecoveryCodes = `-- name: ListUnusedRecoveryCodes :many
+SELECT id, hash FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL ORDER BY id
+`
+
+func (r *pgsqlTwoFactorRepository) ListUnusedRecoveryCodes(ctx context.Context, userID int64) ([]RecoveryCode, error) {
+	rows, err := r.db.Query(ctx, listUnusedRecoveryCodes, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []RecoveryCode{}
+	for rows.Next() {
+		var i RecoveryCode
+		if err := rows.Scan(&i.ID, &i.Hash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :exec
+UPDATE user_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE id = $1 AND used_at IS NULL
+`
+
+// UseRecoveryCode marks the recovery code as used, it returns false if it was already used.
+func (r *pgsqlTwoFactorRepository) UseRecoveryCode(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.Exec(ctx, useRecoveryCode, id)
+	return result.RowsAffected() > 0, err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes WHERE user_id = $1
+`
+
+func (r *pgsqlTwoFactorRepository) DeleteRecoveryCodes(ctx context.Context, userID int64) error {
+	_, err := r.db.Exec(ctx, deleteRecoveryCodes, userID)
+	return err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	twoFactorAttemptsKeyPrefix  = "two_factor_attempts:"
+	twoFactorChallengeKeyPrefix = "two_factor_challenge:"
+)
+
+// TwoFactorAttemptRepository keeps track of the two-factor checks of a user and of the challenges already used for a login.
+type TwoFactorAttemptRepository interface {
+	AddAttempt(ctx context.Context, userID int64, window time.Duration) (int64, error)
+	ResetAttempts(ctx context.Context, userID int64) error
+	IsChallengeUsed(ctx context.Context, challengeID string) (bool, error)
+	UseChallenge(ctx context.Context, challengeID string, ttl time.Duration) (bool, error)
+}
+
+type twoFactorAttemptRepositoryImpl struct {
+	redis redis.UniversalClient
+}
+
+func NewTwoFactorAttemptRepository(redis redis.UniversalClient) *twoFactorAttemptRepositoryImpl {
+	return &twoFactorAttemptRepositoryImpl{
+		redis: redis,
+	}
+}
+
+// AddAttempt counts a two-factor check of the user and returns the number of checks.
+// The checks are forgotten once the window passes without another check.
+func (r *twoFactorAttemptRepositoryImpl) AddAttempt(ctx context.Context, userID int64, window time.Duration) (int64, error) {
+	key := twoFactorAttemptsKeyPrefix + strconv.FormatInt(userID, 10)
+
+	var incr *redis.IntCmd
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.PExpire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// ResetAttempts forgets the two-factor checks of the user.
+func (r *twoFactorAttemptRepositoryImpl) ResetAttempts(ctx context.Context, userID int64) error {
+	return r.redis.Del(ctx, twoFactorAttemptsKeyPrefix+strconv.FormatInt(userID, 10)).Err()
+}
+
+// IsChallengeUsed reports whether the challenge was already used, without using it.
+func (r *twoFactorAttemptRepositoryImpl) IsChallengeUsed(ctx context.Context, challengeID string) (bool, error) {
+	n, err := r.redis.Exists(ctx, twoFactorChallengeKeyPrefix+challengeID).Result()
+	return n > 0, err
+}
+
+// UseChallenge marks the challenge as used until the ttl passes, which should outlive the challenge itself.
+// It returns false if the challenge was already used.
+func (r *twoFactorAttemptRepositoryImpl) UseChallenge(ctx context.Context, challengeID string, ttl time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, twoFactorChallengeKeyPrefix+challengeID, "", ttl).Result()
+}
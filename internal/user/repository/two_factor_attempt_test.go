@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTwoFactorAttempt_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewTwoFactorAttemptRepository(redisClient)
+
+	for i := int64(1); i <= 3; i++ {
+		attempts, err := repo.AddAttempt(ctx, 1, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, i, attempts)
+	}
+
+	attempts, err := repo.AddAttempt(ctx, 2, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), attempts)
+
+	if assert.NoError(t, repo.ResetAttempts(ctx, 1)) {
+		attempts, err := repo.AddAttempt(ctx, 1, time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), attempts)
+	}
+}
+
+func TestUseTwoFactorChallenge_Success(t *testing.T) {
+	ctx := context.Background()
+	redisContainer, redisClient := getRedisClient(t)
+	defer setupRedisCleanup(t, redisClient, redisContainer)
+
+	repo := repository.NewTwoFactorAttemptRepository(redisClient)
+
+	used, err := repo.IsChallengeUsed(ctx, "challenge")
+	assert.NoError(t, err)
+	assert.False(t, used)
+
+	ok, err := repo.UseChallenge(ctx, "challenge", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	used, err = repo.IsChallengeUsed(ctx, "challenge")
+	assert.NoError(t, err)
+	assert.True(t, used)
+
+	ok, err = repo.UseChallenge(ctx, "challenge", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.UseChallenge(ctx, "other", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
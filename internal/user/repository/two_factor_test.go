@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+var twoFactorSecret = []byte("encrypted-secret")
+
+func TestUpsertTwoFactor_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.Upsert(ctx, user.ID, []byte("old-secret"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.Upsert(ctx, user.ID, twoFactorSecret)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	twoFactor, err := repo.Get(ctx, user.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, repository.TwoFactor{UserID: user.ID, Secret: twoFactorSecret}, twoFactor)
+	}
+}
+
+func TestUpsertTwoFactor_Enabled(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.Upsert(ctx, user.ID, twoFactorSecret)
+	assert.NoError(t, err)
+	ok, err := repo.Enable(ctx, user.ID, 10)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.Upsert(ctx, user.ID, []byte("new-secret"))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	twoFactor, err := repo.Get(ctx, user.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, twoFactorSecret, twoFactor.Secret)
+		assert.True(t, twoFactor.Enabled)
+		assert.Equal(t, int64(10), twoFactor.LastUsedStep)
+	}
+}
+
+func TestGetTwoFactor_NotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	_, err := repo.Get(ctx, 1)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestUseTwoFactorStep_Replay(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.Upsert(ctx, user.ID, twoFactorSecret)
+	assert.NoError(t, err)
+	_, err = repo.Enable(ctx, user.ID, 10)
+	assert.NoError(t, err)
+
+	ok, err := repo.UseStep(ctx, user.ID, 10)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.UseStep(ctx, user.ID, 11)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.UseStep(ctx, user.ID, 11)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestDeleteTwoFactor_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.Upsert(ctx, user.ID, twoFactorSecret)
+	assert.NoError(t, err)
+
+	ok, err := repo.Delete(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = repo.Get(ctx, user.ID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestRecoveryCodes_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewTwoFactorRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	hashes := []string{strings.Repeat("a", 128), strings.Repeat("b", 128)}
+
+	assert.NoError(t, repo.CreateRecoveryCodes(ctx, user.ID, hashes))
+
+	codes, err := repo.ListUnusedRecoveryCodes(ctx, user.ID)
+	if !assert.NoError(t, err) || !assert.Len(t, codes, 2) {
+		return
+	}
+
+	ok, err := repo.UseRecoveryCode(ctx, codes[0].ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = repo.UseRecoveryCode(ctx, codes[0].ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	codes, err = repo.ListUnusedRecoveryCodes(ctx, user.ID)
+	if assert.NoError(t, err) && assert.Len(t, codes, 1) {
+		assert.Equal(t, hashes[1], codes[0].Hash)
+	}
+
+	assert.NoError(t, repo.DeleteRecoveryCodes(ctx, user.ID))
+
+	codes, err = repo.ListUnusedRecoveryCodes(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, codes)
+}
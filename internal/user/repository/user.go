@@ -5,8 +5,10 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
+	"github.com/hexley21/fixup/internal/user/domain"
 	"github.com/hexley21/fixup/pkg/infra/postgres"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -21,14 +23,26 @@ type UserRepository interface {
 	Delete(ctx context.Context, id int64) (bool, error)
 	GetHashById(ctx context.Context, id int64) (string, error)
 	GetAccountInfo(ctx context.Context, id int64) (GetUserAccountInfoRow, error)
+	GetAccountInfoForUpdate(ctx context.Context, id int64) (GetUserAccountInfoRow, error)
 	GetVerificationInfo(ctx context.Context, email string) (GetUserVerificationInfoRow, error)
 	GetPicture(ctx context.Context, id int64) (pgtype.Text, error)
 	Get(ctx context.Context, id int64) (User, error)
 	GetAuthInfoByEmail(ctx context.Context, email string) (GetUserAuthInfoByEmailRow, error)
+	Search(ctx context.Context, filter domain.UserFilter, limit int64, offset int64) ([]User, error)
+	SearchKeyset(ctx context.Context, filter domain.UserFilter, keyset domain.Keyset, limit int64) ([]User, error)
 	Update(ctx context.Context, id int64, arg UpdateUserRow) (UpdateUserRow, error)
 	UpdateVerification(ctx context.Context, id int64, verified bool) (bool, error)
+	UpdatePhoneVerification(ctx context.Context, id int64, phoneNumber string) (bool, error)
+	UpdateEmail(ctx context.Context, id int64, email string) (bool, error)
 	UpdateHash(ctx context.Context, id int64, hash string) (bool, error)
 	UpdatePicture(ctx context.Context, id int64, picture string) (bool, error)
+	UpdateRole(ctx context.Context, id int64, role string) (bool, error)
+	UpdateSuspension(ctx context.Context, id int64, suspended bool) (bool, error)
+	Deactivate(ctx context.Context, id int64) (bool, error)
+	Restore(ctx context.Context, id int64) (bool, error)
+	ListDeactivated(ctx context.Context, deactivatedBefore time.Time, limit int32) ([]int64, error)
+	ClaimDeactivated(ctx context.Context, id int64, deactivatedBefore time.Time) (pgtype.Text, error)
+	PurgeDeactivated(ctx context.Context, id int64) (bool, error)
 }
 
 type pgsqlUserRepository struct {
@@ -53,7 +67,7 @@ INSERT INTO users (
 ) VALUES (
   $1, $2, $3, $4, $5, $6, $7
 )
-RETURNING id, first_name, last_name, phone_number, email, picture, role, verified, created_at
+RETURNING id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
 `
 
 type CreateUserParams struct {
@@ -86,6 +100,8 @@ func (r *pgsqlUserRepository) Create(ctx context.Context, arg CreateUserParams)
 		&i.Picture,
 		&i.Role,
 		&i.Verified,
+		&i.PhoneVerified,
+		&i.Suspended,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -113,18 +129,32 @@ func (r *pgsqlUserRepository) GetHashById(ctx context.Context, id int64) (string
 }
 
 const getUserAccountInfo = `-- name: GetUserAccountInfo :one
-SELECT role, verifid FROM users WHERE id = $1
+SELECT role, verified, phone_verified, suspended FROM users WHERE id = $1
 `
 
 type GetUserAccountInfoRow struct {
-	Role     string
-	Verified pgtype.Bool
+	Role          string
+	Verified      pgtype.Bool
+	PhoneVerified pgtype.Bool
+	Suspended     bool
 }
 
 func (r *pgsqlUserRepository) GetAccountInfo(ctx context.Context, id int64) (GetUserAccountInfoRow, error) {
 	row := r.db.QueryRow(ctx, getUserAccountInfo, id)
 	var i GetUserAccountInfoRow
-	err := row.Scan(&i.Role, &i.Verified)
+	err := row.Scan(&i.Role, &i.Verified, &i.PhoneVerified, &i.Suspended)
+	return i, err
+}
+
+const getUserAccountInfoForUpdate = `-- name: GetUserAccountInfoForUpdate :one
+SELECT role, verified, phone_verified, suspended FROM users WHERE id = $1 FOR UPDATE
+`
+
+// GetAccountInfoForUpdate retrieves the user's account information and locks the user's row until the end of the transaction.
+func (r *pgsqlUserRepository) GetAccountInfoForUpdate(ctx context.Context, id int64) (GetUserAccountInfoRow, error) {
+	row := r.db.QueryRow(ctx, getUserAccountInfoForUpdate, id)
+	var i GetUserAccountInfoRow
+	err := row.Scan(&i.Role, &i.Verified, &i.PhoneVerified, &i.Suspended)
 	return i, err
 }
 
@@ -157,7 +187,7 @@ func (r *pgsqlUserRepository) GetPicture(ctx context.Context, id int64) (pgtype.
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, first_name, last_name, phone_number, email, picture, role, verified, created_at FROM users WHERE id = $1
+SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at FROM users WHERE id = $1
 `
 
 func (r *pgsqlUserRepository) Get(ctx context.Context, id int64) (User, error) {
@@ -172,20 +202,28 @@ func (r *pgsqlUserRepository) Get(ctx context.Context, id int64) (User, error) {
 		&i.Picture,
 		&i.Role,
 		&i.Verified,
+		&i.PhoneVerified,
+		&i.Suspended,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
 const getUserAuthInfoByEmail = `-- name: GetUserAuthInfoByEmail :one
-SELECT id, role, verified, hash FROM users WHERE email = $1
+SELECT u.id, u.role, u.verified, u.phone_verified, u.suspended, u.deactivated_at IS NOT NULL AS deactivated, u.hash, COALESCE(t.enabled, FALSE) AS two_factor_enabled
+FROM users u LEFT JOIN user_two_factor t ON t.user_id = u.id
+WHERE u.email = $1
 `
 
 type GetUserAuthInfoByEmailRow struct {
-	ID       int64
-	Role     string
-	Verified pgtype.Bool
-	Hash     string
+	ID               int64
+	Role             string
+	Verified         pgtype.Bool
+	PhoneVerified    pgtype.Bool
+	Suspended        bool
+	Deactivated      bool
+	Hash             string
+	TwoFactorEnabled bool
 }
 
 func (r *pgsqlUserRepository) GetAuthInfoByEmail(ctx context.Context, email string) (GetUserAuthInfoByEmailRow, error) {
@@ -195,11 +233,115 @@ func (r *pgsqlUserRepository) GetAuthInfoByEmail(ctx context.Context, email stri
 		&i.ID,
 		&i.Role,
 		&i.Verified,
+		&i.PhoneVerified,
+		&i.Suspended,
+		&i.Deactivated,
 		&i.Hash,
+		&i.TwoFactorEnabled,
 	)
 	return i, err
 }
 
+const searchUsers = `-- name: SearchUsers :many
+SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+FROM users
+WHERE ($3::TEXT IS NULL OR role = $3::USER_ROLE)
+  AND ($4::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = $4)
+  AND ($5::TEXT IS NULL OR lower(email) LIKE lower($5) || '%')
+  AND ($6::TIMESTAMP IS NULL OR created_at >= $6)
+  AND ($7::TIMESTAMP IS NULL OR created_at < $7)
+ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2
+`
+
+const searchUsersAfter = `-- name: SearchUsersAfter :many
+SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+FROM users
+WHERE ($4::TEXT IS NULL OR role = $4::USER_ROLE)
+  AND ($5::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = $5)
+  AND ($6::TEXT IS NULL OR lower(email) LIKE lower($6) || '%')
+  AND ($7::TIMESTAMP IS NULL OR created_at >= $7)
+  AND ($8::TIMESTAMP IS NULL OR created_at < $8)
+  AND ($1::BIGINT = 0 OR (created_at, id) < ($2::TIMESTAMP, $1::BIGINT))
+ORDER BY created_at DESC, id DESC LIMIT $3
+`
+
+const searchUsersBefore = `-- name: SearchUsersBefore :many
+SELECT * FROM (
+    SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+    FROM users
+    WHERE ($4::TEXT IS NULL OR role = $4::USER_ROLE)
+      AND ($5::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = $5)
+      AND ($6::TEXT IS NULL OR lower(email) LIKE lower($6) || '%')
+      AND ($7::TIMESTAMP IS NULL OR created_at >= $7)
+      AND ($8::TIMESTAMP IS NULL OR created_at < $8)
+      AND (created_at, id) > ($2::TIMESTAMP, $1::BIGINT)
+    ORDER BY created_at, id LIMIT $3
+) AS page ORDER BY created_at DESC, id DESC
+`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search retrieves users matching the filter, newest first, empty filter fields are ignored.
+func (r *pgsqlUserRepository) Search(ctx context.Context, filter domain.UserFilter, limit int64, offset int64) ([]User, error) {
+	return r.search(ctx, searchUsers, filter, limit, offset)
+}
+
+// SearchKeyset retrieves a page of users matching the filter, newest first, starting after or before the keyset.
+// Users are ordered by their creation time and id, the keyset is compared by its values, so it stays valid after its user is deleted.
+func (r *pgsqlUserRepository) SearchKeyset(ctx context.Context, filter domain.UserFilter, keyset domain.Keyset, limit int64) ([]User, error) {
+	query := searchUsersAfter
+	if keyset.Backward {
+		query = searchUsersBefore
+	}
+
+	return r.search(ctx, query, filter, keyset.ID, pgtype.Timestamp{Time: keyset.CreatedAt, Valid: keyset.ID != 0}, limit)
+}
+
+// search runs a query of users taking the leading arguments, followed by the filter's arguments.
+func (r *pgsqlUserRepository) search(ctx context.Context, query string, filter domain.UserFilter, args ...any) ([]User, error) {
+	var verified pgtype.Bool
+	if filter.Verified != nil {
+		verified = pgtype.Bool{Bool: *filter.Verified, Valid: true}
+	}
+
+	rows, err := r.db.Query(ctx, query, append(args,
+		pgtype.Text{String: string(filter.Role), Valid: filter.Role != ""},
+		verified,
+		pgtype.Text{String: likeEscaper.Replace(filter.EmailPrefix), Valid: filter.EmailPrefix != ""},
+		pgtype.Timestamp{Time: filter.CreatedAfter, Valid: !filter.CreatedAfter.IsZero()},
+		pgtype.Timestamp{Time: filter.CreatedBefore, Valid: !filter.CreatedBefore.IsZero()},
+	)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.PhoneNumber,
+			&i.Email,
+			&i.Picture,
+			&i.Role,
+			&i.Verified,
+			&i.PhoneVerified,
+			&i.Suspended,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const baseUpdateUserData = `
 UPDATE users
 SET 
@@ -214,6 +356,7 @@ type UpdateUserRow struct {
 
 // Update updates a user's information by their ID, supporting partial updates.
 // It constructs an SQL query based on the provided fields in UpdateUserRow and executes it.
+// A changed phone number has to be verified again.
 // It returns the updated user information or an error if the update fails or if no fields are provided.
 func (r *pgsqlUserRepository) Update(ctx context.Context, id int64, arg UpdateUserRow) (UpdateUserRow, error) {
 	var i UpdateUserRow
@@ -231,7 +374,8 @@ func (r *pgsqlUserRepository) Update(ctx context.Context, id int64, arg UpdateUs
 		params = append(params, arg.LastName)
 	}
 	if arg.PhoneNumber != "" {
-		setClauses = append(setClauses, "phone_number = $"+strconv.Itoa(len(params)+1))
+		param := "$" + strconv.Itoa(len(params)+1)
+		setClauses = append(setClauses, "phone_number = "+param, "phone_verified = phone_verified AND phone_number = "+param)
 		params = append(params, arg.PhoneNumber)
 	}
 	if arg.Email != "" {
@@ -266,6 +410,27 @@ func (r *pgsqlUserRepository) UpdateVerification(ctx context.Context, id int64,
 	return result.RowsAffected() > 0, err
 }
 
+const updateUserPhoneVerification = `-- name: UpdateUserPhoneVerification :exec
+UPDATE users SET phone_verified = TRUE WHERE id = $1 AND phone_number = $2
+`
+
+// UpdatePhoneVerification marks the user's phone number as verified.
+// It returns false if the user does not exist or the phone number was changed in the meantime.
+func (r *pgsqlUserRepository) UpdatePhoneVerification(ctx context.Context, id int64, phoneNumber string) (bool, error) {
+	result, err := r.db.Exec(ctx, updateUserPhoneVerification, id, phoneNumber)
+	return result.RowsAffected() > 0, err
+}
+
+const updateUserEmail = `-- name: UpdateUserEmail :exec
+UPDATE users SET email = $2, verified = TRUE WHERE id = $1
+`
+
+// UpdateEmail replaces the user's email with a confirmed one, so the user is verified as well.
+func (r *pgsqlUserRepository) UpdateEmail(ctx context.Context, id int64, email string) (bool, error) {
+	result, err := r.db.Exec(ctx, updateUserEmail, id, email)
+	return result.RowsAffected() > 0, err
+}
+
 const updateUserHash = `-- name: UpdateUserHash :exec
 UPDATE users SET hash = $2 where id = $1
 `
@@ -283,3 +448,96 @@ func (r *pgsqlUserRepository) UpdatePicture(ctx context.Context, id int64, pictu
 	result, err := r.db.Exec(ctx, updateUserPicture, id, pgtype.Text{String: picture, Valid: true})
 	return result.RowsAffected() > 0, err
 }
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1
+`
+
+func (r *pgsqlUserRepository) UpdateRole(ctx context.Context, id int64, role string) (bool, error) {
+	result, err := r.db.Exec(ctx, updateUserRole, id, role)
+	return result.RowsAffected() > 0, err
+}
+
+const updateUserSuspension = `-- name: UpdateUserSuspension :exec
+UPDATE users SET suspended = $2 WHERE id = $1
+`
+
+func (r *pgsqlUserRepository) UpdateSuspension(ctx context.Context, id int64, suspended bool) (bool, error) {
+	result, err := r.db.Exec(ctx, updateUserSuspension, id, suspended)
+	return result.RowsAffected() > 0, err
+}
+
+const deactivateUser = `-- name: DeactivateUser :exec
+UPDATE users SET deactivated_at = COALESCE(deactivated_at, CURRENT_TIMESTAMP) WHERE id = $1
+`
+
+// Deactivate marks the user for the deletion, deactivating an already deactivated user keeps the original time.
+func (r *pgsqlUserRepository) Deactivate(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.Exec(ctx, deactivateUser, id)
+	return result.RowsAffected() > 0, err
+}
+
+const restoreUser = `-- name: RestoreUser :exec
+UPDATE users SET deactivated_at = NULL WHERE id = $1 AND deactivated_at IS NOT NULL AND NOT purging
+`
+
+// Restore cancels the deletion of the user, it returns false if the user is not deactivated or is already being purged.
+func (r *pgsqlUserRepository) Restore(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.Exec(ctx, restoreUser, id)
+	return result.RowsAffected() > 0, err
+}
+
+const listDeactivatedUsers = `-- name: ListDeactivatedUsers :many
+SELECT id FROM users
+WHERE deactivated_at < $1
+ORDER BY deactivated_at
+LIMIT $2
+`
+
+// ListDeactivated retrieves the IDs of the users deactivated before the time, longest deactivated first.
+func (r *pgsqlUserRepository) ListDeactivated(ctx context.Context, deactivatedBefore time.Time, limit int32) ([]int64, error) {
+	rows, err := r.db.Query(ctx, listDeactivatedUsers, deactivatedBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []int64
+	for rows.Next() {
+		var i int64
+		if err := rows.Scan(&i); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimDeactivatedUser = `-- name: ClaimDeactivatedUser :one
+UPDATE users SET purging = TRUE
+WHERE id = $1 AND deactivated_at < $2
+RETURNING picture
+`
+
+// ClaimDeactivated marks the user deactivated since before the time as being purged, so it can't be restored anymore, and returns its picture.
+// Claiming an already claimed user succeeds, so an interrupted purge can be retried.
+// It returns pgx.ErrNoRows if the user was restored in the meantime.
+func (r *pgsqlUserRepository) ClaimDeactivated(ctx context.Context, id int64, deactivatedBefore time.Time) (pgtype.Text, error) {
+	var picture pgtype.Text
+	err := r.db.QueryRow(ctx, claimDeactivatedUser, id, deactivatedBefore).Scan(&picture)
+	return picture, err
+}
+
+const purgeDeactivatedUser = `-- name: PurgeDeactivatedUser :exec
+DELETE FROM users
+WHERE id = $1 AND purging
+`
+
+// PurgeDeactivated deletes the user claimed by ClaimDeactivated, it returns false if the user is not claimed.
+func (r *pgsqlUserRepository) PurgeDeactivated(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.Exec(ctx, purgeDeactivatedUser, id)
+	return result.RowsAffected() > 0, err
+}
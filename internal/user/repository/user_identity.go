@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type UserIdentityRepository interface {
+	postgres.Repository[UserIdentityRepository]
+	Create(ctx context.Context, provider string, subject string, userID int64) error
+	GetAuthInfo(ctx context.Context, provider string, subject string) (GetUserIdentityAuthInfoRow, error)
+}
+
+type pgsqlUserIdentityRepository struct {
+	db postgres.PGXQuerier
+}
+
+func NewUserIdentityRepository(dbtx postgres.PGXQuerier) *pgsqlUserIdentityRepository {
+	return &pgsqlUserIdentityRepository{
+		dbtx,
+	}
+}
+
+func (r *pgsqlUserIdentityRepository) WithTx(tx postgres.PGXQuerier) UserIdentityRepository {
+	return NewUserIdentityRepository(tx)
+}
+
+const createUserIdentity = `-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (provider, subject, user_id) VALUES ($1, $2, $3)
+`
+
+// Create links the subject of the provider to the user.
+func (r *pgsqlUserIdentityRepository) Create(ctx context.Context, provider string, subject string, userID int64) error {
+	_, err := r.db.Exec(ctx, createUserIdentity, provider, subject, userID)
+	return err
+}
+
+const getUserIdentityAuthInfo = `-- name: GetUserIdentityAuthInfo :one
+SELECT u.id, u.role, u.verified, u.phone_verified, u.suspended, u.deactivated_at IS NOT NULL AS deactivated, COALESCE(t.enabled, FALSE) AS two_factor_enabled
+FROM user_identities i
+JOIN users u ON u.id = i.user_id
+LEFT JOIN user_two_factor t ON t.user_id = u.id
+WHERE i.provider = $1 AND i.subject = $2
+`
+
+type GetUserIdentityAuthInfoRow struct {
+	ID               int64
+	Role             string
+	Verified         pgtype.Bool
+	PhoneVerified    pgtype.Bool
+	Suspended        bool
+	Deactivated      bool
+	TwoFactorEnabled bool
+}
+
+// GetAuthInfo retrieves the account information of the user linked to the subject of the provider.
+func (r *pgsqlUserIdentityRepository) GetAuthInfo(ctx context.Context, provider string, subject string) (GetUserIdentityAuthInfoRow, error) {
+	row := r.db.QueryRow(ctx, getUserIdentityAuthInfo, provider, subject)
+	var i GetUserIdentityAuthInfoRow
+	err := row.Scan(
+		&i.ID,
+		&i.Role,
+		&i.Verified,
+		&i.PhoneVerified,
+		&i.Suspended,
+		&i.Deactivated,
+		&i.TwoFactorEnabled,
+	)
+	return i, err
+}
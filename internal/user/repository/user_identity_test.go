@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	identityProvider = "google"
+	identitySubject  = "1234567890"
+)
+
+func TestCreateUserIdentity_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserIdentityRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, identityProvider, identitySubject, user.ID))
+
+	authInfo, err := repo.GetAuthInfo(ctx, identityProvider, identitySubject)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, authInfo.ID)
+	assert.Equal(t, user.Role, authInfo.Role)
+	assert.False(t, authInfo.TwoFactorEnabled)
+}
+
+func TestCreateUserIdentity_Taken(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserIdentityRepository(dbPool)
+
+	user, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	assert.NoError(t, repo.Create(ctx, identityProvider, identitySubject, user.ID))
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, repo.Create(ctx, identityProvider, identitySubject, user.ID), &pgErr) {
+		assert.Equal(t, pgerrcode.UniqueViolation, pgErr.Code)
+	}
+}
+
+func TestGetUserIdentityAuthInfo_NotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserIdentityRepository(dbPool)
+
+	_, err := repo.GetAuthInfo(ctx, identityProvider, identitySubject)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
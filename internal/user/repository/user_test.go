@@ -3,9 +3,12 @@ package repository_test
 import (
 	"context"
 	"log"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
 	"github.com/hexley21/fixup/internal/user/repository"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
@@ -326,6 +329,126 @@ func TestUpdateVerification_Success(t *testing.T) {
 	assert.True(t, updatedStatus.Bool)
 }
 
+func TestUpdateEmail_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.UpdateEmail(ctx, insert.ID, "new@email.com")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	row := dbPool.QueryRow(ctx, "SELECT email, verified from users where id = $1", insert.ID)
+	var email string
+	var verified pgtype.Bool
+	err = row.Scan(&email, &verified)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "new@email.com", email)
+	assert.True(t, verified.Bool)
+}
+
+func TestUpdateEmail_Taken(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	otherArgs := userCreateArgs
+	otherArgs.Email = "other@email.com"
+	if _, err := insertUser(dbPool, ctx, otherArgs, 2); err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.UpdateEmail(ctx, insert.ID, otherArgs.Email)
+
+	var pgErr *pgconn.PgError
+	if assert.ErrorAs(t, err, &pgErr) {
+		assert.Equal(t, pgerrcode.UniqueViolation, pgErr.Code)
+	}
+}
+
+func TestUpdatePhoneVerification_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	assert.False(t, insert.PhoneVerified.Bool)
+
+	ok, err := repo.UpdatePhoneVerification(ctx, insert.ID, insert.PhoneNumber)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	accountInfo, err := repo.GetAccountInfo(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, accountInfo.PhoneVerified.Bool)
+}
+
+func TestUpdatePhoneVerification_PhoneChanged(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.UpdatePhoneVerification(ctx, insert.ID, "995111111111")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestUpdate_ResetsPhoneVerification(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.UpdatePhoneVerification(ctx, insert.ID, insert.PhoneNumber)
+	assert.NoError(t, err)
+
+	_, err = repo.Update(ctx, insert.ID, repository.UpdateUserRow{PhoneNumber: insert.PhoneNumber})
+	assert.NoError(t, err)
+
+	accountInfo, err := repo.GetAccountInfo(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, accountInfo.PhoneVerified.Bool, "unchanged phone number stays verified")
+
+	_, err = repo.Update(ctx, insert.ID, repository.UpdateUserRow{PhoneNumber: "995111111111"})
+	assert.NoError(t, err)
+
+	accountInfo, err = repo.GetAccountInfo(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.False(t, accountInfo.PhoneVerified.Bool)
+}
+
 func TestUpdateVerification_NotFound(t *testing.T) {
 	ctx := context.Background()
 	dbPool := getPgPool(ctx)
@@ -338,6 +461,197 @@ func TestUpdateVerification_NotFound(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestSearch_Filter(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	customer, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	providerArgs := userCreateArgs
+	providerArgs.Email = "provider_1@email.com"
+	providerArgs.Role = string(enum.UserRolePROVIDER)
+	provider, err := insertUser(dbPool, ctx, providerArgs, 2)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	users, err := repo.Search(ctx, domain.UserFilter{}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+
+	users, err = repo.Search(ctx, domain.UserFilter{Role: enum.UserRolePROVIDER}, 10, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, provider.ID, users[0].ID)
+	}
+
+	users, err = repo.Search(ctx, domain.UserFilter{EmailPrefix: "TEST"}, 10, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, customer.ID, users[0].ID)
+	}
+
+	// the underscore is matched literally
+	users, err = repo.Search(ctx, domain.UserFilter{EmailPrefix: "provider_"}, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	verified := true
+	users, err = repo.Search(ctx, domain.UserFilter{Verified: &verified}, 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+
+	users, err = repo.Search(ctx, domain.UserFilter{CreatedAfter: customer.CreatedAt.Time.Add(time.Hour)}, 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+
+	users, err = repo.Search(ctx, domain.UserFilter{}, 1, 1)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+}
+
+func TestSearchKeyset_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	ids := make([]int64, 3)
+	keysets := make([]domain.Keyset, 3)
+	for i := range ids {
+		args := userCreateArgs
+		args.Email = strconv.Itoa(i) + args.Email
+		args.PhoneNumber = args.PhoneNumber[:len(args.PhoneNumber)-1] + strconv.Itoa(i)
+		insert, err := insertUser(dbPool, ctx, args, int64(i+1))
+		if err != nil {
+			t.Fatalf("failed to insert user: %v", err)
+		}
+		ids[i] = insert.ID
+		keysets[i] = domain.NewKeyset(insert.ID, insert.CreatedAt.Time, false)
+	}
+
+	// users are listed newest first
+	first, err := repo.SearchKeyset(ctx, domain.UserFilter{}, domain.NewKeyset(0, time.Time{}, false), 2)
+	assert.NoError(t, err)
+	if assert.Len(t, first, 2) {
+		assert.Equal(t, ids[2], first[0].ID)
+		assert.Equal(t, ids[1], first[1].ID)
+	}
+
+	next, err := repo.SearchKeyset(ctx, domain.UserFilter{}, keysets[1], 2)
+	assert.NoError(t, err)
+	if assert.Len(t, next, 1) {
+		assert.Equal(t, ids[0], next[0].ID)
+	}
+
+	backward := keysets[0]
+	backward.Backward = true
+	prev, err := repo.SearchKeyset(ctx, domain.UserFilter{}, backward, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, first, prev)
+
+	// the filter applies to the pages too
+	filtered, err := repo.SearchKeyset(ctx, domain.UserFilter{EmailPrefix: "0"}, keysets[2], 2)
+	assert.NoError(t, err)
+	if assert.Len(t, filtered, 1) {
+		assert.Equal(t, ids[0], filtered[0].ID)
+	}
+
+	// the keyset stays valid after its user is deleted
+	if _, err := dbPool.Exec(ctx, "DELETE FROM users WHERE id = $1", ids[1]); err != nil {
+		t.Fatalf("failed to delete user: %v", err)
+	}
+
+	next, err = repo.SearchKeyset(ctx, domain.UserFilter{}, keysets[1], 2)
+	assert.NoError(t, err)
+	if assert.Len(t, next, 1) {
+		assert.Equal(t, ids[0], next[0].ID)
+	}
+}
+
+func TestUpdateRole_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.UpdateRole(ctx, insert.ID, string(enum.UserRoleMODERATOR))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	accountInfo, err := repo.GetAccountInfo(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, string(enum.UserRoleMODERATOR), accountInfo.Role)
+}
+
+func TestUpdateRole_InvalidRole(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.UpdateRole(ctx, insert.ID, invalidValue)
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
+
+func TestUpdateSuspension_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+	assert.False(t, insert.Suspended)
+
+	ok, err := repo.UpdateSuspension(ctx, insert.ID, true)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	accountInfo, err := repo.GetAccountInfoForUpdate(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, accountInfo.Suspended)
+
+	authInfo, err := repo.GetAuthInfoByEmail(ctx, insert.Email)
+	assert.NoError(t, err)
+	assert.True(t, authInfo.Suspended)
+}
+
+func TestUpdateSuspension_NotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	ok, err := repo.UpdateSuspension(ctx, 1, true)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
 func TestUpdateHash_Success(t *testing.T) {
 	ctx := context.Background()
 	dbPool := getPgPool(ctx)
@@ -429,10 +743,117 @@ func TestDeleteById_NotFound(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestDeactivate_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.Deactivate(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	authInfo, err := repo.GetAuthInfoByEmail(ctx, insert.Email)
+	assert.NoError(t, err)
+	assert.True(t, authInfo.Deactivated)
+
+	ok, err = repo.Restore(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	authInfo, err = repo.GetAuthInfoByEmail(ctx, insert.Email)
+	assert.NoError(t, err)
+	assert.False(t, authInfo.Deactivated)
+}
+
+func TestDeactivate_NotFound(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	ok, err := repo.Deactivate(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPurgeDeactivated_Success(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	ok, err := repo.Deactivate(ctx, insert.ID)
+	if err != nil || !ok {
+		t.Fatalf("failed to deactivate user: %v", err)
+	}
+
+	ids, err := repo.ListDeactivated(ctx, time.Now().UTC().Add(-time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	deactivatedBefore := time.Now().UTC().Add(time.Hour)
+
+	ids, err = repo.ListDeactivated(ctx, deactivatedBefore, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{insert.ID}, ids)
+
+	_, err = repo.ClaimDeactivated(ctx, insert.ID, deactivatedBefore)
+	assert.NoError(t, err)
+
+	// a claimed user can't be restored anymore
+	ok, err = repo.Restore(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = repo.PurgeDeactivated(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = repo.Get(ctx, insert.ID)
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+func TestPurgeDeactivated_Restored(t *testing.T) {
+	ctx := context.Background()
+	dbPool := getPgPool(ctx)
+	defer cleanupPostgres(ctx, dbPool)
+
+	repo := repository.NewUserRepository(dbPool, nil)
+
+	insert, err := insertUser(dbPool, ctx, userCreateArgs, 1)
+	if err != nil {
+		t.Fatalf("failed to insert user: %v", err)
+	}
+
+	_, err = repo.ClaimDeactivated(ctx, insert.ID, time.Now().UTC().Add(time.Hour))
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+	ok, err := repo.PurgeDeactivated(ctx, insert.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = repo.Get(ctx, insert.ID)
+	assert.NoError(t, err)
+}
+
 func insertUser(dbPool *pgxpool.Pool, ctx context.Context, args repository.CreateUserParams, id int64) (repository.User, error) {
 	row := dbPool.QueryRow(
 		ctx,
-		"INSERT INTO users (id, first_name, last_name, phone_number, email, hash, role) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *",
+		"INSERT INTO users (id, first_name, last_name, phone_number, email, hash, role) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, first_name, last_name, phone_number, email, picture, hash, role, verified, created_at, phone_verified, suspended",
 		id,
 		args.FirstName,
 		args.LastName,
@@ -453,6 +874,8 @@ func insertUser(dbPool *pgxpool.Pool, ctx context.Context, args repository.Creat
 		&i.Role,
 		&i.Verified,
 		&i.CreatedAt,
+		&i.PhoneVerified,
+		&i.Suspended,
 	)
 	return i, err
 }
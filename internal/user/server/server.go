@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -17,10 +18,14 @@ import (
 	"github.com/hexley21/fixup/internal/common/auth_jwt"
 	"github.com/hexley21/fixup/internal/common/middleware"
 	"github.com/hexley21/fixup/internal/user/delivery/http/v1"
+	"github.com/hexley21/fixup/internal/user/jwt/challenge_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/email_change_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/refresh_jwt"
+	"github.com/hexley21/fixup/internal/user/jwt/reset_jwt"
 	"github.com/hexley21/fixup/internal/user/jwt/verify_jwt"
 	"github.com/hexley21/fixup/internal/user/repository"
 	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/internal/user/worker"
 	"github.com/hexley21/fixup/pkg/config"
 	"github.com/hexley21/fixup/pkg/encryption"
 	"github.com/hexley21/fixup/pkg/hasher"
@@ -33,18 +38,31 @@ import (
 	"github.com/hexley21/fixup/pkg/infra/s3"
 	"github.com/hexley21/fixup/pkg/logger"
 	"github.com/hexley21/fixup/pkg/mailer"
+	"github.com/hexley21/fixup/pkg/oidc"
+	"github.com/hexley21/fixup/pkg/sms"
 	"github.com/hexley21/fixup/pkg/validator"
 )
 
 type services struct {
-	authService service.AuthService
-	userService service.UserService
+	authService        service.AuthService
+	userService        service.UserService
+	sessionService     service.SessionService
+	twoFactorService   service.TwoFactorService
+	emailChangeService service.EmailChangeService
+	phoneService       service.PhoneVerificationService
+	oidcService        service.OIDCService
+	adminService       service.AdminService
+	providerService    service.ProviderVerificationService
+	privacyService     service.PrivacyService
 }
 
 type jWTManagers struct {
 	accessJWTManager       auth_jwt.Manager
 	refreshJWTManager      refresh_jwt.Manager
 	verificationJWTManager verify_jwt.Manager
+	resetJWTManager        reset_jwt.Manager
+	challengeJWTManager    challenge_jwt.Manager
+	emailChangeJWTManager  email_change_jwt.Manager
 }
 type server struct {
 	router            chi.Router
@@ -58,10 +76,13 @@ type server struct {
 	jWTManagers       *jWTManagers
 	services          *services
 	cdnUrlSigner      cdn.URLSigner
+	purger            *worker.Purger
+	purgerCtx         context.Context
+	stopPurger        context.CancelFunc
 }
 
 // NewServer initializes and returns a new server instance with the provided configuration and dependencies.
-// It sets up repositories, services, JWT managers, handler components, and HTTP servers for both main and metrics endpoints.
+// It sets up repositories, services, the account purger, JWT managers, handler components, and HTTP servers for both main and metrics endpoints.
 func NewServer(
 	cfg *config.Config,
 	dbPool *pgxpool.Pool,
@@ -73,17 +94,36 @@ func NewServer(
 	cdnFileInvalidator cdn.FileInvalidator,
 	hasher hasher.Hasher,
 	encryptor encryption.Encryptor,
+	decryptor encryption.Decryptor,
 	mailer mailer.Mailer,
+	smsSender sms.Sender,
+	oidcProvider oidc.Provider,
 ) *server {
 	userRepository := repository.NewUserRepository(dbPool, snowflakeNode)
 	providerRepository := repository.NewProviderRepository(dbPool)
 	verificationRepository := repository.NewVerificationRepository(redisCluster)
+	passwordResetRepository := repository.NewPasswordResetRepository(redisCluster)
+	sessionRepository := repository.NewSessionRepository(redisCluster)
+	twoFactorRepository := repository.NewTwoFactorRepository(dbPool)
+	loginAttemptRepository := repository.NewLoginAttemptRepository(redisCluster)
+	twoFactorAttemptRepository := repository.NewTwoFactorAttemptRepository(redisCluster)
+	emailChangeRepository := repository.NewEmailChangeRepository(dbPool)
+	phoneVerificationRepository := repository.NewPhoneVerificationRepository(redisCluster)
+	userIdentityRepository := repository.NewUserIdentityRepository(dbPool)
+	oidcStateRepository := repository.NewOIDCStateRepository(redisCluster)
+	auditLogRepository := repository.NewAuditLogRepository(dbPool)
+	providerDocumentRepository := repository.NewProviderDocumentRepository(dbPool)
 
 	authService := service.NewAuthService(
 		userRepository,
 		providerRepository,
 		verificationRepository,
 		cfg.JWT.VerificationTTL,
+		passwordResetRepository,
+		cfg.JWT.PasswordResetTTL,
+		sessionRepository,
+		loginAttemptRepository,
+		cfg.LoginThrottle,
 		dbPool,
 		hasher,
 		encryptor,
@@ -96,20 +136,112 @@ func NewServer(
 
 	userService := service.NewUserService(
 		userRepository,
+		sessionRepository,
 		s3Bucket,
 		cdnFileInvalidator,
 		hasher,
 	)
 
+	sessionService := service.NewSessionService(sessionRepository, cfg.JWT.RefreshTTL)
+
+	twoFactorService := service.NewTwoFactorService(
+		userRepository,
+		twoFactorRepository,
+		twoFactorAttemptRepository,
+		dbPool,
+		hasher,
+		encryptor,
+		decryptor,
+		cfg.TwoFactor,
+		cfg.JWT.TwoFactorTTL,
+	)
+
+	emailChangeService := service.NewEmailChangeService(
+		userRepository,
+		emailChangeRepository,
+		cfg.JWT.EmailChangeTTL,
+		dbPool,
+		mailer,
+		cfg.Server.Email,
+	)
+	if err := emailChangeService.ParseTemplates(cfg.Templates); err != nil {
+		logger.Fatalf("error starting server %v", err)
+	}
+
+	phoneService := service.NewPhoneVerificationService(
+		userRepository,
+		phoneVerificationRepository,
+		hasher,
+		smsSender,
+		cfg.PhoneVerification,
+	)
+
+	oidcService := service.NewOIDCService(
+		userRepository,
+		userIdentityRepository,
+		oidcStateRepository,
+		cfg.OIDC.StateTTL,
+		oidcProvider,
+		cfg.OIDC.Provider,
+		dbPool,
+		hasher,
+	)
+
+	adminService := service.NewAdminService(
+		userRepository,
+		providerRepository,
+		auditLogRepository,
+		sessionRepository,
+		dbPool,
+	)
+
+	providerService := service.NewProviderVerificationService(
+		userRepository,
+		providerRepository,
+		providerDocumentRepository,
+		auditLogRepository,
+		dbPool,
+		s3Bucket,
+		decryptor,
+		mailer,
+		cfg.Server.Email,
+	)
+	if err := providerService.ParseTemplates(cfg.Templates); err != nil {
+		logger.Fatalf("error starting server %v", err)
+	}
+
+	privacyService := service.NewPrivacyService(
+		userRepository,
+		providerRepository,
+		providerDocumentRepository,
+		s3Bucket,
+		cdnFileInvalidator,
+		decryptor,
+	)
+
+	purger := worker.NewPurger(privacyService, cfg.AccountDeletion, logger)
+	purgerCtx, stopPurger := context.WithCancel(context.Background())
+
 	services := &services{
-		authService: authService,
-		userService: userService,
+		authService:        authService,
+		userService:        userService,
+		sessionService:     sessionService,
+		twoFactorService:   twoFactorService,
+		emailChangeService: emailChangeService,
+		phoneService:       phoneService,
+		oidcService:        oidcService,
+		adminService:       adminService,
+		providerService:    providerService,
+		privacyService:     privacyService,
 	}
 
 	jWTManagers := &jWTManagers{
 		accessJWTManager:       auth_jwt.NewManager(cfg.JWT.AccessSecret, cfg.JWT.AccessTTL),
 		refreshJWTManager:      refresh_jwt.NewManager(cfg.JWT.RefreshSecret, cfg.JWT.RefreshTTL),
 		verificationJWTManager: verify_jwt.NewManager(cfg.JWT.VerificationSecret, cfg.JWT.VerificationTTL),
+		resetJWTManager:        reset_jwt.NewManager(cfg.JWT.PasswordResetSecret, cfg.JWT.PasswordResetTTL),
+		challengeJWTManager:    challenge_jwt.NewManager(cfg.JWT.TwoFactorSecret, cfg.JWT.TwoFactorTTL),
+		emailChangeJWTManager:  email_change_jwt.NewManager(cfg.JWT.EmailChangeSecret, cfg.JWT.EmailChangeTTL),
 	}
 
 	jsonManager := std_json.New()
@@ -150,11 +282,14 @@ func NewServer(
 		jWTManagers:       jWTManagers,
 		services:          services,
 		cdnUrlSigner:      cdn.NewCloudFrontURLSigner(cfg.AWS.CDN),
+		purger:            purger,
+		purgerCtx:         purgerCtx,
+		stopPurger:        stopPurger,
 	}
 }
 
-// Run starts the server and its associated components, including the main HTTP server and metrics server.
-// It returns an error if either the main server or the metrics server fails to start or run.
+// Run starts the server and its associated components, including the main HTTP server, metrics server and account purger.
+// It returns an error if the main server or the metrics server fails to start or run, or if the purger stops.
 func (s *server) Run() error {
 	// Initialize middleware with binder and writer components
 	Middleware := middleware.NewMiddleware(s.handlerComponents.Binder, s.handlerComponents.Writer)
@@ -178,11 +313,23 @@ func (s *server) Run() error {
 	v1.MapV1Routes(v1.RouterArgs{
 		AuthService:            s.services.authService,
 		UserService:            s.services.userService,
+		SessionService:         s.services.sessionService,
+		TwoFactorService:       s.services.twoFactorService,
+		EmailChangeService:     s.services.emailChangeService,
+		PhoneService:           s.services.phoneService,
+		OIDCService:            s.services.oidcService,
+		AdminService:           s.services.adminService,
+		ProviderService:        s.services.providerService,
+		PrivacyService:         s.services.privacyService,
 		Middleware:             Middleware,
 		HandlerComponents:      s.handlerComponents,
+		PaginationConfig:       &s.cfg.Pagination,
 		AccessJWTManager:       s.jWTManagers.accessJWTManager,
 		RefreshJWTManager:      s.jWTManagers.refreshJWTManager,
 		VerificationJWTManager: s.jWTManagers.verificationJWTManager,
+		ResetJWTManager:        s.jWTManagers.resetJWTManager,
+		ChallengeJWTManager:    s.jWTManagers.challengeJWTManager,
+		EmailChangeJWTManager:  s.jWTManagers.emailChangeJWTManager,
 		CdnUrlSigner:           s.cdnUrlSigner,
 	}, s.router)
 
@@ -192,6 +339,7 @@ func (s *server) Run() error {
 
 	mainErrChan := make(chan error, 1)
 	metricsErrChan := make(chan error, 1)
+	purgerErrChan := make(chan error, 1)
 
 	go func() {
 		mainErrChan <- s.mux.ListenAndServe()
@@ -201,15 +349,23 @@ func (s *server) Run() error {
 		metricsErrChan <- s.metricsMux.ListenAndServe()
 	}()
 
+	go func() {
+		if err := s.purger.Run(s.purgerCtx); !errors.Is(err, context.Canceled) {
+			purgerErrChan <- fmt.Errorf("account purger stopped: %w", err)
+		}
+	}()
+
 	select {
 	case mainErr := <-mainErrChan:
 		return mainErr
 	case metricsErr := <-metricsErrChan:
 		return metricsErr
+	case purgerErr := <-purgerErrChan:
+		return purgerErr
 	}
 }
 
-// Close gracefully shuts down the server, including its HTTP mux, metrics mux, database pool, and Redis cluster.
+// Close gracefully shuts down the server, including its HTTP mux, metrics mux, account purger, database pool, and Redis cluster.
 // Errors during shutdown are logged, but the function returns nil to ensure all components attempt to close.
 // Complies to io.Closer interface.
 func (s *server) Close() error {
@@ -228,6 +384,8 @@ func (s *server) Close() error {
 		err = nil
 	}
 
+	s.stopPurger()
+
 	err = postgres.Close(s.dbPool)
 	if err != nil {
 		s.handlerComponents.Logger.Error(err)
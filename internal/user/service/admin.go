@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AdminService interface {
+	SearchUsers(ctx context.Context, filter domain.UserFilter, limit int64, offset int64) ([]*domain.User, error)
+	SearchUsersKeyset(ctx context.Context, filter domain.UserFilter, keyset domain.Keyset, limit int64) ([]*domain.User, error)
+	ChangeRole(ctx context.Context, actorID int64, userID int64, role enum.UserRole) error
+	Suspend(ctx context.Context, actorID int64, userID int64, reason string) error
+	Unsuspend(ctx context.Context, actorID int64, userID int64) error
+	ForceVerify(ctx context.Context, actorID int64, userID int64) error
+	ListAuditLogs(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.AuditLog, error)
+}
+
+type adminServiceImpl struct {
+	userRepository     repository.UserRepository
+	providerRepository repository.ProviderRepository
+	auditLogRepository repository.AuditLogRepository
+	sessionRepository  repository.SessionRepository
+	pgx                postgres.PGX
+}
+
+func NewAdminService(
+	userRepository repository.UserRepository,
+	providerRepository repository.ProviderRepository,
+	auditLogRepository repository.AuditLogRepository,
+	sessionRepository repository.SessionRepository,
+	pgx postgres.PGX,
+) *adminServiceImpl {
+	return &adminServiceImpl{
+		userRepository:     userRepository,
+		providerRepository: providerRepository,
+		auditLogRepository: auditLogRepository,
+		sessionRepository:  sessionRepository,
+		pgx:                pgx,
+	}
+}
+
+// SearchUsers retrieves users matching the filter, newest first.
+func (s *adminServiceImpl) SearchUsers(ctx context.Context, filter domain.UserFilter, limit int64, offset int64) ([]*domain.User, error) {
+	userModels, err := s.userRepository.Search(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUserModels(userModels)
+}
+
+// SearchUsersKeyset retrieves a page of users matching the filter, newest first, starting after or before the keyset.
+func (s *adminServiceImpl) SearchUsersKeyset(ctx context.Context, filter domain.UserFilter, keyset domain.Keyset, limit int64) ([]*domain.User, error) {
+	userModels, err := s.userRepository.SearchKeyset(ctx, filter, keyset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUserModels(userModels)
+}
+
+func mapUserModels(userModels []repository.User) ([]*domain.User, error) {
+	users := make([]*domain.User, len(userModels))
+	for i, userModel := range userModels {
+		user, err := MapUserModelToEntity(userModel)
+		if err != nil {
+			return nil, err
+		}
+		users[i] = user
+	}
+
+	return users, nil
+}
+
+// ChangeRole changes the role of the user and writes it to the audit trail, the new role is applied on the user's next token refresh.
+// It returns ErrAdminSelfAction if the actor is the user, ErrUserRoleUnchanged if the user already has the role
+// ErrProviderNotRegistered if the user is promoted to a provider without having registered as one
+// and ErrProviderNotApproved if the provider's identity verification is not approved.
+func (s *adminServiceImpl) ChangeRole(ctx context.Context, actorID int64, userID int64, role enum.UserRole) error {
+	if actorID == userID {
+		return ErrAdminSelfAction
+	}
+
+	return s.audit(ctx, actorID, userID, func(tx pgx.Tx, accountInfo repository.GetUserAccountInfoRow) (repository.CreateUserAuditLogParams, error) {
+		if accountInfo.Role == string(role) {
+			return repository.CreateUserAuditLogParams{}, ErrUserRoleUnchanged
+		}
+
+		if role == enum.UserRolePROVIDER {
+			verification, err := s.providerRepository.WithTx(tx).GetVerification(ctx, userID)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return repository.CreateUserAuditLogParams{}, ErrProviderNotRegistered
+				}
+				return repository.CreateUserAuditLogParams{}, err
+			}
+			if verification.VerificationStatus != string(domain.ProviderVerificationApproved) {
+				return repository.CreateUserAuditLogParams{}, ErrProviderNotApproved
+			}
+		}
+
+		if _, err := s.userRepository.WithTx(tx).UpdateRole(ctx, userID, string(role)); err != nil {
+			return repository.CreateUserAuditLogParams{}, err
+		}
+
+		return repository.CreateUserAuditLogParams{
+			Action:   string(domain.AuditActionRoleChange),
+			OldValue: pgtype.Text{String: accountInfo.Role, Valid: true},
+			NewValue: pgtype.Text{String: string(role), Valid: true},
+		}, nil
+	})
+}
+
+// Suspend suspends the user, writes it to the audit trail and revokes all of the user's sessions.
+// A suspended user can't log in or refresh the access token.
+// It returns ErrAdminSelfAction if the actor is the user and ErrUserSuspended if the user is already suspended.
+func (s *adminServiceImpl) Suspend(ctx context.Context, actorID int64, userID int64, reason string) error {
+	if actorID == userID {
+		return ErrAdminSelfAction
+	}
+
+	err := s.audit(ctx, actorID, userID, func(tx pgx.Tx, accountInfo repository.GetUserAccountInfoRow) (repository.CreateUserAuditLogParams, error) {
+		if accountInfo.Suspended {
+			return repository.CreateUserAuditLogParams{}, ErrUserSuspended
+		}
+
+		if _, err := s.userRepository.WithTx(tx).UpdateSuspension(ctx, userID, true); err != nil {
+			return repository.CreateUserAuditLogParams{}, err
+		}
+
+		return repository.CreateUserAuditLogParams{
+			Action: string(domain.AuditActionSuspend),
+			Reason: pgtype.Text{String: reason, Valid: reason != ""},
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.sessionRepository.DeleteAll(ctx, userID)
+}
+
+// Unsuspend lifts the suspension of the user and writes it to the audit trail.
+// It returns ErrUserNotSuspended if the user is not suspended.
+func (s *adminServiceImpl) Unsuspend(ctx context.Context, actorID int64, userID int64) error {
+	return s.audit(ctx, actorID, userID, func(tx pgx.Tx, accountInfo repository.GetUserAccountInfoRow) (repository.CreateUserAuditLogParams, error) {
+		if !accountInfo.Suspended {
+			return repository.CreateUserAuditLogParams{}, ErrUserNotSuspended
+		}
+
+		if _, err := s.userRepository.WithTx(tx).UpdateSuspension(ctx, userID, false); err != nil {
+			return repository.CreateUserAuditLogParams{}, err
+		}
+
+		return repository.CreateUserAuditLogParams{Action: string(domain.AuditActionUnsuspend)}, nil
+	})
+}
+
+// ForceVerify verifies the user without the verification letter and writes it to the audit trail.
+// It returns ErrUserVerified if the user is already verified.
+func (s *adminServiceImpl) ForceVerify(ctx context.Context, actorID int64, userID int64) error {
+	return s.audit(ctx, actorID, userID, func(tx pgx.Tx, accountInfo repository.GetUserAccountInfoRow) (repository.CreateUserAuditLogParams, error) {
+		if accountInfo.Verified.Bool {
+			return repository.CreateUserAuditLogParams{}, ErrUserVerified
+		}
+
+		if _, err := s.userRepository.WithTx(tx).UpdateVerification(ctx, userID, true); err != nil {
+			return repository.CreateUserAuditLogParams{}, err
+		}
+
+		return repository.CreateUserAuditLogParams{
+			Action:   string(domain.AuditActionForceVerify),
+			OldValue: pgtype.Text{String: strconv.FormatBool(accountInfo.Verified.Bool), Valid: true},
+			NewValue: pgtype.Text{String: strconv.FormatBool(true), Valid: true},
+		}, nil
+	})
+}
+
+// ListAuditLogs retrieves the audit trail of the user, newest first.
+func (s *adminServiceImpl) ListAuditLogs(ctx context.Context, userID int64, limit int64, offset int64) ([]domain.AuditLog, error) {
+	logModels, err := s.auditLogRepository.List(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]domain.AuditLog, len(logModels))
+	for i, logModel := range logModels {
+		logs[i] = MapAuditLogModelToEntity(logModel)
+	}
+
+	return logs, nil
+}
+
+// audit runs the action of the actor on the locked account of the user and writes the audit log it returns in the same transaction.
+// It returns ErrUserNotFound if the user does not exist.
+func (s *adminServiceImpl) audit(
+	ctx context.Context,
+	actorID int64,
+	userID int64,
+	action func(tx pgx.Tx, accountInfo repository.GetUserAccountInfoRow) (repository.CreateUserAuditLogParams, error),
+) error {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	accountInfo, err := s.userRepository.WithTx(tx).GetAccountInfoForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return postgres.Rollback(tx, ctx, ErrUserNotFound)
+		}
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	logParams, err := action(tx, accountInfo)
+	if err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	logParams.UserID = userID
+	logParams.ActorID = actorID
+	if err := s.auditLogRepository.WithTx(tx).Create(ctx, logParams); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,343 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	adminActorId int64 = 1
+	adminUserId  int64 = 2
+)
+
+var adminAccountInfo = repository.GetUserAccountInfoRow{
+	Role:     string(enum.UserRoleCUSTOMER),
+	Verified: pgtype.Bool{Bool: true, Valid: true},
+}
+
+func setupAdmin(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.AdminService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockProviderRepository *mock_repository.MockProviderRepository,
+	mockAuditLogRepository *mock_repository.MockAuditLogRepository,
+	mockSessionRepository *mock_repository.MockSessionRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockProviderRepository = mock_repository.NewMockProviderRepository(ctrl)
+	mockAuditLogRepository = mock_repository.NewMockAuditLogRepository(ctrl)
+	mockSessionRepository = mock_repository.NewMockSessionRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+
+	svc = service.NewAdminService(
+		mockUserRepository,
+		mockProviderRepository,
+		mockAuditLogRepository,
+		mockSessionRepository,
+		mockPgx,
+	)
+	return
+}
+
+// expectLockedAccount expects the transaction of an administrative action to begin and lock the account of the user.
+func expectLockedAccount(
+	ctx context.Context,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	accountInfo repository.GetUserAccountInfoRow,
+) {
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository).AnyTimes()
+	mockUserRepository.EXPECT().GetAccountInfoForUpdate(ctx, adminUserId).Return(accountInfo, nil)
+}
+
+// expectAuditLog expects the audit log to be written and the transaction to be committed.
+func expectAuditLog(
+	ctx context.Context,
+	mockAuditLogRepository *mock_repository.MockAuditLogRepository,
+	mockTx *mock_postgres.MockTx,
+	params repository.CreateUserAuditLogParams,
+) {
+	params.UserID = adminUserId
+	params.ActorID = adminActorId
+
+	mockAuditLogRepository.EXPECT().WithTx(mockTx).Return(mockAuditLogRepository)
+	mockAuditLogRepository.EXPECT().Create(ctx, params).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+}
+
+func TestSearchUsers_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	filter := domain.NewUserFilter(enum.UserRoleCUSTOMER, nil, "larry", time.Time{}, time.Time{})
+	mockUserRepository.EXPECT().Search(ctx, filter, int64(10), int64(20)).Return([]repository.User{
+		{ID: adminUserId, Role: string(enum.UserRoleCUSTOMER), Suspended: true},
+	}, nil)
+
+	users, err := svc.SearchUsers(ctx, filter, 10, 20)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, adminUserId, users[0].ID)
+		assert.True(t, users[0].Suspended)
+	}
+}
+
+func TestSearchUsers_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Search(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New(""))
+
+	users, err := svc.SearchUsers(ctx, domain.UserFilter{}, 10, 0)
+	assert.Error(t, err)
+	assert.Nil(t, users)
+}
+
+func TestSearchUsersKeyset_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	filter := domain.NewUserFilter(enum.UserRolePROVIDER, nil, "", time.Time{}, time.Time{})
+	keyset := domain.NewKeyset(adminUserId+1, time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC), true)
+	mockUserRepository.EXPECT().SearchKeyset(ctx, filter, keyset, int64(10)).Return([]repository.User{
+		{ID: adminUserId, Role: string(enum.UserRolePROVIDER)},
+	}, nil)
+
+	users, err := svc.SearchUsersKeyset(ctx, filter, keyset, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, users, 1) {
+		assert.Equal(t, adminUserId, users[0].ID)
+		assert.Equal(t, enum.UserRolePROVIDER, users[0].AccountInfo.Role)
+	}
+}
+
+func TestSearchUsersKeyset_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().SearchKeyset(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New(""))
+
+	users, err := svc.SearchUsersKeyset(ctx, domain.UserFilter{}, domain.NewKeyset(0, time.Time{}, false), 10)
+	assert.Error(t, err)
+	assert.Nil(t, users)
+}
+
+func TestChangeRole_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockAuditLogRepository, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockUserRepository.EXPECT().UpdateRole(ctx, adminUserId, string(enum.UserRoleMODERATOR)).Return(true, nil)
+	expectAuditLog(ctx, mockAuditLogRepository, mockTx, repository.CreateUserAuditLogParams{
+		Action:   string(domain.AuditActionRoleChange),
+		OldValue: pgtype.Text{String: string(enum.UserRoleCUSTOMER), Valid: true},
+		NewValue: pgtype.Text{String: string(enum.UserRoleMODERATOR), Valid: true},
+	})
+
+	assert.NoError(t, svc.ChangeRole(ctx, adminActorId, adminUserId, enum.UserRoleMODERATOR))
+}
+
+func TestChangeRole_Self(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	assert.ErrorIs(t, svc.ChangeRole(ctx, adminActorId, adminActorId, enum.UserRoleCUSTOMER), service.ErrAdminSelfAction)
+}
+
+func TestChangeRole_Unchanged(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ChangeRole(ctx, adminActorId, adminUserId, enum.UserRoleCUSTOMER), service.ErrUserRoleUnchanged)
+}
+
+func TestChangeRole_ProviderNotRegistered(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockProviderRepository, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockProviderRepository.EXPECT().WithTx(mockTx).Return(mockProviderRepository)
+	mockProviderRepository.EXPECT().GetVerification(ctx, adminUserId).Return(repository.ProviderVerificationRow{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ChangeRole(ctx, adminActorId, adminUserId, enum.UserRolePROVIDER), service.ErrProviderNotRegistered)
+}
+
+func TestChangeRole_ProviderNotApproved(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockProviderRepository, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockProviderRepository.EXPECT().WithTx(mockTx).Return(mockProviderRepository)
+	mockProviderRepository.EXPECT().GetVerification(ctx, adminUserId).Return(repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationPending),
+	}, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ChangeRole(ctx, adminActorId, adminUserId, enum.UserRolePROVIDER), service.ErrProviderNotApproved)
+}
+
+func TestChangeRole_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().GetAccountInfoForUpdate(ctx, adminUserId).Return(repository.GetUserAccountInfoRow{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ChangeRole(ctx, adminActorId, adminUserId, enum.UserRoleADMIN), service.ErrUserNotFound)
+}
+
+func TestSuspend_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockAuditLogRepository, mockSessionRepository, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockUserRepository.EXPECT().UpdateSuspension(ctx, adminUserId, true).Return(true, nil)
+	expectAuditLog(ctx, mockAuditLogRepository, mockTx, repository.CreateUserAuditLogParams{
+		Action: string(domain.AuditActionSuspend),
+		Reason: pgtype.Text{String: "spam", Valid: true},
+	})
+	mockSessionRepository.EXPECT().DeleteAll(ctx, adminUserId).Return(nil)
+
+	assert.NoError(t, svc.Suspend(ctx, adminActorId, adminUserId, "spam"))
+}
+
+func TestSuspend_AlreadySuspended(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	accountInfo := adminAccountInfo
+	accountInfo.Suspended = true
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, accountInfo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Suspend(ctx, adminActorId, adminUserId, ""), service.ErrUserSuspended)
+}
+
+func TestSuspend_Self(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	assert.ErrorIs(t, svc.Suspend(ctx, adminActorId, adminActorId, ""), service.ErrAdminSelfAction)
+}
+
+func TestUnsuspend_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockAuditLogRepository, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	accountInfo := adminAccountInfo
+	accountInfo.Suspended = true
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, accountInfo)
+	mockUserRepository.EXPECT().UpdateSuspension(ctx, adminUserId, false).Return(true, nil)
+	expectAuditLog(ctx, mockAuditLogRepository, mockTx, repository.CreateUserAuditLogParams{
+		Action: string(domain.AuditActionUnsuspend),
+	})
+
+	assert.NoError(t, svc.Unsuspend(ctx, adminActorId, adminUserId))
+}
+
+func TestUnsuspend_NotSuspended(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Unsuspend(ctx, adminActorId, adminUserId), service.ErrUserNotSuspended)
+}
+
+func TestForceVerify_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockAuditLogRepository, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	accountInfo := adminAccountInfo
+	accountInfo.Verified = pgtype.Bool{}
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, accountInfo)
+	mockUserRepository.EXPECT().UpdateVerification(ctx, adminUserId, true).Return(true, nil)
+	expectAuditLog(ctx, mockAuditLogRepository, mockTx, repository.CreateUserAuditLogParams{
+		Action:   string(domain.AuditActionForceVerify),
+		OldValue: pgtype.Text{String: "false", Valid: true},
+		NewValue: pgtype.Text{String: "true", Valid: true},
+	})
+
+	assert.NoError(t, svc.ForceVerify(ctx, adminActorId, adminUserId))
+}
+
+func TestForceVerify_AlreadyVerified(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, adminAccountInfo)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ForceVerify(ctx, adminActorId, adminUserId), service.ErrUserVerified)
+}
+
+func TestForceVerify_AuditLogError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockAuditLogRepository, _, mockPgx, mockTx := setupAdmin(t)
+	defer ctrl.Finish()
+
+	accountInfo := adminAccountInfo
+	accountInfo.Verified = pgtype.Bool{}
+
+	expectLockedAccount(ctx, mockUserRepository, mockPgx, mockTx, accountInfo)
+	mockUserRepository.EXPECT().UpdateVerification(ctx, adminUserId, true).Return(true, nil)
+	mockAuditLogRepository.EXPECT().WithTx(mockTx).Return(mockAuditLogRepository)
+	mockAuditLogRepository.EXPECT().Create(ctx, gomock.Any()).Return(errors.New(""))
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.Error(t, svc.ForceVerify(ctx, adminActorId, adminUserId))
+}
+
+func TestListAuditLogs_Success(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockAuditLogRepository, _, _, _ := setupAdmin(t)
+	defer ctrl.Finish()
+
+	mockAuditLogRepository.EXPECT().List(ctx, adminUserId, int64(10), int64(0)).Return([]repository.UserAuditLog{
+		{
+			ID:      1,
+			UserID:  adminUserId,
+			ActorID: adminActorId,
+			Action:  string(domain.AuditActionSuspend),
+			Reason:  pgtype.Text{String: "spam", Valid: true},
+		},
+	}, nil)
+
+	logs, err := svc.ListAuditLogs(ctx, adminUserId, 10, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, logs, 1) {
+		assert.Equal(t, domain.AuditActionSuspend, logs[0].Action)
+		assert.Equal(t, adminActorId, logs[0].ActorID)
+		assert.Equal(t, "spam", logs[0].Reason)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"html/template"
+	"strings"
 	"time"
 
 	"github.com/hexley21/fixup/internal/common/enum"
@@ -23,21 +24,25 @@ import (
 type templates struct {
 	verification        *template.Template
 	verificationSuccess *template.Template
+	passwordReset       *template.Template
+	loginLockout        *template.Template
 }
 
-func NewTemplates(verification *template.Template, verificationSuccess *template.Template) *templates {
-	return &templates{verification: verification, verificationSuccess: verificationSuccess}
+func NewTemplates(verification *template.Template, verificationSuccess *template.Template, passwordReset *template.Template, loginLockout *template.Template) *templates {
+	return &templates{verification: verification, verificationSuccess: verificationSuccess, passwordReset: passwordReset, loginLockout: loginLockout}
 }
 
 type AuthService interface {
 	RegisterCustomer(ctx context.Context, password string, personalInfo *domain.UserPersonalInfo) (*domain.User, error)
 	RegisterProvider(ctx context.Context, password string, personalIdNumber string, personalInfo *domain.UserPersonalInfo) (*domain.User, error)
-	AuthenticateUser(ctx context.Context, email string, password string) (domain.UserIdentity, error)
-	RefreshUserToken(ctx context.Context, id int64, tokenFunc func(role enum.UserRole, verified bool) (string, error)) (string, error)
+	AuthenticateUser(ctx context.Context, email string, password string, ip string) (domain.UserIdentity, error)
+	RefreshUserToken(ctx context.Context, id int64, tokenFunc func(role enum.UserRole, verified bool, phoneVerified bool) (string, error)) (string, error)
 	VerifyUser(ctx context.Context, token string, ttl time.Duration, id int64) error
 	ResendVerificationLetter(ctx context.Context, tokenFunc func(id int64) (string, error), email string) error
 	SendVerificationLetter(ctx context.Context, token string, email string, name string) error
 	SendVerificationSuccessLetter(email string) error
+	SendPasswordResetLetter(ctx context.Context, tokenFunc func(id int64) (string, error), email string) error
+	ResetPassword(ctx context.Context, token string, ttl time.Duration, id int64, password string) error
 }
 
 type authServiceImpl struct {
@@ -45,6 +50,11 @@ type authServiceImpl struct {
 	providerRepository     repository.ProviderRepository
 	verificationRepository repository.VerificationRepository
 	verificationTokenTTL   time.Duration
+	resetRepository        repository.PasswordResetRepository
+	resetTokenTTL          time.Duration
+	sessionRepository      repository.SessionRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	loginThrottle          config.LoginThrottle
 	pgx                    postgres.PGX
 	hasher                 hasher.Hasher
 	encryptor              encryption.Encryptor
@@ -58,6 +68,11 @@ func NewAuthService(
 	providerRepository repository.ProviderRepository,
 	verificationRepository repository.VerificationRepository,
 	verificationTokenTTL time.Duration,
+	resetRepository repository.PasswordResetRepository,
+	resetTokenTTL time.Duration,
+	sessionRepository repository.SessionRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	loginThrottle config.LoginThrottle,
 	pgx postgres.PGX,
 	hasher hasher.Hasher,
 	encryptor encryption.Encryptor,
@@ -69,6 +84,11 @@ func NewAuthService(
 		providerRepository:     providerRepository,
 		verificationRepository: verificationRepository,
 		verificationTokenTTL:   verificationTokenTTL,
+		resetRepository:        resetRepository,
+		resetTokenTTL:          resetTokenTTL,
+		sessionRepository:      sessionRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		loginThrottle:          loginThrottle,
 		pgx:                    pgx,
 		hasher:                 hasher,
 		encryptor:              encryptor,
@@ -90,13 +110,26 @@ func (s *authServiceImpl) ParseTemplates(cfg config.Templates) error {
 	if err != nil {
 		return err
 	}
+	passwordResetTemplate, err := template.ParseFiles(cfg.PasswordResetPath)
+	if err != nil {
+		return err
+	}
+	loginLockoutTemplate, err := template.ParseFiles(cfg.LoginLockoutPath)
+	if err != nil {
+		return err
+	}
 
-	s.templates = NewTemplates(verificationTemplate, verificationSuccessTemplate)
+	s.templates = NewTemplates(verificationTemplate, verificationSuccessTemplate, passwordResetTemplate, loginLockoutTemplate)
 	return nil
 }
 
-func (s *authServiceImpl) SetTemplates(verificationTemplate *template.Template, verificationSuccessTemplate *template.Template) {
-	s.templates = NewTemplates(verificationTemplate, verificationSuccessTemplate)
+func (s *authServiceImpl) SetTemplates(
+	verificationTemplate *template.Template,
+	verificationSuccessTemplate *template.Template,
+	passwordResetTemplate *template.Template,
+	loginLockoutTemplate *template.Template,
+) {
+	s.templates = NewTemplates(verificationTemplate, verificationSuccessTemplate, passwordResetTemplate, loginLockoutTemplate)
 }
 
 // RegisterProvider writes user record to a database, returns domain user result.
@@ -188,12 +221,22 @@ func (s *authServiceImpl) RegisterProvider(ctx context.Context, password string,
 }
 
 // AuthenticateUser authenticates a user by verifying their email and password.
-// It returns error if password is incorrect
-func (s *authServiceImpl) AuthenticateUser(ctx context.Context, email string, password string) (domain.UserIdentity, error) {
+// If the user has two-factor authentication enabled, the identity is returned with a pending two-factor state.
+// It returns ErrUserSuspended if the password is correct, but the user is suspended.
+// A deactivated user is restored, unless the login is pending the second factor, which restores the user once it passes.
+// Failed attempts are counted per email and per client ip, each failure locks further attempts with an exponential backoff,
+// and once the threshold is reached, attempts are locked out and the user gets a security email.
+// It returns error if password is incorrect and *LoginLockedError while attempts are locked.
+func (s *authServiceImpl) AuthenticateUser(ctx context.Context, email string, password string, ip string) (domain.UserIdentity, error) {
+	subjects := s.loginSubjects(email, ip)
+	if err := s.checkLoginLock(ctx, subjects); err != nil {
+		return domain.UserIdentity{}, err
+	}
+
 	authInfo, err := s.userRepository.GetAuthInfoByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.UserIdentity{}, ErrUserNotFound
+			return domain.UserIdentity{}, s.failLogin(ctx, subjects, email, ip, ErrUserNotFound)
 		}
 		return domain.UserIdentity{}, err
 	}
@@ -201,18 +244,40 @@ func (s *authServiceImpl) AuthenticateUser(ctx context.Context, email string, pa
 	err = s.hasher.VerifyPassword(password, authInfo.Hash)
 	if err != nil {
 		if errors.Is(err, hasher.ErrPasswordMismatch) {
-			return domain.UserIdentity{}, ErrIncorrectEmailOrPassword
+			return domain.UserIdentity{}, s.failLogin(ctx, subjects, email, ip, ErrIncorrectEmailOrPassword)
 		}
 
 		return domain.UserIdentity{}, err
 	}
 
-	return MapUserIdentity(authInfo.ID, authInfo.Role, authInfo.Verified)
+	if authInfo.Suspended {
+		return domain.UserIdentity{}, ErrUserSuspended
+	}
+
+	// logging in cancels the scheduled deletion of a deactivated account
+	if authInfo.Deactivated && !authInfo.TwoFactorEnabled {
+		if _, err := s.userRepository.Restore(ctx, authInfo.ID); err != nil {
+			return domain.UserIdentity{}, err
+		}
+	}
+
+	// only the failures of the email are forgotten, so a client can't reset its ip failures with an account of its own
+	if err := s.loginAttemptRepository.Reset(ctx, subjects[0].key); err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	identity, err := MapUserIdentity(authInfo.ID, authInfo.Role, authInfo.Verified, authInfo.PhoneVerified)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+	identity.TwoFactorPending = authInfo.TwoFactorEnabled
+
+	return identity, nil
 }
 
 // RefreshUserToken retrieves user's current accout information and returns a new access token.
-// It returns an error if the user is not found or if any other error occurs during the process.
-func (s *authServiceImpl) RefreshUserToken(ctx context.Context, id int64, tokenFunc func(role enum.UserRole, verified bool) (string, error)) (string, error) {
+// It returns ErrUserSuspended if the user is suspended, an error if the user is not found or if any other error occurs during the process.
+func (s *authServiceImpl) RefreshUserToken(ctx context.Context, id int64, tokenFunc func(role enum.UserRole, verified bool, phoneVerified bool) (string, error)) (string, error) {
 	accountInfo, err := s.userRepository.GetAccountInfo(ctx, id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -221,9 +286,11 @@ func (s *authServiceImpl) RefreshUserToken(ctx context.Context, id int64, tokenF
 
 		return "", err
 	}
+	if accountInfo.Suspended {
+		return "", ErrUserSuspended
+	}
 
-
-	return tokenFunc(enum.UserRole(accountInfo.Role), accountInfo.Verified.Bool)
+	return tokenFunc(enum.UserRole(accountInfo.Role), accountInfo.Verified.Bool, accountInfo.PhoneVerified.Bool)
 }
 
 // VerifyUser verifies a user by setting the token as used and updating the user's verification status.
@@ -308,3 +375,189 @@ func (s *authServiceImpl) SendVerificationSuccessLetter(email string) error {
 		nil,
 	)
 }
+
+// SendPasswordResetLetter sends a password reset email with a token generated by tokenFunc to the specified address.
+// It returns ErrUserNotFound if there is no user with the email.
+func (s *authServiceImpl) SendPasswordResetLetter(ctx context.Context, tokenFunc func(id int64) (string, error), email string) error {
+	userInfo, err := s.userRepository.GetVerificationInfo(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+
+		return err
+	}
+
+	token, err := tokenFunc(userInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.SendHTML(
+		s.emailAddress,
+		email,
+		"Password reset",
+		s.templates.passwordReset,
+		struct {
+			Name    string
+			Token   string
+			Minutes int
+		}{
+			Name:    userInfo.FirstName,
+			Token:   token,
+			Minutes: int(s.resetTokenTTL.Minutes()),
+		},
+	)
+}
+
+// ResetPassword sets the token as used, replaces the user's password and revokes all of the user's sessions.
+// It returns ErrPasswordResetTokenUsed if the token has already been used and ErrUserNotFound if the user does not exist.
+func (s *authServiceImpl) ResetPassword(ctx context.Context, token string, ttl time.Duration, id int64, password string) error {
+	err := s.resetRepository.SetTokenUsed(ctx, token, ttl)
+	if err != nil {
+		if errors.Is(err, redis.TxFailedErr) {
+			return ErrPasswordResetTokenUsed
+		}
+		return err
+	}
+
+	hash, err := s.hasher.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.userRepository.UpdateHash(ctx, id, hash)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUserNotFound
+	}
+
+	return s.sessionRepository.DeleteAll(ctx, id)
+}
+
+// loginSubject is a subject the failed logins are counted for.
+type loginSubject struct {
+	key         string
+	maxAttempts int
+	// notify tells if the user is emailed when the subject gets locked out
+	notify bool
+}
+
+// loginSubjects returns the subjects of a login attempt, the email comes first.
+func (s *authServiceImpl) loginSubjects(email string, ip string) []loginSubject {
+	subjects := []loginSubject{
+		{key: "email:" + strings.ToLower(email), maxAttempts: s.loginThrottle.MaxAttempts, notify: true},
+	}
+	if ip != "" {
+		subjects = append(subjects, loginSubject{key: "ip:" + ip, maxAttempts: s.loginThrottle.MaxIPAttempts})
+	}
+
+	return subjects
+}
+
+// checkLoginLock returns *LoginLockedError with the longest remaining lock, if any of the subjects is locked.
+func (s *authServiceImpl) checkLoginLock(ctx context.Context, subjects []loginSubject) error {
+	var retryAfter time.Duration
+	for _, subject := range subjects {
+		lock, err := s.loginAttemptRepository.GetLock(ctx, subject.key)
+		if err != nil {
+			return err
+		}
+		retryAfter = max(retryAfter, lock)
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// failLogin counts the failed attempt for every subject and locks them for the backoff.
+// It returns cause, or *LoginLockedError if any subject got locked out by this attempt.
+func (s *authServiceImpl) failLogin(ctx context.Context, subjects []loginSubject, email string, ip string, cause error) error {
+	var lockout time.Duration
+	var notify bool
+	for _, subject := range subjects {
+		failures, err := s.loginAttemptRepository.AddFailure(ctx, subject.key, s.loginThrottle.Window)
+		if err != nil {
+			return err
+		}
+
+		lock := s.loginDelay(failures, subject.maxAttempts)
+		if lock <= 0 {
+			continue
+		}
+		if err := s.loginAttemptRepository.Lock(ctx, subject.key, lock); err != nil {
+			return err
+		}
+
+		if failures < int64(subject.maxAttempts) {
+			continue
+		}
+		lockout = max(lockout, lock)
+		// the letter goes out once, when the threshold is reached
+		notify = notify || subject.notify && failures == int64(subject.maxAttempts)
+	}
+
+	if lockout <= 0 {
+		return cause
+	}
+
+	lockedErr := &LoginLockedError{RetryAfter: lockout}
+	if notify && !errors.Is(cause, ErrUserNotFound) {
+		if err := s.sendLoginLockoutLetter(ctx, email, ip); err != nil {
+			return errors.Join(lockedErr, err)
+		}
+	}
+
+	return lockedErr
+}
+
+// loginDelay returns for how long a subject is locked after its failures, which doubles with every failure,
+// starting from the base delay and capped by the lockout. Once the failures reach maxAttempts, it's the full lockout.
+// The doubling is scaled by the attempts of the subject relative to those of an email,
+// so a subject allowed more attempts, such as an IP shared by many users, backs off slower.
+func (s *authServiceImpl) loginDelay(failures int64, maxAttempts int) time.Duration {
+	if failures >= int64(maxAttempts) {
+		return s.loginThrottle.Lockout
+	}
+	if s.loginThrottle.BaseDelay <= 0 {
+		return 0
+	}
+
+	doublings := (failures - 1) * int64(s.loginThrottle.MaxAttempts) / int64(maxAttempts)
+
+	delay := s.loginThrottle.BaseDelay
+	for i := int64(0); i < doublings && delay < s.loginThrottle.Lockout; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.loginThrottle.Lockout)
+}
+
+// sendLoginLockoutLetter sends a security email about the lockout to the specified address.
+func (s *authServiceImpl) sendLoginLockoutLetter(ctx context.Context, email string, ip string) error {
+	userInfo, err := s.userRepository.GetVerificationInfo(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.SendHTML(
+		s.emailAddress,
+		email,
+		"Account locked",
+		s.templates.loginLockout,
+		struct {
+			Name    string
+			IP      string
+			Minutes int
+		}{
+			Name:    userInfo.FirstName,
+			IP:      ip,
+			Minutes: int(s.loginThrottle.Lockout.Minutes()),
+		},
+	)
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/mailer"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type emailChangeTemplates struct {
+	confirmation *template.Template
+	notice       *template.Template
+}
+
+type EmailChangeService interface {
+	RequestChange(ctx context.Context, userID int64, newEmail string, tokenFunc func(id int64, email string) (string, error)) error
+	ConfirmChange(ctx context.Context, userID int64, newEmail string) error
+}
+
+type emailChangeServiceImpl struct {
+	userRepository        repository.UserRepository
+	emailChangeRepository repository.EmailChangeRepository
+	emailChangeTokenTTL   time.Duration
+	pgx                   postgres.PGX
+	mailer                mailer.Mailer
+	emailAddress          string
+	templates             *emailChangeTemplates
+}
+
+func NewEmailChangeService(
+	userRepository repository.UserRepository,
+	emailChangeRepository repository.EmailChangeRepository,
+	emailChangeTokenTTL time.Duration,
+	pgx postgres.PGX,
+	mailer mailer.Mailer,
+	emailAddress string,
+) *emailChangeServiceImpl {
+	return &emailChangeServiceImpl{
+		userRepository:        userRepository,
+		emailChangeRepository: emailChangeRepository,
+		emailChangeTokenTTL:   emailChangeTokenTTL,
+		pgx:                   pgx,
+		mailer:                mailer,
+		emailAddress:          emailAddress,
+	}
+}
+
+// ParseTemplates parses the email change templates from the provided configuration paths.
+// It returns an error if any template fails to parse.
+func (s *emailChangeServiceImpl) ParseTemplates(cfg config.Templates) error {
+	confirmationTemplate, err := template.ParseFiles(cfg.EmailChangePath)
+	if err != nil {
+		return err
+	}
+	noticeTemplate, err := template.ParseFiles(cfg.EmailChangeNoticePath)
+	if err != nil {
+		return err
+	}
+
+	s.SetTemplates(confirmationTemplate, noticeTemplate)
+	return nil
+}
+
+func (s *emailChangeServiceImpl) SetTemplates(confirmationTemplate *template.Template, noticeTemplate *template.Template) {
+	s.templates = &emailChangeTemplates{confirmation: confirmationTemplate, notice: noticeTemplate}
+}
+
+// RequestChange stores a pending change of the user's email, replacing the previous one.
+// A confirmation link with a token generated by tokenFunc is sent to the new email and a notice is sent to the current one.
+// It returns ErrUserNotFound if the user does not exist, ErrEmailUnchanged if the email is the current one
+// and ErrUserEmailTaken if another user has the email.
+func (s *emailChangeServiceImpl) RequestChange(ctx context.Context, userID int64, newEmail string, tokenFunc func(id int64, email string) (string, error)) error {
+	userModel, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if strings.EqualFold(userModel.Email, newEmail) {
+		return ErrEmailUnchanged
+	}
+
+	// the email is checked again on confirmation, this only spares a letter that can't be confirmed
+	_, err = s.userRepository.GetVerificationInfo(ctx, newEmail)
+	if err == nil {
+		return ErrUserEmailTaken
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err := s.emailChangeRepository.Upsert(ctx, userID, newEmail); err != nil {
+		return err
+	}
+
+	token, err := tokenFunc(userID, newEmail)
+	if err != nil {
+		return err
+	}
+
+	err = s.mailer.SendHTML(
+		s.emailAddress,
+		newEmail,
+		"Email change confirmation",
+		s.templates.confirmation,
+		struct {
+			Name  string
+			Token string
+			Hours int
+		}{
+			Name:  userModel.FirstName,
+			Token: token,
+			Hours: int(s.emailChangeTokenTTL.Hours()),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.SendHTML(
+		s.emailAddress,
+		userModel.Email,
+		"Email change requested",
+		s.templates.notice,
+		struct {
+			Name     string
+			NewEmail string
+		}{
+			Name:     userModel.FirstName,
+			NewEmail: newEmail,
+		},
+	)
+}
+
+// ConfirmChange applies the pending change of the user's email, the user is verified by the confirmation.
+// It returns ErrEmailChangeNotFound if there is no pending change to the email, it was already applied or replaced by a newer one,
+// ErrUserEmailTaken if another user took the email in the meantime and ErrUserNotFound if the user does not exist.
+func (s *emailChangeServiceImpl) ConfirmChange(ctx context.Context, userID int64, newEmail string) error {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.emailChangeRepository.WithTx(tx).Delete(ctx, userID, newEmail)
+	if err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+	if !ok {
+		return postgres.Rollback(tx, ctx, ErrEmailChangeNotFound)
+	}
+
+	ok, err = s.userRepository.WithTx(tx).UpdateEmail(ctx, userID, newEmail)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return postgres.Rollback(tx, ctx, ErrUserEmailTaken)
+			}
+		}
+		return postgres.Rollback(tx, ctx, err)
+	}
+	if !ok {
+		return postgres.Rollback(tx, ctx, ErrUserNotFound)
+	}
+
+	return tx.Commit(ctx)
+}
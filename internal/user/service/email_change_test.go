@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	mock_mailer "github.com/hexley21/fixup/pkg/mailer/mock"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	emailChangeUserId       int64 = 1
+	emailChangeOldEmail           = "larry@page.com"
+	emailChangeNewEmail           = "larry@google.com"
+	emailChangeToken              = "email-change-token"
+	emailChangeEmailAddress       = "fixup@gmail.com"
+)
+
+var (
+	emailChangeTemplate       = template.New("email_change")
+	emailChangeNoticeTemplate = template.New("email_change_notice")
+
+	emailChangeUser = repository.User{ID: emailChangeUserId, FirstName: "Larry", Email: emailChangeOldEmail}
+)
+
+func setupEmailChange(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.EmailChangeService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockEmailChangeRepository *mock_repository.MockEmailChangeRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	mockMailer *mock_mailer.MockMailer,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockEmailChangeRepository = mock_repository.NewMockEmailChangeRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	mockMailer = mock_mailer.NewMockMailer(ctrl)
+
+	s := service.NewEmailChangeService(
+		mockUserRepository,
+		mockEmailChangeRepository,
+		24*time.Hour,
+		mockPgx,
+		mockMailer,
+		emailChangeEmailAddress,
+	)
+	s.SetTemplates(emailChangeTemplate, emailChangeNoticeTemplate)
+
+	svc = s
+	return
+}
+
+func emailChangeTokenFunc(t *testing.T) func(id int64, email string) (string, error) {
+	return func(id int64, email string) (string, error) {
+		assert.Equal(t, emailChangeUserId, id)
+		assert.Equal(t, emailChangeNewEmail, email)
+		return emailChangeToken, nil
+	}
+}
+
+func TestRequestEmailChange_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockEmailChangeRepository, _, _, mockMailer := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockUserRepository.EXPECT().Get(ctx, emailChangeUserId).Return(emailChangeUser, nil),
+		mockUserRepository.EXPECT().GetVerificationInfo(ctx, emailChangeNewEmail).Return(repository.GetUserVerificationInfoRow{}, pgx.ErrNoRows),
+		mockEmailChangeRepository.EXPECT().Upsert(ctx, emailChangeUserId, emailChangeNewEmail).Return(nil),
+		mockMailer.EXPECT().SendHTML(emailChangeEmailAddress, emailChangeNewEmail, gomock.Any(), emailChangeTemplate, gomock.Any()).Return(nil),
+		mockMailer.EXPECT().SendHTML(emailChangeEmailAddress, emailChangeOldEmail, gomock.Any(), emailChangeNoticeTemplate, gomock.Any()).Return(nil),
+	)
+
+	assert.NoError(t, svc.RequestChange(ctx, emailChangeUserId, emailChangeNewEmail, emailChangeTokenFunc(t)))
+}
+
+func TestRequestEmailChange_Unchanged(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, emailChangeUserId).Return(emailChangeUser, nil)
+
+	err := svc.RequestChange(ctx, emailChangeUserId, "Larry@Page.com", emailChangeTokenFunc(t))
+	assert.ErrorIs(t, err, service.ErrEmailUnchanged)
+}
+
+func TestRequestEmailChange_EmailTaken(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, emailChangeUserId).Return(emailChangeUser, nil)
+	mockUserRepository.EXPECT().GetVerificationInfo(ctx, emailChangeNewEmail).Return(repository.GetUserVerificationInfoRow{ID: 2}, nil)
+
+	err := svc.RequestChange(ctx, emailChangeUserId, emailChangeNewEmail, emailChangeTokenFunc(t))
+	assert.ErrorIs(t, err, service.ErrUserEmailTaken)
+}
+
+func TestRequestEmailChange_UserNotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, emailChangeUserId).Return(repository.User{}, pgx.ErrNoRows)
+
+	err := svc.RequestChange(ctx, emailChangeUserId, emailChangeNewEmail, emailChangeTokenFunc(t))
+	assert.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestConfirmEmailChange_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockEmailChangeRepository, mockPgx, mockTx, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockEmailChangeRepository.EXPECT().WithTx(mockTx).Return(mockEmailChangeRepository)
+	mockEmailChangeRepository.EXPECT().Delete(ctx, emailChangeUserId, emailChangeNewEmail).Return(true, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().UpdateEmail(ctx, emailChangeUserId, emailChangeNewEmail).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	assert.NoError(t, svc.ConfirmChange(ctx, emailChangeUserId, emailChangeNewEmail))
+}
+
+func TestConfirmEmailChange_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockEmailChangeRepository, mockPgx, mockTx, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockEmailChangeRepository.EXPECT().WithTx(mockTx).Return(mockEmailChangeRepository)
+	mockEmailChangeRepository.EXPECT().Delete(ctx, emailChangeUserId, emailChangeNewEmail).Return(false, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ConfirmChange(ctx, emailChangeUserId, emailChangeNewEmail), service.ErrEmailChangeNotFound)
+}
+
+func TestConfirmEmailChange_EmailTaken(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockEmailChangeRepository, mockPgx, mockTx, _ := setupEmailChange(t)
+	defer ctrl.Finish()
+
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockEmailChangeRepository.EXPECT().WithTx(mockTx).Return(mockEmailChangeRepository)
+	mockEmailChangeRepository.EXPECT().Delete(ctx, emailChangeUserId, emailChangeNewEmail).Return(true, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().UpdateEmail(ctx, emailChangeUserId, emailChangeNewEmail).Return(false, &pgconn.PgError{Code: pgerrcode.UniqueViolation})
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.ConfirmChange(ctx, emailChangeUserId, emailChangeNewEmail), service.ErrUserEmailTaken)
+}
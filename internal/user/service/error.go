@@ -1,17 +1,73 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrUserNotFound   = errors.New("user not found")
 	ErrUserNotUpdated = errors.New("user not updated")
 	ErrUserEmailTaken = errors.New("user email is taken")
+	ErrEmailUnchanged = errors.New("new email is the same as the current one")
 	ErrIncorrectPassword = errors.New("incorrect password")
 	ErrIncorrectEmailOrPassword = errors.New("incorrect email or password")
+	ErrUserSuspended = errors.New("user is suspended")
 
 	ErrUserVerified          = errors.New("user is already verified")
 	ErrUserNotRegistered     = errors.New("could not register user")
 	ErrProviderNotRegistered = errors.New("could not register provider")
 	ErrVerificationTokenUsed = errors.New("user verification token already used")
+
+	ErrPasswordResetTokenUsed = errors.New("password reset token already used")
+
+	ErrEmailChangeNotFound = errors.New("email change not found")
+
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrRefreshTokenReused = errors.New("refresh token already used, session revoked")
+
+	ErrTwoFactorEnabled     = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled = errors.New("two-factor authentication is not enrolled")
+	ErrTwoFactorNotEnabled  = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTwoFactorCode = errors.New("invalid two-factor code")
+
+	ErrTwoFactorAttemptsExceeded = errors.New("too many two-factor attempts, try again later")
+	ErrTwoFactorChallengeUsed    = errors.New("two-factor challenge already used, log in again")
+
+	ErrLoginLocked = errors.New("too many failed login attempts, try again later")
+
+	ErrAdminSelfAction   = errors.New("admins can't change their own role or suspend themselves")
+	ErrUserRoleUnchanged = errors.New("user already has the role")
+	ErrUserNotSuspended  = errors.New("user is not suspended")
+
+	ErrPhoneVerified             = errors.New("phone number is already verified")
+	ErrPhoneNumberMissing        = errors.New("phone number is missing, add it to the profile first")
+	ErrPhoneCodeRecentlySent     = errors.New("phone verification code was sent recently, try again later")
+	ErrPhoneCodeNotFound         = errors.New("phone verification code not found")
+	ErrInvalidPhoneCode          = errors.New("invalid phone verification code")
+	ErrPhoneCodeAttemptsExceeded = errors.New("too many phone verification attempts, request a new code")
+
+	ErrOIDCStateNotFound = errors.New("login state not found or expired")
+	ErrOIDCLoginFailed   = errors.New("identity provider login failed")
+	ErrOIDCEmailMissing  = errors.New("identity provider did not share the email")
+	ErrOIDCEmailTooLong  = errors.New("email of the identity provider is too long to register")
+
+	ErrProviderNotFound    = errors.New("provider not found")
+	ErrProviderApproved    = errors.New("provider is already approved")
+	ErrProviderNotPending  = errors.New("provider verification is not pending review")
+	ErrProviderNotApproved = errors.New("provider is not approved")
 )
 
+// LoginLockedError is returned while logins are locked after failed attempts, it unwraps to ErrLoginLocked.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return ErrLoginLocked.Error()
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
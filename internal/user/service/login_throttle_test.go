@@ -0,0 +1,290 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/hasher"
+	mock_hasher "github.com/hexley21/fixup/pkg/hasher/mock"
+	mock_mailer "github.com/hexley21/fixup/pkg/mailer/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	loginUserId       int64 = 1
+	loginEmail              = "Larry@Page.com"
+	loginEmailSubject       = "email:larry@page.com"
+	loginIP                 = "10.0.0.1"
+	loginIPSubject          = "ip:10.0.0.1"
+	loginPassword           = "Password123!"
+	loginHash               = "hash"
+	loginEmailAddress       = "fixup@gmail.com"
+)
+
+var (
+	loginThrottle = config.LoginThrottle{
+		MaxAttempts:   3,
+		MaxIPAttempts: 10,
+		BaseDelay:     time.Second,
+		Lockout:       15 * time.Minute,
+		Window:        time.Hour,
+	}
+
+	loginLockoutTemplate = template.New("login_lockout")
+
+	loginAuthInfo = repository.GetUserAuthInfoByEmailRow{
+		ID:       loginUserId,
+		Role:     string(enum.UserRoleCUSTOMER),
+		Verified: pgtype.Bool{Bool: true, Valid: true},
+		Hash:     loginHash,
+	}
+)
+
+func setupLoginThrottle(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.AuthService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockLoginAttemptRepository *mock_repository.MockLoginAttemptRepository,
+	mockHasher *mock_hasher.MockHasher,
+	mockMailer *mock_mailer.MockMailer,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockLoginAttemptRepository = mock_repository.NewMockLoginAttemptRepository(ctrl)
+	mockHasher = mock_hasher.NewMockHasher(ctrl)
+	mockMailer = mock_mailer.NewMockMailer(ctrl)
+
+	s := service.NewAuthService(
+		mockUserRepository,
+		nil,
+		nil,
+		time.Hour,
+		nil,
+		time.Hour,
+		nil,
+		mockLoginAttemptRepository,
+		loginThrottle,
+		nil,
+		mockHasher,
+		nil,
+		mockMailer,
+		loginEmailAddress,
+	)
+	s.SetTemplates(nil, nil, nil, loginLockoutTemplate)
+
+	svc = s
+	return
+}
+
+func expectNoLoginLock(ctx context.Context, mockLoginAttemptRepository *mock_repository.MockLoginAttemptRepository) {
+	mockLoginAttemptRepository.EXPECT().GetLock(ctx, loginEmailSubject).Return(time.Duration(0), nil)
+	mockLoginAttemptRepository.EXPECT().GetLock(ctx, loginIPSubject).Return(time.Duration(0), nil)
+}
+
+func TestAuthenticateUser_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(loginAuthInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(nil)
+	mockLoginAttemptRepository.EXPECT().Reset(ctx, loginEmailSubject).Return(nil)
+
+	identity, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	if assert.NoError(t, err) {
+		assert.Equal(t, loginUserId, identity.ID)
+		assert.Equal(t, enum.UserRoleCUSTOMER, identity.AccountInfo.Role)
+	}
+}
+
+func TestAuthenticateUser_Suspended(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	authInfo := loginAuthInfo
+	authInfo.Suspended = true
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(authInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	assert.ErrorIs(t, err, service.ErrUserSuspended)
+}
+
+func TestAuthenticateUser_RestoresDeactivated(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	authInfo := loginAuthInfo
+	authInfo.Deactivated = true
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(authInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(nil)
+	mockLoginAttemptRepository.EXPECT().Reset(ctx, loginEmailSubject).Return(nil)
+	mockUserRepository.EXPECT().Restore(ctx, loginUserId).Return(true, nil)
+
+	identity, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	if assert.NoError(t, err) {
+		assert.Equal(t, loginUserId, identity.ID)
+	}
+}
+
+func TestAuthenticateUser_DeactivatedPendingTwoFactor(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	authInfo := loginAuthInfo
+	authInfo.Deactivated = true
+	authInfo.TwoFactorEnabled = true
+
+	// the user is restored only once the second factor passes
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(authInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(nil)
+	mockLoginAttemptRepository.EXPECT().Reset(ctx, loginEmailSubject).Return(nil)
+
+	identity, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	if assert.NoError(t, err) {
+		assert.True(t, identity.TwoFactorPending)
+	}
+}
+
+func TestRefreshUserToken_Suspended(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().GetAccountInfo(ctx, loginUserId).Return(repository.GetUserAccountInfoRow{
+		Role:      string(enum.UserRoleCUSTOMER),
+		Suspended: true,
+	}, nil)
+
+	token, err := svc.RefreshUserToken(ctx, loginUserId, func(role enum.UserRole, verified bool, phoneVerified bool) (string, error) {
+		t.Fatal("token of a suspended user must not be generated")
+		return "", nil
+	})
+	assert.ErrorIs(t, err, service.ErrUserSuspended)
+	assert.Empty(t, token)
+}
+
+func TestAuthenticateUser_Locked(t *testing.T) {
+	ctrl, ctx, svc, _, mockLoginAttemptRepository, _, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	mockLoginAttemptRepository.EXPECT().GetLock(ctx, loginEmailSubject).Return(2*time.Second, nil)
+	mockLoginAttemptRepository.EXPECT().GetLock(ctx, loginIPSubject).Return(10*time.Minute, nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+
+	var lockedErr *service.LoginLockedError
+	if assert.ErrorAs(t, err, &lockedErr) {
+		assert.Equal(t, 10*time.Minute, lockedErr.RetryAfter)
+		assert.ErrorIs(t, err, service.ErrLoginLocked)
+	}
+}
+
+func TestAuthenticateUser_FailureBacksOff(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(loginAuthInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(hasher.ErrPasswordMismatch)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginEmailSubject, loginThrottle.Window).Return(int64(2), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginEmailSubject, 2*time.Second).Return(nil)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginIPSubject, loginThrottle.Window).Return(int64(5), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginIPSubject, 2*time.Second).Return(nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	assert.ErrorIs(t, err, service.ErrIncorrectEmailOrPassword)
+}
+
+func TestAuthenticateUser_SharedIPBacksOffSlower(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	// failures of many users behind the IP, the email subject is fresh
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(loginAuthInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(hasher.ErrPasswordMismatch)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginEmailSubject, loginThrottle.Window).Return(int64(1), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginEmailSubject, time.Second).Return(nil)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginIPSubject, loginThrottle.Window).Return(int64(loginThrottle.MaxIPAttempts-1), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginIPSubject, 4*time.Second).Return(nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	assert.ErrorIs(t, err, service.ErrIncorrectEmailOrPassword)
+}
+
+func TestAuthenticateUser_LockoutSendsLetter(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, mockMailer := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(loginAuthInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(hasher.ErrPasswordMismatch)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginEmailSubject, loginThrottle.Window).Return(int64(3), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginEmailSubject, loginThrottle.Lockout).Return(nil)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginIPSubject, loginThrottle.Window).Return(int64(3), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginIPSubject, time.Second).Return(nil)
+	mockUserRepository.EXPECT().GetVerificationInfo(ctx, loginEmail).Return(repository.GetUserVerificationInfoRow{ID: loginUserId, FirstName: "Larry"}, nil)
+	mockMailer.EXPECT().SendHTML(loginEmailAddress, loginEmail, gomock.Any(), loginLockoutTemplate, gomock.Any()).Return(nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+
+	var lockedErr *service.LoginLockedError
+	if assert.ErrorAs(t, err, &lockedErr) {
+		assert.Equal(t, loginThrottle.Lockout, lockedErr.RetryAfter)
+	}
+}
+
+func TestAuthenticateUser_LockoutOfUnknownEmail(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, _, _ := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(repository.GetUserAuthInfoByEmailRow{}, pgx.ErrNoRows)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginEmailSubject, loginThrottle.Window).Return(int64(4), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginEmailSubject, loginThrottle.Lockout).Return(nil)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginIPSubject, loginThrottle.Window).Return(int64(1), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginIPSubject, time.Second).Return(nil)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	assert.ErrorIs(t, err, service.ErrLoginLocked)
+}
+
+func TestAuthenticateUser_LockoutLetterError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockLoginAttemptRepository, mockHasher, mockMailer := setupLoginThrottle(t)
+	defer ctrl.Finish()
+
+	mailErr := errors.New("smtp error")
+
+	expectNoLoginLock(ctx, mockLoginAttemptRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, loginEmail).Return(loginAuthInfo, nil)
+	mockHasher.EXPECT().VerifyPassword(loginPassword, loginHash).Return(hasher.ErrPasswordMismatch)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginEmailSubject, loginThrottle.Window).Return(int64(3), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginEmailSubject, loginThrottle.Lockout).Return(nil)
+	mockLoginAttemptRepository.EXPECT().AddFailure(ctx, loginIPSubject, loginThrottle.Window).Return(int64(12), nil)
+	mockLoginAttemptRepository.EXPECT().Lock(ctx, loginIPSubject, loginThrottle.Lockout).Return(nil)
+	mockUserRepository.EXPECT().GetVerificationInfo(ctx, loginEmail).Return(repository.GetUserVerificationInfoRow{ID: loginUserId, FirstName: "Larry"}, nil)
+	mockMailer.EXPECT().SendHTML(loginEmailAddress, loginEmail, gomock.Any(), loginLockoutTemplate, gomock.Any()).Return(mailErr)
+
+	_, err := svc.AuthenticateUser(ctx, loginEmail, loginPassword, loginIP)
+	assert.ErrorIs(t, err, service.ErrLoginLocked)
+	assert.ErrorIs(t, err, mailErr)
+}
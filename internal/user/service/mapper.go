@@ -8,12 +8,12 @@ import (
 )
 
 func MapUserModelToEntity(user repository.User) (*domain.User, error) {
-	accountInfo, err := MapUserAccountInfo(user.Role, user.Verified)
+	accountInfo, err := MapUserAccountInfo(user.Role, user.Verified, user.PhoneVerified)
 	if err != nil {
 		return nil, err
 	}
 
-	return domain.NewUser(
+	entity := domain.NewUser(
 		user.ID,
 		user.Picture.String,
 		domain.NewUserPersonalInfo(
@@ -24,10 +24,13 @@ func MapUserModelToEntity(user repository.User) (*domain.User, error) {
 		),
 		accountInfo,
 		user.CreatedAt.Time,
-	), nil
+	)
+	entity.Suspended = user.Suspended
+
+	return entity, nil
 }
 
-func MapUserAccountInfo(r string, verifier pgtype.Bool) (domain.UserAccountInfo, error) {
+func MapUserAccountInfo(r string, verifier pgtype.Bool, phoneVerified pgtype.Bool) (domain.UserAccountInfo, error) {
 	role, err := enum.ParseRole(r)
 	if err != nil {
 		return domain.UserAccountInfo{}, err
@@ -36,14 +39,58 @@ func MapUserAccountInfo(r string, verifier pgtype.Bool) (domain.UserAccountInfo,
 	return domain.NewUserAccountInfo(
 		role,
 		verifier.Bool,
+		phoneVerified.Bool,
 	), nil
 }
 
-func MapUserIdentity(id int64, r string, verifier pgtype.Bool) (domain.UserIdentity, error) {
-	accountInfo, err := MapUserAccountInfo(r, verifier)
+func MapUserIdentity(id int64, r string, verifier pgtype.Bool, phoneVerified pgtype.Bool) (domain.UserIdentity, error) {
+	accountInfo, err := MapUserAccountInfo(r, verifier, phoneVerified)
 	if err != nil {
 		return domain.UserIdentity{}, err
 	}
 
 	return domain.NewUserIdentity(id, accountInfo), nil
-}
\ No newline at end of file
+}
+
+func MapAuditLogModelToEntity(log repository.UserAuditLog) domain.AuditLog {
+	return domain.NewAuditLog(
+		log.ID,
+		log.UserID,
+		log.ActorID,
+		domain.AuditAction(log.Action),
+		log.OldValue.String,
+		log.NewValue.String,
+		log.Reason.String,
+		log.CreatedAt.Time,
+	)
+}
+
+func MapProviderVerificationModelToEntity(verification repository.ProviderVerificationRow, documents []repository.ProviderDocument) domain.ProviderVerification {
+	documentEntities := make([]domain.ProviderDocument, len(documents))
+	for i, document := range documents {
+		documentEntities[i] = domain.NewProviderDocument(document.ID, document.Path, document.CreatedAt.Time)
+	}
+
+	return domain.NewProviderVerification(
+		verification.UserID,
+		verification.FirstName,
+		verification.LastName,
+		verification.Email,
+		verification.PersonalIDPreview,
+		domain.ProviderVerificationStatus(verification.VerificationStatus),
+		verification.RejectionReason.String,
+		verification.SubmittedAt.Time,
+		verification.ReviewedAt.Time,
+		documentEntities,
+	)
+}
+
+func MapSessionModelToEntity(session repository.SessionModel) domain.Session {
+	return domain.NewSession(
+		session.ID,
+		session.UserID,
+		domain.NewSessionDevice(session.UserAgent, session.IP),
+		session.CreatedAt,
+		session.LastUsedAt,
+	)
+}
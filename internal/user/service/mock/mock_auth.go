@@ -43,22 +43,22 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 }
 
 // AuthenticateUser mocks base method.
-func (m *MockAuthService) AuthenticateUser(ctx context.Context, email, password string) (domain.UserIdentity, error) {
+func (m *MockAuthService) AuthenticateUser(ctx context.Context, email, password, ip string) (domain.UserIdentity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthenticateUser", ctx, email, password)
+	ret := m.ctrl.Call(m, "AuthenticateUser", ctx, email, password, ip)
 	ret0, _ := ret[0].(domain.UserIdentity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthenticateUser indicates an expected call of AuthenticateUser.
-func (mr *MockAuthServiceMockRecorder) AuthenticateUser(ctx, email, password any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) AuthenticateUser(ctx, email, password, ip any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockAuthService)(nil).AuthenticateUser), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockAuthService)(nil).AuthenticateUser), ctx, email, password, ip)
 }
 
 // RefreshUserToken mocks base method.
-func (m *MockAuthService) RefreshUserToken(ctx context.Context, id int64, tokenFunc func(enum.UserRole, bool) (string, error)) (string, error) {
+func (m *MockAuthService) RefreshUserToken(ctx context.Context, id int64, tokenFunc func(enum.UserRole, bool, bool) (string, error)) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RefreshUserToken", ctx, id, tokenFunc)
 	ret0, _ := ret[0].(string)
@@ -116,6 +116,34 @@ func (mr *MockAuthServiceMockRecorder) ResendVerificationLetter(ctx, tokenFunc,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerificationLetter", reflect.TypeOf((*MockAuthService)(nil).ResendVerificationLetter), ctx, tokenFunc, email)
 }
 
+// ResetPassword mocks base method.
+func (m *MockAuthService) ResetPassword(ctx context.Context, token string, ttl time.Duration, id int64, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, ttl, id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceMockRecorder) ResetPassword(ctx, token, ttl, id, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthService)(nil).ResetPassword), ctx, token, ttl, id, password)
+}
+
+// SendPasswordResetLetter mocks base method.
+func (m *MockAuthService) SendPasswordResetLetter(ctx context.Context, tokenFunc func(int64) (string, error), email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetLetter", ctx, tokenFunc, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetLetter indicates an expected call of SendPasswordResetLetter.
+func (mr *MockAuthServiceMockRecorder) SendPasswordResetLetter(ctx, tokenFunc, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetLetter", reflect.TypeOf((*MockAuthService)(nil).SendPasswordResetLetter), ctx, tokenFunc, email)
+}
+
 // SendVerificationLetter mocks base method.
 func (m *MockAuthService) SendVerificationLetter(ctx context.Context, token, email, name string) error {
 	m.ctrl.T.Helper()
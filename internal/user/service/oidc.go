@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/hasher"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/oidc"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	oidcRandomBytes = 32
+	maxNameLength   = 30
+	maxEmailLength  = 40
+)
+
+type OIDCService interface {
+	BeginLogin(ctx context.Context) (string, error)
+	CompleteLogin(ctx context.Context, state string, code string) (domain.UserIdentity, error)
+}
+
+type oidcServiceImpl struct {
+	userRepository         repository.UserRepository
+	userIdentityRepository repository.UserIdentityRepository
+	oidcStateRepository    repository.OIDCStateRepository
+	stateTTL               time.Duration
+	provider               oidc.Provider
+	providerName           string
+	pgx                    postgres.PGX
+	hasher                 hasher.Hasher
+}
+
+func NewOIDCService(
+	userRepository repository.UserRepository,
+	userIdentityRepository repository.UserIdentityRepository,
+	oidcStateRepository repository.OIDCStateRepository,
+	stateTTL time.Duration,
+	provider oidc.Provider,
+	providerName string,
+	pgx postgres.PGX,
+	hasher hasher.Hasher,
+) *oidcServiceImpl {
+	return &oidcServiceImpl{
+		userRepository:         userRepository,
+		userIdentityRepository: userIdentityRepository,
+		oidcStateRepository:    oidcStateRepository,
+		stateTTL:               stateTTL,
+		provider:               provider,
+		providerName:           providerName,
+		pgx:                    pgx,
+		hasher:                 hasher,
+	}
+}
+
+// BeginLogin starts a login through the provider and returns the URL of its consent page.
+// The state, nonce and PKCE verifier of the login are kept until the callback for the state ttl.
+func (s *oidcServiceImpl) BeginLogin(ctx context.Context) (string, error) {
+	state, err := oidc.RandomString(oidcRandomBytes)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oidc.RandomString(oidcRandomBytes)
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oidc.RandomString(oidcRandomBytes)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.oidcStateRepository.Set(ctx, state, repository.OIDCState{CodeVerifier: codeVerifier, Nonce: nonce}, s.stateTTL)
+	if err != nil {
+		return "", err
+	}
+
+	return s.provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(codeVerifier))
+}
+
+// CompleteLogin exchanges the authorization code of the callback and returns the identity of the user linked to the provider's subject.
+// On first login the subject is linked to the user with the same email if the provider verified it, otherwise a customer is registered.
+// A deactivated user is restored, unless the login is pending the second factor, which restores the user once it passes.
+// It returns ErrOIDCStateNotFound if the state is unknown, expired or already used, ErrOIDCLoginFailed if the provider rejects
+// the code or the ID token is invalid, ErrUserEmailTaken if another user has the email the provider did not verify,
+// ErrOIDCEmailTooLong if a customer can't be registered with the email and ErrUserSuspended if the user is suspended.
+func (s *oidcServiceImpl) CompleteLogin(ctx context.Context, state string, code string) (domain.UserIdentity, error) {
+	loginState, err := s.oidcStateRepository.Pop(ctx, state)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return domain.UserIdentity{}, ErrOIDCStateNotFound
+		}
+		return domain.UserIdentity{}, err
+	}
+
+	claims, err := s.provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		if errors.Is(err, oidc.ErrExchange) || errors.Is(err, oidc.ErrInvalidIDToken) {
+			return domain.UserIdentity{}, errors.Join(ErrOIDCLoginFailed, err)
+		}
+		return domain.UserIdentity{}, err
+	}
+
+	authInfo, err := s.userIdentityRepository.GetAuthInfo(ctx, s.providerName, claims.Subject)
+	if err == nil {
+		if authInfo.Suspended {
+			return domain.UserIdentity{}, ErrUserSuspended
+		}
+		if authInfo.Deactivated && !authInfo.TwoFactorEnabled {
+			if _, err := s.userRepository.Restore(ctx, authInfo.ID); err != nil {
+				return domain.UserIdentity{}, err
+			}
+		}
+		return mapIdentityAuthInfo(authInfo.ID, authInfo.Role, authInfo.Verified, authInfo.PhoneVerified, authInfo.TwoFactorEnabled)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return domain.UserIdentity{}, err
+	}
+
+	return s.linkIdentity(ctx, claims)
+}
+
+// linkIdentity links the subject to the user with the email, or to a new customer if there is none.
+func (s *oidcServiceImpl) linkIdentity(ctx context.Context, claims oidc.Claims) (domain.UserIdentity, error) {
+	if claims.Email == "" {
+		return domain.UserIdentity{}, ErrOIDCEmailMissing
+	}
+
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	authInfo, err := s.userRepository.WithTx(tx).GetAuthInfoByEmail(ctx, claims.Email)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.UserIdentity{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	var identity domain.UserIdentity
+	if err == nil {
+		// an unverified email could belong to anyone, so it can't take over the account
+		if !claims.EmailVerified {
+			return domain.UserIdentity{}, postgres.Rollback(tx, ctx, ErrUserEmailTaken)
+		}
+		if authInfo.Suspended {
+			return domain.UserIdentity{}, postgres.Rollback(tx, ctx, ErrUserSuspended)
+		}
+		if authInfo.Deactivated && !authInfo.TwoFactorEnabled {
+			if _, err := s.userRepository.WithTx(tx).Restore(ctx, authInfo.ID); err != nil {
+				return domain.UserIdentity{}, postgres.Rollback(tx, ctx, err)
+			}
+		}
+
+		identity, err = mapIdentityAuthInfo(authInfo.ID, authInfo.Role, authInfo.Verified, authInfo.PhoneVerified, authInfo.TwoFactorEnabled)
+	} else {
+		identity, err = s.registerCustomer(ctx, tx, claims)
+	}
+	if err != nil {
+		return domain.UserIdentity{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := s.userIdentityRepository.WithTx(tx).Create(ctx, s.providerName, claims.Subject, identity.ID); err != nil {
+		return domain.UserIdentity{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	return identity, nil
+}
+
+// registerCustomer creates a customer with the provider's profile, it's verified if the provider verified the email.
+// The password is random, so the user can set one only by resetting it.
+func (s *oidcServiceImpl) registerCustomer(ctx context.Context, tx pgx.Tx, claims oidc.Claims) (domain.UserIdentity, error) {
+	// unlike the names, the email can't be truncated
+	if utf8.RuneCountInString(claims.Email) > maxEmailLength {
+		return domain.UserIdentity{}, ErrOIDCEmailTooLong
+	}
+
+	password, err := oidc.RandomString(oidcRandomBytes)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	hash, err := s.hasher.HashPassword(password)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	userModel, err := s.userRepository.WithTx(tx).Create(ctx,
+		repository.CreateUserParams{
+			FirstName: truncateName(claims.GivenName),
+			LastName:  truncateName(claims.FamilyName),
+			Email:     claims.Email,
+			Hash:      hash,
+			Role:      string(enum.UserRoleCUSTOMER),
+		},
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return domain.UserIdentity{}, ErrUserEmailTaken
+			}
+		}
+		return domain.UserIdentity{}, err
+	}
+
+	if claims.EmailVerified {
+		if _, err := s.userRepository.WithTx(tx).UpdateVerification(ctx, userModel.ID, true); err != nil {
+			return domain.UserIdentity{}, err
+		}
+		userModel.Verified = pgtype.Bool{Bool: true, Valid: true}
+	}
+
+	return MapUserIdentity(userModel.ID, userModel.Role, userModel.Verified, userModel.PhoneVerified)
+}
+
+func mapIdentityAuthInfo(id int64, role string, verified pgtype.Bool, phoneVerified pgtype.Bool, twoFactorEnabled bool) (domain.UserIdentity, error) {
+	identity, err := MapUserIdentity(id, role, verified, phoneVerified)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+	identity.TwoFactorPending = twoFactorEnabled
+
+	return identity, nil
+}
+
+func truncateName(name string) string {
+	runes := []rune(name)
+	if len(runes) > maxNameLength {
+		return string(runes[:maxNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,290 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_hasher "github.com/hexley21/fixup/pkg/hasher/mock"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/hexley21/fixup/pkg/oidc"
+	mock_oidc "github.com/hexley21/fixup/pkg/oidc/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	oidcProviderName       = "google"
+	oidcState              = "state"
+	oidcCode               = "code"
+	oidcUserId       int64 = 1
+	oidcAuthURL            = "https://accounts.google.com/o/oauth2/v2/auth?state=state"
+)
+
+var (
+	oidcLoginState = repository.OIDCState{CodeVerifier: "verifier", Nonce: "nonce"}
+	oidcClaims     = oidc.Claims{
+		Subject:       "subject",
+		Email:         "larry@page.com",
+		EmailVerified: true,
+		GivenName:     "Larry",
+		FamilyName:    "Page",
+	}
+	oidcVerified = pgtype.Bool{Bool: true, Valid: true}
+)
+
+func setupOIDC(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.OIDCService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockUserIdentityRepository *mock_repository.MockUserIdentityRepository,
+	mockOIDCStateRepository *mock_repository.MockOIDCStateRepository,
+	mockProvider *mock_oidc.MockProvider,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	mockHasher *mock_hasher.MockHasher,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockUserIdentityRepository = mock_repository.NewMockUserIdentityRepository(ctrl)
+	mockOIDCStateRepository = mock_repository.NewMockOIDCStateRepository(ctrl)
+	mockProvider = mock_oidc.NewMockProvider(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	mockHasher = mock_hasher.NewMockHasher(ctrl)
+
+	svc = service.NewOIDCService(
+		mockUserRepository,
+		mockUserIdentityRepository,
+		mockOIDCStateRepository,
+		10*time.Minute,
+		mockProvider,
+		oidcProviderName,
+		mockPgx,
+		mockHasher,
+	)
+	return
+}
+
+func TestBeginLogin_Success(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOIDCStateRepository, mockProvider, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	var storedState string
+	var storedLoginState repository.OIDCState
+	mockOIDCStateRepository.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), 10*time.Minute).
+		DoAndReturn(func(_ context.Context, state string, value repository.OIDCState, _ time.Duration) error {
+			storedState = state
+			storedLoginState = value
+			return nil
+		})
+	mockProvider.EXPECT().AuthCodeURL(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, state string, nonce string, codeChallenge string) (string, error) {
+			assert.Equal(t, storedState, state)
+			assert.Equal(t, storedLoginState.Nonce, nonce)
+			assert.Equal(t, oidc.CodeChallenge(storedLoginState.CodeVerifier), codeChallenge)
+			return oidcAuthURL, nil
+		})
+
+	url, err := svc.BeginLogin(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, oidcAuthURL, url)
+	assert.NotEmpty(t, storedState)
+	assert.NotEqual(t, storedState, storedLoginState.Nonce)
+}
+
+func TestBeginLogin_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOIDCStateRepository, _, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New(""))
+
+	url, err := svc.BeginLogin(ctx)
+	assert.Error(t, err)
+	assert.Empty(t, url)
+}
+
+func TestCompleteLogin_LinkedIdentity(t *testing.T) {
+	ctrl, ctx, svc, _, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(oidcClaims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, oidcClaims.Subject).Return(repository.GetUserIdentityAuthInfoRow{
+		ID:               oidcUserId,
+		Role:             string(enum.UserRolePROVIDER),
+		Verified:         oidcVerified,
+		TwoFactorEnabled: true,
+		// the user is restored only once the second factor passes
+		Deactivated: true,
+	}, nil)
+
+	identity, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.NoError(t, err)
+	assert.Equal(t, oidcUserId, identity.ID)
+	assert.Equal(t, enum.UserRolePROVIDER, identity.AccountInfo.Role)
+	assert.True(t, identity.AccountInfo.Verified)
+	assert.True(t, identity.TwoFactorPending)
+}
+
+func TestCompleteLogin_RestoresDeactivated(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(oidcClaims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, oidcClaims.Subject).Return(repository.GetUserIdentityAuthInfoRow{
+		ID:          oidcUserId,
+		Role:        string(enum.UserRoleCUSTOMER),
+		Verified:    oidcVerified,
+		Deactivated: true,
+	}, nil)
+	mockUserRepository.EXPECT().Restore(ctx, oidcUserId).Return(true, nil)
+
+	identity, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.NoError(t, err)
+	assert.Equal(t, oidcUserId, identity.ID)
+	assert.False(t, identity.TwoFactorPending)
+}
+
+func TestCompleteLogin_StateNotFound(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOIDCStateRepository, _, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(repository.OIDCState{}, redis.Nil)
+
+	_, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.ErrorIs(t, err, service.ErrOIDCStateNotFound)
+}
+
+func TestCompleteLogin_ExchangeFailed(t *testing.T) {
+	ctrl, ctx, svc, _, _, mockOIDCStateRepository, mockProvider, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(oidc.Claims{}, oidc.ErrInvalidIDToken)
+
+	_, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.ErrorIs(t, err, service.ErrOIDCLoginFailed)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
+
+func TestCompleteLogin_LinkExistingUser(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, mockPgx, mockTx, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(oidcClaims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, oidcClaims.Subject).Return(repository.GetUserIdentityAuthInfoRow{}, pgx.ErrNoRows)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, oidcClaims.Email).Return(repository.GetUserAuthInfoByEmailRow{
+		ID:       oidcUserId,
+		Role:     string(enum.UserRoleCUSTOMER),
+		Verified: oidcVerified,
+	}, nil)
+	mockUserIdentityRepository.EXPECT().WithTx(mockTx).Return(mockUserIdentityRepository)
+	mockUserIdentityRepository.EXPECT().Create(ctx, oidcProviderName, oidcClaims.Subject, oidcUserId).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	identity, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.NoError(t, err)
+	assert.Equal(t, oidcUserId, identity.ID)
+	assert.Equal(t, enum.UserRoleCUSTOMER, identity.AccountInfo.Role)
+	assert.False(t, identity.TwoFactorPending)
+}
+
+func TestCompleteLogin_UnverifiedEmailTaken(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, mockPgx, mockTx, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	claims := oidcClaims
+	claims.EmailVerified = false
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(claims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, claims.Subject).Return(repository.GetUserIdentityAuthInfoRow{}, pgx.ErrNoRows)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, claims.Email).Return(repository.GetUserAuthInfoByEmailRow{ID: oidcUserId}, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	_, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.ErrorIs(t, err, service.ErrUserEmailTaken)
+}
+
+func TestCompleteLogin_RegisterCustomer(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, mockPgx, mockTx, mockHasher := setupOIDC(t)
+	defer ctrl.Finish()
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(oidcClaims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, oidcClaims.Subject).Return(repository.GetUserIdentityAuthInfoRow{}, pgx.ErrNoRows)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository).Times(3)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, oidcClaims.Email).Return(repository.GetUserAuthInfoByEmailRow{}, pgx.ErrNoRows)
+	mockHasher.EXPECT().HashPassword(gomock.Any()).Return("hash", nil)
+	mockUserRepository.EXPECT().Create(ctx, repository.CreateUserParams{
+		FirstName: oidcClaims.GivenName,
+		LastName:  oidcClaims.FamilyName,
+		Email:     oidcClaims.Email,
+		Hash:      "hash",
+		Role:      string(enum.UserRoleCUSTOMER),
+	}).Return(repository.User{ID: oidcUserId, Role: string(enum.UserRoleCUSTOMER)}, nil)
+	mockUserRepository.EXPECT().UpdateVerification(ctx, oidcUserId, true).Return(true, nil)
+	mockUserIdentityRepository.EXPECT().WithTx(mockTx).Return(mockUserIdentityRepository)
+	mockUserIdentityRepository.EXPECT().Create(ctx, oidcProviderName, oidcClaims.Subject, oidcUserId).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	identity, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.NoError(t, err)
+	assert.Equal(t, oidcUserId, identity.ID)
+	assert.True(t, identity.AccountInfo.Verified)
+	assert.False(t, identity.AccountInfo.PhoneVerified)
+}
+
+func TestCompleteLogin_EmailTooLong(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, mockPgx, mockTx, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	claims := oidcClaims
+	claims.Email = strings.Repeat("a", 30) + "@example.com"
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(claims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, claims.Subject).Return(repository.GetUserIdentityAuthInfoRow{}, pgx.ErrNoRows)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository)
+	mockUserRepository.EXPECT().GetAuthInfoByEmail(ctx, claims.Email).Return(repository.GetUserAuthInfoByEmailRow{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	_, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.ErrorIs(t, err, service.ErrOIDCEmailTooLong)
+}
+
+func TestCompleteLogin_EmailMissing(t *testing.T) {
+	ctrl, ctx, svc, _, mockUserIdentityRepository, mockOIDCStateRepository, mockProvider, _, _, _ := setupOIDC(t)
+	defer ctrl.Finish()
+
+	claims := oidcClaims
+	claims.Email = ""
+
+	mockOIDCStateRepository.EXPECT().Pop(ctx, oidcState).Return(oidcLoginState, nil)
+	mockProvider.EXPECT().Exchange(ctx, oidcCode, oidcLoginState.CodeVerifier, oidcLoginState.Nonce).Return(claims, nil)
+	mockUserIdentityRepository.EXPECT().GetAuthInfo(ctx, oidcProviderName, claims.Subject).Return(repository.GetUserIdentityAuthInfoRow{}, pgx.ErrNoRows)
+
+	_, err := svc.CompleteLogin(ctx, oidcState, oidcCode)
+	assert.ErrorIs(t, err, service.ErrOIDCEmailMissing)
+}
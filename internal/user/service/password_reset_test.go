@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"html/template"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
+	mock_hasher "github.com/hexley21/fixup/pkg/hasher/mock"
+	mock_mailer "github.com/hexley21/fixup/pkg/mailer/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	resetUserId       int64 = 1
+	resetEmail              = "larry@page.com"
+	resetToken              = "reset-token"
+	resetPassword           = "Password123!"
+	resetHash               = "hash"
+	resetEmailAddress       = "fixup@gmail.com"
+
+	resetTokenTTL = 30 * time.Minute
+)
+
+var passwordResetTemplate = template.New("password_reset")
+
+func setupPasswordReset(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.AuthService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockResetRepository *mock_repository.MockPasswordResetRepository,
+	mockSessionRepository *mock_repository.MockSessionRepository,
+	mockHasher *mock_hasher.MockHasher,
+	mockMailer *mock_mailer.MockMailer,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockResetRepository = mock_repository.NewMockPasswordResetRepository(ctrl)
+	mockSessionRepository = mock_repository.NewMockSessionRepository(ctrl)
+	mockHasher = mock_hasher.NewMockHasher(ctrl)
+	mockMailer = mock_mailer.NewMockMailer(ctrl)
+
+	s := service.NewAuthService(
+		mockUserRepository,
+		nil,
+		nil,
+		time.Hour,
+		mockResetRepository,
+		resetTokenTTL,
+		mockSessionRepository,
+		nil,
+		config.LoginThrottle{},
+		nil,
+		mockHasher,
+		nil,
+		mockMailer,
+		resetEmailAddress,
+	)
+	s.SetTemplates(nil, nil, passwordResetTemplate, nil)
+
+	svc = s
+	return
+}
+
+func TestSendPasswordResetLetter_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, mockMailer := setupPasswordReset(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().GetVerificationInfo(ctx, resetEmail).Return(repository.GetUserVerificationInfoRow{ID: resetUserId, FirstName: "Larry"}, nil)
+	mockMailer.EXPECT().SendHTML(resetEmailAddress, resetEmail, gomock.Any(), passwordResetTemplate, gomock.Any()).Return(nil)
+
+	tokenFunc := func(id int64) (string, error) {
+		assert.Equal(t, resetUserId, id)
+		return resetToken, nil
+	}
+
+	assert.NoError(t, svc.SendPasswordResetLetter(ctx, tokenFunc, resetEmail))
+}
+
+func TestSendPasswordResetLetter_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _ := setupPasswordReset(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().GetVerificationInfo(ctx, resetEmail).Return(repository.GetUserVerificationInfoRow{}, pgx.ErrNoRows)
+
+	tokenFunc := func(id int64) (string, error) {
+		t.Fatal("token must not be generated for an unknown email")
+		return "", nil
+	}
+
+	assert.ErrorIs(t, svc.SendPasswordResetLetter(ctx, tokenFunc, resetEmail), service.ErrUserNotFound)
+}
+
+func TestResetPassword_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockResetRepository, mockSessionRepository, mockHasher, _ := setupPasswordReset(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockResetRepository.EXPECT().SetTokenUsed(ctx, resetToken, resetTokenTTL).Return(nil),
+		mockHasher.EXPECT().HashPassword(resetPassword).Return(resetHash, nil),
+		mockUserRepository.EXPECT().UpdateHash(ctx, resetUserId, resetHash).Return(true, nil),
+		mockSessionRepository.EXPECT().DeleteAll(ctx, resetUserId).Return(nil),
+	)
+
+	assert.NoError(t, svc.ResetPassword(ctx, resetToken, resetTokenTTL, resetUserId, resetPassword))
+}
+
+func TestResetPassword_TokenUsed(t *testing.T) {
+	ctrl, ctx, svc, _, mockResetRepository, _, _, _ := setupPasswordReset(t)
+	defer ctrl.Finish()
+
+	mockResetRepository.EXPECT().SetTokenUsed(ctx, resetToken, resetTokenTTL).Return(redis.TxFailedErr)
+
+	assert.ErrorIs(t, svc.ResetPassword(ctx, resetToken, resetTokenTTL, resetUserId, resetPassword), service.ErrPasswordResetTokenUsed)
+}
+
+func TestResetPassword_UserNotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockResetRepository, _, mockHasher, _ := setupPasswordReset(t)
+	defer ctrl.Finish()
+
+	mockResetRepository.EXPECT().SetTokenUsed(ctx, resetToken, resetTokenTTL).Return(nil)
+	mockHasher.EXPECT().HashPassword(resetPassword).Return(resetHash, nil)
+	mockUserRepository.EXPECT().UpdateHash(ctx, resetUserId, resetHash).Return(false, nil)
+
+	assert.ErrorIs(t, svc.ResetPassword(ctx, resetToken, resetTokenTTL, resetUserId, resetPassword), service.ErrUserNotFound)
+}
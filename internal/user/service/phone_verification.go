@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/hasher"
+	"github.com/hexley21/fixup/pkg/sms"
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const phoneCodeDigits = 6
+
+var phoneCodeMax = big.NewInt(1_000_000)
+
+type PhoneVerificationService interface {
+	SendCode(ctx context.Context, userID int64) error
+	VerifyCode(ctx context.Context, userID int64, code string) error
+}
+
+type phoneVerificationServiceImpl struct {
+	userRepository              repository.UserRepository
+	phoneVerificationRepository repository.PhoneVerificationRepository
+	hasher                      hasher.Hasher
+	sender                      sms.Sender
+	cfg                         config.PhoneVerification
+}
+
+func NewPhoneVerificationService(
+	userRepository repository.UserRepository,
+	phoneVerificationRepository repository.PhoneVerificationRepository,
+	hasher hasher.Hasher,
+	sender sms.Sender,
+	cfg config.PhoneVerification,
+) *phoneVerificationServiceImpl {
+	return &phoneVerificationServiceImpl{
+		userRepository:              userRepository,
+		phoneVerificationRepository: phoneVerificationRepository,
+		hasher:                      hasher,
+		sender:                      sender,
+		cfg:                         cfg,
+	}
+}
+
+// SendCode sends a one-time code to the user's phone number by SMS, the code is stored hashed until it expires.
+// Another code can't be sent until the resend interval passes, a new code replaces the previous one.
+// It returns ErrUserNotFound if the user does not exist, ErrPhoneVerified if the phone number is already verified,
+// ErrPhoneNumberMissing if the user has no phone number and ErrPhoneCodeRecentlySent if the previous code was sent within the resend interval.
+func (s *phoneVerificationServiceImpl) SendCode(ctx context.Context, userID int64) error {
+	userModel, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if userModel.PhoneVerified.Bool {
+		return ErrPhoneVerified
+	}
+	// users registered through an identity provider have no phone number
+	if userModel.PhoneNumber == "" {
+		return ErrPhoneNumberMissing
+	}
+
+	ok, err := s.phoneVerificationRepository.SetCooldown(ctx, userID, s.cfg.ResendInterval)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPhoneCodeRecentlySent
+	}
+
+	code, err := newPhoneCode()
+	if err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.HashPassword(code)
+	if err != nil {
+		return err
+	}
+
+	err = s.phoneVerificationRepository.Set(
+		ctx,
+		userID,
+		repository.PhoneVerificationCode{PhoneNumber: userModel.PhoneNumber, Hash: hash},
+		s.cfg.CodeTTL,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.sender.Send(
+		userModel.PhoneNumber,
+		fmt.Sprintf("Your Fixup verification code is %s, it expires in %d minutes.", code, int(s.cfg.CodeTTL.Minutes())),
+	)
+}
+
+// VerifyCode marks the user's phone number as verified if the code matches the pending one, which is used up then.
+// The code is dropped once the attempts run out, so a new one has to be requested.
+// It returns ErrPhoneCodeNotFound if there is no pending code or the phone number was changed since it was sent,
+// ErrPhoneCodeAttemptsExceeded if the attempts ran out and ErrInvalidPhoneCode if the code does not match.
+func (s *phoneVerificationServiceImpl) VerifyCode(ctx context.Context, userID int64, code string) error {
+	// the attempt is counted before the code is checked, so concurrent guesses can't exceed the limit
+	attempts, err := s.phoneVerificationRepository.AddAttempt(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrPhoneCodeNotFound
+		}
+		return err
+	}
+	if attempts > s.cfg.MaxAttempts {
+		return errors.Join(ErrPhoneCodeAttemptsExceeded, s.phoneVerificationRepository.Delete(ctx, userID))
+	}
+
+	pending, err := s.phoneVerificationRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrPhoneCodeNotFound
+		}
+		return err
+	}
+
+	if err := s.hasher.VerifyPassword(code, pending.Hash); err != nil {
+		if errors.Is(err, hasher.ErrPasswordMismatch) {
+			return ErrInvalidPhoneCode
+		}
+		return err
+	}
+
+	ok, err := s.userRepository.UpdatePhoneVerification(ctx, userID, pending.PhoneNumber)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Join(ErrPhoneCodeNotFound, s.phoneVerificationRepository.Delete(ctx, userID))
+	}
+
+	return s.phoneVerificationRepository.Delete(ctx, userID)
+}
+
+// newPhoneCode generates a random numeric code, padded with leading zeros.
+func newPhoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, phoneCodeMax)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", phoneCodeDigits, n), nil
+}
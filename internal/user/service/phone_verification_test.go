@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/hasher"
+	mock_hasher "github.com/hexley21/fixup/pkg/hasher/mock"
+	mock_sms "github.com/hexley21/fixup/pkg/sms/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	phoneUserId   int64 = 1
+	phoneNumber         = "995555123456"
+	phoneCode           = "123456"
+	phoneCodeHash       = "phone-code-hash"
+)
+
+var (
+	phoneVerificationCfg = config.PhoneVerification{
+		CodeTTL:        10 * time.Minute,
+		ResendInterval: time.Minute,
+		MaxAttempts:    3,
+	}
+
+	phoneUser         = repository.User{ID: phoneUserId, PhoneNumber: phoneNumber}
+	pendingPhoneCode  = repository.PhoneVerificationCode{PhoneNumber: phoneNumber, Hash: phoneCodeHash}
+	phoneCodeInTextRe = regexp.MustCompile(`\b\d{6}\b`)
+)
+
+func setupPhoneVerification(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.PhoneVerificationService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockPhoneVerificationRepository *mock_repository.MockPhoneVerificationRepository,
+	mockHasher *mock_hasher.MockHasher,
+	mockSender *mock_sms.MockSender,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockPhoneVerificationRepository = mock_repository.NewMockPhoneVerificationRepository(ctrl)
+	mockHasher = mock_hasher.NewMockHasher(ctrl)
+	mockSender = mock_sms.NewMockSender(ctrl)
+
+	svc = service.NewPhoneVerificationService(
+		mockUserRepository,
+		mockPhoneVerificationRepository,
+		mockHasher,
+		mockSender,
+		phoneVerificationCfg,
+	)
+
+	return
+}
+
+func TestSendPhoneCode_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockPhoneVerificationRepository, mockHasher, mockSender := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	var sentCode string
+	gomock.InOrder(
+		mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(phoneUser, nil),
+		mockPhoneVerificationRepository.EXPECT().SetCooldown(ctx, phoneUserId, phoneVerificationCfg.ResendInterval).Return(true, nil),
+		mockHasher.EXPECT().HashPassword(gomock.Any()).DoAndReturn(func(code string) (string, error) {
+			sentCode = code
+			return phoneCodeHash, nil
+		}),
+		mockPhoneVerificationRepository.EXPECT().Set(ctx, phoneUserId, pendingPhoneCode, phoneVerificationCfg.CodeTTL).Return(nil),
+		mockSender.EXPECT().Send(phoneNumber, gomock.Any()).DoAndReturn(func(to string, text string) error {
+			assert.Equal(t, sentCode, phoneCodeInTextRe.FindString(text))
+			return nil
+		}),
+	)
+
+	assert.NoError(t, svc.SendCode(ctx, phoneUserId))
+	assert.Len(t, sentCode, 6)
+}
+
+func TestSendPhoneCode_AlreadyVerified(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	verifiedUser := phoneUser
+	verifiedUser.PhoneVerified = pgtype.Bool{Bool: true, Valid: true}
+	mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(verifiedUser, nil)
+
+	assert.ErrorIs(t, svc.SendCode(ctx, phoneUserId), service.ErrPhoneVerified)
+}
+
+func TestSendPhoneCode_PhoneNumberMissing(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	oidcUser := phoneUser
+	oidcUser.PhoneNumber = ""
+	mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(oidcUser, nil)
+
+	assert.ErrorIs(t, svc.SendCode(ctx, phoneUserId), service.ErrPhoneNumberMissing)
+}
+
+func TestSendPhoneCode_RecentlySent(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockPhoneVerificationRepository, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(phoneUser, nil)
+	mockPhoneVerificationRepository.EXPECT().SetCooldown(ctx, phoneUserId, phoneVerificationCfg.ResendInterval).Return(false, nil)
+
+	assert.ErrorIs(t, svc.SendCode(ctx, phoneUserId), service.ErrPhoneCodeRecentlySent)
+}
+
+func TestSendPhoneCode_UserNotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(repository.User{}, pgx.ErrNoRows)
+
+	assert.ErrorIs(t, svc.SendCode(ctx, phoneUserId), service.ErrUserNotFound)
+}
+
+func TestSendPhoneCode_SenderError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockPhoneVerificationRepository, mockHasher, mockSender := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	sendErr := errors.New("gateway unavailable")
+
+	mockUserRepository.EXPECT().Get(ctx, phoneUserId).Return(phoneUser, nil)
+	mockPhoneVerificationRepository.EXPECT().SetCooldown(ctx, phoneUserId, phoneVerificationCfg.ResendInterval).Return(true, nil)
+	mockHasher.EXPECT().HashPassword(gomock.Any()).Return(phoneCodeHash, nil)
+	mockPhoneVerificationRepository.EXPECT().Set(ctx, phoneUserId, pendingPhoneCode, phoneVerificationCfg.CodeTTL).Return(nil)
+	mockSender.EXPECT().Send(phoneNumber, gomock.Any()).Return(sendErr)
+
+	assert.ErrorIs(t, svc.SendCode(ctx, phoneUserId), sendErr)
+}
+
+func TestVerifyPhoneCode_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockPhoneVerificationRepository, mockHasher, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		mockPhoneVerificationRepository.EXPECT().AddAttempt(ctx, phoneUserId).Return(int64(1), nil),
+		mockPhoneVerificationRepository.EXPECT().Get(ctx, phoneUserId).Return(pendingPhoneCode, nil),
+		mockHasher.EXPECT().VerifyPassword(phoneCode, phoneCodeHash).Return(nil),
+		mockUserRepository.EXPECT().UpdatePhoneVerification(ctx, phoneUserId, phoneNumber).Return(true, nil),
+		mockPhoneVerificationRepository.EXPECT().Delete(ctx, phoneUserId).Return(nil),
+	)
+
+	assert.NoError(t, svc.VerifyCode(ctx, phoneUserId, phoneCode))
+}
+
+func TestVerifyPhoneCode_InvalidCode(t *testing.T) {
+	ctrl, ctx, svc, _, mockPhoneVerificationRepository, mockHasher, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockPhoneVerificationRepository.EXPECT().AddAttempt(ctx, phoneUserId).Return(int64(1), nil)
+	mockPhoneVerificationRepository.EXPECT().Get(ctx, phoneUserId).Return(pendingPhoneCode, nil)
+	mockHasher.EXPECT().VerifyPassword(phoneCode, phoneCodeHash).Return(hasher.ErrPasswordMismatch)
+
+	assert.ErrorIs(t, svc.VerifyCode(ctx, phoneUserId, phoneCode), service.ErrInvalidPhoneCode)
+}
+
+func TestVerifyPhoneCode_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockPhoneVerificationRepository, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockPhoneVerificationRepository.EXPECT().AddAttempt(ctx, phoneUserId).Return(int64(0), redis.Nil)
+
+	assert.ErrorIs(t, svc.VerifyCode(ctx, phoneUserId, phoneCode), service.ErrPhoneCodeNotFound)
+}
+
+func TestVerifyPhoneCode_AttemptsExceeded(t *testing.T) {
+	ctrl, ctx, svc, _, mockPhoneVerificationRepository, _, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockPhoneVerificationRepository.EXPECT().AddAttempt(ctx, phoneUserId).Return(phoneVerificationCfg.MaxAttempts+1, nil)
+	mockPhoneVerificationRepository.EXPECT().Delete(ctx, phoneUserId).Return(nil)
+
+	assert.ErrorIs(t, svc.VerifyCode(ctx, phoneUserId, phoneCode), service.ErrPhoneCodeAttemptsExceeded)
+}
+
+func TestVerifyPhoneCode_PhoneChanged(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockPhoneVerificationRepository, mockHasher, _ := setupPhoneVerification(t)
+	defer ctrl.Finish()
+
+	mockPhoneVerificationRepository.EXPECT().AddAttempt(ctx, phoneUserId).Return(int64(1), nil)
+	mockPhoneVerificationRepository.EXPECT().Get(ctx, phoneUserId).Return(pendingPhoneCode, nil)
+	mockHasher.EXPECT().VerifyPassword(phoneCode, phoneCodeHash).Return(nil)
+	mockUserRepository.EXPECT().UpdatePhoneVerification(ctx, phoneUserId, phoneNumber).Return(false, nil)
+	mockPhoneVerificationRepository.EXPECT().Delete(ctx, phoneUserId).Return(nil)
+
+	assert.ErrorIs(t, svc.VerifyCode(ctx, phoneUserId, phoneCode), service.ErrPhoneCodeNotFound)
+}
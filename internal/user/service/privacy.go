@@ -0,0 +1,267 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/encryption"
+	"github.com/hexley21/fixup/pkg/infra/cdn"
+	"github.com/hexley21/fixup/pkg/infra/s3"
+	"github.com/jackc/pgx/v5"
+)
+
+// TODO: move this to config
+var exportDirectory = "exports/"
+
+type PrivacyService interface {
+	Export(ctx context.Context, userID int64) (string, error)
+	PurgeDeactivated(ctx context.Context, deactivatedBefore time.Time, limit int32) (int, error)
+}
+
+type privacyServiceImpl struct {
+	userRepository             repository.UserRepository
+	providerRepository         repository.ProviderRepository
+	providerDocumentRepository repository.ProviderDocumentRepository
+	s3Bucket                   s3.Bucket
+	cdnFileInvalidator         cdn.FileInvalidator
+	decryptor                  encryption.Decryptor
+}
+
+func NewPrivacyService(
+	userRepository repository.UserRepository,
+	providerRepository repository.ProviderRepository,
+	providerDocumentRepository repository.ProviderDocumentRepository,
+	s3Bucket s3.Bucket,
+	cdnFileInvalidator cdn.FileInvalidator,
+	decryptor encryption.Decryptor,
+) *privacyServiceImpl {
+	return &privacyServiceImpl{
+		userRepository:             userRepository,
+		providerRepository:         providerRepository,
+		providerDocumentRepository: providerDocumentRepository,
+		s3Bucket:                   s3Bucket,
+		cdnFileInvalidator:         cdnFileInvalidator,
+		decryptor:                  decryptor,
+	}
+}
+
+type exportedProfile struct {
+	ID            string    `json:"id"`
+	FirstName     string    `json:"first_name"`
+	LastName      string    `json:"last_name"`
+	Email         string    `json:"email"`
+	PhoneNumber   string    `json:"phone_number"`
+	Role          string    `json:"role"`
+	Verified      bool      `json:"verified"`
+	PhoneVerified bool      `json:"phone_verified"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type exportedProvider struct {
+	PersonalIDNumber   string     `json:"personal_id_number"`
+	VerificationStatus string     `json:"verification_status"`
+	RejectionReason    string     `json:"rejection_reason,omitempty"`
+	SubmittedAt        *time.Time `json:"submitted_at,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// Export assembles the profile, the provider record, the profile picture and the ID documents of the user into a ZIP archive,
+// uploads it to S3 in place of the previous export and returns its path.
+// It returns ErrUserNotFound if the user does not exist.
+func (s *privacyServiceImpl) Export(ctx context.Context, userID int64) (string, error) {
+	userModel, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	err = writeJSON(archive, "profile.json", exportedProfile{
+		ID:            strconv.FormatInt(userModel.ID, 10),
+		FirstName:     userModel.FirstName,
+		LastName:      userModel.LastName,
+		Email:         userModel.Email,
+		PhoneNumber:   userModel.PhoneNumber,
+		Role:          userModel.Role,
+		Verified:      userModel.Verified.Bool,
+		PhoneVerified: userModel.PhoneVerified.Bool,
+		CreatedAt:     userModel.CreatedAt.Time,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if userModel.Picture.String != "" {
+		if err := s.writeObject(ctx, archive, "picture/"+path.Base(userModel.Picture.String), userModel.Picture.String); err != nil {
+			return "", err
+		}
+	}
+
+	if err := s.exportProvider(ctx, archive, userID); err != nil {
+		return "", err
+	}
+
+	if err := archive.Close(); err != nil {
+		return "", err
+	}
+
+	fileName := strconv.FormatInt(userID, 10) + ".zip"
+	if _, err := s.s3Bucket.PutObject(ctx, &buf, exportDirectory, fileName, int64(buf.Len()), "application/zip"); err != nil {
+		return "", err
+	}
+
+	// the export keeps its path, so a cached previous export must not be served
+	exportPath := exportDirectory + fileName
+	if err := s.cdnFileInvalidator.InvalidateFile(ctx, exportPath); err != nil {
+		return "", err
+	}
+
+	return exportPath, nil
+}
+
+// PurgeDeactivated deletes at most limit users deactivated before the time with their profile pictures, ID documents and data exports.
+// Each user is claimed before its files are deleted, so it can't be restored with some of its files gone.
+// The files are deleted before the user, so a failure leaves the claimed user to be purged again by the next run instead of orphaning its files.
+// Users restored before being claimed are kept. It returns the number of the purged users.
+func (s *privacyServiceImpl) PurgeDeactivated(ctx context.Context, deactivatedBefore time.Time, limit int32) (int, error) {
+	ids, err := s.userRepository.ListDeactivated(ctx, deactivatedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		picture, err := s.userRepository.ClaimDeactivated(ctx, id, deactivatedBefore)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return purged, err
+		}
+
+		documents, err := s.providerDocumentRepository.List(ctx, id)
+		if err != nil {
+			return purged, err
+		}
+
+		files := make([]string, 0, len(documents)+2)
+		files = append(files, exportDirectory+strconv.FormatInt(id, 10)+".zip")
+		if picture.String != "" {
+			files = append(files, picture.String)
+		}
+		for _, document := range documents {
+			files = append(files, document.Path)
+		}
+
+		for _, file := range files {
+			if err := s.s3Bucket.DeleteObject(ctx, file); err != nil {
+				return purged, err
+			}
+			if err := s.cdnFileInvalidator.InvalidateFile(ctx, file); err != nil {
+				return purged, err
+			}
+		}
+
+		ok, err := s.userRepository.PurgeDeactivated(ctx, id)
+		if err != nil {
+			return purged, err
+		}
+		if ok {
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// exportProvider writes the provider record with the decrypted personal ID number and the ID documents, users that are not providers are skipped.
+func (s *privacyServiceImpl) exportProvider(ctx context.Context, archive *zip.Writer, userID int64) error {
+	provider, err := s.providerRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	verification, err := s.providerRepository.GetVerification(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	personalIDNumber, err := s.decryptor.Decrypt(provider.PersonalIDNumber)
+	if err != nil {
+		return err
+	}
+
+	exported := exportedProvider{
+		PersonalIDNumber:   string(personalIDNumber),
+		VerificationStatus: verification.VerificationStatus,
+		RejectionReason:    verification.RejectionReason.String,
+	}
+	if verification.SubmittedAt.Valid {
+		exported.SubmittedAt = &verification.SubmittedAt.Time
+	}
+	if verification.ReviewedAt.Valid {
+		exported.ReviewedAt = &verification.ReviewedAt.Time
+	}
+
+	if err := writeJSON(archive, "provider.json", exported); err != nil {
+		return err
+	}
+
+	documents, err := s.providerDocumentRepository.List(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, document := range documents {
+		if err := s.writeObject(ctx, archive, "documents/"+path.Base(document.Path), document.Path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeObject copies the S3 object into the archive under the name.
+func (s *privacyServiceImpl) writeObject(ctx context.Context, archive *zip.Writer, name string, key string) error {
+	object, err := s.s3Bucket.GetObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if closer, ok := object.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, object)
+	return err
+}
+
+func writeJSON(archive *zip.Writer, name string, v any) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_encryption "github.com/hexley21/fixup/pkg/encryption/mock"
+	mock_cdn "github.com/hexley21/fixup/pkg/infra/cdn/mock"
+	mock_s3 "github.com/hexley21/fixup/pkg/infra/s3/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const privacyUserId int64 = 3
+
+func setupPrivacy(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.PrivacyService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockProviderRepository *mock_repository.MockProviderRepository,
+	mockDocumentRepository *mock_repository.MockProviderDocumentRepository,
+	mockS3 *mock_s3.MockBucket,
+	mockInvalidator *mock_cdn.MockFileInvalidator,
+	mockDecryptor *mock_encryption.MockDecryptor,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockProviderRepository = mock_repository.NewMockProviderRepository(ctrl)
+	mockDocumentRepository = mock_repository.NewMockProviderDocumentRepository(ctrl)
+	mockS3 = mock_s3.NewMockBucket(ctrl)
+	mockInvalidator = mock_cdn.NewMockFileInvalidator(ctrl)
+	mockDecryptor = mock_encryption.NewMockDecryptor(ctrl)
+
+	svc = service.NewPrivacyService(
+		mockUserRepository,
+		mockProviderRepository,
+		mockDocumentRepository,
+		mockS3,
+		mockInvalidator,
+		mockDecryptor,
+	)
+
+	return
+}
+
+func TestExport_Provider(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockProviderRepository, mockDocumentRepository, mockS3, mockInvalidator, mockDecryptor := setupPrivacy(t)
+	defer ctrl.Finish()
+
+	var archive []byte
+
+	mockUserRepository.EXPECT().Get(ctx, privacyUserId).Return(repository.User{
+		ID:      privacyUserId,
+		Email:   "larry@page.com",
+		Picture: pgtype.Text{String: "pfp/3", Valid: true},
+	}, nil)
+	mockS3.EXPECT().GetObject(ctx, "pfp/3").Return(strings.NewReader("picture"), nil)
+	mockProviderRepository.EXPECT().Get(ctx, privacyUserId).Return(repository.Provider{PersonalIDNumber: []byte("encrypted")}, nil)
+	mockProviderRepository.EXPECT().GetVerification(ctx, privacyUserId).Return(repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationApproved),
+	}, nil)
+	mockDecryptor.EXPECT().Decrypt([]byte("encrypted")).Return([]byte("01234567890"), nil)
+	mockDocumentRepository.EXPECT().List(ctx, privacyUserId).Return([]repository.ProviderDocument{{Path: "kyc/3/document"}}, nil)
+	mockS3.EXPECT().GetObject(ctx, "kyc/3/document").Return(strings.NewReader("document"), nil)
+	mockS3.EXPECT().PutObject(ctx, gomock.Any(), "exports/", "3.zip", gomock.Any(), "application/zip").
+		DoAndReturn(func(_ context.Context, file io.Reader, _ string, _ string, _ int64, _ string) (string, error) {
+			var err error
+			archive, err = io.ReadAll(file)
+			return "3.zip", err
+		})
+	mockInvalidator.EXPECT().InvalidateFile(ctx, "exports/3.zip").Return(nil)
+
+	exportPath, err := svc.Export(ctx, privacyUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, "exports/3.zip", exportPath)
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if assert.NoError(t, err) {
+		names := make([]string, len(reader.File))
+		for i, file := range reader.File {
+			names[i] = file.Name
+		}
+		assert.Equal(t, []string{"profile.json", "picture/3", "provider.json", "documents/document"}, names)
+	}
+}
+
+func TestExport_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, _, _, _, _ := setupPrivacy(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, privacyUserId).Return(repository.User{}, pgx.ErrNoRows)
+
+	_, err := svc.Export(ctx, privacyUserId)
+	assert.ErrorIs(t, err, service.ErrUserNotFound)
+}
+
+func TestPurgeDeactivated_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockDocumentRepository, mockS3, mockInvalidator, _ := setupPrivacy(t)
+	defer ctrl.Finish()
+
+	before := time.Now()
+	restoredUserId := privacyUserId + 1
+
+	mockUserRepository.EXPECT().ListDeactivated(ctx, before, int32(10)).Return([]int64{privacyUserId, restoredUserId}, nil)
+	mockUserRepository.EXPECT().ClaimDeactivated(ctx, privacyUserId, before).Return(pgtype.Text{String: "pfp/3", Valid: true}, nil)
+	mockDocumentRepository.EXPECT().List(ctx, privacyUserId).Return([]repository.ProviderDocument{{Path: "kyc/3/document"}}, nil)
+	for _, file := range []string{"exports/3.zip", "pfp/3", "kyc/3/document"} {
+		mockS3.EXPECT().DeleteObject(ctx, file).Return(nil)
+		mockInvalidator.EXPECT().InvalidateFile(ctx, file).Return(nil)
+	}
+	mockUserRepository.EXPECT().PurgeDeactivated(ctx, privacyUserId).Return(true, nil)
+
+	// the second user logs in before being claimed, so none of its files are deleted
+	mockUserRepository.EXPECT().ClaimDeactivated(ctx, restoredUserId, before).Return(pgtype.Text{}, pgx.ErrNoRows)
+
+	purged, err := svc.PurgeDeactivated(ctx, before, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
+
+func TestPurgeDeactivated_RepositoryError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockDocumentRepository, mockS3, mockInvalidator, _ := setupPrivacy(t)
+	defer ctrl.Finish()
+
+	before := time.Now()
+	repoErr := errors.New("")
+
+	mockUserRepository.EXPECT().ListDeactivated(ctx, before, int32(10)).Return([]int64{privacyUserId}, nil)
+	mockUserRepository.EXPECT().ClaimDeactivated(ctx, privacyUserId, before).Return(pgtype.Text{}, nil)
+	mockDocumentRepository.EXPECT().List(ctx, privacyUserId).Return(nil, nil)
+	mockS3.EXPECT().DeleteObject(ctx, "exports/3.zip").Return(nil)
+	mockInvalidator.EXPECT().InvalidateFile(ctx, "exports/3.zip").Return(nil)
+	mockUserRepository.EXPECT().PurgeDeactivated(ctx, privacyUserId).Return(false, repoErr)
+
+	purged, err := svc.PurgeDeactivated(ctx, before, 10)
+	assert.ErrorIs(t, err, repoErr)
+	assert.Equal(t, 0, purged)
+}
+
+func TestPurgeDeactivated_DeleteObjectError(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, _, mockDocumentRepository, mockS3, _, _ := setupPrivacy(t)
+	defer ctrl.Finish()
+
+	before := time.Now()
+	s3Err := errors.New("")
+
+	// the user stays claimed to be purged again by the next run
+	mockUserRepository.EXPECT().ListDeactivated(ctx, before, int32(10)).Return([]int64{privacyUserId}, nil)
+	mockUserRepository.EXPECT().ClaimDeactivated(ctx, privacyUserId, before).Return(pgtype.Text{}, nil)
+	mockDocumentRepository.EXPECT().List(ctx, privacyUserId).Return(nil, nil)
+	mockS3.EXPECT().DeleteObject(ctx, "exports/3.zip").Return(s3Err)
+
+	purged, err := svc.PurgeDeactivated(ctx, before, 10)
+	assert.ErrorIs(t, err, s3Err)
+	assert.Equal(t, 0, purged)
+}
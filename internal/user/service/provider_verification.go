@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/encryption"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/infra/s3"
+	"github.com/hexley21/fixup/pkg/mailer"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TODO: move this to config
+var documentDirectory = "kyc/"
+
+type providerVerificationTemplates struct {
+	approved *template.Template
+	rejected *template.Template
+}
+
+type ProviderVerificationService interface {
+	UploadDocument(ctx context.Context, userID int64, file io.Reader, fileSize int64, fileType string) error
+	GetVerification(ctx context.Context, userID int64) (domain.ProviderVerification, error)
+	ListPending(ctx context.Context, limit int64, offset int64) ([]domain.ProviderVerification, error)
+	RevealPersonalIDNumber(ctx context.Context, actorID int64, userID int64) (string, error)
+	Approve(ctx context.Context, actorID int64, userID int64) error
+	Reject(ctx context.Context, actorID int64, userID int64, reason string) error
+}
+
+type providerVerificationServiceImpl struct {
+	userRepository             repository.UserRepository
+	providerRepository         repository.ProviderRepository
+	providerDocumentRepository repository.ProviderDocumentRepository
+	auditLogRepository         repository.AuditLogRepository
+	pgx                        postgres.PGX
+	s3Bucket                   s3.Bucket
+	decryptor                  encryption.Decryptor
+	mailer                     mailer.Mailer
+	emailAddress               string
+	templates                  *providerVerificationTemplates
+}
+
+func NewProviderVerificationService(
+	userRepository repository.UserRepository,
+	providerRepository repository.ProviderRepository,
+	providerDocumentRepository repository.ProviderDocumentRepository,
+	auditLogRepository repository.AuditLogRepository,
+	pgx postgres.PGX,
+	s3Bucket s3.Bucket,
+	decryptor encryption.Decryptor,
+	mailer mailer.Mailer,
+	emailAddress string,
+) *providerVerificationServiceImpl {
+	return &providerVerificationServiceImpl{
+		userRepository:             userRepository,
+		providerRepository:         providerRepository,
+		providerDocumentRepository: providerDocumentRepository,
+		auditLogRepository:         auditLogRepository,
+		pgx:                        pgx,
+		s3Bucket:                   s3Bucket,
+		decryptor:                  decryptor,
+		mailer:                     mailer,
+		emailAddress:               emailAddress,
+	}
+}
+
+// ParseTemplates parses the review outcome templates from the provided configuration paths.
+// It returns an error if any template fails to parse.
+func (s *providerVerificationServiceImpl) ParseTemplates(cfg config.Templates) error {
+	approvedTemplate, err := template.ParseFiles(cfg.ProviderApprovedPath)
+	if err != nil {
+		return err
+	}
+	rejectedTemplate, err := template.ParseFiles(cfg.ProviderRejectedPath)
+	if err != nil {
+		return err
+	}
+
+	s.SetTemplates(approvedTemplate, rejectedTemplate)
+	return nil
+}
+
+func (s *providerVerificationServiceImpl) SetTemplates(approvedTemplate *template.Template, rejectedTemplate *template.Template) {
+	s.templates = &providerVerificationTemplates{approved: approvedTemplate, rejected: rejectedTemplate}
+}
+
+// UploadDocument uploads an ID document image of the provider to S3 and puts the provider into the review queue,
+// a rejected provider is reviewed again.
+// The image is uploaded before the provider is locked, so the lock isn't held during the upload, and it is deleted if the document isn't saved.
+// It returns ErrProviderNotFound if the user is not registered as a provider and ErrProviderApproved if the provider is already approved.
+func (s *providerVerificationServiceImpl) UploadDocument(ctx context.Context, userID int64, file io.Reader, fileSize int64, fileType string) error {
+	var directoryBuilder strings.Builder
+	directoryBuilder.WriteString(documentDirectory)
+	directoryBuilder.WriteString(strconv.FormatInt(userID, 10))
+	directoryBuilder.WriteString("/")
+	directory := directoryBuilder.String()
+
+	fileName, err := s.s3Bucket.PutObject(ctx, file, directory, "", fileSize, fileType)
+	if err != nil {
+		return err
+	}
+
+	key := directory + fileName
+	if err := s.submitDocument(ctx, userID, key); err != nil {
+		return errors.Join(err, s.s3Bucket.DeleteObject(ctx, key))
+	}
+
+	return nil
+}
+
+// submitDocument saves the uploaded document of the provider and puts the provider into the review queue within a single transaction.
+func (s *providerVerificationServiceImpl) submitDocument(ctx context.Context, userID int64, path string) error {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	verification, err := s.providerRepository.WithTx(tx).GetVerificationForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return postgres.Rollback(tx, ctx, ErrProviderNotFound)
+		}
+		return postgres.Rollback(tx, ctx, err)
+	}
+	if verification.VerificationStatus == string(domain.ProviderVerificationApproved) {
+		return postgres.Rollback(tx, ctx, ErrProviderApproved)
+	}
+
+	if _, err := s.providerDocumentRepository.WithTx(tx).Create(ctx, userID, path); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	if _, err := s.providerRepository.WithTx(tx).SubmitVerification(ctx, userID); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetVerification retrieves the identity verification of the provider with the uploaded documents.
+// It returns ErrProviderNotFound if the user is not registered as a provider.
+func (s *providerVerificationServiceImpl) GetVerification(ctx context.Context, userID int64) (domain.ProviderVerification, error) {
+	verification, err := s.providerRepository.GetVerification(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ProviderVerification{}, ErrProviderNotFound
+		}
+		return domain.ProviderVerification{}, err
+	}
+
+	documents, err := s.providerDocumentRepository.List(ctx, userID)
+	if err != nil {
+		return domain.ProviderVerification{}, err
+	}
+
+	return MapProviderVerificationModelToEntity(verification, documents), nil
+}
+
+// ListPending retrieves the providers waiting for the review, oldest submission first. The documents are not included.
+func (s *providerVerificationServiceImpl) ListPending(ctx context.Context, limit int64, offset int64) ([]domain.ProviderVerification, error) {
+	verifications, err := s.providerRepository.ListPendingVerifications(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]domain.ProviderVerification, len(verifications))
+	for i, verification := range verifications {
+		entities[i] = MapProviderVerificationModelToEntity(verification, nil)
+	}
+
+	return entities, nil
+}
+
+// RevealPersonalIDNumber decrypts the personal ID number of the provider, each access is written to the audit trail before the number is revealed.
+// It returns ErrProviderNotFound if the user is not registered as a provider.
+func (s *providerVerificationServiceImpl) RevealPersonalIDNumber(ctx context.Context, actorID int64, userID int64) (string, error) {
+	provider, err := s.providerRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrProviderNotFound
+		}
+		return "", err
+	}
+
+	err = s.auditLogRepository.Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:  userID,
+		ActorID: actorID,
+		Action:  string(domain.AuditActionPersonalIDView),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	personalIDNumber, err := s.decryptor.Decrypt(provider.PersonalIDNumber)
+	if err != nil {
+		return "", err
+	}
+
+	return string(personalIDNumber), nil
+}
+
+// Approve approves the provider waiting for the review, promotes the customer to the provider role and emails the provider.
+// The new role is applied on the provider's next token refresh.
+// It returns ErrProviderNotFound if the user is not registered as a provider and ErrProviderNotPending if the provider is not waiting for the review.
+func (s *providerVerificationServiceImpl) Approve(ctx context.Context, actorID int64, userID int64) error {
+	verification, err := s.review(ctx, actorID, userID, domain.ProviderVerificationApproved, "", func(tx pgx.Tx) error {
+		accountInfo, err := s.userRepository.WithTx(tx).GetAccountInfoForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// staff accounts keep their role
+		if accountInfo.Role != string(enum.UserRoleCUSTOMER) {
+			return nil
+		}
+
+		_, err = s.userRepository.WithTx(tx).UpdateRole(ctx, userID, string(enum.UserRolePROVIDER))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.SendHTML(
+		s.emailAddress,
+		verification.Email,
+		"Provider verification approved",
+		s.templates.approved,
+		struct {
+			Name string
+		}{
+			Name: verification.FirstName,
+		},
+	)
+}
+
+// Reject rejects the provider waiting for the review with the reason and emails the provider, the provider can upload new documents to be reviewed again.
+// It returns ErrProviderNotFound if the user is not registered as a provider and ErrProviderNotPending if the provider is not waiting for the review.
+func (s *providerVerificationServiceImpl) Reject(ctx context.Context, actorID int64, userID int64, reason string) error {
+	verification, err := s.review(ctx, actorID, userID, domain.ProviderVerificationRejected, reason, func(pgx.Tx) error { return nil })
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.SendHTML(
+		s.emailAddress,
+		verification.Email,
+		"Provider verification rejected",
+		s.templates.rejected,
+		struct {
+			Name   string
+			Reason string
+		}{
+			Name:   verification.FirstName,
+			Reason: reason,
+		},
+	)
+}
+
+// review writes the outcome of the review of the locked provider, runs the action and writes the review to the audit trail in the same transaction.
+// It returns the verification of the provider before the review.
+func (s *providerVerificationServiceImpl) review(
+	ctx context.Context,
+	actorID int64,
+	userID int64,
+	status domain.ProviderVerificationStatus,
+	reason string,
+	action func(tx pgx.Tx) error,
+) (repository.ProviderVerificationRow, error) {
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return repository.ProviderVerificationRow{}, err
+	}
+
+	verification, err := s.providerRepository.WithTx(tx).GetVerificationForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, ErrProviderNotFound)
+		}
+		return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, err)
+	}
+	if verification.VerificationStatus != string(domain.ProviderVerificationPending) || !verification.SubmittedAt.Valid {
+		return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, ErrProviderNotPending)
+	}
+
+	_, err = s.providerRepository.WithTx(tx).UpdateVerification(ctx, repository.UpdateProviderVerificationParams{
+		UserID:             userID,
+		VerificationStatus: string(status),
+		RejectionReason:    pgtype.Text{String: reason, Valid: reason != ""},
+		ReviewedBy:         actorID,
+	})
+	if err != nil {
+		return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := action(tx); err != nil {
+		return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	auditAction := domain.AuditActionProviderApprove
+	if status == domain.ProviderVerificationRejected {
+		auditAction = domain.AuditActionProviderReject
+	}
+
+	err = s.auditLogRepository.WithTx(tx).Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:   userID,
+		ActorID:  actorID,
+		Action:   string(auditAction),
+		OldValue: pgtype.Text{String: verification.VerificationStatus, Valid: true},
+		NewValue: pgtype.Text{String: string(status), Valid: true},
+		Reason:   pgtype.Text{String: reason, Valid: reason != ""},
+	})
+	if err != nil {
+		return repository.ProviderVerificationRow{}, postgres.Rollback(tx, ctx, err)
+	}
+
+	return verification, tx.Commit(ctx)
+}
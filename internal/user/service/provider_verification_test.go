@@ -0,0 +1,322 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	mock_encryption "github.com/hexley21/fixup/pkg/encryption/mock"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	mock_s3 "github.com/hexley21/fixup/pkg/infra/s3/mock"
+	mock_mailer "github.com/hexley21/fixup/pkg/mailer/mock"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	reviewerId          int64 = 1
+	providerUserId      int64 = 2
+	providerEmail             = "larry@page.com"
+	providerEmailSender       = "fixup@gmail.com"
+)
+
+var (
+	providerApprovedTemplate = template.New("provider_approved")
+	providerRejectedTemplate = template.New("provider_rejected")
+
+	pendingVerification = repository.ProviderVerificationRow{
+		UserID:             providerUserId,
+		FirstName:          "Larry",
+		Email:              providerEmail,
+		VerificationStatus: string(domain.ProviderVerificationPending),
+		SubmittedAt:        pgtype.Timestamp{Valid: true},
+	}
+)
+
+func setupProviderVerification(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.ProviderVerificationService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockProviderRepository *mock_repository.MockProviderRepository,
+	mockDocumentRepository *mock_repository.MockProviderDocumentRepository,
+	mockAuditLogRepository *mock_repository.MockAuditLogRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	mockS3 *mock_s3.MockBucket,
+	mockDecryptor *mock_encryption.MockDecryptor,
+	mockMailer *mock_mailer.MockMailer,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockProviderRepository = mock_repository.NewMockProviderRepository(ctrl)
+	mockDocumentRepository = mock_repository.NewMockProviderDocumentRepository(ctrl)
+	mockAuditLogRepository = mock_repository.NewMockAuditLogRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	mockS3 = mock_s3.NewMockBucket(ctrl)
+	mockDecryptor = mock_encryption.NewMockDecryptor(ctrl)
+	mockMailer = mock_mailer.NewMockMailer(ctrl)
+
+	s := service.NewProviderVerificationService(
+		mockUserRepository,
+		mockProviderRepository,
+		mockDocumentRepository,
+		mockAuditLogRepository,
+		mockPgx,
+		mockS3,
+		mockDecryptor,
+		mockMailer,
+		providerEmailSender,
+	)
+	s.SetTemplates(providerApprovedTemplate, providerRejectedTemplate)
+
+	svc = s
+	return
+}
+
+// expectLockedProvider expects the transaction to begin and lock the provider with the verification.
+func expectLockedProvider(
+	ctx context.Context,
+	mockProviderRepository *mock_repository.MockProviderRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	verification repository.ProviderVerificationRow,
+	err error,
+) {
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockProviderRepository.EXPECT().WithTx(mockTx).Return(mockProviderRepository).AnyTimes()
+	mockProviderRepository.EXPECT().GetVerificationForUpdate(ctx, providerUserId).Return(verification, err)
+}
+
+func TestUploadDocument_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, mockDocumentRepository, _, mockPgx, mockTx, mockS3, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	file := strings.NewReader("image")
+
+	mockS3.EXPECT().PutObject(ctx, file, "kyc/2/", "", int64(5), "image/png").Return("document", nil)
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationRejected),
+	}, nil)
+	mockDocumentRepository.EXPECT().WithTx(mockTx).Return(mockDocumentRepository)
+	mockDocumentRepository.EXPECT().Create(ctx, providerUserId, "kyc/2/document").Return(repository.ProviderDocument{}, nil)
+	mockProviderRepository.EXPECT().SubmitVerification(ctx, providerUserId).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	assert.NoError(t, svc.UploadDocument(ctx, providerUserId, file, 5, "image/png"))
+}
+
+func TestUploadDocument_PutObjectError(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _, mockS3, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	s3Err := errors.New("")
+	file := strings.NewReader("image")
+
+	mockS3.EXPECT().PutObject(ctx, file, "kyc/2/", "", int64(5), "image/png").Return("", s3Err)
+
+	assert.ErrorIs(t, svc.UploadDocument(ctx, providerUserId, file, 5, "image/png"), s3Err)
+}
+
+func TestUploadDocument_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, mockPgx, mockTx, mockS3, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	file := strings.NewReader("")
+
+	mockS3.EXPECT().PutObject(ctx, file, "kyc/2/", "", int64(0), "image/png").Return("document", nil)
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+	mockS3.EXPECT().DeleteObject(ctx, "kyc/2/document").Return(nil)
+
+	assert.ErrorIs(t, svc.UploadDocument(ctx, providerUserId, file, 0, "image/png"), service.ErrProviderNotFound)
+}
+
+func TestUploadDocument_Approved(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, mockPgx, mockTx, mockS3, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	file := strings.NewReader("")
+
+	mockS3.EXPECT().PutObject(ctx, file, "kyc/2/", "", int64(0), "image/png").Return("document", nil)
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationApproved),
+	}, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+	mockS3.EXPECT().DeleteObject(ctx, "kyc/2/document").Return(nil)
+
+	assert.ErrorIs(t, svc.UploadDocument(ctx, providerUserId, file, 0, "image/png"), service.ErrProviderApproved)
+}
+
+func TestUploadDocument_CommitError(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, mockDocumentRepository, _, mockPgx, mockTx, mockS3, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	txErr := errors.New("")
+	file := strings.NewReader("image")
+
+	mockS3.EXPECT().PutObject(ctx, file, "kyc/2/", "", int64(5), "image/png").Return("document", nil)
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationRejected),
+	}, nil)
+	mockDocumentRepository.EXPECT().WithTx(mockTx).Return(mockDocumentRepository)
+	mockDocumentRepository.EXPECT().Create(ctx, providerUserId, "kyc/2/document").Return(repository.ProviderDocument{}, nil)
+	mockProviderRepository.EXPECT().SubmitVerification(ctx, providerUserId).Return(true, nil)
+	mockTx.EXPECT().Commit(ctx).Return(txErr)
+	mockS3.EXPECT().DeleteObject(ctx, "kyc/2/document").Return(nil)
+
+	assert.ErrorIs(t, svc.UploadDocument(ctx, providerUserId, file, 5, "image/png"), txErr)
+}
+
+func TestGetVerification_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, mockDocumentRepository, _, _, _, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	mockProviderRepository.EXPECT().GetVerification(ctx, providerUserId).Return(pendingVerification, nil)
+	mockDocumentRepository.EXPECT().List(ctx, providerUserId).Return([]repository.ProviderDocument{{ID: 1, Path: "kyc/2/document"}}, nil)
+
+	verification, err := svc.GetVerification(ctx, providerUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ProviderVerificationPending, verification.Status)
+	if assert.Len(t, verification.Documents, 1) {
+		assert.Equal(t, "kyc/2/document", verification.Documents[0].Path)
+	}
+}
+
+func TestGetVerification_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, _, _, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	mockProviderRepository.EXPECT().GetVerification(ctx, providerUserId).Return(repository.ProviderVerificationRow{}, pgx.ErrNoRows)
+
+	_, err := svc.GetVerification(ctx, providerUserId)
+	assert.ErrorIs(t, err, service.ErrProviderNotFound)
+}
+
+func TestRevealPersonalIDNumber_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, mockAuditLogRepository, _, _, _, mockDecryptor, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	mockProviderRepository.EXPECT().Get(ctx, providerUserId).Return(repository.Provider{PersonalIDNumber: []byte("encrypted")}, nil)
+	mockAuditLogRepository.EXPECT().Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:  providerUserId,
+		ActorID: reviewerId,
+		Action:  string(domain.AuditActionPersonalIDView),
+	}).Return(nil)
+	mockDecryptor.EXPECT().Decrypt([]byte("encrypted")).Return([]byte("01234567890"), nil)
+
+	personalIDNumber, err := svc.RevealPersonalIDNumber(ctx, reviewerId, providerUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, "01234567890", personalIDNumber)
+}
+
+func TestRevealPersonalIDNumber_AuditError(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, mockAuditLogRepository, _, _, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	mockProviderRepository.EXPECT().Get(ctx, providerUserId).Return(repository.Provider{PersonalIDNumber: []byte("encrypted")}, nil)
+	mockAuditLogRepository.EXPECT().Create(ctx, gomock.Any()).Return(errors.New(""))
+
+	personalIDNumber, err := svc.RevealPersonalIDNumber(ctx, reviewerId, providerUserId)
+	assert.Error(t, err)
+	assert.Empty(t, personalIDNumber)
+}
+
+func TestRevealPersonalIDNumber_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, _, _, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	mockProviderRepository.EXPECT().Get(ctx, providerUserId).Return(repository.Provider{}, pgx.ErrNoRows)
+
+	_, err := svc.RevealPersonalIDNumber(ctx, reviewerId, providerUserId)
+	assert.ErrorIs(t, err, service.ErrProviderNotFound)
+}
+
+func TestApproveProvider_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockProviderRepository, _, mockAuditLogRepository, mockPgx, mockTx, _, _, mockMailer := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, pendingVerification, nil)
+	mockProviderRepository.EXPECT().UpdateVerification(ctx, repository.UpdateProviderVerificationParams{
+		UserID:             providerUserId,
+		VerificationStatus: string(domain.ProviderVerificationApproved),
+		ReviewedBy:         reviewerId,
+	}).Return(true, nil)
+	mockUserRepository.EXPECT().WithTx(mockTx).Return(mockUserRepository).Times(2)
+	mockUserRepository.EXPECT().GetAccountInfoForUpdate(ctx, providerUserId).Return(repository.GetUserAccountInfoRow{Role: string(enum.UserRoleCUSTOMER)}, nil)
+	mockUserRepository.EXPECT().UpdateRole(ctx, providerUserId, string(enum.UserRolePROVIDER)).Return(true, nil)
+	mockAuditLogRepository.EXPECT().WithTx(mockTx).Return(mockAuditLogRepository)
+	mockAuditLogRepository.EXPECT().Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:   providerUserId,
+		ActorID:  reviewerId,
+		Action:   string(domain.AuditActionProviderApprove),
+		OldValue: pgtype.Text{String: string(domain.ProviderVerificationPending), Valid: true},
+		NewValue: pgtype.Text{String: string(domain.ProviderVerificationApproved), Valid: true},
+	}).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockMailer.EXPECT().SendHTML(providerEmailSender, providerEmail, gomock.Any(), providerApprovedTemplate, gomock.Any()).Return(nil)
+
+	assert.NoError(t, svc.Approve(ctx, reviewerId, providerUserId))
+}
+
+func TestApproveProvider_NotPending(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, mockPgx, mockTx, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{
+		VerificationStatus: string(domain.ProviderVerificationPending),
+	}, nil)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Approve(ctx, reviewerId, providerUserId), service.ErrProviderNotPending)
+}
+
+func TestApproveProvider_NotFound(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, _, mockPgx, mockTx, _, _, _ := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, repository.ProviderVerificationRow{}, pgx.ErrNoRows)
+	mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+	assert.ErrorIs(t, svc.Approve(ctx, reviewerId, providerUserId), service.ErrProviderNotFound)
+}
+
+func TestRejectProvider_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockProviderRepository, _, mockAuditLogRepository, mockPgx, mockTx, _, _, mockMailer := setupProviderVerification(t)
+	defer ctrl.Finish()
+
+	reason := pgtype.Text{String: "blurry", Valid: true}
+
+	expectLockedProvider(ctx, mockProviderRepository, mockPgx, mockTx, pendingVerification, nil)
+	mockProviderRepository.EXPECT().UpdateVerification(ctx, repository.UpdateProviderVerificationParams{
+		UserID:             providerUserId,
+		VerificationStatus: string(domain.ProviderVerificationRejected),
+		RejectionReason:    reason,
+		ReviewedBy:         reviewerId,
+	}).Return(true, nil)
+	mockAuditLogRepository.EXPECT().WithTx(mockTx).Return(mockAuditLogRepository)
+	mockAuditLogRepository.EXPECT().Create(ctx, repository.CreateUserAuditLogParams{
+		UserID:   providerUserId,
+		ActorID:  reviewerId,
+		Action:   string(domain.AuditActionProviderReject),
+		OldValue: pgtype.Text{String: string(domain.ProviderVerificationPending), Valid: true},
+		NewValue: pgtype.Text{String: string(domain.ProviderVerificationRejected), Valid: true},
+		Reason:   reason,
+	}).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+	mockMailer.EXPECT().SendHTML(providerEmailSender, providerEmail, gomock.Any(), providerRejectedTemplate, gomock.Any()).Return(nil)
+
+	assert.NoError(t, svc.Reject(ctx, reviewerId, providerUserId, "blurry"))
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+const sessionIdBytes = 16
+
+type SessionService interface {
+	Create(ctx context.Context, userID int64, device domain.SessionDevice) (sessionID string, tokenID string, err error)
+	Rotate(ctx context.Context, userID int64, sessionID string, tokenID string) (string, error)
+	List(ctx context.Context, userID int64) ([]domain.Session, error)
+	Revoke(ctx context.Context, userID int64, sessionID string) error
+	RevokeAll(ctx context.Context, userID int64) error
+}
+
+type sessionServiceImpl struct {
+	sessionRepository repository.SessionRepository
+	sessionTTL        time.Duration
+}
+
+func NewSessionService(sessionRepository repository.SessionRepository, sessionTTL time.Duration) *sessionServiceImpl {
+	return &sessionServiceImpl{
+		sessionRepository: sessionRepository,
+		sessionTTL:        sessionTTL,
+	}
+}
+
+// Create starts a new session for the device of the user, the session lives as long as the refresh token.
+// It returns the id of the session and the id of its first refresh token.
+func (s *sessionServiceImpl) Create(ctx context.Context, userID int64, device domain.SessionDevice) (string, string, error) {
+	sessionID, err := newSessionId()
+	if err != nil {
+		return "", "", err
+	}
+	tokenID, err := newSessionId()
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	err = s.sessionRepository.Create(ctx, repository.SessionModel{
+		ID:         sessionID,
+		UserID:     userID,
+		TokenID:    tokenID,
+		UserAgent:  device.UserAgent,
+		IP:         device.IP,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}, s.sessionTTL)
+	if err != nil {
+		return "", "", err
+	}
+
+	return sessionID, tokenID, nil
+}
+
+// Rotate exchanges the current refresh token id of the session for a new one and returns it.
+// It returns ErrSessionNotFound if the session expired or was revoked.
+// If the token id was already rotated, the token was stolen or replayed, so the whole session is revoked and ErrRefreshTokenReused is returned.
+func (s *sessionServiceImpl) Rotate(ctx context.Context, userID int64, sessionID string, tokenID string) (string, error) {
+	if sessionID == "" || tokenID == "" {
+		return "", ErrSessionNotFound
+	}
+
+	newTokenID, err := newSessionId()
+	if err != nil {
+		return "", err
+	}
+
+	err = s.sessionRepository.Rotate(ctx, userID, sessionID, tokenID, newTokenID, s.sessionTTL)
+	if err != nil {
+		switch {
+		case errors.Is(err, redis.Nil):
+			return "", ErrSessionNotFound
+		case errors.Is(err, repository.ErrRefreshTokenReused):
+			return "", ErrRefreshTokenReused
+		}
+		return "", err
+	}
+
+	return newTokenID, nil
+}
+
+// List returns the active sessions of the user.
+func (s *sessionServiceImpl) List(ctx context.Context, userID int64) ([]domain.Session, error) {
+	sessionModels, err := s.sessionRepository.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]domain.Session, len(sessionModels))
+	for i, session := range sessionModels {
+		sessions[i] = MapSessionModelToEntity(session)
+	}
+
+	return sessions, nil
+}
+
+// Revoke ends the session of the user, its refresh token can no longer be used.
+// It returns ErrSessionNotFound if the user has no such session.
+func (s *sessionServiceImpl) Revoke(ctx context.Context, userID int64, sessionID string) error {
+	ok, err := s.sessionRepository.Delete(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeAll ends every session of the user.
+func (s *sessionServiceImpl) RevokeAll(ctx context.Context, userID int64) error {
+	return s.sessionRepository.DeleteAll(ctx, userID)
+}
+
+func newSessionId() (string, error) {
+	b := make([]byte, sessionIdBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	sessionUserId  int64 = 1
+	sessionId            = "session-id"
+	sessionTokenId       = "token-id"
+	sessionTTL           = 168 * time.Hour
+)
+
+func setupSession(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.SessionService,
+	mockSessionRepository *mock_repository.MockSessionRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockSessionRepository = mock_repository.NewMockSessionRepository(ctrl)
+	svc = service.NewSessionService(mockSessionRepository, sessionTTL)
+
+	return
+}
+
+func TestCreateSession_Success(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	device := domain.NewSessionDevice("Mozilla/5.0", "127.0.0.1")
+
+	var created repository.SessionModel
+	mockSessionRepository.EXPECT().Create(ctx, gomock.Any(), sessionTTL).DoAndReturn(
+		func(_ context.Context, session repository.SessionModel, _ time.Duration) error {
+			created = session
+			return nil
+		},
+	)
+
+	sessionID, tokenID, err := svc.Create(ctx, sessionUserId, device)
+	if assert.NoError(t, err) {
+		assert.Equal(t, created.ID, sessionID)
+		assert.Equal(t, created.TokenID, tokenID)
+		assert.NotEmpty(t, sessionID)
+		assert.NotEqual(t, sessionID, tokenID)
+		assert.Equal(t, sessionUserId, created.UserID)
+		assert.Equal(t, device.UserAgent, created.UserAgent)
+		assert.Equal(t, device.IP, created.IP)
+	}
+}
+
+func TestCreateSession_Error(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	expectedErr := errors.New("redis error")
+	mockSessionRepository.EXPECT().Create(ctx, gomock.Any(), sessionTTL).Return(expectedErr)
+
+	_, _, err := svc.Create(ctx, sessionUserId, domain.SessionDevice{})
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestRotateSession_Success(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	var rotatedTo string
+	mockSessionRepository.EXPECT().Rotate(ctx, sessionUserId, sessionId, sessionTokenId, gomock.Any(), sessionTTL).DoAndReturn(
+		func(_ context.Context, _ int64, _ string, _ string, newTokenID string, _ time.Duration) error {
+			rotatedTo = newTokenID
+			return nil
+		},
+	)
+
+	tokenID, err := svc.Rotate(ctx, sessionUserId, sessionId, sessionTokenId)
+	if assert.NoError(t, err) {
+		assert.Equal(t, rotatedTo, tokenID)
+		assert.NotEqual(t, sessionTokenId, tokenID)
+	}
+}
+
+func TestRotateSession_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	mockSessionRepository.EXPECT().Rotate(ctx, sessionUserId, sessionId, sessionTokenId, gomock.Any(), sessionTTL).Return(redis.Nil)
+
+	_, err := svc.Rotate(ctx, sessionUserId, sessionId, sessionTokenId)
+	assert.ErrorIs(t, err, service.ErrSessionNotFound)
+}
+
+func TestRotateSession_Reused(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	mockSessionRepository.EXPECT().Rotate(ctx, sessionUserId, sessionId, sessionTokenId, gomock.Any(), sessionTTL).Return(repository.ErrRefreshTokenReused)
+
+	_, err := svc.Rotate(ctx, sessionUserId, sessionId, sessionTokenId)
+	assert.ErrorIs(t, err, service.ErrRefreshTokenReused)
+}
+
+func TestRotateSession_WithoutSession(t *testing.T) {
+	ctrl, ctx, svc, _ := setupSession(t)
+	defer ctrl.Finish()
+
+	_, err := svc.Rotate(ctx, sessionUserId, "", sessionTokenId)
+	assert.ErrorIs(t, err, service.ErrSessionNotFound)
+}
+
+func TestListSessions_Success(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(1700000000, 0)
+	mockSessionRepository.EXPECT().List(ctx, sessionUserId).Return([]repository.SessionModel{
+		{ID: sessionId, UserID: sessionUserId, TokenID: sessionTokenId, UserAgent: "Mozilla/5.0", IP: "127.0.0.1", CreatedAt: now, LastUsedAt: now},
+	}, nil)
+
+	sessions, err := svc.List(ctx, sessionUserId)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []domain.Session{
+			domain.NewSession(sessionId, sessionUserId, domain.NewSessionDevice("Mozilla/5.0", "127.0.0.1"), now, now),
+		}, sessions)
+	}
+}
+
+func TestRevokeSession_Success(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	mockSessionRepository.EXPECT().Delete(ctx, sessionUserId, sessionId).Return(true, nil)
+
+	assert.NoError(t, svc.Revoke(ctx, sessionUserId, sessionId))
+}
+
+func TestRevokeSession_NotFound(t *testing.T) {
+	ctrl, ctx, svc, mockSessionRepository := setupSession(t)
+	defer ctrl.Finish()
+
+	mockSessionRepository.EXPECT().Delete(ctx, sessionUserId, sessionId).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Revoke(ctx, sessionUserId, sessionId), service.ErrSessionNotFound)
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/domain"
+	"github.com/hexley21/fixup/internal/user/repository"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/encryption"
+	"github.com/hexley21/fixup/pkg/hasher"
+	"github.com/hexley21/fixup/pkg/infra/postgres"
+	"github.com/hexley21/fixup/pkg/totp"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// codes of the neighbouring time steps are accepted, to tolerate clock drift of the authenticator
+	totpSkew          = 1
+	recoveryCodeBytes = 5
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type TwoFactorService interface {
+	Enroll(ctx context.Context, userID int64) (domain.TwoFactorEnrolment, error)
+	Confirm(ctx context.Context, userID int64, code string) ([]string, error)
+	Disable(ctx context.Context, userID int64, code string) error
+	Verify(ctx context.Context, userID int64, challengeID string, code string) (domain.UserIdentity, error)
+}
+
+type twoFactorServiceImpl struct {
+	userRepository             repository.UserRepository
+	twoFactorRepository        repository.TwoFactorRepository
+	twoFactorAttemptRepository repository.TwoFactorAttemptRepository
+	pgx                        postgres.PGX
+	hasher                     hasher.Hasher
+	encryptor                  encryption.Encryptor
+	decryptor                  encryption.Decryptor
+	cfg                        config.TwoFactor
+	challengeTTL               time.Duration
+}
+
+func NewTwoFactorService(
+	userRepository repository.UserRepository,
+	twoFactorRepository repository.TwoFactorRepository,
+	twoFactorAttemptRepository repository.TwoFactorAttemptRepository,
+	pgx postgres.PGX,
+	hasher hasher.Hasher,
+	encryptor encryption.Encryptor,
+	decryptor encryption.Decryptor,
+	cfg config.TwoFactor,
+	challengeTTL time.Duration,
+) *twoFactorServiceImpl {
+	return &twoFactorServiceImpl{
+		userRepository:             userRepository,
+		twoFactorRepository:        twoFactorRepository,
+		twoFactorAttemptRepository: twoFactorAttemptRepository,
+		pgx:                        pgx,
+		hasher:                     hasher,
+		encryptor:                  encryptor,
+		decryptor:                  decryptor,
+		cfg:                        cfg,
+		challengeTTL:               challengeTTL,
+	}
+}
+
+// Enroll starts a two-factor enrolment with a new secret, which is stored encrypted.
+// The secret and its otpauth URI are returned, the enrolment stays pending until it's confirmed with a code.
+// It returns ErrTwoFactorEnabled if the user already has two-factor authentication enabled.
+func (s *twoFactorServiceImpl) Enroll(ctx context.Context, userID int64) (domain.TwoFactorEnrolment, error) {
+	userModel, err := s.userRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TwoFactorEnrolment{}, ErrUserNotFound
+		}
+		return domain.TwoFactorEnrolment{}, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return domain.TwoFactorEnrolment{}, err
+	}
+
+	enc, err := s.encryptor.Encrypt([]byte(secret))
+	if err != nil {
+		return domain.TwoFactorEnrolment{}, err
+	}
+
+	ok, err := s.twoFactorRepository.Upsert(ctx, userID, enc)
+	if err != nil {
+		return domain.TwoFactorEnrolment{}, err
+	}
+	if !ok {
+		return domain.TwoFactorEnrolment{}, ErrTwoFactorEnabled
+	}
+
+	return domain.NewTwoFactorEnrolment(secret, totp.URI(s.cfg.Issuer, userModel.Email, secret)), nil
+}
+
+// Confirm enables the pending enrolment if the code matches its secret, and returns new recovery codes.
+// Recovery codes are stored hashed, so they can't be shown again.
+// It returns ErrTwoFactorNotEnrolled if there is no enrolment, ErrTwoFactorEnabled if it's already enabled
+// and ErrInvalidTwoFactorCode if the code does not match.
+func (s *twoFactorServiceImpl) Confirm(ctx context.Context, userID int64, code string) ([]string, error) {
+	twoFactor, err := s.twoFactorRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTwoFactorNotEnrolled
+		}
+		return nil, err
+	}
+	if twoFactor.Enabled {
+		return nil, ErrTwoFactorEnabled
+	}
+
+	step, err := s.validateCode(twoFactor, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.twoFactorRepository.WithTx(tx).Enable(ctx, userID, step)
+	if err != nil {
+		return nil, postgres.Rollback(tx, ctx, err)
+	}
+	if !ok {
+		return nil, postgres.Rollback(tx, ctx, ErrInvalidTwoFactorCode)
+	}
+
+	if err := s.twoFactorRepository.WithTx(tx).DeleteRecoveryCodes(ctx, userID); err != nil {
+		return nil, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := s.twoFactorRepository.WithTx(tx).CreateRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable turns the two-factor authentication off and deletes the recovery codes.
+// The code is checked like on Verify, a recovery code can be used as well.
+func (s *twoFactorServiceImpl) Disable(ctx context.Context, userID int64, code string) error {
+	if err := s.checkCode(ctx, userID, code); err != nil {
+		return err
+	}
+
+	tx, err := s.pgx.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted})
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.twoFactorRepository.WithTx(tx).Delete(ctx, userID); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	if err := s.twoFactorRepository.WithTx(tx).DeleteRecoveryCodes(ctx, userID); err != nil {
+		return postgres.Rollback(tx, ctx, err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Verify completes the two-factor check of a user and returns the user's identity.
+// The code is either a one-time password, which can only be used once, or an unused recovery code.
+// The challenge, identified by the id of its token, can complete a single login only,
+// a used challenge is rejected before the code is checked, so it neither counts a check nor uses the code.
+// It returns ErrTwoFactorNotEnabled if the user has no two-factor authentication, ErrInvalidTwoFactorCode if the code does not match,
+// ErrTwoFactorAttemptsExceeded if the checks ran out, ErrTwoFactorChallengeUsed if the challenge was already used
+// and ErrUserSuspended if the user was suspended after the login. A deactivated user is restored.
+func (s *twoFactorServiceImpl) Verify(ctx context.Context, userID int64, challengeID string, code string) (domain.UserIdentity, error) {
+	if challengeID == "" {
+		return domain.UserIdentity{}, ErrTwoFactorChallengeUsed
+	}
+
+	used, err := s.twoFactorAttemptRepository.IsChallengeUsed(ctx, challengeID)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+	if used {
+		return domain.UserIdentity{}, ErrTwoFactorChallengeUsed
+	}
+
+	if err := s.checkCode(ctx, userID, code); err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	// the challenge is used only once the code matches, a concurrent login with the same challenge may still win
+	ok, err := s.twoFactorAttemptRepository.UseChallenge(ctx, challengeID, s.challengeTTL)
+	if err != nil {
+		return domain.UserIdentity{}, err
+	}
+	if !ok {
+		return domain.UserIdentity{}, ErrTwoFactorChallengeUsed
+	}
+
+	accountInfo, err := s.userRepository.GetAccountInfo(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserIdentity{}, ErrUserNotFound
+		}
+		return domain.UserIdentity{}, err
+	}
+	if accountInfo.Suspended {
+		return domain.UserIdentity{}, ErrUserSuspended
+	}
+
+	// the login is complete, so it cancels the scheduled deletion of a deactivated account
+	if _, err := s.userRepository.Restore(ctx, userID); err != nil {
+		return domain.UserIdentity{}, err
+	}
+
+	return MapUserIdentity(userID, accountInfo.Role, accountInfo.Verified, accountInfo.PhoneVerified)
+}
+
+// checkCode checks the code against the enabled two-factor authentication and marks it as used.
+// The checks of the user are limited, they are forgotten once a code matches or the lockout passes without another check.
+func (s *twoFactorServiceImpl) checkCode(ctx context.Context, userID int64, code string) error {
+	// the attempt is counted before the code is checked, so concurrent guesses can't exceed the limit
+	attempts, err := s.twoFactorAttemptRepository.AddAttempt(ctx, userID, s.cfg.Lockout)
+	if err != nil {
+		return err
+	}
+	if attempts > s.cfg.MaxAttempts {
+		return ErrTwoFactorAttemptsExceeded
+	}
+
+	if err := s.verifyCode(ctx, userID, code); err != nil {
+		return err
+	}
+
+	return s.twoFactorAttemptRepository.ResetAttempts(ctx, userID)
+}
+
+// verifyCode finds the matching one-time password or recovery code and marks it as used.
+func (s *twoFactorServiceImpl) verifyCode(ctx context.Context, userID int64, code string) error {
+	twoFactor, err := s.twoFactorRepository.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrTwoFactorNotEnabled
+		}
+		return err
+	}
+	if !twoFactor.Enabled {
+		return ErrTwoFactorNotEnabled
+	}
+
+	step, err := s.validateCode(twoFactor, code)
+	if err == nil {
+		ok, err := s.twoFactorRepository.UseStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvalidTwoFactorCode
+		}
+
+		return nil
+	}
+	if !errors.Is(err, ErrInvalidTwoFactorCode) {
+		return err
+	}
+
+	return s.useRecoveryCode(ctx, userID, code)
+}
+
+// validateCode validates the one-time password against the decrypted secret and returns its time step.
+func (s *twoFactorServiceImpl) validateCode(twoFactor repository.TwoFactor, code string) (int64, error) {
+	secret, err := s.decryptor.Decrypt(twoFactor.Secret)
+	if err != nil {
+		return 0, err
+	}
+
+	step, err := totp.Validate(code, string(secret), time.Now(), totpSkew)
+	if err != nil {
+		if errors.Is(err, totp.ErrInvalidCode) {
+			return 0, ErrInvalidTwoFactorCode
+		}
+		return 0, err
+	}
+
+	return step, nil
+}
+
+// useRecoveryCode finds the matching unused recovery code and marks it as used.
+func (s *twoFactorServiceImpl) useRecoveryCode(ctx context.Context, userID int64, code string) error {
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeEncoding.EncodedLen(recoveryCodeBytes) {
+		return ErrInvalidTwoFactorCode
+	}
+
+	recoveryCodes, err := s.twoFactorRepository.ListUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		err := s.hasher.VerifyPassword(normalized, recoveryCode.Hash)
+		if errors.Is(err, hasher.ErrPasswordMismatch) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		ok, err := s.twoFactorRepository.UseRecoveryCode(ctx, recoveryCode.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvalidTwoFactorCode
+		}
+
+		return nil
+	}
+
+	return ErrInvalidTwoFactorCode
+}
+
+// newRecoveryCodes generates recovery codes formatted as "xxxx-xxxx" and returns them along with their hashes.
+func (s *twoFactorServiceImpl) newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, s.cfg.RecoveryCodes)
+	hashes := make([]string, s.cfg.RecoveryCodes)
+
+	for i := range codes {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		hash, err := s.hasher.HashPassword(code)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes[i] = code[:len(code)/2] + "-" + code[len(code)/2:]
+		hashes[i] = hash
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
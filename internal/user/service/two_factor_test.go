@@ -0,0 +1,358 @@
+package service_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/internal/common/enum"
+	"github.com/hexley21/fixup/internal/user/repository"
+	mock_repository "github.com/hexley21/fixup/internal/user/repository/mock"
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
+	mock_encryption "github.com/hexley21/fixup/pkg/encryption/mock"
+	"github.com/hexley21/fixup/pkg/hasher"
+	mock_hasher "github.com/hexley21/fixup/pkg/hasher/mock"
+	mock_postgres "github.com/hexley21/fixup/pkg/infra/postgres/mock"
+	"github.com/hexley21/fixup/pkg/totp"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	twoFactorUserId       int64 = 1
+	twoFactorEmail              = "larry@page.com"
+	twoFactorIssuer             = "Fixup"
+	twoFactorSecret             = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	recoveryCodes               = 3
+	recoveryCode                = "abcd-efgh"
+	recoveryCodeHash            = "recovery-hash"
+	twoFactorChallengeId        = "challenge-id"
+	twoFactorMaxAttempts        = 5
+	twoFactorLockout            = 15 * time.Minute
+	twoFactorChallengeTTL       = 5 * time.Minute
+)
+
+var (
+	encryptedSecret = []byte("encrypted")
+
+	enabledTwoFactor = repository.TwoFactor{UserID: twoFactorUserId, Secret: encryptedSecret, Enabled: true}
+	pendingTwoFactor = repository.TwoFactor{UserID: twoFactorUserId, Secret: encryptedSecret}
+)
+
+func setupTwoFactor(t *testing.T) (
+	ctrl *gomock.Controller,
+	ctx context.Context,
+	svc service.TwoFactorService,
+	mockUserRepository *mock_repository.MockUserRepository,
+	mockTwoFactorRepository *mock_repository.MockTwoFactorRepository,
+	mockPgx *mock_postgres.MockPGX,
+	mockTx *mock_postgres.MockTx,
+	mockHasher *mock_hasher.MockHasher,
+	mockEncryptor *mock_encryption.MockEncryptor,
+	mockDecryptor *mock_encryption.MockDecryptor,
+	mockTwoFactorAttemptRepository *mock_repository.MockTwoFactorAttemptRepository,
+) {
+	ctrl = gomock.NewController(t)
+	ctx = context.Background()
+
+	mockUserRepository = mock_repository.NewMockUserRepository(ctrl)
+	mockTwoFactorRepository = mock_repository.NewMockTwoFactorRepository(ctrl)
+	mockPgx = mock_postgres.NewMockPGX(ctrl)
+	mockTx = mock_postgres.NewMockTx(ctrl)
+	mockHasher = mock_hasher.NewMockHasher(ctrl)
+	mockEncryptor = mock_encryption.NewMockEncryptor(ctrl)
+	mockDecryptor = mock_encryption.NewMockDecryptor(ctrl)
+	mockTwoFactorAttemptRepository = mock_repository.NewMockTwoFactorAttemptRepository(ctrl)
+
+	svc = service.NewTwoFactorService(
+		mockUserRepository,
+		mockTwoFactorRepository,
+		mockTwoFactorAttemptRepository,
+		mockPgx,
+		mockHasher,
+		mockEncryptor,
+		mockDecryptor,
+		config.TwoFactor{
+			Issuer:        twoFactorIssuer,
+			RecoveryCodes: recoveryCodes,
+			MaxAttempts:   twoFactorMaxAttempts,
+			Lockout:       twoFactorLockout,
+		},
+		twoFactorChallengeTTL,
+	)
+
+	return
+}
+
+func currentCode(t *testing.T) (string, int64) {
+	step := totp.Step(time.Now())
+	code, err := totp.Code(twoFactorSecret, step)
+	if err != nil {
+		t.Fatalf("failed to generate code: %v", err)
+	}
+
+	return code, step
+}
+
+func TestEnrollTwoFactor_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockTwoFactorRepository, _, _, _, mockEncryptor, _, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, twoFactorUserId).Return(repository.User{ID: twoFactorUserId, Email: twoFactorEmail}, nil)
+	mockEncryptor.EXPECT().Encrypt(gomock.Any()).Return(encryptedSecret, nil)
+	mockTwoFactorRepository.EXPECT().Upsert(ctx, twoFactorUserId, encryptedSecret).Return(true, nil)
+
+	enrolment, err := svc.Enroll(ctx, twoFactorUserId)
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, enrolment.Secret)
+
+		uri, err := url.Parse(enrolment.URI)
+		assert.NoError(t, err)
+		assert.Equal(t, enrolment.Secret, uri.Query().Get("secret"))
+		assert.Equal(t, "/"+twoFactorIssuer+":"+twoFactorEmail, uri.Path)
+	}
+}
+
+func TestEnrollTwoFactor_AlreadyEnabled(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockTwoFactorRepository, _, _, _, mockEncryptor, _, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockUserRepository.EXPECT().Get(ctx, twoFactorUserId).Return(repository.User{ID: twoFactorUserId, Email: twoFactorEmail}, nil)
+	mockEncryptor.EXPECT().Encrypt(gomock.Any()).Return(encryptedSecret, nil)
+	mockTwoFactorRepository.EXPECT().Upsert(ctx, twoFactorUserId, encryptedSecret).Return(false, nil)
+
+	_, err := svc.Enroll(ctx, twoFactorUserId)
+	assert.ErrorIs(t, err, service.ErrTwoFactorEnabled)
+}
+
+func TestConfirmTwoFactor_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, mockPgx, mockTx, mockHasher, _, mockDecryptor, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, step := currentCode(t)
+
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(pendingTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockHasher.EXPECT().HashPassword(gomock.Any()).Return(recoveryCodeHash, nil).Times(recoveryCodes)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockTwoFactorRepository.EXPECT().WithTx(mockTx).Return(mockTwoFactorRepository).Times(3)
+	mockTwoFactorRepository.EXPECT().Enable(ctx, twoFactorUserId, step).Return(true, nil)
+	mockTwoFactorRepository.EXPECT().DeleteRecoveryCodes(ctx, twoFactorUserId).Return(nil)
+	mockTwoFactorRepository.EXPECT().CreateRecoveryCodes(ctx, twoFactorUserId, []string{recoveryCodeHash, recoveryCodeHash, recoveryCodeHash}).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	codes, err := svc.Confirm(ctx, twoFactorUserId, code)
+	if assert.NoError(t, err) && assert.Len(t, codes, recoveryCodes) {
+		assert.Regexp(t, "^[a-z2-7]{4}-[a-z2-7]{4}$", codes[0])
+		assert.NotEqual(t, codes[0], codes[1])
+	}
+}
+
+func TestConfirmTwoFactor_InvalidCode(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, mockDecryptor, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(pendingTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+
+	_, err := svc.Confirm(ctx, twoFactorUserId, "12345a")
+	assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+}
+
+func TestConfirmTwoFactor_NotEnrolled(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, _, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(repository.TwoFactor{}, pgx.ErrNoRows)
+
+	_, err := svc.Confirm(ctx, twoFactorUserId, "123456")
+	assert.ErrorIs(t, err, service.ErrTwoFactorNotEnrolled)
+}
+
+func TestConfirmTwoFactor_AlreadyEnabled(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, _, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+
+	_, err := svc.Confirm(ctx, twoFactorUserId, "123456")
+	assert.ErrorIs(t, err, service.ErrTwoFactorEnabled)
+}
+
+func TestVerifyTwoFactor_Success(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockTwoFactorRepository, _, _, _, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, step := currentCode(t)
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().UseStep(ctx, twoFactorUserId, step).Return(true, nil)
+	mockTwoFactorAttemptRepository.EXPECT().ResetAttempts(ctx, twoFactorUserId).Return(nil)
+	mockTwoFactorAttemptRepository.EXPECT().UseChallenge(ctx, twoFactorChallengeId, twoFactorChallengeTTL).Return(true, nil)
+	mockUserRepository.EXPECT().GetAccountInfo(ctx, twoFactorUserId).Return(repository.GetUserAccountInfoRow{
+		Role:     string(enum.UserRoleCUSTOMER),
+		Verified: pgtype.Bool{Bool: true, Valid: true},
+	}, nil)
+	mockUserRepository.EXPECT().Restore(ctx, twoFactorUserId).Return(false, nil)
+
+	identity, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, code)
+	if assert.NoError(t, err) {
+		assert.Equal(t, twoFactorUserId, identity.ID)
+		assert.Equal(t, enum.UserRoleCUSTOMER, identity.AccountInfo.Role)
+		assert.False(t, identity.TwoFactorPending)
+	}
+}
+
+func TestVerifyTwoFactor_ReplayedCode(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, step := currentCode(t)
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().UseStep(ctx, twoFactorUserId, step).Return(false, nil)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, code)
+	assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+}
+
+func TestVerifyTwoFactor_RecoveryCode(t *testing.T) {
+	ctrl, ctx, svc, mockUserRepository, mockTwoFactorRepository, _, _, mockHasher, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().ListUnusedRecoveryCodes(ctx, twoFactorUserId).Return([]repository.RecoveryCode{
+		{ID: 1, Hash: "other-hash"},
+		{ID: 2, Hash: recoveryCodeHash},
+	}, nil)
+	mockHasher.EXPECT().VerifyPassword("abcdefgh", "other-hash").Return(hasher.ErrPasswordMismatch)
+	mockHasher.EXPECT().VerifyPassword("abcdefgh", recoveryCodeHash).Return(nil)
+	mockTwoFactorRepository.EXPECT().UseRecoveryCode(ctx, int64(2)).Return(true, nil)
+	mockTwoFactorAttemptRepository.EXPECT().ResetAttempts(ctx, twoFactorUserId).Return(nil)
+	mockTwoFactorAttemptRepository.EXPECT().UseChallenge(ctx, twoFactorChallengeId, twoFactorChallengeTTL).Return(true, nil)
+	mockUserRepository.EXPECT().GetAccountInfo(ctx, twoFactorUserId).Return(repository.GetUserAccountInfoRow{
+		Role:     string(enum.UserRolePROVIDER),
+		Verified: pgtype.Bool{Bool: true, Valid: true},
+	}, nil)
+	// a deactivated user is restored once the second factor passes
+	mockUserRepository.EXPECT().Restore(ctx, twoFactorUserId).Return(true, nil)
+
+	identity, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, "ABCD-EFGH")
+	if assert.NoError(t, err) {
+		assert.Equal(t, enum.UserRolePROVIDER, identity.AccountInfo.Role)
+	}
+}
+
+func TestVerifyTwoFactor_InvalidRecoveryCode(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, mockHasher, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().ListUnusedRecoveryCodes(ctx, twoFactorUserId).Return([]repository.RecoveryCode{
+		{ID: 1, Hash: recoveryCodeHash},
+	}, nil)
+	mockHasher.EXPECT().VerifyPassword("abcdefgh", recoveryCodeHash).Return(hasher.ErrPasswordMismatch)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, recoveryCode)
+	assert.ErrorIs(t, err, service.ErrInvalidTwoFactorCode)
+}
+
+func TestVerifyTwoFactor_NotEnabled(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, _, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(pendingTwoFactor, nil)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, "123456")
+	assert.ErrorIs(t, err, service.ErrTwoFactorNotEnabled)
+}
+
+func TestVerifyTwoFactor_AttemptsExceeded(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _, _, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, _ := currentCode(t)
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(twoFactorMaxAttempts+1), nil)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, code)
+	assert.ErrorIs(t, err, service.ErrTwoFactorAttemptsExceeded)
+}
+
+func TestVerifyTwoFactor_ChallengeUsed(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _, _, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, _ := currentCode(t)
+
+	// the code is left unchecked, so it stays usable
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(true, nil)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, code)
+	assert.ErrorIs(t, err, service.ErrTwoFactorChallengeUsed)
+}
+
+func TestVerifyTwoFactor_ChallengeUsedConcurrently(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, _, _, _, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, step := currentCode(t)
+
+	mockTwoFactorAttemptRepository.EXPECT().IsChallengeUsed(ctx, twoFactorChallengeId).Return(false, nil)
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().UseStep(ctx, twoFactorUserId, step).Return(true, nil)
+	mockTwoFactorAttemptRepository.EXPECT().ResetAttempts(ctx, twoFactorUserId).Return(nil)
+	mockTwoFactorAttemptRepository.EXPECT().UseChallenge(ctx, twoFactorChallengeId, twoFactorChallengeTTL).Return(false, nil)
+
+	_, err := svc.Verify(ctx, twoFactorUserId, twoFactorChallengeId, code)
+	assert.ErrorIs(t, err, service.ErrTwoFactorChallengeUsed)
+}
+
+func TestVerifyTwoFactor_NoChallengeId(t *testing.T) {
+	ctrl, ctx, svc, _, _, _, _, _, _, _, _ := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	_, err := svc.Verify(ctx, twoFactorUserId, "", "123456")
+	assert.ErrorIs(t, err, service.ErrTwoFactorChallengeUsed)
+}
+
+func TestDisableTwoFactor_Success(t *testing.T) {
+	ctrl, ctx, svc, _, mockTwoFactorRepository, mockPgx, mockTx, _, _, mockDecryptor, mockTwoFactorAttemptRepository := setupTwoFactor(t)
+	defer ctrl.Finish()
+
+	code, step := currentCode(t)
+
+	mockTwoFactorAttemptRepository.EXPECT().AddAttempt(ctx, twoFactorUserId, twoFactorLockout).Return(int64(1), nil)
+	mockTwoFactorRepository.EXPECT().Get(ctx, twoFactorUserId).Return(enabledTwoFactor, nil)
+	mockDecryptor.EXPECT().Decrypt(encryptedSecret).Return([]byte(twoFactorSecret), nil)
+	mockTwoFactorRepository.EXPECT().UseStep(ctx, twoFactorUserId, step).Return(true, nil)
+	mockTwoFactorAttemptRepository.EXPECT().ResetAttempts(ctx, twoFactorUserId).Return(nil)
+	mockPgx.EXPECT().BeginTx(ctx, gomock.Any()).Return(mockTx, nil)
+	mockTwoFactorRepository.EXPECT().WithTx(mockTx).Return(mockTwoFactorRepository).Times(2)
+	mockTwoFactorRepository.EXPECT().Delete(ctx, twoFactorUserId).Return(true, nil)
+	mockTwoFactorRepository.EXPECT().DeleteRecoveryCodes(ctx, twoFactorUserId).Return(nil)
+	mockTx.EXPECT().Commit(ctx).Return(nil)
+
+	assert.NoError(t, svc.Disable(ctx, twoFactorUserId, code))
+}
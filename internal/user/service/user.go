@@ -27,6 +27,7 @@ type UserService interface {
 
 type userServiceImpl struct {
 	userRepository     repository.UserRepository
+	sessionRepository  repository.SessionRepository
 	s3Bucket           s3.Bucket
 	cdnFileInvalidator cdn.FileInvalidator
 	hasher             hasher.Hasher
@@ -34,12 +35,14 @@ type userServiceImpl struct {
 
 func NewUserService(
 	userRepository repository.UserRepository,
+	sessionRepository repository.SessionRepository,
 	s3Bucket s3.Bucket,
 	cdnFileInvalidator cdn.FileInvalidator,
 	hasher hasher.Hasher,
 ) *userServiceImpl {
 	return &userServiceImpl{
 		userRepository,
+		sessionRepository,
 		s3Bucket,
 		cdnFileInvalidator,
 		hasher,
@@ -62,13 +65,13 @@ func (s *userServiceImpl) Get(ctx context.Context, userId int64) (*domain.User,
 }
 
 // UpdatePersonalInfo updates a user's personal information by their ID and returns domain user personal info.
+// The email is not updated, it can only be changed through a confirmed email change.
 // If the user is not found, it returns ErrUserNotFound.
 // If the update parameters are invalid, it returns ErrUserNotUpdated.
 func (s *userServiceImpl) UpdatePersonalInfo(ctx context.Context, id int64, personalInfo *domain.UserPersonalInfo) (*domain.UserPersonalInfo, error) {
 	user, err := s.userRepository.Update(ctx, id, repository.UpdateUserRow{
 		FirstName:   personalInfo.FirstName,
 		LastName:    personalInfo.LastName,
-		Email:       personalInfo.Email,
 		PhoneNumber: personalInfo.PhoneNumber,
 	})
 	if err != nil {
@@ -167,29 +170,11 @@ func (s *userServiceImpl) UpdatePassword(ctx context.Context, id int64, oldPasso
 	return nil
 }
 
-// Delete removes a user by their userId. It first retrieves the user's picture from the repository.
-// If the user has a picture, it deletes the picture from the S3 bucket and invalidates the file in the CDN.
-// Finally, it deletes the user from the repository.
+// Delete deactivates a user by their userId and revokes all of the user's sessions.
+// The user is purged with the picture once the deletion grace period passes, unless the user logs in again.
 // If the user is not found, it returns ErrUserNotFound.
 func (s *userServiceImpl) Delete(ctx context.Context, userId int64) error {
-	picture, err := s.userRepository.GetPicture(ctx, userId)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return ErrUserNotFound
-		}
-		return err
-	}
-
-	if picture.String != "" {
-		if err := s.s3Bucket.DeleteObject(ctx, picture.String); err != nil {
-			return err
-		}
-		if err := s.cdnFileInvalidator.InvalidateFile(ctx, picture.String); err != nil {
-			return err
-		}
-	}
-
-	ok, err := s.userRepository.Delete(ctx, userId)
+	ok, err := s.userRepository.Deactivate(ctx, userId)
 	if err != nil {
 		return err
 	}
@@ -197,5 +182,5 @@ func (s *userServiceImpl) Delete(ctx context.Context, userId int64) error {
 		return ErrUserNotFound
 	}
 
-	return nil
+	return s.sessionRepository.DeleteAll(ctx, userId)
 }
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hexley21/fixup/internal/user/service"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/logger"
+)
+
+// Purger purges the deactivated users once the deletion grace period passes.
+// Every instance runs its own purger, a user is purged only by the first one to reach it.
+type Purger struct {
+	service     service.PrivacyService
+	gracePeriod time.Duration
+	interval    time.Duration
+	batchSize   int32
+	logger      logger.Logger
+}
+
+func NewPurger(service service.PrivacyService, cfg config.AccountDeletion, logger logger.Logger) *Purger {
+	return &Purger{
+		service:     service,
+		gracePeriod: cfg.GracePeriod,
+		interval:    cfg.PurgeInterval,
+		batchSize:   cfg.PurgeBatchSize,
+		logger:      logger,
+	}
+}
+
+// Run purges the users on every interval until the context is cancelled.
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			p.purge(ctx)
+		}
+	}
+}
+
+// purge purges the users in batches until a batch comes out short or fails.
+func (p *Purger) purge(ctx context.Context) {
+	deactivatedBefore := time.Now().UTC().Add(-p.gracePeriod)
+
+	for {
+		purged, err := p.service.PurgeDeactivated(ctx, deactivatedBefore, p.batchSize)
+		if purged > 0 {
+			p.logger.Infof("Purge deactivated users - %d", purged)
+		}
+		if err != nil {
+			p.logger.Errorf("failed to purge deactivated users: %v", err)
+			return
+		}
+		if purged < int(p.batchSize) {
+			return
+		}
+	}
+}
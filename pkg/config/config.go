@@ -21,19 +21,28 @@ import (
 
 type (
 	Config struct {
-		Server       Server
-		HTTP         HTTP
-		Pagination   Pagination
-		Templates    Templates
-		Metrics      Metrics
-		Postgres     Postgres
-		Redis        Redis
-		AWS          AWS
-		JWT          JWT
-		Argon2       Argon2
-		AesEncryptor AesEncryptor
-		Mailer       Mailer
-		Logging      Logging
+		Server            Server
+		HTTP              HTTP
+		Pagination        Pagination
+		Templates         Templates
+		Metrics           Metrics
+		Postgres          Postgres
+		CatalogPostgres   Postgres `yaml:"catalog_postgres"`
+		Cassandra         Cassandra
+		Redis             Redis
+		AWS               AWS
+		JWT               JWT
+		Argon2            Argon2
+		AesEncryptor      AesEncryptor
+		TwoFactor         TwoFactor         `yaml:"two_factor"`
+		LoginThrottle     LoginThrottle     `yaml:"login_throttle"`
+		PhoneVerification PhoneVerification `yaml:"phone_verification"`
+		OIDC              OIDC              `yaml:"oidc"`
+		AccountDeletion   AccountDeletion   `yaml:"account_deletion"`
+		Localization      Localization
+		Mailer            Mailer
+		SMS               SMS
+		Logging           Logging
 	}
 
 	Server struct {
@@ -62,6 +71,12 @@ type (
 	Templates struct {
 		VerificationPath        string `yaml:"verification"`
 		VerificationSuccessPath string `yaml:"verification_success"`
+		PasswordResetPath       string `yaml:"password_reset"`
+		LoginLockoutPath        string `yaml:"login_lockout"`
+		EmailChangePath         string `yaml:"email_change"`
+		EmailChangeNoticePath   string `yaml:"email_change_notice"`
+		ProviderApprovedPath    string `yaml:"provider_approved"`
+		ProviderRejectedPath    string `yaml:"provider_rejected"`
 	}
 
 	Metrics struct {
@@ -82,6 +97,18 @@ type (
 		MaxConnIdleTime   time.Duration `yaml:"max-conn-idle-time"`
 	}
 
+	Cassandra struct {
+		User              string
+		Password          string
+		Hosts             string        `yaml:"hosts"`
+		Keyspace          string        `yaml:"keyspace"`
+		ReplicationFactor int           `yaml:"replication_factor"`
+		Consistency       string        `yaml:"consistency"`
+		NumConns          int           `yaml:"num_conns"`
+		Timeout           time.Duration `yaml:"timeout"`
+		ConnectTimeout    time.Duration `yaml:"connect_timeout"`
+	}
+
 	Redis struct {
 		Password     string
 		Addresses    string        `yaml:"addresses"`
@@ -118,12 +145,18 @@ type (
 	}
 
 	JWT struct {
-		AccessSecret       string
-		AccessTTL          time.Duration `yaml:"access_ttl"`
-		RefreshSecret      string
-		RefreshTTL         time.Duration `yaml:"refresh_ttl"`
-		VerificationSecret string
-		VerificationTTL    time.Duration `yaml:"verification_ttl"`
+		AccessSecret        string
+		AccessTTL           time.Duration `yaml:"access_ttl"`
+		RefreshSecret       string
+		RefreshTTL          time.Duration `yaml:"refresh_ttl"`
+		VerificationSecret  string
+		VerificationTTL     time.Duration `yaml:"verification_ttl"`
+		PasswordResetSecret string
+		PasswordResetTTL    time.Duration `yaml:"password_reset_ttl"`
+		TwoFactorSecret     string
+		TwoFactorTTL        time.Duration `yaml:"two_factor_ttl"`
+		EmailChangeSecret   string
+		EmailChangeTTL      time.Duration `yaml:"email_change_ttl"`
 	}
 
 	Mailer struct {
@@ -133,6 +166,24 @@ type (
 		Password string
 	}
 
+	SMS struct {
+		GatewayURL string        `yaml:"gateway_url"`
+		From       string        `yaml:"from"`
+		Timeout    time.Duration `yaml:"timeout"`
+		Token      string
+	}
+
+	OIDC struct {
+		Provider     string        `yaml:"provider"`
+		Issuer       string        `yaml:"issuer"`
+		ClientID     string        `yaml:"client_id"`
+		RedirectURL  string        `yaml:"redirect_url"`
+		Scopes       []string      `yaml:"scopes"`
+		StateTTL     time.Duration `yaml:"state_ttl"`
+		Timeout      time.Duration `yaml:"timeout"`
+		ClientSecret string
+	}
+
 	Argon2 struct {
 		SaltLen    uint32 `yaml:"salt_len"`
 		KeyLen     uint32 `yaml:"key_len"`
@@ -146,6 +197,38 @@ type (
 		Key string
 	}
 
+	TwoFactor struct {
+		Issuer        string        `yaml:"issuer"`
+		RecoveryCodes int           `yaml:"recovery_codes"`
+		MaxAttempts   int64         `yaml:"max_attempts"`
+		Lockout       time.Duration `yaml:"lockout"`
+	}
+
+	LoginThrottle struct {
+		MaxAttempts   int           `yaml:"max_attempts"`
+		MaxIPAttempts int           `yaml:"max_ip_attempts"`
+		BaseDelay     time.Duration `yaml:"base_delay"`
+		Lockout       time.Duration `yaml:"lockout"`
+		Window        time.Duration `yaml:"window"`
+	}
+
+	PhoneVerification struct {
+		CodeTTL        time.Duration `yaml:"code_ttl"`
+		ResendInterval time.Duration `yaml:"resend_interval"`
+		MaxAttempts    int64         `yaml:"max_attempts"`
+	}
+
+	AccountDeletion struct {
+		GracePeriod    time.Duration `yaml:"grace_period"`
+		PurgeInterval  time.Duration `yaml:"purge_interval"`
+		PurgeBatchSize int32         `yaml:"purge_batch_size"`
+	}
+
+	Localization struct {
+		DefaultLocale string   `yaml:"default_locale"`
+		Locales       []string `yaml:"locales"`
+	}
+
 	Logging struct {
 		LogLevel      string `yaml:"level"`
 		CallerEnabled bool   `yaml:"caller_enabled"`
@@ -204,11 +287,21 @@ func parseEnv(cfg *Config) error {
 	cfg.JWT.AccessSecret = os.Getenv("JWT_ACCESS_SECRET")
 	cfg.JWT.RefreshSecret = os.Getenv("JWT_REFRESH_SECRET")
 	cfg.JWT.VerificationSecret = os.Getenv("JWT_VERIFICATION_SECRET")
+	cfg.JWT.PasswordResetSecret = os.Getenv("JWT_PASSWORD_RESET_SECRET")
+	cfg.JWT.TwoFactorSecret = os.Getenv("JWT_TWO_FACTOR_SECRET")
+	cfg.JWT.EmailChangeSecret = os.Getenv("JWT_EMAIL_CHANGE_SECRET")
 
 	cfg.Postgres.User = os.Getenv("POSTGRES_USER")
 	cfg.Postgres.Password = os.Getenv("POSTGRES_PASSWORD")
 	cfg.Postgres.SslMode = os.Getenv("POSTGRES_SSL_MODE")
 
+	cfg.CatalogPostgres.User = cfg.Postgres.User
+	cfg.CatalogPostgres.Password = cfg.Postgres.Password
+	cfg.CatalogPostgres.SslMode = cfg.Postgres.SslMode
+
+	cfg.Cassandra.User = os.Getenv("CASSANDRA_USER")
+	cfg.Cassandra.Password = os.Getenv("CASSANDRA_PASSWORD")
+
 	cfg.Redis.Password = os.Getenv("REDIS_PASSWORD")
 
 	cfg.AWS.AWSCfg.AccessKeyID = os.Getenv("AWS_AC_ID")
@@ -229,6 +322,10 @@ func parseEnv(cfg *Config) error {
 	cfg.Server.Email = cfg.Mailer.User
 	cfg.Mailer.Password = os.Getenv("SMTP_PASSWORD")
 
+	cfg.SMS.Token = os.Getenv("SMS_GATEWAY_TOKEN")
+
+	cfg.OIDC.ClientSecret = os.Getenv("OIDC_CLIENT_SECRET")
+
 	cfg.AesEncryptor.Key = os.Getenv("DATA_ENCRYPTION_KEY")
 
 	return nil
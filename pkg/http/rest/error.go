@@ -79,6 +79,10 @@ func NewConflictError(cause error) *ErrorResponse {
 	return newError(cause, http.StatusConflict, cause.Error())
 }
 
+func NewTooManyRequestsError(cause error) *ErrorResponse {
+	return newError(cause, http.StatusTooManyRequests, cause.Error())
+}
+
 func NewInternalServerError(cause error) *ErrorResponse {
 	return newError(cause, http.StatusInternalServerError, MsgInternalServerError)
 }
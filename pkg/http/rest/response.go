@@ -1,9 +1,16 @@
 package rest
 
 type ApiResponse[T any] struct {
-	Data T `json:"data"`
+	Data       T      `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 func NewApiResponse[T any](data T) *ApiResponse[T] {
 	return &ApiResponse[T]{Data: data}
 }
+
+// NewCursorApiResponse returns a response of a cursor paginated list, an empty cursor means there is no page in its direction.
+func NewCursorApiResponse[T any](data T, nextCursor string, prevCursor string) *ApiResponse[T] {
+	return &ApiResponse[T]{Data: data, NextCursor: nextCursor, PrevCursor: prevCursor}
+}
@@ -32,6 +32,17 @@ func (aw *jSONHTTPWriter) WriteData(w http.ResponseWriter, code int, data any) {
     }
 }
 
+// WriteCursorData writes the provided data along with the cursors of the neighbouring pages as a JSON response,
+// otherwise it behaves like WriteData.
+func (aw *jSONHTTPWriter) WriteCursorData(w http.ResponseWriter, code int, data any, nextCursor string, prevCursor string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := aw.jsonSerializer.Serialize(w, rest.NewCursorApiResponse(data, nextCursor, prevCursor)); err != nil {
+		http.Error(w, msgErrReturningResult, http.StatusInternalServerError)
+	}
+}
+
 func (aw *jSONHTTPWriter) WriteNoContent(w http.ResponseWriter, code int) {
 	w.WriteHeader(code)
 }
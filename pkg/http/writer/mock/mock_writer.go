@@ -40,6 +40,18 @@ func (m *MockHTTPWriter) EXPECT() *MockHTTPWriterMockRecorder {
 	return m.recorder
 }
 
+// WriteCursorData mocks base method.
+func (m *MockHTTPWriter) WriteCursorData(w http.ResponseWriter, code int, data any, nextCursor, prevCursor string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WriteCursorData", w, code, data, nextCursor, prevCursor)
+}
+
+// WriteCursorData indicates an expected call of WriteCursorData.
+func (mr *MockHTTPWriterMockRecorder) WriteCursorData(w, code, data, nextCursor, prevCursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteCursorData", reflect.TypeOf((*MockHTTPWriter)(nil).WriteCursorData), w, code, data, nextCursor, prevCursor)
+}
+
 // WriteData mocks base method.
 func (m *MockHTTPWriter) WriteData(w http.ResponseWriter, code int, data any) {
 	m.ctrl.T.Helper()
@@ -99,6 +111,18 @@ func (m *MockHTTPDataWriter) EXPECT() *MockHTTPDataWriterMockRecorder {
 	return m.recorder
 }
 
+// WriteCursorData mocks base method.
+func (m *MockHTTPDataWriter) WriteCursorData(w http.ResponseWriter, code int, data any, nextCursor, prevCursor string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WriteCursorData", w, code, data, nextCursor, prevCursor)
+}
+
+// WriteCursorData indicates an expected call of WriteCursorData.
+func (mr *MockHTTPDataWriterMockRecorder) WriteCursorData(w, code, data, nextCursor, prevCursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteCursorData", reflect.TypeOf((*MockHTTPDataWriter)(nil).WriteCursorData), w, code, data, nextCursor, prevCursor)
+}
+
 // WriteData mocks base method.
 func (m *MockHTTPDataWriter) WriteData(w http.ResponseWriter, code int, data any) {
 	m.ctrl.T.Helper()
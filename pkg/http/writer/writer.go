@@ -14,6 +14,7 @@ type HTTPWriter interface {
 
 type HTTPDataWriter interface {
 	WriteData(w http.ResponseWriter, code int, data any)
+	WriteCursorData(w http.ResponseWriter, code int, data any, nextCursor string, prevCursor string)
 	WriteNoContent(w http.ResponseWriter, code int)
 }
 
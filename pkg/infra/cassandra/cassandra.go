@@ -0,0 +1,65 @@
+package cassandra
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/hexley21/fixup/pkg/config"
+)
+
+var errNoSession = errors.New("no session")
+
+// NewSession creates the keyspace if it does not exist and returns a session bound to it.
+func NewSession(cfg *config.Cassandra) (*gocql.Session, error) {
+	consistency, err := gocql.ParseConsistencyWrapper(cfg.Consistency)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := gocql.NewCluster(strings.Split(cfg.Hosts, ",")...)
+	cluster.Consistency = consistency
+	cluster.NumConns = cfg.NumConns
+	cluster.Timeout = cfg.Timeout
+	cluster.ConnectTimeout = cfg.ConnectTimeout
+	if cfg.User != "" {
+		cluster.Authenticator = gocql.PasswordAuthenticator{
+			Username: cfg.User,
+			Password: cfg.Password,
+		}
+	}
+
+	if err := createKeyspace(cluster, cfg.Keyspace, cfg.ReplicationFactor); err != nil {
+		return nil, err
+	}
+
+	cluster.Keyspace = cfg.Keyspace
+	return cluster.CreateSession()
+}
+
+func createKeyspace(cluster *gocql.ClusterConfig, keyspace string, replicationFactor int) error {
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	return session.Query(fmt.Sprintf(
+		`CREATE KEYSPACE IF NOT EXISTS %s WITH replication = {'class': 'SimpleStrategy', 'replication_factor': %d}`,
+		keyspace,
+		replicationFactor,
+	)).Exec()
+}
+
+func Close(session *gocql.Session) error {
+	if session == nil {
+		return errNoSession
+	}
+
+	session.Close()
+	log.Println("cassandra session was closed")
+
+	return nil
+}
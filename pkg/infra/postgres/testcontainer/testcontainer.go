@@ -13,4 +13,10 @@ func GetConfig() (string, []testcontainers.ContainerCustomizer) {
 		postgres.WithPassword("password"),
 		postgres.BasicWaitStrategies(),
 	}
+}
+
+// GetPostGISConfig returns configuration for running testcontainers with the PostGIS extension enabled
+func GetPostGISConfig() (string, []testcontainers.ContainerCustomizer) {
+	_, customizers := GetConfig()
+	return "docker.io/postgis/postgis:16-3.4-alpine", customizers
 }
\ No newline at end of file
@@ -0,0 +1,204 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	clientID     = "fixup"
+	clientSecret = "secret"
+	redirectURL  = "http://localhost:5173/oidc/callback"
+	authCode     = "auth-code"
+	codeVerifier = "code-verifier"
+	nonce        = "nonce"
+	keyID        = "key-1"
+	subject      = "1234567890"
+)
+
+// stubProvider is a local OpenID Connect provider, which issues an ID token for authCode.
+type stubProvider struct {
+	*httptest.Server
+	t         *testing.T
+	key       *rsa.PrivateKey
+	signKey   *rsa.PrivateKey
+	audience  string
+	nonce     string
+	discovery int
+}
+
+func newStubProvider(t *testing.T) *stubProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	s := &stubProvider{t: t, key: key, signKey: key, audience: clientID, nonce: nonce}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		s.discovery++
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 s.URL,
+			"authorization_endpoint": s.URL + "/authorize",
+			"token_endpoint":         s.URL + "/token",
+			"jwks_uri":               s.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": keyID,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(s.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", s.token)
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func (s *stubProvider) token(w http.ResponseWriter, r *http.Request) {
+	assert.NoError(s.t, r.ParseForm())
+	assert.Equal(s.t, "authorization_code", r.PostForm.Get("grant_type"))
+	assert.Equal(s.t, clientID, r.PostForm.Get("client_id"))
+	assert.Equal(s.t, clientSecret, r.PostForm.Get("client_secret"))
+	assert.Equal(s.t, redirectURL, r.PostForm.Get("redirect_uri"))
+
+	if r.PostForm.Get("code") != authCode || r.PostForm.Get("code_verifier") != codeVerifier {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.URL,
+		"sub":            subject,
+		"aud":            s.audience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          s.nonce,
+		"email":          "larry@page.com",
+		"email_verified": true,
+		"given_name":     "Larry",
+		"family_name":    "Page",
+	})
+	token.Header["kid"] = keyID
+
+	idToken, err := token.SignedString(s.signKey)
+	assert.NoError(s.t, err)
+
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "id_token": idToken})
+}
+
+func (s *stubProvider) newProvider() oidc.Provider {
+	return oidc.New(&config.OIDC{
+		Issuer:       s.URL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Timeout:      time.Second,
+	})
+}
+
+func TestAuthCodeURL_Success(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+
+	provider := stub.newProvider()
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state", nonce, oidc.CodeChallenge(codeVerifier))
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	assert.Equal(t, stub.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, clientID, query.Get("client_id"))
+	assert.Equal(t, redirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, "state", query.Get("state"))
+	assert.Equal(t, nonce, query.Get("nonce"))
+	assert.Equal(t, oidc.CodeChallenge(codeVerifier), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+	// the discovery is cached
+	_, err = provider.AuthCodeURL(context.Background(), "state", nonce, oidc.CodeChallenge(codeVerifier))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stub.discovery)
+}
+
+func TestExchange_Success(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+
+	claims, err := stub.newProvider().Exchange(context.Background(), authCode, codeVerifier, nonce)
+	assert.NoError(t, err)
+	assert.Equal(t, oidc.Claims{
+		Subject:       subject,
+		Email:         "larry@page.com",
+		EmailVerified: true,
+		GivenName:     "Larry",
+		FamilyName:    "Page",
+	}, claims)
+}
+
+func TestExchange_InvalidCode(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+
+	_, err := stub.newProvider().Exchange(context.Background(), "wrong-code", codeVerifier, nonce)
+	assert.ErrorIs(t, err, oidc.ErrExchange)
+}
+
+func TestExchange_NonceMismatch(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+	stub.nonce = "another-nonce"
+
+	_, err := stub.newProvider().Exchange(context.Background(), authCode, codeVerifier, nonce)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
+
+func TestExchange_WrongAudience(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+	stub.audience = "another-client"
+
+	_, err := stub.newProvider().Exchange(context.Background(), authCode, codeVerifier, nonce)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
+
+func TestExchange_ForeignSignature(t *testing.T) {
+	stub := newStubProvider(t)
+	defer stub.Close()
+
+	foreignKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	stub.signKey = foreignKey
+
+	_, err = stub.newProvider().Exchange(context.Background(), authCode, codeVerifier, nonce)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
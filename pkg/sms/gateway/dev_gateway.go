@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"github.com/hexley21/fixup/pkg/logger"
+)
+
+type devGatewaySender struct {
+	logger logger.Logger
+}
+
+func NewDev(logger logger.Logger) *devGatewaySender {
+	return &devGatewaySender{logger: logger}
+}
+
+// Send logs the text message instead of sending it, so no gateway is needed in development.
+func (s *devGatewaySender) Send(to string, text string) error {
+	s.logger.Infof("SMS - To: %s, Text: %s", to, text)
+	return nil
+}
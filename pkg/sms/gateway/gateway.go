@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hexley21/fixup/pkg/config"
+)
+
+type gatewaySender struct {
+	cfg    *config.SMS
+	client *http.Client
+}
+
+func New(cfg *config.SMS) *gatewaySender {
+	return &gatewaySender{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type message struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+// Send posts the text message to the configured SMS gateway, authorized with a bearer token.
+// Any non-2xx response of the gateway is treated as a failure.
+func (s *gatewaySender) Send(to string, text string) error {
+	body, err := json.Marshal(message{From: s.cfg.From, To: to, Text: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.cfg.GatewayURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("sms gateway responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package gateway_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/pkg/config"
+	"github.com/hexley21/fixup/pkg/sms/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"from": "Fixup", "to": "+995555123456", "text": "hello"}, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := gateway.New(&config.SMS{GatewayURL: server.URL, From: "Fixup", Timeout: time.Second, Token: "token"})
+
+	assert.NoError(t, sender.Send("+995555123456", "hello"))
+}
+
+func TestSend_GatewayError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sender := gateway.New(&config.SMS{GatewayURL: server.URL, Timeout: time.Second})
+
+	assert.Error(t, sender.Send("+995555123456", "hello"))
+}
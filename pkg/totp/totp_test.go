@@ -0,0 +1,89 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hexley21/fixup/pkg/totp"
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 6238 SHA1 test secret, codes are the last 6 digits of the RFC test vectors
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFCVectors(t *testing.T) {
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	for unix, expected := range vectors {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, code)
+	}
+}
+
+func TestCode_InvalidSecret(t *testing.T) {
+	_, err := totp.Code("not base32!", 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidSecret)
+}
+
+func TestValidate_Success(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	step, err := totp.Validate("050471", rfcSecret, now, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, totp.Step(now), step)
+}
+
+func TestValidate_Skew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	previous, err := totp.Code(rfcSecret, totp.Step(now)-1)
+	assert.NoError(t, err)
+
+	step, err := totp.Validate(previous, rfcSecret, now, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, totp.Step(now)-1, step)
+
+	_, err = totp.Validate(previous, rfcSecret, now, 0)
+	assert.ErrorIs(t, err, totp.ErrInvalidCode)
+}
+
+func TestValidate_InvalidCode(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	_, err := totp.Validate("000000", rfcSecret, now, 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidCode)
+
+	_, err = totp.Validate("05047", rfcSecret, now, 1)
+	assert.ErrorIs(t, err, totp.ErrInvalidCode)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	if assert.NoError(t, err) {
+		_, err := totp.Code(secret, 1)
+		assert.NoError(t, err)
+
+		other, err := totp.GenerateSecret()
+		assert.NoError(t, err)
+		assert.NotEqual(t, secret, other)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri, err := url.Parse(totp.URI("Fixup", "larry@page.com", "SECRET"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, "totp", uri.Host)
+		assert.Equal(t, "/Fixup:larry@page.com", uri.Path)
+		assert.Equal(t, "SECRET", uri.Query().Get("secret"))
+		assert.Equal(t, "Fixup", uri.Query().Get("issuer"))
+	}
+}
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS prevent_duplicate_service_trigger ON services;
+DROP FUNCTION IF EXISTS prevent_duplicate_service;
+
+ALTER TABLE services DROP CONSTRAINT IF EXISTS services_name_check;
+
+DROP INDEX IF EXISTS provider_services_service_id_idx;
+
+ALTER TABLE provider_services DROP CONSTRAINT provider_services_service_id_fkey;
+ALTER TABLE provider_services
+    ADD CONSTRAINT provider_services_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id);
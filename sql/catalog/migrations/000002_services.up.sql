@@ -0,0 +1,29 @@
+-- Offerings are removed together with the service
+ALTER TABLE provider_services DROP CONSTRAINT provider_services_service_id_fkey;
+ALTER TABLE provider_services
+    ADD CONSTRAINT provider_services_service_id_fkey FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE;
+
+CREATE INDEX provider_services_service_id_idx ON provider_services(service_id);
+
+ALTER TABLE services ADD CONSTRAINT services_name_check CHECK (LENGTH(name) > 1);
+
+-- services dublicate subcategory_id & name combination
+CREATE OR REPLACE FUNCTION prevent_duplicate_service()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM services
+        WHERE subcategory_id = NEW.subcategory_id
+        AND name = NEW.name
+        AND id <> NEW.id
+    ) THEN
+        RAISE EXCEPTION 'Duplicate service name and subcategory id combination';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_service_trigger
+BEFORE INSERT OR UPDATE ON services
+FOR EACH ROW EXECUTE FUNCTION prevent_duplicate_service();
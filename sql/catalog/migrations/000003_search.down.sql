@@ -0,0 +1,13 @@
+DROP VIEW IF EXISTS catalog_search;
+
+DROP INDEX IF EXISTS services_name_trgm_idx;
+DROP INDEX IF EXISTS subcategories_name_trgm_idx;
+DROP INDEX IF EXISTS categories_name_trgm_idx;
+DROP INDEX IF EXISTS category_types_name_trgm_idx;
+
+DROP INDEX IF EXISTS services_fts_idx;
+DROP INDEX IF EXISTS subcategories_name_fts_idx;
+DROP INDEX IF EXISTS categories_name_fts_idx;
+DROP INDEX IF EXISTS category_types_name_fts_idx;
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,53 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- full-text indexes, the expressions match the documents of catalog_search
+CREATE INDEX category_types_name_fts_idx ON category_types USING GIN (to_tsvector('english', name));
+CREATE INDEX categories_name_fts_idx ON categories USING GIN (to_tsvector('english', name));
+CREATE INDEX subcategories_name_fts_idx ON subcategories USING GIN (to_tsvector('english', name));
+CREATE INDEX services_fts_idx ON services USING GIN (to_tsvector('english', name || ' ' || COALESCE(description, '')));
+
+-- trigram indexes for typo tolerance and prefix autocomplete
+CREATE INDEX category_types_name_trgm_idx ON category_types USING GIN (name gin_trgm_ops);
+CREATE INDEX categories_name_trgm_idx ON categories USING GIN (name gin_trgm_ops);
+CREATE INDEX subcategories_name_trgm_idx ON subcategories USING GIN (name gin_trgm_ops);
+CREATE INDEX services_name_trgm_idx ON services USING GIN (name gin_trgm_ops);
+
+-- every searchable entry of the catalog with the ids and names of its ancestors
+CREATE VIEW catalog_search AS
+SELECT
+    'category_type' AS kind, ct.id, ct.name::TEXT AS name, NULL::TEXT AS description,
+    NULL::INT AS type_id, NULL::TEXT AS type_name,
+    NULL::INT AS category_id, NULL::TEXT AS category_name,
+    NULL::INT AS subcategory_id, NULL::TEXT AS subcategory_name,
+    to_tsvector('english', ct.name) AS document
+FROM category_types ct
+UNION ALL
+SELECT
+    'category', c.id, c.name::TEXT, NULL,
+    ct.id, ct.name::TEXT,
+    NULL, NULL,
+    NULL, NULL,
+    to_tsvector('english', c.name)
+FROM categories c
+JOIN category_types ct ON ct.id = c.type_id
+UNION ALL
+SELECT
+    'subcategory', sc.id, sc.name::TEXT, NULL,
+    ct.id, ct.name::TEXT,
+    c.id, c.name::TEXT,
+    NULL, NULL,
+    to_tsvector('english', sc.name)
+FROM subcategories sc
+JOIN categories c ON c.id = sc.category_id
+JOIN category_types ct ON ct.id = c.type_id
+UNION ALL
+SELECT
+    'service', s.id, s.name::TEXT, s.description,
+    ct.id, ct.name::TEXT,
+    c.id, c.name::TEXT,
+    sc.id, sc.name::TEXT,
+    to_tsvector('english', s.name || ' ' || COALESCE(s.description, ''))
+FROM services s
+JOIN subcategories sc ON sc.id = s.subcategory_id
+JOIN categories c ON c.id = sc.category_id
+JOIN category_types ct ON ct.id = c.type_id;
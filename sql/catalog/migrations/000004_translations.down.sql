@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS prevent_duplicate_service_translations_trigger ON services;
+DROP FUNCTION IF EXISTS prevent_duplicate_service_translations;
+
+DROP TRIGGER IF EXISTS prevent_duplicate_subcategory_translations_trigger ON subcategories;
+DROP FUNCTION IF EXISTS prevent_duplicate_subcategory_translations;
+
+DROP TRIGGER IF EXISTS prevent_duplicate_translations_trigger ON categories;
+DROP FUNCTION IF EXISTS prevent_duplicate_type_name_translations;
+
+DROP TABLE IF EXISTS service_translations;
+DROP TABLE IF EXISTS subcategory_translations;
+DROP TABLE IF EXISTS category_translations;
+
+DROP FUNCTION IF EXISTS prevent_duplicate_service_translation;
+DROP FUNCTION IF EXISTS prevent_duplicate_subcategory_translation;
+DROP FUNCTION IF EXISTS prevent_duplicate_type_name_translation;
@@ -0,0 +1,162 @@
+-- Translations of the catalog names, the names stored on the catalog tables belong to the default locale
+CREATE TABLE category_translations (
+    category_id INT NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) > 1),
+    PRIMARY KEY(category_id, locale)
+);
+
+CREATE TABLE subcategory_translations (
+    subcategory_id INT NOT NULL REFERENCES subcategories(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) > 1),
+    PRIMARY KEY(subcategory_id, locale)
+);
+
+CREATE TABLE service_translations (
+    service_id INT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) > 1),
+    description TEXT,
+    PRIMARY KEY(service_id, locale)
+);
+
+-- category translations dublicate type_id & name combination within a locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_type_name_translation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM category_translations t
+        JOIN categories c ON t.category_id = c.id
+        WHERE c.type_id = (SELECT type_id FROM categories WHERE id = NEW.category_id)
+        AND t.locale = NEW.locale
+        AND t.name = NEW.name
+        AND t.category_id <> NEW.category_id
+    ) THEN
+        RAISE EXCEPTION 'A translation with the same type_id, locale and name already exists.';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_translation_trigger
+BEFORE INSERT OR UPDATE ON category_translations
+FOR EACH ROW
+EXECUTE FUNCTION prevent_duplicate_type_name_translation();
+
+-- categories moved to another type keep their translations unique within each locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_type_name_translations()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM category_translations t
+        JOIN category_translations o ON o.locale = t.locale AND o.name = t.name
+        JOIN categories c ON o.category_id = c.id
+        WHERE t.category_id = NEW.id
+        AND c.type_id = NEW.type_id
+        AND c.id <> NEW.id
+    ) THEN
+        RAISE EXCEPTION 'A translation with the same type_id, locale and name already exists.';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_translations_trigger
+BEFORE UPDATE OF type_id ON categories
+FOR EACH ROW
+EXECUTE FUNCTION prevent_duplicate_type_name_translations();
+
+-- subcategory translations dublicate category_id & name combination within a locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_subcategory_translation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM subcategory_translations t
+        JOIN subcategories sc ON t.subcategory_id = sc.id
+        WHERE sc.category_id = (SELECT category_id FROM subcategories WHERE id = NEW.subcategory_id)
+        AND t.locale = NEW.locale
+        AND t.name = NEW.name
+        AND t.subcategory_id <> NEW.subcategory_id
+    ) THEN
+        RAISE EXCEPTION 'Duplicate subcategory translation name, locale and category id combination';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_subcategory_translation_trigger
+BEFORE INSERT OR UPDATE ON subcategory_translations
+FOR EACH ROW EXECUTE FUNCTION prevent_duplicate_subcategory_translation();
+
+-- subcategories moved to another category keep their translations unique within each locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_subcategory_translations()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM subcategory_translations t
+        JOIN subcategory_translations o ON o.locale = t.locale AND o.name = t.name
+        JOIN subcategories sc ON o.subcategory_id = sc.id
+        WHERE t.subcategory_id = NEW.id
+        AND sc.category_id = NEW.category_id
+        AND sc.id <> NEW.id
+    ) THEN
+        RAISE EXCEPTION 'Duplicate subcategory translation name, locale and category id combination';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_subcategory_translations_trigger
+BEFORE UPDATE OF category_id ON subcategories
+FOR EACH ROW EXECUTE FUNCTION prevent_duplicate_subcategory_translations();
+
+-- service translations dublicate subcategory_id & name combination within a locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_service_translation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM service_translations t
+        JOIN services s ON t.service_id = s.id
+        WHERE s.subcategory_id = (SELECT subcategory_id FROM services WHERE id = NEW.service_id)
+        AND t.locale = NEW.locale
+        AND t.name = NEW.name
+        AND t.service_id <> NEW.service_id
+    ) THEN
+        RAISE EXCEPTION 'Duplicate service translation name, locale and subcategory id combination';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_service_translation_trigger
+BEFORE INSERT OR UPDATE ON service_translations
+FOR EACH ROW EXECUTE FUNCTION prevent_duplicate_service_translation();
+
+-- services moved to another subcategory keep their translations unique within each locale
+CREATE OR REPLACE FUNCTION prevent_duplicate_service_translations()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM service_translations t
+        JOIN service_translations o ON o.locale = t.locale AND o.name = t.name
+        JOIN services s ON o.service_id = s.id
+        WHERE t.service_id = NEW.id
+        AND s.subcategory_id = NEW.subcategory_id
+        AND s.id <> NEW.id
+    ) THEN
+        RAISE EXCEPTION 'Duplicate service translation name, locale and subcategory id combination';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_duplicate_service_translations_trigger
+BEFORE UPDATE OF subcategory_id ON services
+FOR EACH ROW EXECUTE FUNCTION prevent_duplicate_service_translations();
@@ -0,0 +1,68 @@
+DROP VIEW IF EXISTS catalog_search;
+
+-- the documents and names are computed per locale, falling back to the default names, so no expression index can match them
+-- and every search scans the catalog, which is small and curated by admins
+DROP INDEX IF EXISTS category_types_name_fts_idx;
+DROP INDEX IF EXISTS categories_name_fts_idx;
+DROP INDEX IF EXISTS subcategories_name_fts_idx;
+DROP INDEX IF EXISTS services_fts_idx;
+DROP INDEX IF EXISTS category_types_name_trgm_idx;
+DROP INDEX IF EXISTS categories_name_trgm_idx;
+DROP INDEX IF EXISTS subcategories_name_trgm_idx;
+DROP INDEX IF EXISTS services_name_trgm_idx;
+
+-- every searchable entry of the catalog with the ids and names of its ancestors,
+-- names are translated to the locale, falling back to the names of the default locale where a translation is missing
+CREATE FUNCTION catalog_search(search_locale TEXT)
+RETURNS TABLE (
+    kind TEXT, id INT, name TEXT, description TEXT,
+    type_id INT, type_name TEXT,
+    category_id INT, category_name TEXT,
+    subcategory_id INT, subcategory_name TEXT,
+    document TSVECTOR
+)
+LANGUAGE sql STABLE AS $$
+SELECT
+    'category_type'::TEXT, ct.id, ct.name::TEXT, NULL::TEXT,
+    NULL::INT, NULL::TEXT,
+    NULL::INT, NULL::TEXT,
+    NULL::INT, NULL::TEXT,
+    to_tsvector('english', ct.name)
+FROM category_types ct
+UNION ALL
+SELECT
+    'category', c.id, COALESCE(ctr.name, c.name)::TEXT, NULL,
+    ct.id, ct.name::TEXT,
+    NULL, NULL,
+    NULL, NULL,
+    to_tsvector('english', COALESCE(ctr.name, c.name))
+FROM categories c
+JOIN category_types ct ON ct.id = c.type_id
+LEFT JOIN category_translations ctr ON ctr.category_id = c.id AND ctr.locale = search_locale
+UNION ALL
+SELECT
+    'subcategory', sc.id, COALESCE(sctr.name, sc.name)::TEXT, NULL,
+    ct.id, ct.name::TEXT,
+    c.id, COALESCE(ctr.name, c.name)::TEXT,
+    NULL, NULL,
+    to_tsvector('english', COALESCE(sctr.name, sc.name))
+FROM subcategories sc
+JOIN categories c ON c.id = sc.category_id
+JOIN category_types ct ON ct.id = c.type_id
+LEFT JOIN category_translations ctr ON ctr.category_id = c.id AND ctr.locale = search_locale
+LEFT JOIN subcategory_translations sctr ON sctr.subcategory_id = sc.id AND sctr.locale = search_locale
+UNION ALL
+SELECT
+    'service', s.id, COALESCE(str.name, s.name)::TEXT, COALESCE(str.description, s.description),
+    ct.id, ct.name::TEXT,
+    c.id, COALESCE(ctr.name, c.name)::TEXT,
+    sc.id, COALESCE(sctr.name, sc.name)::TEXT,
+    to_tsvector('english', COALESCE(str.name, s.name) || ' ' || COALESCE(str.description, s.description, ''))
+FROM services s
+JOIN subcategories sc ON sc.id = s.subcategory_id
+JOIN categories c ON c.id = sc.category_id
+JOIN category_types ct ON ct.id = c.type_id
+LEFT JOIN category_translations ctr ON ctr.category_id = c.id AND ctr.locale = search_locale
+LEFT JOIN subcategory_translations sctr ON sctr.subcategory_id = sc.id AND sctr.locale = search_locale
+LEFT JOIN service_translations str ON str.service_id = s.id AND str.locale = search_locale
+$$;
@@ -0,0 +1,13 @@
+-- name: ListCatalogTree :many
+SELECT ct.id, ct.name,
+    c.id, COALESCE(ctr.name, c.name),
+    sc.id, COALESCE(sctr.name, sc.name),
+    s.id, COALESCE(str.name, s.name), COALESCE(str.description, s.description)
+FROM category_types ct
+LEFT JOIN categories c ON c.type_id = ct.id
+LEFT JOIN category_translations ctr ON ctr.category_id = c.id AND ctr.locale = $1
+LEFT JOIN subcategories sc ON sc.category_id = c.id
+LEFT JOIN subcategory_translations sctr ON sctr.subcategory_id = sc.id AND sctr.locale = $1
+LEFT JOIN services s ON s.subcategory_id = sc.id
+LEFT JOIN service_translations str ON str.service_id = s.id AND str.locale = $1
+ORDER BY ct.id, c.id, sc.id, s.id;
@@ -7,9 +7,37 @@ SELECT * FROM categories WHERE id = $1;
 -- name: ListCategoriesByTypeId :many
 SELECT * FROM categories WHERE type_id = $1 ORDER BY id DESC OFFSET $2 LIMIT $3;
 
+-- name: ListCategoriesByTypeIdAfter :many
+SELECT id, type_id, name
+FROM categories
+WHERE type_id = $1 AND ($2 = 0 OR id < $2)
+ORDER BY id DESC LIMIT $3;
+
+-- name: ListCategoriesByTypeIdBefore :many
+SELECT * FROM (
+    SELECT id, type_id, name
+    FROM categories
+    WHERE type_id = $1 AND id > $2
+    ORDER BY id LIMIT $3
+) AS page ORDER BY id DESC;
+
 -- name: ListCategories :many
 SELECT * FROM categories ORDER BY id DESC OFFSET $1 LIMIT $2;
 
+-- name: ListCategoriesAfter :many
+SELECT id, type_id, name
+FROM categories
+WHERE ($1 = 0 OR id < $1)
+ORDER BY id DESC LIMIT $2;
+
+-- name: ListCategoriesBefore :many
+SELECT * FROM (
+    SELECT id, type_id, name
+    FROM categories
+    WHERE id > $1
+    ORDER BY id LIMIT $2
+) AS page ORDER BY id DESC;
+
 -- name: UpdateCategory :one
 UPDATE categories SET name = $2, type_id = $3 WHERE id = $1 Returning *;
 
@@ -7,6 +7,20 @@ SELECT * FROM category_types WHERE id = $1;
 -- name: ListCategoryTypes :many
 SELECT * FROM category_types ORDER BY id DESC OFFSET $1 LIMIT $2;
 
+-- name: ListCategoryTypesAfter :many
+SELECT id, name
+FROM category_types
+WHERE ($1 = 0 OR id < $1)
+ORDER BY id DESC LIMIT $2;
+
+-- name: ListCategoryTypesBefore :many
+SELECT * FROM (
+    SELECT id, name
+    FROM category_types
+    WHERE id > $1
+    ORDER BY id LIMIT $2
+) AS page ORDER BY id DESC;
+
 -- name: UpdateCategoryType :exec
 UPDATE category_types SET name = $2 WHERE id = $1 Returning *;
 
@@ -0,0 +1,51 @@
+-- name: GetProviderService :one
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1 AND ps.service_id = $2;
+
+-- name: ListServicesByProviderId :many
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1
+ORDER BY s.id LIMIT $2 OFFSET $3;
+
+-- name: ListServicesByProviderIdAfter :many
+SELECT s.id, s.subcategory_id, s.name, s.description
+FROM provider_services ps
+JOIN services s ON ps.service_id = s.id
+WHERE ps.provider_id = $1 AND s.id > $2
+ORDER BY s.id LIMIT $3;
+
+-- name: ListServicesByProviderIdBefore :many
+SELECT * FROM (
+    SELECT s.id, s.subcategory_id, s.name, s.description
+    FROM provider_services ps
+    JOIN services s ON ps.service_id = s.id
+    WHERE ps.provider_id = $1 AND s.id < $2
+    ORDER BY s.id DESC LIMIT $3
+) AS page ORDER BY id;
+
+-- name: ListProviderIdsByServiceId :many
+SELECT provider_id FROM provider_services WHERE service_id = $1 ORDER BY provider_id LIMIT $2 OFFSET $3;
+
+-- name: ListProviderIdsByServiceIdAfter :many
+SELECT provider_id
+FROM provider_services
+WHERE service_id = $1 AND provider_id > $2
+ORDER BY provider_id LIMIT $3;
+
+-- name: ListProviderIdsByServiceIdBefore :many
+SELECT * FROM (
+    SELECT provider_id
+    FROM provider_services
+    WHERE service_id = $1 AND provider_id < $2
+    ORDER BY provider_id DESC LIMIT $3
+) AS page ORDER BY provider_id;
+
+-- name: CreateProviderService :exec
+INSERT INTO provider_services (provider_id, service_id) VALUES ($1, $2);
+
+-- name: DeleteProviderService :exec
+DELETE FROM provider_services WHERE provider_id = $1 AND service_id = $2;
@@ -0,0 +1,16 @@
+-- name: SearchCatalog :many
+WITH q AS (
+    SELECT replace(plainto_tsquery('english', $1::TEXT)::TEXT, '&', '|')::TSQUERY AS tsquery
+)
+SELECT kind, id, name, description, type_id, type_name, category_id, category_name, subcategory_id, subcategory_name,
+    (ts_rank(document, q.tsquery) + word_similarity($1, name))::REAL AS rank
+FROM catalog_search($2), q
+WHERE document @@ q.tsquery OR $1 <% name
+ORDER BY rank DESC, kind, id
+LIMIT $3 OFFSET $4;
+
+-- name: AutocompleteCatalog :many
+SELECT kind, id, name FROM catalog_search($2)
+WHERE name ILIKE $1::TEXT || '%' OR name ILIKE '% ' || $1::TEXT || '%'
+ORDER BY name ILIKE $1::TEXT || '%' DESC, LENGTH(name), name, kind, id
+LIMIT $3;
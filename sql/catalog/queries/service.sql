@@ -0,0 +1,28 @@
+-- name: GetServiceById :one
+SELECT id, subcategory_id, name, description FROM services WHERE id = $1;
+
+-- name: ListServices :many
+SELECT id, subcategory_id, name, description FROM services ORDER BY id LIMIT $1 OFFSET $2;
+
+-- name: ListServicesAfter :many
+SELECT id, subcategory_id, name, description
+FROM services
+WHERE id > $1
+ORDER BY id LIMIT $2;
+
+-- name: ListServicesBefore :many
+SELECT * FROM (
+    SELECT id, subcategory_id, name, description
+    FROM services
+    WHERE id < $1
+    ORDER BY id DESC LIMIT $2
+) AS page ORDER BY id;
+
+-- name: CreateService :one
+INSERT INTO services (subcategory_id, name, description) VALUES ($1, $2, $3) RETURNING id;
+
+-- name: UpdateService :one
+UPDATE services SET subcategory_id = $1, name = $2, description = $3 WHERE id = $4 RETURNING id, subcategory_id, name, description;
+
+-- name: DeleteService :exec
+DELETE FROM services WHERE id = $1;
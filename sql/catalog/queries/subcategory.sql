@@ -7,9 +7,37 @@ SELECT * FROM subcategories WHERE id = $1;
 -- name: ListSubategories :many
 SELECT * FROM subcategories ORDER BY id OFFSET $1 LIMIT $2;
 
+-- name: ListSubcategoriesAfter :many
+SELECT id, category_id, name
+FROM subcategories
+WHERE id > $1
+ORDER BY id LIMIT $2;
+
+-- name: ListSubcategoriesBefore :many
+SELECT * FROM (
+    SELECT id, category_id, name
+    FROM subcategories
+    WHERE id < $1
+    ORDER BY id DESC LIMIT $2
+) AS page ORDER BY id;
+
 -- name: ListSubategoriesByCategoryId :many
 SELECT * FROM subcategories WHERE category_id = $1 ORDER BY id OFFSET $2 LIMIT $3;
 
+-- name: ListSubcategoriesByCategoryIdAfter :many
+SELECT id, category_id, name
+FROM subcategories
+WHERE category_id = $1 AND id > $2
+ORDER BY id LIMIT $3;
+
+-- name: ListSubcategoriesByCategoryIdBefore :many
+SELECT * FROM (
+    SELECT id, category_id, name
+    FROM subcategories
+    WHERE category_id = $1 AND id < $2
+    ORDER BY id DESC LIMIT $3
+) AS page ORDER BY id;
+
 -- name: ListSubategoriesByTypeId :many
 SELECT s.* 
 FROM subcategories s
@@ -17,6 +45,22 @@ JOIN categories c ON s.category_id = c.id
 WHERE c.type_id = $1
 ORDER BY s.id OFFSET $2 LIMIT $3;
 
+-- name: ListSubcategoriesByTypeIdAfter :many
+SELECT s.id, s.category_id, s.name
+FROM subcategories s
+JOIN categories c ON s.category_id = c.id
+WHERE c.type_id = $1 AND s.id > $2
+ORDER BY s.id LIMIT $3;
+
+-- name: ListSubcategoriesByTypeIdBefore :many
+SELECT * FROM (
+    SELECT s.id, s.category_id, s.name
+    FROM subcategories s
+    JOIN categories c ON s.category_id = c.id
+    WHERE c.type_id = $1 AND s.id < $2
+    ORDER BY s.id DESC LIMIT $3
+) AS page ORDER BY id;
+
 -- name: UpdateSubcategory :one
 UPDATE subcategories SET name = $1, category_id = $2 WHERE id = $3 RETURNING *;
 
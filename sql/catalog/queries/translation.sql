@@ -0,0 +1,41 @@
+-- name: ListCategoryTranslations :many
+SELECT category_id, locale, name, NULL::TEXT AS description FROM category_translations WHERE category_id = $1 ORDER BY locale;
+
+-- name: ListCategoryTranslationsByLocale :many
+SELECT category_id, locale, name, NULL::TEXT AS description FROM category_translations WHERE category_id = ANY($1::INT[]) AND locale = $2;
+
+-- name: UpsertCategoryTranslation :one
+INSERT INTO category_translations (category_id, locale, name) VALUES ($1, $2, $3)
+ON CONFLICT (category_id, locale) DO UPDATE SET name = EXCLUDED.name
+RETURNING category_id, locale, name, NULL::TEXT AS description;
+
+-- name: DeleteCategoryTranslation :exec
+DELETE FROM category_translations WHERE category_id = $1 AND locale = $2;
+
+-- name: ListSubcategoryTranslations :many
+SELECT subcategory_id, locale, name, NULL::TEXT AS description FROM subcategory_translations WHERE subcategory_id = $1 ORDER BY locale;
+
+-- name: ListSubcategoryTranslationsByLocale :many
+SELECT subcategory_id, locale, name, NULL::TEXT AS description FROM subcategory_translations WHERE subcategory_id = ANY($1::INT[]) AND locale = $2;
+
+-- name: UpsertSubcategoryTranslation :one
+INSERT INTO subcategory_translations (subcategory_id, locale, name) VALUES ($1, $2, $3)
+ON CONFLICT (subcategory_id, locale) DO UPDATE SET name = EXCLUDED.name
+RETURNING subcategory_id, locale, name, NULL::TEXT AS description;
+
+-- name: DeleteSubcategoryTranslation :exec
+DELETE FROM subcategory_translations WHERE subcategory_id = $1 AND locale = $2;
+
+-- name: ListServiceTranslations :many
+SELECT service_id, locale, name, description FROM service_translations WHERE service_id = $1 ORDER BY locale;
+
+-- name: ListServiceTranslationsByLocale :many
+SELECT service_id, locale, name, description FROM service_translations WHERE service_id = ANY($1::INT[]) AND locale = $2;
+
+-- name: UpsertServiceTranslation :one
+INSERT INTO service_translations (service_id, locale, name, description) VALUES ($1, $2, $3, $4)
+ON CONFLICT (service_id, locale) DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description
+RETURNING service_id, locale, name, description;
+
+-- name: DeleteServiceTranslation :exec
+DELETE FROM service_translations WHERE service_id = $1 AND locale = $2;
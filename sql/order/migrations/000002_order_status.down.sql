@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS orders_user_id_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,6 @@
+-- Orders lifecycle
+ALTER TABLE orders
+    ADD COLUMN status ORDER_STATUS NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX orders_user_id_idx ON orders(user_id);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS offers_order_accepted_idx;
+DROP INDEX IF EXISTS offers_provider_order_pending_idx;
+DROP INDEX IF EXISTS offers_provider_id_idx;
+DROP INDEX IF EXISTS offers_order_id_idx;
+
+ALTER TABLE offers
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS updated_at;
+
+DROP TYPE IF EXISTS OFFER_STATUS;
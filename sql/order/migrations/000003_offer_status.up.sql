@@ -0,0 +1,16 @@
+-- Offers lifecycle
+CREATE TYPE OFFER_STATUS AS ENUM ('PENDING', 'ACCEPTED', 'REJECTED', 'WITHDRAWN');
+
+ALTER TABLE offers
+    ADD COLUMN status OFFER_STATUS NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX offers_order_id_idx ON offers(order_id);
+CREATE INDEX offers_provider_id_idx ON offers(provider_id);
+
+-- A provider may only have one active offer per order
+CREATE UNIQUE INDEX offers_provider_order_pending_idx ON offers(provider_id, order_id) WHERE status = 'PENDING';
+
+-- An order may only have one accepted offer
+CREATE UNIQUE INDEX offers_order_accepted_idx ON offers(order_id) WHERE status = 'ACCEPTED';
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS job_status_history CASCADE;
+
+DROP INDEX IF EXISTS jobs_provider_id_idx;
+DROP INDEX IF EXISTS jobs_user_id_idx;
+DROP INDEX IF EXISTS jobs_offer_id_idx;
+
+ALTER TABLE jobs ALTER COLUMN status DROP NOT NULL;
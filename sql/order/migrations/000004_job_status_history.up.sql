@@ -0,0 +1,17 @@
+-- Jobs lifecycle
+ALTER TABLE jobs ALTER COLUMN status SET NOT NULL;
+
+CREATE UNIQUE INDEX jobs_offer_id_idx ON jobs(offer_id);
+CREATE INDEX jobs_user_id_idx ON jobs(user_id);
+CREATE INDEX jobs_provider_id_idx ON jobs(provider_id);
+
+-- Job Status History Table
+CREATE TABLE job_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    job_id BIGINT NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    status ORDER_STATUS NOT NULL,
+    changed_by BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX job_status_history_job_id_idx ON job_status_history(job_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS provider_ratings CASCADE;
+
+DROP INDEX IF EXISTS reviews_user_id_idx;
+DROP INDEX IF EXISTS reviews_provider_id_idx;
+DROP INDEX IF EXISTS reviews_job_id_idx;
@@ -0,0 +1,17 @@
+-- A job may only be reviewed once
+CREATE UNIQUE INDEX reviews_job_id_idx ON reviews(job_id);
+CREATE INDEX reviews_provider_id_idx ON reviews(provider_id);
+CREATE INDEX reviews_user_id_idx ON reviews(user_id);
+
+-- Provider Ratings Table, aggregates are maintained on every review insert
+CREATE TABLE provider_ratings (
+    provider_id BIGINT PRIMARY KEY NOT NULL,
+    rating_sum NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    review_count INT NOT NULL DEFAULT 0,
+    rating_avg NUMERIC(3, 2) GENERATED ALWAYS AS (
+        CASE WHEN review_count = 0 THEN 0 ELSE rating_sum / review_count END
+    ) STORED
+);
+
+CREATE INDEX provider_ratings_rating_avg_idx ON provider_ratings(rating_avg DESC);
+CREATE INDEX provider_ratings_review_count_idx ON provider_ratings(review_count DESC);
@@ -0,0 +1,5 @@
+ALTER TABLE provider_locations ALTER COLUMN location DROP NOT NULL;
+
+DROP INDEX IF EXISTS orders_location_idx;
+DROP INDEX IF EXISTS provider_locations_city_id_idx;
+DROP INDEX IF EXISTS provider_locations_location_idx;
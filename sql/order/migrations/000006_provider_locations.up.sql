@@ -0,0 +1,6 @@
+-- Spatial indexes used by the radius search
+CREATE INDEX provider_locations_location_idx ON provider_locations USING GIST(location);
+CREATE INDEX provider_locations_city_id_idx ON provider_locations(city_id);
+CREATE INDEX orders_location_idx ON orders USING GIST(location);
+
+ALTER TABLE provider_locations ALTER COLUMN location SET NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS cities_name_prefix_idx;
+DROP INDEX IF EXISTS cities_iso2_idx;
+DROP INDEX IF EXISTS cities_country_idx;
+
+DROP INDEX IF EXISTS currencies_code_idx;
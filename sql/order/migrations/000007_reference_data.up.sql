@@ -0,0 +1,7 @@
+-- Currencies are looked up by their ISO 4217 code
+CREATE UNIQUE INDEX currencies_code_idx ON currencies(code);
+
+-- City filters
+CREATE INDEX cities_country_idx ON cities(lower(country));
+CREATE INDEX cities_iso2_idx ON cities(iso2);
+CREATE INDEX cities_name_prefix_idx ON cities(lower(name) text_pattern_ops);
@@ -0,0 +1,28 @@
+-- name: CreateCity :one
+INSERT INTO cities (
+  name, location, country, iso2, admin_name, capital
+) VALUES (
+  $1, ST_SetSRID(ST_MakePoint(sqlc.arg(longitude), sqlc.arg(latitude)), 4326)::geography, $2, $3, $4, $5
+)
+RETURNING city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital;
+
+-- name: GetCity :one
+SELECT city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+FROM cities WHERE city_id = $1;
+
+-- name: ListCities :many
+SELECT city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital
+FROM cities
+WHERE (sqlc.narg(country)::TEXT IS NULL OR lower(country) = lower(sqlc.narg(country)))
+  AND (sqlc.narg(iso2)::TEXT IS NULL OR iso2 = upper(sqlc.narg(iso2)))
+  AND (sqlc.narg(name_prefix)::TEXT IS NULL OR lower(name) LIKE lower(sqlc.narg(name_prefix)) || '%')
+ORDER BY name, city_id LIMIT $1 OFFSET $2;
+
+-- name: UpdateCity :one
+UPDATE cities
+SET name = $2, location = ST_SetSRID(ST_MakePoint(sqlc.arg(longitude), sqlc.arg(latitude)), 4326)::geography, country = $3, iso2 = $4, admin_name = $5, capital = $6
+WHERE city_id = $1
+RETURNING city_id, name, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, country, iso2, admin_name, capital;
+
+-- name: DeleteCity :exec
+DELETE FROM cities WHERE city_id = $1;
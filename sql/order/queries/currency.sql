@@ -0,0 +1,16 @@
+-- name: CreateCurrency :one
+INSERT INTO currencies (currency, code, symbol) VALUES ($1, $2, $3)
+RETURNING id, currency, code, symbol;
+
+-- name: GetCurrency :one
+SELECT id, currency, code, symbol FROM currencies WHERE id = $1;
+
+-- name: ListCurrencies :many
+SELECT id, currency, code, symbol FROM currencies ORDER BY code LIMIT $1 OFFSET $2;
+
+-- name: UpdateCurrency :one
+UPDATE currencies SET currency = $2, code = $3, symbol = $4 WHERE id = $1
+RETURNING id, currency, code, symbol;
+
+-- name: DeleteCurrency :exec
+DELETE FROM currencies WHERE id = $1;
@@ -0,0 +1,31 @@
+-- name: CreateJob :one
+INSERT INTO jobs (
+  id, offer_id, order_id, user_id, provider_id, service_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at;
+
+-- name: GetJob :one
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE id = $1;
+
+-- name: ListJobsByProviderId :many
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: ListJobsByUserId :many
+SELECT id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at
+FROM jobs WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: UpdateJobStatus :one
+UPDATE jobs SET status = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = $2
+RETURNING id, offer_id, order_id, user_id, provider_id, service_id, status, created_at, updated_at;
+
+-- name: CreateJobStatusHistory :exec
+INSERT INTO job_status_history (job_id, status, changed_by) VALUES ($1, $2, $3);
+
+-- name: ListJobStatusHistory :many
+SELECT id, job_id, status, changed_by, created_at
+FROM job_status_history WHERE job_id = $1 ORDER BY id;
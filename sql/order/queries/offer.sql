@@ -0,0 +1,39 @@
+-- name: CreateOffer :one
+INSERT INTO offers (
+  id, provider_id, order_id, price, currency_id, book_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at;
+
+-- name: GetOffer :one
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE id = $1;
+
+-- name: GetOfferForUpdate :one
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE id = $1 FOR UPDATE;
+
+-- name: ListOffersByOrderId :many
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE order_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: ListOffersByProviderId :many
+SELECT id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at
+FROM offers WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: UpdateOffer :one
+UPDATE offers SET
+  price = $2,
+  currency_id = $3,
+  book_time = $4,
+  updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'PENDING'
+RETURNING id, provider_id, order_id, price, currency_id, book_time, status, created_at, updated_at;
+
+-- name: UpdateOfferStatus :exec
+UPDATE offers SET status = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1 AND status = 'PENDING';
+
+-- name: RejectCompetingOffers :exec
+UPDATE offers SET status = 'REJECTED', updated_at = CURRENT_TIMESTAMP
+WHERE order_id = $1 AND id <> $2 AND status = 'PENDING';
@@ -0,0 +1,44 @@
+-- name: CreateOrder :one
+INSERT INTO orders (
+  id, user_id, service_id, location, time_start, time_end, description
+) VALUES (
+  $1, $2, $3, ST_SetSRID(ST_MakePoint(sqlc.narg(longitude), sqlc.narg(latitude)), 4326)::geography, $4, $5, $6
+)
+RETURNING id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at;
+
+-- name: GetOrder :one
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE id = $1;
+
+-- name: GetOrderForUpdate :one
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE id = $1 FOR UPDATE;
+
+-- name: ListOrders :many
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE status = 'PENDING' AND NOT EXISTS (
+  SELECT 1 FROM offers WHERE offers.order_id = orders.id AND offers.status = 'ACCEPTED'
+)
+ORDER BY id DESC LIMIT $1 OFFSET $2;
+
+-- name: ListOrdersByUserId :many
+SELECT id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at
+FROM orders WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: UpdateOrder :one
+UPDATE orders SET
+  service_id = $2,
+  location = ST_SetSRID(ST_MakePoint(sqlc.narg(longitude), sqlc.narg(latitude)), 4326)::geography,
+  time_start = $3,
+  time_end = $4,
+  description = $5
+WHERE id = $1
+RETURNING id, user_id, service_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, time_start, time_end, description, status, created_at;
+
+-- name: IsOrderAssigned :one
+SELECT EXISTS (
+  SELECT 1 FROM offers WHERE order_id = $1 AND status = 'ACCEPTED'
+);
+
+-- name: UpdateOrderStatus :exec
+UPDATE orders SET status = $2 WHERE id = $1;
@@ -0,0 +1,24 @@
+-- name: UpsertProviderLocation :one
+INSERT INTO provider_locations (
+  provider_id, city_id, location, address
+) VALUES (
+  $1, $2, ST_SetSRID(ST_MakePoint(sqlc.arg(longitude), sqlc.arg(latitude)), 4326)::geography, $3
+)
+ON CONFLICT (provider_id) DO UPDATE
+SET city_id = EXCLUDED.city_id, location = EXCLUDED.location, address = EXCLUDED.address
+RETURNING provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address;
+
+-- name: GetProviderLocation :one
+SELECT provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address
+FROM provider_locations WHERE provider_id = $1;
+
+-- name: ListProviderIdsByCityId :many
+SELECT provider_id FROM provider_locations WHERE city_id = $1 ORDER BY provider_id LIMIT $2 OFFSET $3;
+
+-- name: ListNearbyProviders :many
+SELECT provider_id, city_id, ST_X(location::geometry) AS longitude, ST_Y(location::geometry) AS latitude, address,
+  ST_Distance(location, ST_SetSRID(ST_MakePoint(sqlc.arg(longitude), sqlc.arg(latitude)), 4326)::geography) AS distance
+FROM provider_locations
+WHERE ST_DWithin(location, ST_SetSRID(ST_MakePoint(sqlc.arg(longitude), sqlc.arg(latitude)), 4326)::geography, sqlc.arg(radius))
+  AND (sqlc.narg(provider_ids)::BIGINT[] IS NULL OR provider_id = ANY(sqlc.narg(provider_ids)::BIGINT[]))
+ORDER BY distance, provider_id LIMIT $1 OFFSET $2;
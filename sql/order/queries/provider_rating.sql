@@ -0,0 +1,18 @@
+-- name: AddProviderRating :one
+INSERT INTO provider_ratings (provider_id, rating_sum, review_count)
+VALUES ($1, $2, 1)
+ON CONFLICT (provider_id) DO UPDATE
+SET rating_sum = provider_ratings.rating_sum + EXCLUDED.rating_sum,
+    review_count = provider_ratings.review_count + 1
+RETURNING provider_id, rating_avg, review_count;
+
+-- name: GetProviderRating :one
+SELECT provider_id, rating_avg, review_count FROM provider_ratings WHERE provider_id = $1;
+
+-- name: ListProviderRatingsByRating :many
+SELECT provider_id, rating_avg, review_count FROM provider_ratings
+ORDER BY rating_avg DESC, review_count DESC, provider_id LIMIT $1 OFFSET $2;
+
+-- name: ListProviderRatingsByReviews :many
+SELECT provider_id, rating_avg, review_count FROM provider_ratings
+ORDER BY review_count DESC, rating_avg DESC, provider_id LIMIT $1 OFFSET $2;
@@ -0,0 +1,19 @@
+-- name: CreateReview :one
+INSERT INTO reviews (
+  id, job_id, user_id, provider_id, rating, comment
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING id, job_id, user_id, provider_id, rating, comment, created_at;
+
+-- name: GetReview :one
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE id = $1;
+
+-- name: ListReviewsByProviderId :many
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE provider_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
+
+-- name: ListReviewsByUserId :many
+SELECT id, job_id, user_id, provider_id, rating, comment, created_at
+FROM reviews WHERE user_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3;
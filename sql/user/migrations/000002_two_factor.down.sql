@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS user_recovery_codes_user_id_idx;
+
+DROP TABLE IF EXISTS user_recovery_codes CASCADE;
+DROP TABLE IF EXISTS user_two_factor CASCADE;
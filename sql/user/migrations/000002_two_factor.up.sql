@@ -0,0 +1,18 @@
+-- TOTP Two-Factor Table, the secret is encrypted and the enrolment is pending until it's enabled
+CREATE TABLE user_two_factor (
+    user_id BIGINT PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    secret BYTEA NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Recovery Codes Table, codes are single-use and stored as hashes
+CREATE TABLE user_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hash VARCHAR(128) NOT NULL CHECK(LENGTH(hash) = 128),
+    used_at TIMESTAMP
+);
+
+CREATE INDEX user_recovery_codes_user_id_idx ON user_recovery_codes(user_id);
@@ -0,0 +1,6 @@
+-- Pending Email Changes Table, the new email is applied only once it's confirmed from the new address
+CREATE TABLE email_changes (
+    user_id BIGINT PRIMARY KEY NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    new_email VARCHAR(40) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Phone numbers are verified with a one-time code sent by SMS, a changed number has to be verified again
+ALTER TABLE users ADD COLUMN phone_verified BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_audit_logs CASCADE;
+DROP INDEX IF EXISTS users_email_prefix_idx;
+DROP INDEX IF EXISTS users_created_at_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended;
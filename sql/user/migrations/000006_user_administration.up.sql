@@ -0,0 +1,19 @@
+-- Suspended users can't log in or refresh their tokens
+ALTER TABLE users ADD COLUMN suspended BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX users_created_at_idx ON users(created_at);
+CREATE INDEX users_email_prefix_idx ON users(lower(email) text_pattern_ops);
+
+-- Audit Trail of the administrative actions, kept after the user or the admin is deleted
+CREATE TABLE user_audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    old_value VARCHAR(30),
+    new_value VARCHAR(30),
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX user_audit_logs_user_id_idx ON user_audit_logs(user_id, created_at);
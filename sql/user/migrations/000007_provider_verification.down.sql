@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS provider_documents CASCADE;
+DROP INDEX IF EXISTS providers_verification_queue_idx;
+ALTER TABLE providers
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS submitted_at,
+    DROP COLUMN IF EXISTS rejection_reason,
+    DROP COLUMN IF EXISTS verification_status;
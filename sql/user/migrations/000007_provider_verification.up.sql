@@ -0,0 +1,24 @@
+-- Identity verification of the providers, providers are promoted to the PROVIDER role once approved
+ALTER TABLE providers
+    ADD COLUMN verification_status VARCHAR(10) NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN rejection_reason TEXT,
+    ADD COLUMN submitted_at TIMESTAMP,
+    ADD COLUMN reviewed_by BIGINT,
+    ADD COLUMN reviewed_at TIMESTAMP;
+
+-- providers promoted before the review existed are treated as approved
+UPDATE providers p SET verification_status = 'APPROVED'
+FROM users u
+WHERE u.id = p.user_id AND u.role = 'PROVIDER';
+
+CREATE INDEX providers_verification_queue_idx ON providers(verification_status, submitted_at);
+
+-- ID document images of the providers, stored in the s3 bucket
+CREATE TABLE provider_documents (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    path VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX provider_documents_user_id_idx ON provider_documents(user_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_deactivated_at_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS purging;
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_at;
@@ -0,0 +1,6 @@
+-- Deactivated users are restored by logging in, or purged once the grace period passes
+ALTER TABLE users ADD COLUMN deactivated_at TIMESTAMP;
+-- A user claimed by the purge can't be restored anymore, so its files are never deleted from under a restored account
+ALTER TABLE users ADD COLUMN purging BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX users_deactivated_at_idx ON users(deactivated_at) WHERE deactivated_at IS NOT NULL;
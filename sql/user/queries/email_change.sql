@@ -0,0 +1,7 @@
+-- name: UpsertEmailChange :exec
+INSERT INTO email_changes (user_id, new_email) VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET new_email = EXCLUDED.new_email, created_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteEmailChange :exec
+DELETE FROM email_changes WHERE user_id = $1 AND new_email = $2;
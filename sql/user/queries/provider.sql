@@ -13,4 +13,68 @@ SELECT
 FROM 
   providers
 WHERE 
-  user_id = $1;
\ No newline at end of file
+  user_id = $1;
+
+-- name: GetProviderVerification :one
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.user_id = $1;
+
+-- name: GetProviderVerificationForUpdate :one
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.user_id = $1
+FOR UPDATE OF p;
+
+-- name: ListPendingProviderVerifications :many
+SELECT
+  p.user_id,
+  u.first_name,
+  u.last_name,
+  u.email,
+  p.personal_id_preview,
+  p.verification_status,
+  p.rejection_reason,
+  p.submitted_at,
+  p.reviewed_at
+FROM
+  providers p
+  JOIN users u ON u.id = p.user_id
+WHERE
+  p.verification_status = 'PENDING' AND p.submitted_at IS NOT NULL
+ORDER BY p.submitted_at, p.user_id
+LIMIT $1 OFFSET $2;
+
+-- name: SubmitProviderVerification :exec
+UPDATE providers
+SET verification_status = 'PENDING', rejection_reason = NULL, submitted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1 AND verification_status <> 'APPROVED';
+
+-- name: UpdateProviderVerification :exec
+UPDATE providers
+SET verification_status = $2, rejection_reason = $3, reviewed_by = $4, reviewed_at = CURRENT_TIMESTAMP
+WHERE user_id = $1;
\ No newline at end of file
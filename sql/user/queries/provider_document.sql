@@ -0,0 +1,8 @@
+-- name: CreateProviderDocument :one
+INSERT INTO provider_documents (user_id, path) VALUES ($1, $2)
+RETURNING id, user_id, path, created_at;
+
+-- name: ListProviderDocuments :many
+SELECT id, user_id, path, created_at
+FROM provider_documents WHERE user_id = $1
+ORDER BY created_at, id;
@@ -0,0 +1,31 @@
+-- name: UpsertTwoFactor :exec
+INSERT INTO user_two_factor (user_id, secret) VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, last_used_step = 0, created_at = CURRENT_TIMESTAMP
+WHERE user_two_factor.enabled = FALSE;
+
+-- name: GetTwoFactor :one
+SELECT user_id, secret, enabled, last_used_step FROM user_two_factor WHERE user_id = $1;
+
+-- name: EnableTwoFactor :exec
+UPDATE user_two_factor SET enabled = TRUE, last_used_step = $2
+WHERE user_id = $1 AND enabled = FALSE AND last_used_step < $2;
+
+-- name: UseTwoFactorStep :exec
+UPDATE user_two_factor SET last_used_step = $2
+WHERE user_id = $1 AND enabled = TRUE AND last_used_step < $2;
+
+-- name: DeleteTwoFactor :exec
+DELETE FROM user_two_factor WHERE user_id = $1;
+
+-- name: CreateRecoveryCodes :exec
+INSERT INTO user_recovery_codes (user_id, hash) SELECT $1, unnest($2::VARCHAR[]);
+
+-- name: ListUnusedRecoveryCodes :many
+SELECT id, hash FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL ORDER BY id;
+
+-- name: UseRecoveryCode :exec
+UPDATE user_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE id = $1 AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes WHERE user_id = $1;
@@ -2,7 +2,9 @@
 SELECT * FROM users WHERE id = $1;
 
 -- name: GetUserAuthInfoByEmail :one
-SELECT id, role, verified, hash FROM users WHERE email = $1;
+SELECT u.id, u.role, u.verified, u.phone_verified, u.suspended, u.deactivated_at IS NOT NULL AS deactivated, u.hash, COALESCE(t.enabled, FALSE) AS two_factor_enabled
+FROM users u LEFT JOIN user_two_factor t ON t.user_id = u.id
+WHERE u.email = $1;
 
 -- name: GetHashById :one
 SELECT hash FROM users WHERE id = $1;
@@ -11,7 +13,44 @@ SELECT hash FROM users WHERE id = $1;
 SELECT id, verified, first_name FROM users WHERE email = $1;
 
 -- name: GetUserAccountInfo :one
-SELECT role, verified FROM users WHERE id = $1;
+SELECT role, verified, phone_verified, suspended FROM users WHERE id = $1;
+
+-- name: GetUserAccountInfoForUpdate :one
+SELECT role, verified, phone_verified, suspended FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: SearchUsers :many
+SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+FROM users
+WHERE (sqlc.narg(role)::TEXT IS NULL OR role = sqlc.narg(role)::USER_ROLE)
+  AND (sqlc.narg(verified)::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = sqlc.narg(verified))
+  AND (sqlc.narg(email_prefix)::TEXT IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)) || '%')
+  AND (sqlc.narg(created_after)::TIMESTAMP IS NULL OR created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::TIMESTAMP IS NULL OR created_at < sqlc.narg(created_before))
+ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2;
+
+-- name: SearchUsersAfter :many
+SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+FROM users
+WHERE (sqlc.narg(role)::TEXT IS NULL OR role = sqlc.narg(role)::USER_ROLE)
+  AND (sqlc.narg(verified)::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = sqlc.narg(verified))
+  AND (sqlc.narg(email_prefix)::TEXT IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)) || '%')
+  AND (sqlc.narg(created_after)::TIMESTAMP IS NULL OR created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::TIMESTAMP IS NULL OR created_at < sqlc.narg(created_before))
+  AND ($1::BIGINT = 0 OR (created_at, id) < ($2::TIMESTAMP, $1::BIGINT))
+ORDER BY created_at DESC, id DESC LIMIT $3;
+
+-- name: SearchUsersBefore :many
+SELECT * FROM (
+    SELECT id, first_name, last_name, phone_number, email, picture, role, verified, phone_verified, suspended, created_at
+    FROM users
+    WHERE (sqlc.narg(role)::TEXT IS NULL OR role = sqlc.narg(role)::USER_ROLE)
+      AND (sqlc.narg(verified)::BOOLEAN IS NULL OR COALESCE(verified, FALSE) = sqlc.narg(verified))
+      AND (sqlc.narg(email_prefix)::TEXT IS NULL OR lower(email) LIKE lower(sqlc.narg(email_prefix)) || '%')
+      AND (sqlc.narg(created_after)::TIMESTAMP IS NULL OR created_at >= sqlc.narg(created_after))
+      AND (sqlc.narg(created_before)::TIMESTAMP IS NULL OR created_at < sqlc.narg(created_before))
+      AND (created_at, id) > ($2::TIMESTAMP, $1::BIGINT)
+    ORDER BY created_at, id LIMIT $3
+) AS page ORDER BY created_at DESC, id DESC;
 
 -- name: GetUserPicture :one
 SELECT picture FROM users WHERE id = $1;
@@ -25,17 +64,50 @@ INSERT INTO users (
 RETURNING *;
 
 -- name: UpdateUser :one
-UPDATE users SET first_name = $2, last_name = $3, phone_number = $4, email = $5 WHERE id = $1 Returning first_name, last_name, phone_number, email;
+UPDATE users SET first_name = $2, last_name = $3, phone_number = $4, phone_verified = phone_verified AND phone_number = $4, email = $5 WHERE id = $1 Returning first_name, last_name, phone_number, email;
 
 -- name: UpdateUserVerification :exec
 UPDATE users SET verified = $2 WHERE id = $1;
 
+-- name: UpdateUserPhoneVerification :exec
+UPDATE users SET phone_verified = TRUE WHERE id = $1 AND phone_number = $2;
+
+-- name: UpdateUserEmail :exec
+UPDATE users SET email = $2, verified = TRUE WHERE id = $1;
+
 -- name: UpdateUserPicture :exec
 UPDATE users SET picture = $2 WHERE id = $1;
 
 -- name: UpdateUserHash :exec
 UPDATE users SET hash = $2 where id = $1;
 
+-- name: UpdateUserRole :exec
+UPDATE users SET role = $2 WHERE id = $1;
+
+-- name: UpdateUserSuspension :exec
+UPDATE users SET suspended = $2 WHERE id = $1;
+
 -- name: DeleteUser :exec
 DELETE FROM users
 WHERE id = $1;
+
+-- name: DeactivateUser :exec
+UPDATE users SET deactivated_at = COALESCE(deactivated_at, CURRENT_TIMESTAMP) WHERE id = $1;
+
+-- name: RestoreUser :exec
+UPDATE users SET deactivated_at = NULL WHERE id = $1 AND deactivated_at IS NOT NULL AND NOT purging;
+
+-- name: ListDeactivatedUsers :many
+SELECT id FROM users
+WHERE deactivated_at < $1
+ORDER BY deactivated_at
+LIMIT $2;
+
+-- name: ClaimDeactivatedUser :one
+UPDATE users SET purging = TRUE
+WHERE id = $1 AND deactivated_at < $2
+RETURNING picture;
+
+-- name: PurgeDeactivatedUser :exec
+DELETE FROM users
+WHERE id = $1 AND purging;
\ No newline at end of file
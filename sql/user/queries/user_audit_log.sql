@@ -0,0 +1,7 @@
+-- name: CreateUserAuditLog :exec
+INSERT INTO user_audit_logs (user_id, actor_id, action, old_value, new_value, reason) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListUserAuditLogs :many
+SELECT id, user_id, actor_id, action, old_value, new_value, reason, created_at
+FROM user_audit_logs WHERE user_id = $1
+ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3;
@@ -0,0 +1,9 @@
+-- name: CreateUserIdentity :exec
+INSERT INTO user_identities (provider, subject, user_id) VALUES ($1, $2, $3);
+
+-- name: GetUserIdentityAuthInfo :one
+SELECT u.id, u.role, u.verified, u.phone_verified, u.suspended, u.deactivated_at IS NOT NULL AS deactivated, COALESCE(t.enabled, FALSE) AS two_factor_enabled
+FROM user_identities i
+JOIN users u ON u.id = i.user_id
+LEFT JOIN user_two_factor t ON t.user_id = u.id
+WHERE i.provider = $1 AND i.subject = $2;